@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/derailed/k9s/internal/auth"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const defaultLoginTimeout = 5 * time.Minute
+
+func authCmd() *cobra.Command {
+	command := cobra.Command{
+		Use:   "auth",
+		Short: "Manage oidc logins",
+		Long:  "Manage oidc logins for kubeconfig users authenticating via oidc exec plugins",
+	}
+	command.PersistentFlags().StringVar(k8sFlags.KubeConfig, "kubeconfig", "", "Path to the kubeconfig file to use")
+	command.PersistentFlags().StringVar(k8sFlags.Context, "context", "", "The name of the kubeconfig context to use")
+	command.PersistentFlags().StringVar(k8sFlags.AuthInfoName, "user", "", "The name of the kubeconfig user to use")
+	command.AddCommand(loginCmd(), logoutCmd())
+
+	return &command
+}
+
+func loginCmd() *cobra.Command {
+	var (
+		device  bool
+		listen  string
+		timeout time.Duration
+	)
+
+	command := cobra.Command{
+		Use:   "login",
+		Short: "Log into the current context oidc provider",
+		Long:  "Log into the current context oidc provider using a device code or browser login and cache the issued tokens",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return login(cmd.Context(), device, listen, timeout)
+		},
+	}
+	command.Flags().BoolVar(&device, "device", false, "Use a device code login. Default on headless boxes")
+	command.Flags().StringVar(&listen, "listen", auth.DefaultListenAddr, "Browser login redirect address")
+	command.Flags().DurationVar(&timeout, "timeout", defaultLoginTimeout, "Time to wait for the login to complete")
+
+	return &command
+}
+
+func logoutCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Evict the current context cached oidc tokens",
+		RunE: func(*cobra.Command, []string) error {
+			o, cache, err := currentOIDC()
+			if err != nil {
+				return err
+			}
+			if err := cache.Clear(o); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(out, color.Colorize("Logged out of "+o.IssuerURL, color.Cyan))
+
+			return nil
+		},
+	}
+}
+
+func login(ctx context.Context, device bool, listen string, timeout time.Duration) error {
+	o, cache, err := currentOIDC()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var tok *auth.Token
+	if device || o.DeviceFlow || isHeadless() {
+		tok, err = auth.DeviceLogin(ctx, o, func(uri, code string) {
+			_, _ = fmt.Fprintf(out, "To log into %s, visit %s and enter code %s\n", o.IssuerURL, color.Colorize(uri, color.Cyan), color.Colorize(code, color.Green))
+		})
+	} else {
+		tok, err = auth.BrowserLogin(ctx, o, listen, func(uri, _ string) {
+			_, _ = fmt.Fprintf(out, "Opening browser to log into %s. If it does not open, visit:\n%s\n", o.IssuerURL, color.Colorize(uri, color.Cyan))
+			openBrowser(uri)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if err := cache.Save(o, tok); err != nil {
+		return err
+	}
+	msg := "Logged in"
+	if !tok.Expiry.IsZero() {
+		msg += ". Token expires " + tok.Expiry.Local().Format(time.RFC1123)
+	}
+	_, _ = fmt.Fprintln(out, color.Colorize(msg, color.Green))
+
+	return nil
+}
+
+// currentOIDC returns the active kubeconfig user oidc exec plugin configuration.
+func currentOIDC() (*auth.OIDC, *auth.Cache, error) {
+	if err := config.InitLocs(); err != nil {
+		return nil, nil, err
+	}
+	cfg, err := client.NewConfig(k8sFlags).RESTConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	o, ok := auth.FromExec(cfg.ExecProvider)
+	if !ok {
+		return nil, nil, errors.New("current user does not authenticate via an oidc exec plugin")
+	}
+
+	return o, auth.NewCache(config.AppAuthDir), nil
+}
+
+func isHeadless() bool {
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		return false
+	}
+
+	return os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == ""
+}
+
+func openBrowser(uri string) {
+	bin := "sensible-browser"
+	switch runtime.GOOS {
+	case "darwin":
+		bin = "open"
+	case "windows":
+		bin = "explorer"
+	}
+	// Best effort, users may open the printed url instead.
+	_ = exec.Command(bin, uri).Start()
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/derailed/k9s/internal/auth"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
@@ -64,6 +65,7 @@ func init() {
 	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(authCmd())
 }
 
 // Execute root command.
@@ -131,6 +133,7 @@ func loadConfiguration() (*config.Config, error) {
 	slog.Info("🐶 K9s starting up...")
 
 	k8sCfg := client.NewConfig(k8sFlags)
+	k8sCfg.SetTokenCache(auth.NewCache(config.AppAuthDir))
 	k9sCfg := config.NewConfig(k8sCfg)
 	var errs error
 
@@ -255,6 +258,12 @@ func initK9sFlags() {
 		false,
 		"Sets write mode by overriding the readOnly configuration setting",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.DryRun,
+		"dry-run",
+		false,
+		"Sends delete, scale and patch operations as server side dry runs",
+	)
 	rootCmd.Flags().StringVar(
 		k9sFlags.ScreenDumpDir,
 		"screen-dump-dir",
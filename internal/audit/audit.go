@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// eventsBuffer tracks the number of pending events before recording blocks.
+const eventsBuffer = 1_000
+
+const (
+	// ViewAction tracks resource views.
+	ViewAction = "view"
+
+	// ExecAction tracks container shells.
+	ExecAction = "exec"
+
+	// EditAction tracks resource edits.
+	EditAction = "edit"
+
+	// DeleteAction tracks resource deletions.
+	DeleteAction = "delete"
+
+	// ScaleAction tracks replicas changes.
+	ScaleAction = "scale"
+
+	// RestartAction tracks rollout restarts.
+	RestartAction = "restart"
+
+	// RollbackAction tracks rollout rollbacks.
+	RollbackAction = "rollback"
+
+	// PauseAction tracks rollout pauses.
+	PauseAction = "pause"
+
+	// ResumeAction tracks rollout and cronjob resumes.
+	ResumeAction = "resume"
+
+	// SuspendAction tracks cronjob suspensions.
+	SuspendAction = "suspend"
+
+	// TriggerAction tracks cronjob manual triggers.
+	TriggerAction = "trigger"
+
+	// CloneAction tracks job re-runs.
+	CloneAction = "clone"
+
+	// SnapshotAction tracks claims snapshots.
+	SnapshotAction = "snapshot"
+
+	// RestoreAction tracks claims restored from a snapshot.
+	RestoreAction = "restore"
+
+	// RefreshAction tracks external secrets forced refreshes.
+	RefreshAction = "refresh"
+
+	// RenewAction tracks certificates manual renewals.
+	RenewAction = "renew"
+
+	// CordonAction tracks nodes cordons.
+	CordonAction = "cordon"
+
+	// UncordonAction tracks nodes uncordons.
+	UncordonAction = "uncordon"
+
+	// DrainAction tracks nodes drains.
+	DrainAction = "drain"
+
+	// LabelAction tracks labels changes.
+	LabelAction = "label"
+
+	// TaintAction tracks nodes taints changes.
+	TaintAction = "taint"
+
+	// UndoAction tracks reverted mutations.
+	UndoAction = "undo"
+
+	// RedoAction tracks re-applied mutations.
+	RedoAction = "redo"
+)
+
+const (
+	// OutcomeSucceeded tracks actions that completed.
+	OutcomeSucceeded = "succeeded"
+
+	// OutcomeFailed tracks actions that errored out.
+	OutcomeFailed = "failed"
+
+	// OutcomeDenied tracks actions that were not authorized.
+	OutcomeDenied = "denied"
+)
+
+// ErrDenied flags an action k9s refused to carry out.
+var ErrDenied = errors.New("action denied")
+
+// Event represents a user initiated action.
+type Event struct {
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+	Context  string    `json:"context"`
+	Cluster  string    `json:"cluster,omitempty"`
+	Action   string    `json:"action"`
+	Resource string    `json:"resource"`
+	Path     string    `json:"path,omitempty"`
+	DryRun   bool      `json:"dryRun,omitempty"`
+	Outcome  string    `json:"outcome,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// OutcomeFor returns an action outcome given its error if any.
+func OutcomeFor(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSucceeded
+	case errors.Is(err, ErrDenied), apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return OutcomeDenied
+	default:
+		return OutcomeFailed
+	}
+}
+
+// Sink represents an audit events destination.
+type Sink interface {
+	// Write records an event.
+	Write(Event) error
+
+	// Close releases the sink resources.
+	Close() error
+}
+
+// Logger dispatches audit events to a collection of sinks.
+type Logger struct {
+	sinks  []Sink
+	events chan Event
+	done   chan struct{}
+	once   sync.Once
+	mx     sync.RWMutex
+	closed bool
+}
+
+// New returns a logger for the given configuration or nil when auditing is disabled.
+func New(cfg config.Audit) (*Logger, error) {
+	if !cfg.Enabled || len(cfg.Sinks) == 0 {
+		return nil, nil
+	}
+
+	ss := make([]Sink, 0, len(cfg.Sinks))
+	for _, c := range cfg.Sinks {
+		s, err := newSink(c)
+		if err != nil {
+			for _, s := range ss {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+
+	return NewLogger(ss...), nil
+}
+
+// NewLogger returns a logger for the given sinks.
+func NewLogger(ss ...Sink) *Logger {
+	l := Logger{
+		sinks:  ss,
+		events: make(chan Event, eventsBuffer),
+		done:   make(chan struct{}),
+	}
+	go l.dispatch()
+
+	return &l
+}
+
+// Log records an event. Log blocks while the sinks catch up once the events
+// buffer is full so no event is lost.
+func (l *Logger) Log(e Event) {
+	if l == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+	if l.closed {
+		slog.Warn("Audit logger closed. Unable to record event",
+			slogs.Action, e.Action,
+			slogs.Path, e.Path,
+		)
+		return
+	}
+	l.events <- e
+}
+
+// Close flushes pending events and closes all sinks.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	var errs []error
+	l.once.Do(func() {
+		l.mx.Lock()
+		l.closed = true
+		close(l.events)
+		l.mx.Unlock()
+		<-l.done
+		for _, s := range l.sinks {
+			errs = append(errs, s.Close())
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+func (l *Logger) dispatch() {
+	defer close(l.done)
+
+	for e := range l.events {
+		for _, s := range l.sinks {
+			if err := s.Write(e); err != nil {
+				slog.Warn("Audit sink write failed", slogs.Error, err)
+			}
+		}
+	}
+}
+
+func newSink(c config.AuditSink) (Sink, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	switch c.Type {
+	case config.AuditFileSink:
+		return newFileSink(c.Path)
+	case config.AuditSyslogSink:
+		return newSyslogSink(c.Network, c.Address, c.Tag)
+	case config.AuditWebhookSink:
+		return newWebhookSink(c.URL, c.Headers), nil
+	default:
+		return nil, fmt.Errorf("unsupported audit sink type: %q", c.Type)
+	}
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNewDisabled(t *testing.T) {
+	uu := map[string]config.Audit{
+		"disabled": {Sinks: []config.AuditSink{{Type: config.AuditFileSink, Path: "/tmp/blee"}}},
+		"no-sinks": {Enabled: true},
+	}
+
+	for k := range uu {
+		cfg := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := audit.New(cfg)
+			require.NoError(t, err)
+			assert.Nil(t, l)
+			l.Log(audit.Event{Action: audit.ViewAction})
+			require.NoError(t, l.Close())
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	uu := map[string]struct {
+		sink config.AuditSink
+		err  string
+	}{
+		"unknown": {
+			sink: config.AuditSink{Type: "blee"},
+			err:  `unsupported audit sink type: "blee"`,
+		},
+		"file-no-path": {
+			sink: config.AuditSink{Type: config.AuditFileSink},
+			err:  "audit file sink requires a path",
+		},
+		"webhook-no-url": {
+			sink: config.AuditSink{Type: config.AuditWebhookSink},
+			err:  "audit webhook sink requires a url",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := audit.New(config.Audit{Enabled: true, Sinks: []config.AuditSink{u.sink}})
+			require.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "k9s.log")
+	l, err := audit.New(config.Audit{
+		Enabled: true,
+		Sinks:   []config.AuditSink{{Type: config.AuditFileSink, Path: path}},
+	})
+	require.NoError(t, err)
+
+	l.Log(audit.Event{User: "fred", Context: "ctx1", Action: audit.DeleteAction, Resource: "v1/pods", Path: "ns1/p1"})
+	l.Log(audit.Event{User: "fred", Context: "ctx1", Action: audit.ViewAction, Resource: "v1/pods"})
+	require.NoError(t, l.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var ee []audit.Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e audit.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		ee = append(ee, e)
+	}
+	require.Len(t, ee, 2)
+	assert.Equal(t, audit.DeleteAction, ee[0].Action)
+	assert.Equal(t, "ns1/p1", ee[0].Path)
+	assert.False(t, ee[0].Time.IsZero())
+	assert.Equal(t, audit.ViewAction, ee[1].Action)
+}
+
+func TestWebhookSink(t *testing.T) {
+	events := make(chan audit.Event, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer blee", r.Header.Get("Authorization"))
+		var e audit.Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		events <- e
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	l, err := audit.New(config.Audit{
+		Enabled: true,
+		Sinks: []config.AuditSink{{
+			Type:    config.AuditWebhookSink,
+			URL:     srv.URL,
+			Headers: map[string]string{"Authorization": "Bearer blee"},
+		}},
+	})
+	require.NoError(t, err)
+	l.Log(audit.Event{User: "fred", Action: audit.ExecAction, Resource: "v1/pods", Path: "ns1/p1"})
+	require.NoError(t, l.Close())
+
+	e := <-events
+	assert.Equal(t, audit.ExecAction, e.Action)
+	assert.Equal(t, "fred", e.User)
+}
+
+func TestLoggerLagging(t *testing.T) {
+	var s countSink
+	l := audit.NewLogger(&s)
+	const count = 2_500
+	for range count {
+		l.Log(audit.Event{Action: audit.ViewAction})
+	}
+	require.NoError(t, l.Close())
+	assert.Equal(t, count, s.count)
+
+	l.Log(audit.Event{Action: audit.ViewAction})
+	assert.Equal(t, count, s.count)
+}
+
+func TestOutcomeFor(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   string
+	}{
+		"ok": {
+			e: audit.OutcomeSucceeded,
+		},
+		"failed": {
+			err: errors.New("blee"),
+			e:   audit.OutcomeFailed,
+		},
+		"denied": {
+			err: fmt.Errorf("%w: read-only mode", audit.ErrDenied),
+			e:   audit.OutcomeDenied,
+		},
+		"forbidden": {
+			err: apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "p1", errors.New("blee")),
+			e:   audit.OutcomeDenied,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, audit.OutcomeFor(u.err))
+		})
+	}
+}
+
+type countSink struct {
+	count int
+}
+
+func (s *countSink) Write(audit.Event) error {
+	time.Sleep(time.Microsecond)
+	s.count++
+	return nil
+}
+
+func (*countSink) Close() error {
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+// fileSink appends audit events as json lines to a file.
+type fileSink struct {
+	file *os.File
+	mx   sync.Mutex
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, data.DefaultFileMod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{file: f}, nil
+}
+
+// Write records an event.
+func (s *fileSink) Write(e Event) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	_, err = s.file.Write(append(raw, '\n'))
+
+	return err
+}
+
+// Close closes the audit file.
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
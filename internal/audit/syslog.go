@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build !windows && !plan9
+
+package audit
+
+import (
+	"encoding/json"
+	"log/syslog"
+)
+
+// defaultSyslogTag tracks syslog entries emitter.
+const defaultSyslogTag = "k9s"
+
+// syslogSink forwards audit events as json to a syslog daemon.
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func newSyslogSink(network, address, tag string) (*syslogSink, error) {
+	if tag == "" {
+		tag = defaultSyslogTag
+	}
+	w, err := syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogSink{w: w}, nil
+}
+
+// Write records an event.
+func (s *syslogSink) Write(e Event) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return s.w.Info(string(raw))
+}
+
+// Close closes the syslog connection.
+func (s *syslogSink) Close() error {
+	return s.w.Close()
+}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build windows || plan9
+
+package audit
+
+import "errors"
+
+func newSyslogSink(string, string, string) (Sink, error) {
+	return nil, errors.New("audit syslog sink is not supported on this platform")
+}
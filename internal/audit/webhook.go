@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// webhookTimeout tracks the maximum time spent posting an event.
+const webhookTimeout = 5 * time.Second
+
+// webhookSink posts audit events as json to a remote endpoint.
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newWebhookSink(url string, headers map[string]string) *webhookSink {
+	return &webhookSink{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Write records an event.
+func (s *webhookSink) Write(e Event) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("audit webhook %s returned %s", s.url, resp.Status)
+	}
+
+	return nil
+}
+
+// Close releases idle connections.
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+
+	return nil
+}
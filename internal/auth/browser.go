@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultListenAddr tracks the default browser login redirect address.
+const DefaultListenAddr = "localhost:8000"
+
+const loginDoneMsg = "K9s login succeeded. You may now close this window."
+
+// BrowserLogin performs an oauth2 authorization code grant with PKCE via a local redirect.
+func BrowserLogin(ctx context.Context, o *OIDC, addr string, prompt Prompter) (*Token, error) {
+	p, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.AuthURL == "" {
+		return nil, fmt.Errorf("no authorization endpoint advertised by %s", o.IssuerURL)
+	}
+
+	l, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for login redirect on %s: %w", addr, err)
+	}
+	redirect := "http://" + addr
+	if strings.HasSuffix(addr, ":0") {
+		redirect = "http://" + l.Addr().String()
+	}
+	verifier, state := randomString(), randomString()
+	challenge := sha256.Sum256([]byte(verifier))
+	authURL := p.AuthURL + "?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {o.ClientID},
+		"redirect_uri":          {redirect},
+		"scope":                 {o.scope()},
+		"state":                 {state},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+
+	codes, errs := make(chan string, 1), make(chan error, 1)
+	srv := http.Server{
+		ReadHeaderTimeout: httpTimeout,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			switch {
+			case q.Get("state") != state:
+				http.Error(w, "invalid login state", http.StatusBadRequest)
+				return
+			case q.Get("error") != "":
+				http.Error(w, q.Get("error"), http.StatusUnauthorized)
+				errs <- fmt.Errorf("browser login failed: %w", (&tokenResponse{Error: q.Get("error"), ErrorDesc: q.Get("error_description")}).err())
+				return
+			}
+			_, _ = fmt.Fprintln(w, loginDoneMsg)
+			codes <- q.Get("code")
+		}),
+	}
+	go func() {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- err
+		}
+	}()
+	defer func() {
+		sctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = srv.Shutdown(sctx)
+	}()
+	prompt(authURL, "")
+
+	var code string
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("browser login timed out: %w", ctx.Err())
+	case err := <-errs:
+		return nil, err
+	case code = <-codes:
+	}
+	r, err := postForm(ctx, p.TokenURL, o.form(url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirect},
+		"code_verifier": {verifier},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if r.Error != "" {
+		return nil, fmt.Errorf("browser login failed: %w", r.err())
+	}
+
+	return r.token()
+}
+
+func randomString() string {
+	bb := make([]byte, 32)
+	_, _ = rand.Read(bb)
+
+	return base64.RawURLEncoding.EncodeToString(bb)
+}
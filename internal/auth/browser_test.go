@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserLogin(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	var redirect string
+	_, o := newIssuer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.Form.Get("grant_type"))
+		assert.Equal(t, "c1", r.Form.Get("code"))
+		assert.Equal(t, redirect, r.Form.Get("redirect_uri"))
+		assert.NotEmpty(t, r.Form.Get("code_verifier"))
+		writeJSON(w, http.StatusOK, tokenResponse{IDToken: makeJWT(exp)})
+	})
+
+	tok, err := BrowserLogin(context.Background(), o, "127.0.0.1:0", func(uri, _ string) {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		q := u.Query()
+		assert.Equal(t, "S256", q.Get("code_challenge_method"))
+		redirect = q.Get("redirect_uri")
+		go func() {
+			resp, err := http.Get(redirect + "?code=c1&state=" + url.QueryEscape(q.Get("state")))
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+	})
+	require.NoError(t, err)
+	assert.True(t, exp.Equal(tok.Expiry))
+}
+
+func TestBrowserLoginDenied(t *testing.T) {
+	_, o := newIssuer(t, func(http.ResponseWriter, *http.Request) {
+		t.Fatal("token endpoint should not be called")
+	})
+
+	_, err := BrowserLogin(context.Background(), o, "127.0.0.1:0", func(uri, _ string) {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		q := u.Query()
+		go func() {
+			resp, err := http.Get(q.Get("redirect_uri") + "?error=access_denied&state=" + url.QueryEscape(q.Get("state")))
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+	})
+	require.EqualError(t, err, "browser login failed: access_denied")
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	cacheDirMod  = 0o700
+	cacheFileMod = 0o600
+	expiryDelta  = 30 * time.Second
+)
+
+// ErrLoginRequired indicates no valid cached credentials are available.
+var ErrLoginRequired = errors.New("oidc login required. Run `k9s auth login`")
+
+// Token represents a cached oidc token set.
+type Token struct {
+	IDToken      string    `json:"id_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitzero"`
+}
+
+// Valid checks if the id token is still usable at a given time.
+func (t *Token) Valid(now time.Time) bool {
+	if t == nil || t.IDToken == "" {
+		return false
+	}
+
+	return t.Expiry.IsZero() || now.Add(expiryDelta).Before(t.Expiry)
+}
+
+// Cache tracks oidc tokens on disk keyed by provider client.
+type Cache struct {
+	dir    string
+	mx     sync.Mutex
+	tokens map[string]*Token
+}
+
+// NewCache returns a new token cache.
+func NewCache(dir string) *Cache {
+	return &Cache{
+		dir:    dir,
+		tokens: make(map[string]*Token),
+	}
+}
+
+func (c *Cache) path(o *OIDC) string {
+	return filepath.Join(c.dir, o.Key()+".json")
+}
+
+// Load returns a cached token if any.
+func (c *Cache) Load(o *OIDC) (*Token, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.load(o)
+}
+
+func (c *Cache) load(o *OIDC) (*Token, error) {
+	if t, ok := c.tokens[o.Key()]; ok {
+		return t, nil
+	}
+	bb, err := os.ReadFile(c.path(o))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrLoginRequired
+	}
+	if err != nil {
+		return nil, err
+	}
+	var t Token
+	if err := json.Unmarshal(bb, &t); err != nil {
+		return nil, fmt.Errorf("invalid cached token %s: %w", c.path(o), err)
+	}
+	c.tokens[o.Key()] = &t
+
+	return &t, nil
+}
+
+// Save caches a token.
+func (c *Cache) Save(o *OIDC, t *Token) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.save(o, t)
+}
+
+func (c *Cache) save(o *OIDC, t *Token) error {
+	if err := os.MkdirAll(c.dir, cacheDirMod); err != nil {
+		return err
+	}
+	bb, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.path(o), bb, cacheFileMod); err != nil {
+		return err
+	}
+	c.tokens[o.Key()] = t
+
+	return nil
+}
+
+// Clear evicts a cached token.
+func (c *Cache) Clear(o *OIDC) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.tokens, o.Key())
+	if err := os.Remove(c.path(o)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// IDToken returns a valid cached id token, refreshing it if expired.
+func (c *Cache) IDToken(ctx context.Context, o *OIDC) (string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	t, err := c.load(o)
+	if err != nil {
+		return "", err
+	}
+	if t.Valid(time.Now()) {
+		return t.IDToken, nil
+	}
+	if t.RefreshToken == "" {
+		return "", ErrLoginRequired
+	}
+	nt, err := refresh(ctx, o, t.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrLoginRequired, err)
+	}
+	if err := c.save(o, nt); err != nil {
+		return "", err
+	}
+
+	return nt.IDToken, nil
+}
+
+func refresh(ctx context.Context, o *OIDC, token string) (*Token, error) {
+	p, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := postForm(ctx, p.TokenURL, o.form(url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {token},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if r.Error != "" {
+		return nil, fmt.Errorf("token refresh failed: %w", r.err())
+	}
+	if r.RefreshToken == "" {
+		r.RefreshToken = token
+	}
+
+	return r.token()
+}
+
+// TokenRoundTripper injects cached id tokens as bearer credentials.
+type TokenRoundTripper struct {
+	oidc  *OIDC
+	cache *Cache
+	rt    http.RoundTripper
+}
+
+// NewTokenRoundTripper returns a new round tripper.
+func NewTokenRoundTripper(o *OIDC, c *Cache, rt http.RoundTripper) *TokenRoundTripper {
+	return &TokenRoundTripper{oidc: o, cache: c, rt: rt}
+}
+
+// RoundTrip authenticates a request using the cached id token.
+func (t *TokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.cache.IDToken(req.Context(), t.oidc)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tok)
+
+	return t.rt.RoundTrip(req)
+}
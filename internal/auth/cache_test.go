@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheSaveLoad(t *testing.T) {
+	c := NewCache(t.TempDir())
+	o := OIDC{IssuerURL: "https://issuer.example.com", ClientID: "k9s"}
+
+	_, err := c.Load(&o)
+	require.ErrorIs(t, err, ErrLoginRequired)
+
+	tok := Token{IDToken: "t1", RefreshToken: "r1", Expiry: time.Now().Add(time.Hour).Truncate(time.Second)}
+	require.NoError(t, c.Save(&o, &tok))
+	fi, err := os.Stat(c.path(&o))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(cacheFileMod), fi.Mode().Perm())
+
+	t1, err := NewCache(c.dir).Load(&o)
+	require.NoError(t, err)
+	assert.Equal(t, "t1", t1.IDToken)
+	assert.True(t, tok.Expiry.Equal(t1.Expiry))
+
+	require.NoError(t, c.Clear(&o))
+	_, err = c.Load(&o)
+	require.ErrorIs(t, err, ErrLoginRequired)
+}
+
+func TestCacheIDTokenRefresh(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	_, o := newIssuer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+		assert.Equal(t, "r1", r.Form.Get("refresh_token"))
+		writeJSON(w, http.StatusOK, tokenResponse{IDToken: makeJWT(exp)})
+	})
+	c := NewCache(t.TempDir())
+	require.NoError(t, c.Save(o, &Token{IDToken: "t1", RefreshToken: "r1", Expiry: time.Now().Add(-time.Minute)}))
+
+	tok, err := c.IDToken(context.Background(), o)
+	require.NoError(t, err)
+	assert.Equal(t, makeJWT(exp), tok)
+
+	t1, err := NewCache(c.dir).Load(o)
+	require.NoError(t, err)
+	assert.Equal(t, "r1", t1.RefreshToken)
+	assert.True(t, t1.Valid(time.Now()))
+}
+
+func TestCacheIDTokenExpired(t *testing.T) {
+	c := NewCache(t.TempDir())
+	o := OIDC{IssuerURL: "https://issuer.example.com", ClientID: "k9s"}
+	require.NoError(t, c.Save(&o, &Token{IDToken: "t1", Expiry: time.Now().Add(-time.Minute)}))
+
+	_, err := c.IDToken(context.Background(), &o)
+	require.ErrorIs(t, err, ErrLoginRequired)
+}
+
+func TestTokenRoundTripper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer t1", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewCache(t.TempDir())
+	o := OIDC{IssuerURL: "https://issuer.example.com", ClientID: "k9s"}
+	require.NoError(t, c.Save(&o, &Token{IDToken: "t1"}))
+
+	cl := http.Client{Transport: NewTokenRoundTripper(&o, c, http.DefaultTransport)}
+	resp, err := cl.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	deviceGrant        = "urn:ietf:params:oauth:grant-type:device_code"
+	defaultPollBackoff = 5 * time.Second
+	errPending         = "authorization_pending"
+	errSlowDown        = "slow_down"
+)
+
+// Prompter instructs users how to complete a login given a verification url and an optional user code.
+type Prompter func(uri, code string)
+
+// deviceResponse represents a device authorization response.
+type deviceResponse struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	CompleteURI     string `json:"verification_uri_complete"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// DeviceLogin performs an oauth2 device authorization grant.
+func DeviceLogin(ctx context.Context, o *OIDC, prompt Prompter) (*Token, error) {
+	p, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.DeviceURL == "" {
+		return nil, fmt.Errorf("oidc provider %s does not support device code logins", o.IssuerURL)
+	}
+	d, err := authorizeDevice(ctx, p.DeviceURL, o)
+	if err != nil {
+		return nil, err
+	}
+	uri := d.VerificationURI
+	if d.CompleteURI != "" {
+		uri = d.CompleteURI
+	}
+	prompt(uri, d.UserCode)
+
+	if d.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(d.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	backoff := defaultPollBackoff
+	if d.Interval > 0 {
+		backoff = time.Duration(d.Interval) * time.Second
+	}
+
+	return pollDevice(ctx, p.TokenURL, o, d.DeviceCode, backoff)
+}
+
+func authorizeDevice(ctx context.Context, u string, o *OIDC) (*deviceResponse, error) {
+	vv := o.form(url.Values{"scope": {o.scope()}})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(vv.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device authorization failed: %s", resp.Status)
+	}
+	var d deviceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
+		return nil, fmt.Errorf("invalid device authorization response: %w", err)
+	}
+	if d.DeviceCode == "" || d.VerificationURI == "" {
+		return nil, errors.New("incomplete device authorization response")
+	}
+
+	return &d, nil
+}
+
+func pollDevice(ctx context.Context, u string, o *OIDC, code string, backoff time.Duration) (*Token, error) {
+	vv := o.form(url.Values{
+		"grant_type":  {deviceGrant},
+		"device_code": {code},
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("device login timed out: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		r, err := postForm(ctx, u, vv)
+		if err != nil {
+			return nil, err
+		}
+		switch r.Error {
+		case "":
+			return r.token()
+		case errPending:
+		case errSlowDown:
+			backoff += defaultPollBackoff
+		default:
+			return nil, fmt.Errorf("device login failed: %w", r.err())
+		}
+	}
+}
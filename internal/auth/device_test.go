@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceLogin(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	var polls int
+	srv, o := newIssuer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, deviceGrant, r.Form.Get("grant_type"))
+		assert.Equal(t, "dc1", r.Form.Get("device_code"))
+		if polls++; polls < 2 {
+			writeJSON(w, http.StatusBadRequest, tokenResponse{Error: errPending})
+			return
+		}
+		writeJSON(w, http.StatusOK, tokenResponse{IDToken: makeJWT(exp), RefreshToken: "r1"})
+	})
+	srv.Config.Handler.(*http.ServeMux).HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "k9s", r.Form.Get("client_id"))
+		assert.Equal(t, "openid", r.Form.Get("scope"))
+		writeJSON(w, http.StatusOK, deviceResponse{
+			DeviceCode:      "dc1",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://issuer.example.com/device",
+			ExpiresIn:       60,
+			Interval:        1,
+		})
+	})
+
+	var uri, code string
+	tok, err := DeviceLogin(context.Background(), o, func(u, c string) {
+		uri, code = u, c
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://issuer.example.com/device", uri)
+	assert.Equal(t, "ABCD-EFGH", code)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, "r1", tok.RefreshToken)
+	assert.True(t, exp.Equal(tok.Expiry))
+}
+
+func TestPollDeviceDenied(t *testing.T) {
+	srv, o := newIssuer(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "access_denied", ErrorDesc: "nope"})
+	})
+
+	_, err := pollDevice(context.Background(), srv.URL+"/token", o, "dc1", time.Millisecond)
+	require.EqualError(t, err, "device login failed: access_denied: nope")
+}
+
+func TestPollDeviceTimeout(t *testing.T) {
+	srv, o := newIssuer(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusBadRequest, tokenResponse{Error: errPending})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := pollDevice(ctx, srv.URL+"/token", o, "dc1", 5*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
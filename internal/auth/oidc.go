@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	issuerFlag       = "--oidc-issuer-url"
+	clientIDFlag     = "--oidc-client-id"
+	clientSecretFlag = "--oidc-client-secret"
+	extraScopeFlag   = "--oidc-extra-scope"
+	grantTypeFlag    = "--grant-type"
+	deviceGrantType  = "device-code"
+	getTokenCmd      = "get-token"
+	openIDScope      = "openid"
+	discoveryPath    = "/.well-known/openid-configuration"
+	httpTimeout      = 30 * time.Second
+)
+
+var httpClient = &http.Client{Timeout: httpTimeout}
+
+// OIDC represents an oidc provider client configuration.
+type OIDC struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// DeviceFlow tracks if the plugin is configured for a device code grant.
+	DeviceFlow bool
+}
+
+// FromExec returns an oidc configuration given a kubelogin style exec plugin.
+func FromExec(e *api.ExecConfig) (*OIDC, bool) {
+	if e == nil || !slices.Contains(e.Args, getTokenCmd) {
+		return nil, false
+	}
+
+	var o OIDC
+	for i := 0; i < len(e.Args); i++ {
+		k, v, ok := strings.Cut(e.Args[i], "=")
+		if !ok && strings.HasPrefix(k, "--") && i+1 < len(e.Args) && !strings.HasPrefix(e.Args[i+1], "--") {
+			i++
+			v = e.Args[i]
+		}
+		switch k {
+		case issuerFlag:
+			o.IssuerURL = v
+		case clientIDFlag:
+			o.ClientID = v
+		case clientSecretFlag:
+			o.ClientSecret = v
+		case extraScopeFlag:
+			o.Scopes = append(o.Scopes, strings.Split(v, ",")...)
+		case grantTypeFlag:
+			o.DeviceFlow = v == deviceGrantType
+		}
+	}
+	if o.IssuerURL == "" || o.ClientID == "" {
+		return nil, false
+	}
+
+	return &o, true
+}
+
+// Key returns a unique key for the provider client and scopes.
+func (o *OIDC) Key() string {
+	ss := slices.Clone(o.Scopes)
+	slices.Sort(ss)
+	h := sha256.Sum256([]byte(strings.Join(append([]string{o.IssuerURL, o.ClientID}, ss...), "|")))
+
+	return hex.EncodeToString(h[:])
+}
+
+func (o *OIDC) scope() string {
+	return strings.Join(append([]string{openIDScope}, o.Scopes...), " ")
+}
+
+func (o *OIDC) form(vv url.Values) url.Values {
+	vv.Set("client_id", o.ClientID)
+	if o.ClientSecret != "" {
+		vv.Set("client_secret", o.ClientSecret)
+	}
+
+	return vv
+}
+
+// provider tracks an oidc provider endpoints.
+type provider struct {
+	AuthURL   string `json:"authorization_endpoint"`
+	TokenURL  string `json:"token_endpoint"`
+	DeviceURL string `json:"device_authorization_endpoint"`
+}
+
+func (o *OIDC) discover(ctx context.Context) (*provider, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(o.IssuerURL, "/")+discoveryPath, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc discovery failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc discovery failed for %s: %s", o.IssuerURL, resp.Status)
+	}
+	var p provider
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid oidc discovery document: %w", err)
+	}
+	if p.TokenURL == "" {
+		return nil, fmt.Errorf("no token endpoint advertised by %s", o.IssuerURL)
+	}
+
+	return &p, nil
+}
+
+// tokenResponse represents an oauth2 token endpoint response.
+type tokenResponse struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+func (r *tokenResponse) err() error {
+	if r.ErrorDesc != "" {
+		return fmt.Errorf("%s: %s", r.Error, r.ErrorDesc)
+	}
+
+	return errors.New(r.Error)
+}
+
+func (r *tokenResponse) token() (*Token, error) {
+	if r.IDToken == "" {
+		return nil, errors.New("no id_token returned by oidc provider")
+	}
+	t := Token{IDToken: r.IDToken, RefreshToken: r.RefreshToken}
+	if exp, err := JWTExpiry(r.IDToken); err == nil && !exp.IsZero() {
+		t.Expiry = exp
+	} else if r.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+
+	return &t, nil
+}
+
+func postForm(ctx context.Context, u string, vv url.Values) (*tokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(vv.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var r tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("invalid oidc response (%s): %w", resp.Status, err)
+	}
+	if r.Error == "" && resp.StatusCode != http.StatusOK {
+		r.Error = resp.Status
+	}
+
+	return &r, nil
+}
+
+// JWTExpiry extracts a jwt expiry claim without verifying its signature.
+// A zero time is returned when the token carries no expiry.
+func JWTExpiry(token string) (time.Time, error) {
+	tt := strings.Split(token, ".")
+	if len(tt) != 3 {
+		return time.Time{}, errors.New("not a jwt")
+	}
+	bb, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tt[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(bb, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(int64(claims.Exp), 0), nil
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestFromExec(t *testing.T) {
+	uu := map[string]struct {
+		e  *api.ExecConfig
+		o  *OIDC
+		ok bool
+	}{
+		"nil": {},
+		"not-oidc": {
+			e: &api.ExecConfig{Command: "aws", Args: []string{"eks", "get-token"}},
+		},
+		"kubelogin": {
+			e: &api.ExecConfig{
+				Command: "kubectl",
+				Args: []string{
+					"oidc-login",
+					"get-token",
+					"--oidc-issuer-url=https://issuer.example.com",
+					"--oidc-client-id", "k9s",
+					"--oidc-client-secret=s3cr3t",
+					"--oidc-extra-scope=email,groups",
+					"--oidc-extra-scope", "profile",
+					"--grant-type=device-code",
+				},
+			},
+			o: &OIDC{
+				IssuerURL:    "https://issuer.example.com",
+				ClientID:     "k9s",
+				ClientSecret: "s3cr3t",
+				Scopes:       []string{"email", "groups", "profile"},
+				DeviceFlow:   true,
+			},
+			ok: true,
+		},
+		"no-client": {
+			e: &api.ExecConfig{
+				Command: "kubelogin",
+				Args:    []string{"get-token", "--oidc-issuer-url=https://issuer.example.com"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, ok := FromExec(u.e)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.o, o)
+		})
+	}
+}
+
+func TestOIDCKey(t *testing.T) {
+	o1 := OIDC{IssuerURL: "https://a", ClientID: "k9s", Scopes: []string{"b", "a"}}
+	o2 := OIDC{IssuerURL: "https://a", ClientID: "k9s", Scopes: []string{"a", "b"}}
+	o3 := OIDC{IssuerURL: "https://a", ClientID: "fred"}
+
+	assert.Equal(t, o1.Key(), o2.Key())
+	assert.NotEqual(t, o1.Key(), o3.Key())
+	assert.Equal(t, "openid b a", o1.scope())
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Unix(1_900_000_000, 0)
+	uu := map[string]struct {
+		tok string
+		exp time.Time
+		err bool
+	}{
+		"exp": {
+			tok: makeJWT(exp),
+			exp: exp,
+		},
+		"no-exp": {
+			tok: makeJWT(time.Time{}),
+		},
+		"opaque": {
+			tok: "blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := JWTExpiry(u.tok)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, u.exp.Equal(e))
+		})
+	}
+}
+
+// Helpers...
+
+func makeJWT(exp time.Time) string {
+	claims := `{"sub":"fred"}`
+	if !exp.IsZero() {
+		claims = fmt.Sprintf(`{"sub":"fred","exp":%d}`, exp.Unix())
+	}
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+// newIssuer returns a fake oidc provider serving discovery and a token endpoint.
+func newIssuer(t *testing.T, token http.HandlerFunc) (srv *httptest.Server, o *OIDC) {
+	mux := http.NewServeMux()
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(provider{
+			AuthURL:   srv.URL + "/auth",
+			TokenURL:  srv.URL + "/token",
+			DeviceURL: srv.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/token", token)
+
+	return srv, &OIDC{IssuerURL: srv.URL, ClientID: "k9s"}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cast_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/cast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s1"+cast.Ext)
+	r, err := cast.NewRecorder(path, "fred/p1", 120, 40)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("$ ls\r\n"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("blee \x1b[32mduh\x1b[0m\r\n"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	rec, err := cast.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rec.Header.Version)
+	assert.Equal(t, 120, rec.Header.Width)
+	assert.Equal(t, 40, rec.Header.Height)
+	assert.Equal(t, "fred/p1", rec.Header.Title)
+	require.Len(t, rec.Frames, 2)
+	assert.Equal(t, "blee \x1b[32mduh\x1b[0m\r\n", rec.Frames[1].Data)
+
+	var out bytes.Buffer
+	require.NoError(t, rec.Play(context.Background(), &out, 1, time.Millisecond))
+	assert.Equal(t, "$ ls\r\nblee \x1b[32mduh\x1b[0m\r\n", out.String())
+}
+
+func TestRead(t *testing.T) {
+	uu := map[string]struct {
+		raw    string
+		frames int
+		dur    time.Duration
+		err    string
+	}{
+		"ok": {
+			raw: `{"version": 2, "width": 80, "height": 24}
+[0.5, "o", "a"]
+[1.0, "i", "b"]
+[1.5, "o", "c"]`,
+			frames: 2,
+			dur:    1500 * time.Millisecond,
+		},
+		"empty": {
+			err: "empty recording",
+		},
+		"version": {
+			raw: `{"version": 1}`,
+			err: "unsupported recording version 1",
+		},
+		"bad-event": {
+			raw: `{"version": 2}
+[0.5, "o"]`,
+			err: "invalid recording event on line 2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rec, err := cast.Read(strings.NewReader(u.raw))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, rec.Frames, u.frames)
+			assert.Equal(t, u.dur, rec.Duration())
+		})
+	}
+}
+
+func TestPlayCanceled(t *testing.T) {
+	rec := cast.Recording{Frames: []cast.Frame{
+		{Time: 0, Data: "a"},
+		{Time: time.Hour, Data: "b"},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var out bytes.Buffer
+	assert.ErrorIs(t, rec.Play(ctx, &out, 1, 0), context.DeadlineExceeded)
+	assert.Equal(t, "a", out.String())
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cast
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Frame represents a recorded output frame.
+type Frame struct {
+	// Time is the frame offset from the recording start.
+	Time time.Duration
+
+	// Data is the frame output.
+	Data string
+}
+
+// Recording represents a loaded session recording.
+type Recording struct {
+	Header Header
+	Frames []Frame
+}
+
+// Duration returns the recording duration.
+func (r *Recording) Duration() time.Duration {
+	if len(r.Frames) == 0 {
+		return 0
+	}
+
+	return r.Frames[len(r.Frames)-1].Time
+}
+
+// Load reads a recording file.
+func Load(path string) (*Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read decodes a recording. Non output events are skipped.
+func Read(r io.Reader) (*Recording, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("empty recording")
+	}
+
+	var rec Recording
+	if err := json.Unmarshal(s.Bytes(), &rec.Header); err != nil {
+		return nil, fmt.Errorf("invalid recording header: %w", err)
+	}
+	if rec.Header.Version != version {
+		return nil, fmt.Errorf("unsupported recording version %d", rec.Header.Version)
+	}
+	for line := 2; s.Scan(); line++ {
+		var (
+			ev   []any
+			secs float64
+			ok   bool
+		)
+		if err := json.Unmarshal(s.Bytes(), &ev); err != nil || len(ev) != 3 {
+			return nil, fmt.Errorf("invalid recording event on line %d", line)
+		}
+		if secs, ok = ev[0].(float64); !ok {
+			return nil, fmt.Errorf("invalid recording event time on line %d", line)
+		}
+		if kind, _ := ev[1].(string); kind != outputEvent {
+			continue
+		}
+		data, _ := ev[2].(string)
+		rec.Frames = append(rec.Frames, Frame{
+			Time: time.Duration(secs * float64(time.Second)),
+			Data: data,
+		})
+	}
+
+	return &rec, s.Err()
+}
+
+// Play replays a recording. Idle pauses are capped to maxIdle when set.
+func (r *Recording) Play(ctx context.Context, w io.Writer, speed float64, maxIdle time.Duration) error {
+	if speed <= 0 {
+		speed = 1
+	}
+	var last time.Duration
+	for _, f := range r.Frames {
+		pause := f.Time - last
+		last = f.Time
+		if maxIdle > 0 && pause > maxIdle {
+			pause = maxIdle
+		}
+		if pause > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(float64(pause) / speed)):
+			}
+		}
+		if _, err := io.WriteString(w, f.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package cast records and replays terminal sessions using the asciicast v2 format.
+package cast
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// Ext tracks session recordings file extension.
+	Ext = ".cast"
+
+	version     = 2
+	outputEvent = "o"
+)
+
+// Header represents a recording header.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder records terminal output frames with their timing.
+type Recorder struct {
+	file  *os.File
+	w     *bufio.Writer
+	start time.Time
+	mx    sync.Mutex
+}
+
+// NewRecorder creates a new recording file.
+func NewRecorder(path, title string, width, height int) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	r := Recorder{
+		file:  f,
+		w:     bufio.NewWriter(f),
+		start: time.Now(),
+	}
+	h := Header{
+		Version:   version,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.start.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": os.Getenv("TERM"), "SHELL": os.Getenv("SHELL")},
+	}
+	if err := json.NewEncoder(r.w).Encode(h); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Write records an output frame.
+func (r *Recorder) Write(bb []byte) (int, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	elapsed := time.Since(r.start).Seconds()
+	raw, err := json.Marshal([]any{elapsed, outputEvent, string(bb)})
+	if err != nil {
+		return 0, err
+	}
+	if _, err := r.w.Write(append(raw, '\n')); err != nil {
+		return 0, err
+	}
+
+	return len(bb), nil
+}
+
+// Close flushes and closes the recording.
+func (r *Recorder) Close() error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		_ = r.file.Close()
+		return fmt.Errorf("recording flush failed: %w", err)
+	}
+
+	return r.file.Close()
+}
@@ -340,6 +340,10 @@ func (a *APIClient) Config() *Config {
 
 // HasMetrics checks if the cluster supports metrics.
 func (a *APIClient) HasMetrics() bool {
+	if p := a.metricsProvider(); p != nil {
+		return p.Available()
+	}
+
 	return a.supportsMetricsResources() == nil
 }
 
@@ -566,7 +570,9 @@ func (a *APIClient) SwitchContext(name string) error {
 	}
 	a.reset()
 	ResetMetrics()
-	a.config = NewConfig(a.config.flags)
+	cfg := NewConfig(a.config.flags)
+	cfg.tokens = a.config.tokens
+	a.config = cfg
 	if !a.CheckConnectivity() {
 		slog.Warn("SwitchContext: connectivity check failed", slogs.Context, name)
 	}
@@ -577,6 +583,22 @@ func (a *APIClient) SwitchContext(name string) error {
 	return a.invalidateCache()
 }
 
+// ReloadConfig reloads the kubeconfig files for the active context.
+func (a *APIClient) ReloadConfig() error {
+	cfg, err := a.config.Reload()
+	if err != nil {
+		return err
+	}
+	slog.Debug("Reloading kubeconfig", slogs.Context, a.ActiveContext())
+	a.reset()
+	a.config = cfg
+	if !a.CheckConnectivity() {
+		slog.Warn("ReloadConfig: connectivity check failed", slogs.Context, a.ActiveContext())
+	}
+
+	return a.invalidateCache()
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
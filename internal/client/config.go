@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal/auth"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -28,9 +30,12 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
-	proxy func(*http.Request) (*url.URL, error)
+	flags  *genericclioptions.ConfigFlags
+	mx     sync.RWMutex
+	proxy  func(*http.Request) (*url.URL, error)
+	probe  *execProbe
+	tokens *auth.Cache
+	health healthProber
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
@@ -61,6 +66,14 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.proxy != nil {
 		cfg.Proxy = c.proxy
 	}
+	if o, ok := auth.FromExec(cfg.ExecProvider); ok && c.tokens != nil {
+		if _, err := c.tokens.Load(o); err == nil {
+			cfg.ExecProvider = nil
+			cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+				return auth.NewTokenRoundTripper(o, c.tokens, rt)
+			})
+		}
+	}
 
 	return cfg, nil
 }
@@ -87,8 +100,44 @@ func (c *Config) SwitchContext(name string) error {
 		return fmt.Errorf("context %q does not exist", name)
 	}
 	// !!BOZO!! Do you need to reset the flags?
+	c.flags = c.contextFlags(name, &ct.Cluster)
+
+	return nil
+}
+
+// Reload returns a configuration reloaded from the kubeconfig files for the active context.
+func (c *Config) Reload() (*Config, error) {
+	name, err := c.CurrentContextName()
+	if err != nil {
+		return nil, err
+	}
+	cfg := Config{
+		flags:  c.contextFlags(name, nil),
+		proxy:  c.proxy,
+		tokens: c.tokens,
+	}
+	ct, err := cfg.GetContext(name)
+	if err != nil {
+		return nil, fmt.Errorf("context %q no longer exists", name)
+	}
+	cfg.flags.ClusterName = &ct.Cluster
+
+	return &cfg, nil
+}
+
+// KubeConfigFiles returns the kubeconfig files backing this configuration.
+func (c *Config) KubeConfigFiles() []string {
+	if isSet(c.flags.KubeConfig) {
+		return []string{*c.flags.KubeConfig}
+	}
+
+	return clientcmd.NewDefaultClientConfigLoadingRules().GetLoadingPrecedence()
+}
+
+// contextFlags returns fresh config flags targeting a given context.
+func (c *Config) contextFlags(name string, cluster *string) *genericclioptions.ConfigFlags {
 	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
-	flags.Context, flags.ClusterName = &name, &ct.Cluster
+	flags.Context, flags.ClusterName = &name, cluster
 	flags.Namespace = c.flags.Namespace
 	flags.Timeout = c.flags.Timeout
 	flags.KubeConfig = c.flags.KubeConfig
@@ -98,9 +147,21 @@ func (c *Config) SwitchContext(name string) error {
 	flags.Insecure = c.flags.Insecure
 	flags.BearerToken = c.flags.BearerToken
 
-	c.flags = flags
+	return flags
+}
 
-	return nil
+// ForContext returns a standalone configuration targeting a given context.
+func (c *Config) ForContext(name string) (*Config, error) {
+	cfg := Config{
+		flags:  c.flags,
+		proxy:  c.proxy,
+		tokens: c.tokens,
+	}
+	if err := cfg.SwitchContext(name); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
 }
 
 func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
@@ -117,6 +178,9 @@ func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
 	flags.Namespace = &ns
 	flags.Timeout = c.Flags().Timeout
 	flags.KubeConfig = c.Flags().KubeConfig
+	flags.Impersonate = c.Flags().Impersonate
+	flags.ImpersonateGroup = c.Flags().ImpersonateGroup
+	flags.ImpersonateUID = c.Flags().ImpersonateUID
 
 	return flags, nil
 }
@@ -193,6 +257,11 @@ func (c *Config) GetContext(n string) (*api.Context, error) {
 	return nil, fmt.Errorf("getcontext - invalid context specified: %q", n)
 }
 
+// SetTokenCache sets the cache used to authenticate oidc exec plugin users.
+func (c *Config) SetTokenCache(tc *auth.Cache) {
+	c.tokens = tc
+}
+
 // SetProxy sets the proxy function.
 func (c *Config) SetProxy(proxy func(*http.Request) (*url.URL, error)) {
 	c.proxy = proxy
@@ -286,6 +355,20 @@ func (c *Config) ImpersonateGroups() (string, error) {
 	return "", errors.New("no groups set")
 }
 
+// Impersonate sets the user and groups to act as. A blank user reverts to the kubeconfig identity.
+// The change takes effect on the next context switch.
+func (c *Config) Impersonate(user string, groups []string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	var uid string
+	gg := slices.Clone(groups)
+	if user == "" {
+		gg = nil
+	}
+	c.flags.Impersonate, c.flags.ImpersonateGroup, c.flags.ImpersonateUID = &user, &gg, &uid
+}
+
 // ImpersonateUser retrieves the active user name if set on the CLI.
 func (c *Config) ImpersonateUser() (string, error) {
 	if isSet(c.flags.Impersonate) {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -214,6 +215,98 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigForContext(t *testing.T) {
+	cluster := "duh"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &cluster,
+	}
+
+	cfg := client.NewConfig(&flags)
+	fork, err := cfg.ForContext("blee")
+	require.NoError(t, err)
+	ctx, err := fork.CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "blee", ctx)
+	ctx, err = cfg.CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "duh", ctx)
+
+	_, err = cfg.ForContext("toast")
+	require.Error(t, err)
+}
+
+func TestConfigReload(t *testing.T) {
+	kc := filepath.Join(t.TempDir(), "config")
+	writeKubeConfig := func(token string, cc ...string) {
+		var ctxs string
+		for _, c := range cc {
+			ctxs += "- name: " + c + "\n  context:\n    cluster: c1\n    user: u1\n"
+		}
+		raw := "apiVersion: v1\nkind: Config\ncurrent-context: fred\n" +
+			"clusters:\n- name: c1\n  cluster:\n    server: https://localhost:6443\n" +
+			"users:\n- name: u1\n  user:\n    token: " + token + "\n" +
+			"contexts:\n" + ctxs
+		require.NoError(t, os.WriteFile(kc, []byte(raw), 0600))
+	}
+	writeKubeConfig("t1", "fred")
+	ct := "fred"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kc, Context: &ct})
+	assert.Equal(t, []string{kc}, cfg.KubeConfigFiles())
+	key, err := cfg.CredentialKey()
+	require.NoError(t, err)
+	cc, err := cfg.ContextNames()
+	require.NoError(t, err)
+	assert.Len(t, cc, 1)
+
+	writeKubeConfig("t2", "fred", "blee")
+	fresh, err := cfg.Reload()
+	require.NoError(t, err)
+	cc, err = fresh.ContextNames()
+	require.NoError(t, err)
+	assert.Len(t, cc, 2)
+	n, err := fresh.CurrentContextName()
+	require.NoError(t, err)
+	assert.Equal(t, "fred", n)
+	key1, err := fresh.CredentialKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, key1)
+
+	writeKubeConfig("t2", "blee")
+	_, err = fresh.Reload()
+	assert.EqualError(t, err, `context "fred" no longer exists`)
+}
+
+func TestConfigImpersonate(t *testing.T) {
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	cfg.Impersonate("system:serviceaccount:ns1:fred", []string{"devs", "ops"})
+	require.NoError(t, cfg.SwitchContext("blee"))
+	u, err := cfg.CurrentUserName()
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:ns1:fred", u)
+	gg, err := cfg.ImpersonateGroups()
+	require.NoError(t, err)
+	assert.Equal(t, "devs,ops", gg)
+	rc, err := cfg.RESTConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:ns1:fred", rc.Impersonate.UserName)
+	assert.Equal(t, []string{"devs", "ops"}, rc.Impersonate.Groups)
+
+	cfg.Impersonate("", []string{"devs"})
+	require.NoError(t, cfg.SwitchContext("blee"))
+	_, err = cfg.ImpersonateUser()
+	require.Error(t, err)
+	_, err = cfg.ImpersonateGroups()
+	require.Error(t, err)
+	rc, err = cfg.RESTConfig()
+	require.NoError(t, err)
+	assert.Empty(t, rc.Impersonate.UserName)
+}
+
 func TestConfigAccess(t *testing.T) {
 	context := "duh"
 	flags := genericclioptions.ConfigFlags{
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/auth"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// CredentialToken tracks bearer token credentials.
+	CredentialToken = "token"
+
+	// CredentialOIDC tracks oidc auth provider credentials.
+	CredentialOIDC = "oidc"
+
+	// CredentialExec tracks exec plugin credentials.
+	CredentialExec = "exec"
+
+	// CredentialCert tracks client certificate credentials.
+	CredentialCert = "cert"
+
+	execProbeTimeout = 10 * time.Second
+	execProbeTTL     = 5 * time.Minute
+	execRenewMargin  = time.Minute
+)
+
+// Credential tracks the active kubeconfig credential validity.
+type Credential struct {
+	// Kind represents the credential type.
+	Kind string
+
+	// Expiry tracks when the credential expires. Zero if unknown or never.
+	Expiry time.Time
+
+	// Err tracks a credential retrieval failure if any.
+	Err error
+}
+
+// Expires checks if the credential carries an expiry.
+func (c *Credential) Expires() bool {
+	return !c.Expiry.IsZero()
+}
+
+// Remaining returns the credential validity left at a given time.
+func (c *Credential) Remaining(now time.Time) time.Duration {
+	if !c.Expires() {
+		return 0
+	}
+
+	return c.Expiry.Sub(now)
+}
+
+// execProbe caches an exec plugin credential probe.
+type execProbe struct {
+	key   string
+	cred  Credential
+	until time.Time
+}
+
+// Credential returns the active user credential kind and expiry.
+// Exec plugins are probed non interactively and cached till their credentials near expiry.
+func (c *Config) Credential() (*Credential, error) {
+	if isSet(c.flags.BearerToken) {
+		return tokenCredential(CredentialToken, *c.flags.BearerToken), nil
+	}
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	n, err := c.currentAuthName(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	auth, ok := cfg.AuthInfos[n]
+	if !ok {
+		return nil, fmt.Errorf("unable to locate user %q", n)
+	}
+
+	switch {
+	case auth.Exec != nil:
+		if tok, ok := c.cachedToken(auth.Exec); ok {
+			return tokenCredential(CredentialOIDC, tok), nil
+		}
+		return c.execCredential(n, auth), nil
+	case auth.AuthProvider != nil && auth.AuthProvider.Name == CredentialOIDC:
+		return tokenCredential(CredentialOIDC, auth.AuthProvider.Config["id-token"]), nil
+	case auth.Token != "":
+		return tokenCredential(CredentialToken, auth.Token), nil
+	case auth.TokenFile != "":
+		bb, err := os.ReadFile(auth.TokenFile)
+		if err != nil {
+			return &Credential{Kind: CredentialToken, Err: err}, nil
+		}
+		return tokenCredential(CredentialToken, strings.TrimSpace(string(bb))), nil
+	case len(auth.ClientCertificateData) > 0:
+		return certCredential(auth.ClientCertificateData), nil
+	case auth.ClientCertificate != "":
+		bb, _ := os.ReadFile(auth.ClientCertificate)
+		return certCredential(bb), nil
+	default:
+		return &Credential{}, nil
+	}
+}
+
+// CredentialKey returns a fingerprint of the active user credential settings.
+func (c *Config) CredentialKey() (string, error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return "", err
+	}
+	n, err := c.currentAuthName(&cfg)
+	if err != nil {
+		return "", err
+	}
+	bb, err := json.Marshal(cfg.AuthInfos[n])
+	if err != nil {
+		return "", err
+	}
+	if isSet(c.flags.BearerToken) {
+		bb = append(bb, *c.flags.BearerToken...)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(bb)), nil
+}
+
+// currentAuthName returns the kubeconfig user entry backing the active context.
+func (c *Config) currentAuthName(cfg *api.Config) (string, error) {
+	if isSet(c.flags.AuthInfoName) {
+		return *c.flags.AuthInfoName, nil
+	}
+	current := cfg.CurrentContext
+	if isSet(c.flags.Context) {
+		current = *c.flags.Context
+	}
+	ct, ok := cfg.Contexts[current]
+	if !ok {
+		return "", fmt.Errorf("invalid current context specified: %q", current)
+	}
+
+	return ct.AuthInfo, nil
+}
+
+// interactiveAuth checks if the active user authenticates via a plugin k9s
+// holds no cached token for, thus dialing the cluster may run a login flow.
+func (c *Config) interactiveAuth() (bool, error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return false, err
+	}
+	n, err := c.currentAuthName(&cfg)
+	if err != nil {
+		return false, err
+	}
+	ai, ok := cfg.AuthInfos[n]
+	if !ok {
+		return false, nil
+	}
+	switch {
+	case ai.Exec != nil:
+		o, ok := auth.FromExec(ai.Exec)
+		if !ok || c.tokens == nil {
+			return true, nil
+		}
+		_, err := c.tokens.Load(o)
+		return err != nil, nil
+	case ai.AuthProvider != nil:
+		return ai.AuthProvider.Name != CredentialOIDC || ai.AuthProvider.Config["id-token"] == "", nil
+	default:
+		return false, nil
+	}
+}
+
+// cachedToken returns an oidc exec plugin token cached by k9s logins if any.
+func (c *Config) cachedToken(e *api.ExecConfig) (string, bool) {
+	o, ok := auth.FromExec(e)
+	if !ok || c.tokens == nil {
+		return "", false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), execProbeTimeout)
+	defer cancel()
+	tok, err := c.tokens.IDToken(ctx, o)
+	if err != nil {
+		return "", false
+	}
+
+	return tok, true
+}
+
+func (c *Config) execCredential(name string, auth *api.AuthInfo) *Credential {
+	key := name + "|" + auth.Exec.Command + "|" + strings.Join(auth.Exec.Args, " ")
+	c.mx.RLock()
+	p := c.probe
+	c.mx.RUnlock()
+	if p != nil && p.key == key && time.Now().Before(p.until) {
+		cred := p.cred
+		return &cred
+	}
+
+	cred := probeExec(auth)
+	until := time.Now().Add(execProbeTTL)
+	if renew := cred.Expiry.Add(-execRenewMargin); cred.Expires() && renew.Before(until) {
+		until = renew
+	}
+	c.mx.Lock()
+	c.probe = &execProbe{key: key, cred: *cred, until: until}
+	c.mx.Unlock()
+
+	return cred
+}
+
+func probeExec(ai *api.AuthInfo) *Credential {
+	cred := Credential{Kind: CredentialExec}
+	if ai.Exec.InteractiveMode == api.AlwaysExecInteractiveMode {
+		return &cred
+	}
+
+	command := ai.Exec.Command
+	if !filepath.IsAbs(command) && strings.ContainsRune(command, filepath.Separator) && ai.LocationOfOrigin != "" {
+		command = filepath.Join(filepath.Dir(ai.LocationOfOrigin), command)
+	}
+	info, err := json.Marshal(map[string]any{
+		"apiVersion": ai.Exec.APIVersion,
+		"kind":       "ExecCredential",
+		"spec":       map[string]any{"interactive": false},
+	})
+	if err != nil {
+		cred.Err = err
+		return &cred
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), execProbeTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, command, ai.Exec.Args...)
+	cmd.Env = append(os.Environ(), "KUBERNETES_EXEC_INFO="+string(info))
+	for _, e := range ai.Exec.Env {
+		cmd.Env = append(cmd.Env, e.Name+"="+e.Value)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		cred.Err = fmt.Errorf("exec plugin %s failed: %w %s", filepath.Base(command), err, strings.TrimSpace(stderr.String()))
+		return &cred
+	}
+	var ec clientauthv1.ExecCredential
+	if err := json.Unmarshal(out, &ec); err != nil {
+		cred.Err = fmt.Errorf("invalid exec plugin %s credential: %w", filepath.Base(command), err)
+		return &cred
+	}
+	if ec.Status == nil {
+		cred.Err = fmt.Errorf("exec plugin %s returned no credential", filepath.Base(command))
+		return &cred
+	}
+	switch {
+	case ec.Status.ExpirationTimestamp != nil:
+		cred.Expiry = ec.Status.ExpirationTimestamp.Time
+	case ec.Status.Token != "":
+		cred.Expiry, _ = auth.JWTExpiry(ec.Status.Token)
+	case ec.Status.ClientCertificateData != "":
+		cred.Expiry, _ = certExpiry([]byte(ec.Status.ClientCertificateData))
+	}
+
+	return &cred
+}
+
+func tokenCredential(kind, token string) *Credential {
+	cred := Credential{Kind: kind}
+	if token == "" {
+		cred.Err = errors.New("no token found")
+		return &cred
+	}
+	// Opaque tokens carry no expiry.
+	cred.Expiry, _ = auth.JWTExpiry(token)
+
+	return &cred
+}
+
+func certCredential(bb []byte) *Credential {
+	cred := Credential{Kind: CredentialCert}
+	// Unparsable certificates surface as connection errors instead.
+	cred.Expiry, _ = certExpiry(bb)
+
+	return &cred
+}
+
+func certExpiry(bb []byte) (time.Time, error) {
+	b, _ := pem.Decode(bb)
+	if b == nil {
+		return time.Time{}, errors.New("no pem certificate found")
+	}
+	cert, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
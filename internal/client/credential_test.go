@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/auth"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestConfigCredential(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	dir := t.TempDir()
+
+	plugin := filepath.Join(dir, "plugin.sh")
+	require.NoError(t, os.WriteFile(plugin, fmt.Appendf(nil, `#!/bin/sh
+echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"fred","expirationTimestamp":%q}}'
+`, exp.Format(time.RFC3339)), 0o700))
+	cfgPath := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(cfgPath, fmt.Appendf(nil, `apiVersion: v1
+kind: Config
+clusters:
+- name: c1
+  cluster:
+    server: https://localhost:6443
+contexts:
+- name: jwt
+  context: {cluster: c1, user: jwt}
+- name: opaque
+  context: {cluster: c1, user: opaque}
+- name: cert
+  context: {cluster: c1, user: cert}
+- name: exec
+  context: {cluster: c1, user: exec}
+- name: none
+  context: {cluster: c1, user: none}
+current-context: jwt
+users:
+- name: jwt
+  user:
+    token: %s
+- name: opaque
+  user:
+    token: blee
+- name: cert
+  user:
+    client-certificate-data: %s
+- name: exec
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: %s
+      interactiveMode: Never
+- name: none
+  user: {}
+`, makeJWT(exp), base64.StdEncoding.EncodeToString(makeCert(t, exp)), plugin), 0o600))
+
+	uu := map[string]struct {
+		kind   string
+		expiry time.Time
+	}{
+		"jwt": {
+			kind:   client.CredentialToken,
+			expiry: exp,
+		},
+		"opaque": {
+			kind: client.CredentialToken,
+		},
+		"cert": {
+			kind:   client.CredentialCert,
+			expiry: exp,
+		},
+		"exec": {
+			kind:   client.CredentialExec,
+			expiry: exp,
+		},
+		"none": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ct := k
+			flags := genericclioptions.ConfigFlags{
+				KubeConfig: &cfgPath,
+				Context:    &ct,
+			}
+			cred, err := client.NewConfig(&flags).Credential()
+			require.NoError(t, err)
+			require.NoError(t, cred.Err)
+			assert.Equal(t, u.kind, cred.Kind)
+			assert.True(t, u.expiry.Equal(cred.Expiry), "expected %v but got %v", u.expiry, cred.Expiry)
+		})
+	}
+}
+
+func TestCredentialRemaining(t *testing.T) {
+	now := time.Now()
+	cred := client.Credential{Expiry: now.Add(time.Minute)}
+	assert.True(t, cred.Expires())
+	assert.Equal(t, time.Minute, cred.Remaining(now))
+
+	var none client.Credential
+	assert.False(t, none.Expires())
+	assert.Zero(t, none.Remaining(now))
+}
+
+// Helpers...
+
+func makeJWT(exp time.Time) string {
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		enc.EncodeToString(fmt.Appendf(nil, `{"sub":"fred","exp":%d}`, exp.Unix())) + ".sig"
+}
+
+func makeCert(t *testing.T, exp time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     exp,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestConfigTokenCache(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(cfgPath, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: c1
+  cluster:
+    server: https://localhost:6443
+contexts:
+- name: oidc
+  context: {cluster: c1, user: oidc}
+current-context: oidc
+users:
+- name: oidc
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: kubectl
+      args: [oidc-login, get-token, --oidc-issuer-url=https://issuer.example.com, --oidc-client-id=k9s]
+      interactiveMode: IfAvailable
+`), 0o600))
+	flags := genericclioptions.ConfigFlags{KubeConfig: &cfgPath}
+	cfg := client.NewConfig(&flags)
+	tokens := auth.NewCache(t.TempDir())
+	cfg.SetTokenCache(tokens)
+
+	rc, err := cfg.RESTConfig()
+	require.NoError(t, err)
+	assert.NotNil(t, rc.ExecProvider)
+
+	o, ok := auth.FromExec(rc.ExecProvider)
+	require.True(t, ok)
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, tokens.Save(o, &auth.Token{IDToken: makeJWT(exp), Expiry: exp}))
+
+	rc, err = cfg.RESTConfig()
+	require.NoError(t, err)
+	assert.Nil(t, rc.ExecProvider)
+	assert.NotNil(t, rc.WrapTransport)
+
+	cred, err := cfg.Credential()
+	require.NoError(t, err)
+	assert.Equal(t, client.CredentialOIDC, cred.Kind)
+	assert.True(t, exp.Equal(cred.Expiry))
+}
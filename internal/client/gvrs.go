@@ -39,12 +39,35 @@ var (
 	NpGVR  = NewGVR("networking.k8s.io/v1/networkpolicies")
 	ScGVR  = NewGVR("storage.k8s.io/v1/storageclasses")
 
+	// Snapshots...
+	VsGVR   = NewGVR("snapshot.storage.k8s.io/v1/volumesnapshots")
+	VscGVR  = NewGVR("snapshot.storage.k8s.io/v1/volumesnapshotcontents")
+	VsclGVR = NewGVR("snapshot.storage.k8s.io/v1/volumesnapshotclasses")
+
+	// Secrets operators...
+	SsGVR      = NewGVR("bitnami.com/v1alpha1/sealedsecrets")
+	ExsGVR     = NewGVR("external-secrets.io/v1/externalsecrets")
+	ExsBetaGVR = NewGVR("external-secrets.io/v1beta1/externalsecrets")
+
+	// Certificates...
+	CertGVR = NewGVR("cert-manager.io/v1/certificates")
+
 	// Policy...
 	PdbGVR = NewGVR("policy/v1/poddisruptionbudgets")
 	PspGVR = NewGVR("policy/v1beta1/podsecuritypolicies")
 
 	IngGVR = NewGVR("networking.k8s.io/v1/ingresses")
 
+	// Gateway API...
+	GwGVR = NewGVR("gateway.networking.k8s.io/v1/gateways")
+	HrGVR = NewGVR("gateway.networking.k8s.io/v1/httproutes")
+
+	// Admission...
+	VwcGVR  = NewGVR("admissionregistration.k8s.io/v1/validatingwebhookconfigurations")
+	MwcGVR  = NewGVR("admissionregistration.k8s.io/v1/mutatingwebhookconfigurations")
+	VapGVR  = NewGVR("admissionregistration.k8s.io/v1/validatingadmissionpolicies")
+	VapbGVR = NewGVR("admissionregistration.k8s.io/v1/validatingadmissionpolicybindings")
+
 	// Metrics...
 	NmxGVR = NewGVR("metrics.k8s.io/v1beta1/nodes")
 	PmxGVR = NewGVR("metrics.k8s.io/v1beta1/pods")
@@ -53,9 +76,20 @@ var (
 	CpuGVR = NewGVR("cpu")
 	MemGVR = NewGVR("memory")
 	WkGVR  = NewGVR("workloads")
+	WkhGVR = NewGVR("workloadhealth")
+	NocGVR = NewGVR("nodecapacity")
+	StoGVR = NewGVR("storage")
+	CexGVR = NewGVR("certexpiry")
+	RtGVR  = NewGVR("routing")
 	CoGVR  = NewGVR("containers")
 	CtGVR  = NewGVR("contexts")
 	RefGVR = NewGVR("references")
+	TlGVR  = NewGVR("timeline")
+	RevGVR = NewGVR("revisions")
+	JrGVR  = NewGVR("jobruns")
+	SkGVR  = NewGVR("secretkeys")
+	SepGVR = NewGVR("serviceendpoints")
+	EsGVR  = NewGVR("eventstream")
 	PuGVR  = NewGVR("pulses")
 	ScnGVR = NewGVR("scans")
 	DirGVR = NewGVR("dirs")
@@ -63,6 +97,11 @@ var (
 	SdGVR  = NewGVR("screendumps")
 	BeGVR  = NewGVR("benchmarks")
 	AliGVR = NewGVR("aliases")
+	MutGVR = NewGVR("mutations")
+	MonGVR = NewGVR("monitors")
+	AdmGVR = NewGVR("admission")
+	AlGVR  = NewGVR("alerts")
+	FndGVR = NewGVR("find")
 	XGVR   = NewGVR("xrays")
 	HlpGVR = NewGVR("help")
 	QGVR   = NewGVR("quit")
@@ -74,6 +113,8 @@ var (
 	// RBAC...
 	RbacGVR = NewGVR("rbac")
 	PolGVR  = NewGVR("policy")
+	WcGVR   = NewGVR("whocan")
+	PermGVR = NewGVR("permissions")
 	UsrGVR  = NewGVR("users")
 	GrpGVR  = NewGVR("groups")
 	CrGVR   = NewGVR("rbac.authorization.k8s.io/v1/clusterroles")
@@ -86,9 +127,20 @@ var reservedGVRs = sets.New(
 	CpuGVR,
 	MemGVR,
 	WkGVR,
+	WkhGVR,
+	NocGVR,
+	StoGVR,
+	CexGVR,
+	RtGVR,
 	CoGVR,
 	CtGVR,
 	RefGVR,
+	TlGVR,
+	RevGVR,
+	JrGVR,
+	SkGVR,
+	SepGVR,
+	EsGVR,
 	PuGVR,
 	ScnGVR,
 	DirGVR,
@@ -96,6 +148,11 @@ var reservedGVRs = sets.New(
 	SdGVR,
 	BeGVR,
 	AliGVR,
+	MutGVR,
+	MonGVR,
+	AdmGVR,
+	AlGVR,
+	FndGVR,
 	XGVR,
 	HlpGVR,
 	QGVR,
@@ -103,6 +160,8 @@ var reservedGVRs = sets.New(
 	HmhGVR,
 	RbacGVR,
 	PolGVR,
+	WcGVR,
+	PermGVR,
 	UsrGVR,
 	GrpGVR,
 )
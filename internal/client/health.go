@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// HealthProbing tracks a context health probe in flight.
+	HealthProbing = "probing"
+
+	// HealthOK tracks a reachable cluster with all nodes ready.
+	HealthOK = "ok"
+
+	// HealthDegraded tracks a reachable cluster with unready nodes.
+	HealthDegraded = "degraded"
+
+	// HealthUnreachable tracks an unreachable cluster.
+	HealthUnreachable = "unreachable"
+
+	// HealthSkipped tracks a context requiring an interactive login to probe.
+	HealthSkipped = "skipped"
+
+	healthProbeTimeout = 5 * time.Second
+	healthProbeTTL     = time.Minute
+	healthProbeWorkers = 4
+)
+
+// ContextHealth tracks a context cluster health.
+type ContextHealth struct {
+	// Status represents the cluster health status.
+	Status string
+
+	// Version represents the api server version.
+	Version string
+
+	// Nodes tracks the cluster node count or -1 if unknown.
+	Nodes int
+
+	// ReadyNodes tracks the cluster ready node count.
+	ReadyNodes int
+
+	// Expiry tracks the context credential expiry. Zero if unknown or never.
+	Expiry time.Time
+
+	// Err tracks the last probe failure if any.
+	Err error
+
+	// Probed tracks when the probe completed.
+	Probed time.Time
+}
+
+// healthProber probes contexts health in the background and caches the results.
+// At most healthProbeWorkers probes run at once, others are queued.
+type healthProber struct {
+	mx       sync.Mutex
+	cache    map[string]ContextHealth
+	inflight map[string]struct{}
+	queue    []healthProbe
+	workers  int
+	probeFn  func(*Config, string) ContextHealth
+}
+
+type healthProbe struct {
+	cfg  *Config
+	name string
+}
+
+// ContextHealth returns a context last known health.
+// Stale or missing entries are probed in the background.
+func (c *Config) ContextHealth(name string) ContextHealth {
+	return c.health.get(c, name, time.Now())
+}
+
+func (p *healthProber) get(c *Config, name string, now time.Time) ContextHealth {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.cache == nil {
+		p.cache, p.inflight = make(map[string]ContextHealth), make(map[string]struct{})
+	}
+	h, ok := p.cache[name]
+	if ok && now.Sub(h.Probed) < healthProbeTTL {
+		return h
+	}
+	if !ok {
+		h = ContextHealth{Status: HealthProbing, Nodes: -1}
+	}
+	if _, ok := p.inflight[name]; ok {
+		return h
+	}
+	p.inflight[name] = struct{}{}
+	p.queue = append(p.queue, healthProbe{cfg: c, name: name})
+	if p.workers < healthProbeWorkers {
+		p.workers++
+		go p.work()
+	}
+
+	return h
+}
+
+// work drains the probe queue until empty.
+func (p *healthProber) work() {
+	probe := p.probeFn
+	if probe == nil {
+		probe = probeContext
+	}
+	for {
+		p.mx.Lock()
+		if len(p.queue) == 0 {
+			p.workers--
+			p.mx.Unlock()
+			return
+		}
+		hp := p.queue[0]
+		p.queue = p.queue[1:]
+		p.mx.Unlock()
+
+		h := probe(hp.cfg, hp.name)
+		p.mx.Lock()
+		p.cache[hp.name] = h
+		delete(p.inflight, hp.name)
+		p.mx.Unlock()
+	}
+}
+
+func probeContext(c *Config, name string) (h ContextHealth) {
+	h.Status, h.Nodes = HealthUnreachable, -1
+	defer func() { h.Probed = time.Now() }()
+
+	cfg, err := c.ForContext(name)
+	if err != nil {
+		h.Err = err
+		return h
+	}
+	// Never trigger login plugins for contexts the user has not opened.
+	if ct, err := c.CurrentContextName(); err != nil || ct != name {
+		if ok, err := cfg.interactiveAuth(); err != nil || ok {
+			h.Status, h.Err = HealthSkipped, err
+			return h
+		}
+	}
+	if cred, err := cfg.Credential(); err == nil && cred.Err == nil {
+		h.Expiry = cred.Expiry
+	}
+	rc, err := cfg.RESTConfig()
+	if err != nil {
+		h.Err = err
+		return h
+	}
+	rc.Timeout = healthProbeTimeout
+	dial, err := kubernetes.NewForConfig(rc)
+	if err != nil {
+		h.Err = err
+		return h
+	}
+	info, err := dial.Discovery().ServerVersion()
+	if err != nil {
+		slog.Debug("Context health probe failed", slogs.Context, name, slogs.Error, err)
+		h.Err = err
+		return h
+	}
+	h.Status, h.Version = HealthOK, info.GitVersion
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthProbeTimeout)
+	defer cancel()
+	nn, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// Node access may be forbidden, the cluster is still reachable.
+		h.Err = err
+		return h
+	}
+	h.Nodes, h.ReadyNodes = len(nn.Items), readyNodes(nn.Items)
+	if h.ReadyNodes < h.Nodes {
+		h.Status = HealthDegraded
+	}
+
+	return h
+}
+
+func readyNodes(nn []v1.Node) int {
+	var count int
+	for i := range nn {
+		for _, c := range nn[i].Status.Conditions {
+			if c.Type == v1.NodeReady && c.Status == v1.ConditionTrue {
+				count++
+				break
+			}
+		}
+	}
+
+	return count
+}
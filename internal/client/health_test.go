@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestProbeContext(t *testing.T) {
+	srv, _ := newFakeK8sServer(t)
+	t.Cleanup(srv.Close)
+	dead := httptest.NewServer(nil)
+	dead.Close()
+	t.Setenv("HOME", t.TempDir())
+
+	kubeconfig := writeSwitchTestKubeconfig(t, srv.URL, dead.URL)
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = &kubeconfig
+	c := NewConfig(flags)
+
+	h := probeContext(c, testContext1)
+	assert.Equal(t, HealthOK, h.Status)
+	assert.Equal(t, "v1.28.0", h.Version)
+	assert.Equal(t, -1, h.Nodes)
+	assert.False(t, h.Probed.IsZero())
+
+	h = probeContext(c, testContext2)
+	assert.Equal(t, HealthUnreachable, h.Status)
+	assert.Error(t, h.Err)
+
+	h = probeContext(c, "blee")
+	assert.Equal(t, HealthUnreachable, h.Status)
+	assert.EqualError(t, h.Err, `context "blee" does not exist`)
+}
+
+func TestHealthProberGet(t *testing.T) {
+	var calls atomic.Int32
+	done := make(chan struct{})
+	p := healthProber{
+		probeFn: func(*Config, string) ContextHealth {
+			calls.Add(1)
+			<-done
+			return ContextHealth{Status: HealthOK, Nodes: 2, ReadyNodes: 2, Probed: time.Now()}
+		},
+	}
+
+	now := time.Now()
+	h := p.get(nil, "c1", now)
+	assert.Equal(t, HealthProbing, h.Status)
+	assert.Equal(t, -1, h.Nodes)
+	h = p.get(nil, "c1", now)
+	assert.Equal(t, HealthProbing, h.Status)
+
+	close(done)
+	assert.Eventually(t, func() bool {
+		return p.get(nil, "c1", time.Now()).Status == HealthOK
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+
+	h = p.get(nil, "c1", time.Now().Add(2*healthProbeTTL))
+	assert.Equal(t, HealthOK, h.Status)
+	assert.Eventually(t, func() bool {
+		return calls.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestProbeContextInteractiveAuth(t *testing.T) {
+	srv, _ := newFakeK8sServer(t)
+	t.Cleanup(srv.Close)
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	ran := filepath.Join(dir, "ran")
+	plugin := filepath.Join(dir, "plugin.sh")
+	require.NoError(t, os.WriteFile(plugin, fmt.Appendf(nil, `#!/bin/sh
+touch %s
+echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"fred"}}'
+`, ran), 0o700))
+	kubeconfig := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(kubeconfig, fmt.Appendf(nil, `apiVersion: v1
+kind: Config
+current-context: exec
+clusters:
+- name: c1
+  cluster:
+    server: %s
+contexts:
+- name: exec
+  context: {cluster: c1, user: exec}
+- name: exec-other
+  context: {cluster: c1, user: exec}
+users:
+- name: exec
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: %s
+      interactiveMode: Never
+`, srv.URL, plugin), 0o600))
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = &kubeconfig
+	c := NewConfig(flags)
+
+	h := probeContext(c, "exec-other")
+	assert.Equal(t, HealthSkipped, h.Status)
+	assert.NoError(t, h.Err)
+	assert.NoFileExists(t, ran)
+
+	h = probeContext(c, "exec")
+	assert.Equal(t, HealthOK, h.Status)
+	assert.FileExists(t, ran)
+}
+
+func TestHealthProberWorkers(t *testing.T) {
+	var running, peak, calls atomic.Int32
+	done := make(chan struct{})
+	p := healthProber{
+		probeFn: func(*Config, string) ContextHealth {
+			n := running.Add(1)
+			for {
+				m := peak.Load()
+				if n <= m || peak.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			<-done
+			running.Add(-1)
+			calls.Add(1)
+			return ContextHealth{Status: HealthOK, Probed: time.Now()}
+		},
+	}
+
+	now := time.Now()
+	for i := range 10 {
+		p.get(nil, "c"+strconv.Itoa(i), now)
+	}
+	assert.Eventually(t, func() bool {
+		return running.Load() == healthProbeWorkers
+	}, time.Second, 10*time.Millisecond)
+
+	close(done)
+	assert.Eventually(t, func() bool {
+		return calls.Load() == 10
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(healthProbeWorkers), peak.Load())
+	for i := range 10 {
+		assert.Equal(t, HealthOK, p.get(nil, "c"+strconv.Itoa(i), time.Now()).Status)
+	}
+}
@@ -43,15 +43,41 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	provider MetricsProvider
+	cache    *cache.LRUExpireCache
 }
 
 // NewMetricsServer return a metric server instance.
 func NewMetricsServer(c Connection) *MetricsServer {
-	return &MetricsServer{
+	m := MetricsServer{
 		Connection: c,
 		cache:      cache.NewLRUExpireCache(mxCacheSize),
 	}
+	if c != nil {
+		m.provider = MetricsProviderFor(c.ActiveContext())
+	}
+
+	return &m
+}
+
+// FetchPodsCustomMetrics returns pods custom metrics if the provider supports them.
+func (m *MetricsServer) FetchPodsCustomMetrics(ctx context.Context, ns string) (CustomMetrics, error) {
+	p, ok := m.provider.(CustomMetricsProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	return p.FetchPodsCustomMetrics(ctx, ns)
+}
+
+// FetchNodesCustomMetrics returns nodes custom metrics if the provider supports them.
+func (m *MetricsServer) FetchNodesCustomMetrics(ctx context.Context) (CustomMetrics, error) {
+	p, ok := m.provider.(CustomMetricsProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	return p.FetchNodesCustomMetrics(ctx)
 }
 
 // ClusterLoad retrieves all cluster nodes metrics.
@@ -87,6 +113,12 @@ func (*MetricsServer) ClusterLoad(nos *v1.NodeList, nmx *mv1beta1.NodeMetricsLis
 }
 
 func (m *MetricsServer) checkAccess(ns string, gvr *GVR, msg string) error {
+	if m.provider != nil {
+		if !m.provider.Available() {
+			return errors.New("metrics provider is unavailable")
+		}
+		return nil
+	}
 	if !m.HasMetrics() {
 		return errors.New("no metrics-server detected on cluster")
 	}
@@ -164,11 +196,7 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	mxList, err := m.listNodesMetrics(ctx)
 	if err != nil {
 		return mx, err
 	}
@@ -177,6 +205,18 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 	return mxList, nil
 }
 
+func (m *MetricsServer) listNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	if m.provider != nil {
+		return m.provider.FetchNodesMetrics(ctx)
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+}
+
 // FetchNodeMetrics return all metrics for nodes.
 func (m *MetricsServer) FetchNodeMetrics(ctx context.Context, n string) (*mv1beta1.NodeMetrics, error) {
 	const msg = "user is not authorized to list node metrics"
@@ -235,11 +275,7 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+	mxList, err := m.listPodsMetrics(ctx, ns)
 	if err != nil {
 		return mx, err
 	}
@@ -248,6 +284,18 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 	return mxList, err
 }
 
+func (m *MetricsServer) listPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	if m.provider != nil {
+		return m.provider.FetchPodsMetrics(ctx, ns)
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+}
+
 // FetchContainersMetrics returns a pod's containers metrics.
 func (m *MetricsServer) FetchContainersMetrics(ctx context.Context, fqn string) (ContainersMetrics, error) {
 	mm, err := m.FetchPodMetrics(ctx, fqn)
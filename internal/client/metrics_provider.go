@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// MetricsProvider serves nodes and pods metrics from an alternate source than metrics-server.
+type MetricsProvider interface {
+	// Available checks if the provider can serve metrics.
+	Available() bool
+
+	// FetchNodesMetrics returns all nodes metrics.
+	FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error)
+
+	// FetchPodsMetrics returns all pods metrics in a given namespace.
+	FetchPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error)
+}
+
+// CustomMetrics tracks user defined metrics values keyed by resource fqn then metric name.
+type CustomMetrics map[string]map[string]string
+
+// CustomMetricsProvider serves user defined metrics for pods and nodes.
+type CustomMetricsProvider interface {
+	// FetchPodsCustomMetrics returns pods custom metrics in a given namespace.
+	FetchPodsCustomMetrics(ctx context.Context, ns string) (CustomMetrics, error)
+
+	// FetchNodesCustomMetrics returns nodes custom metrics.
+	FetchNodesCustomMetrics(ctx context.Context) (CustomMetrics, error)
+}
+
+var (
+	metricsProviders  = make(map[string]MetricsProvider)
+	metricsProviderMx sync.RWMutex
+)
+
+// SetMetricsProvider sets a context metrics provider. A nil provider defaults to metrics-server.
+func SetMetricsProvider(ctx string, p MetricsProvider) {
+	metricsProviderMx.Lock()
+	if p == nil {
+		delete(metricsProviders, ctx)
+	} else {
+		metricsProviders[ctx] = p
+	}
+	metricsProviderMx.Unlock()
+
+	ResetMetrics()
+}
+
+// MetricsProviderFor returns a context metrics provider if any.
+func MetricsProviderFor(ctx string) MetricsProvider {
+	metricsProviderMx.RLock()
+	defer metricsProviderMx.RUnlock()
+
+	return metricsProviders[ctx]
+}
+
+// metricsProvider returns the active context metrics provider if any. The
+// active context is only resolved once a provider was registered.
+func (a *APIClient) metricsProvider() MetricsProvider {
+	metricsProviderMx.RLock()
+	n := len(metricsProviders)
+	metricsProviderMx.RUnlock()
+	if n == 0 {
+		return nil
+	}
+
+	return MetricsProviderFor(a.ActiveContext())
+}
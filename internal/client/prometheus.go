@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	promTimeout     = 10 * time.Second
+	promProbeExpiry = 1 * time.Minute
+	promWindow      = 5 * time.Minute
+)
+
+// DefaultPrometheusQueries tracks the default cadvisor based queries.
+var DefaultPrometheusQueries = PrometheusQueries{
+	PodCPU:  `sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD"}[5m]))`,
+	PodMEM:  `sum by (namespace, pod, container) (container_memory_working_set_bytes{container!="",container!="POD"})`,
+	NodeCPU: `sum by (node) (rate(container_cpu_usage_seconds_total{id="/"}[5m]))`,
+	NodeMEM: `sum by (node) (container_memory_working_set_bytes{id="/"})`,
+}
+
+// PrometheusQueries tracks the PromQL queries used to compute resources usage.
+// Pods queries must yield namespace, pod and optionally container labels. Nodes queries
+// must yield a node label. CPU queries are expressed in cores and MEM queries in bytes.
+type PrometheusQueries struct {
+	PodCPU, PodMEM   string
+	NodeCPU, NodeMEM string
+
+	// CustomPods and CustomNodes map custom metric names to queries yielding
+	// either namespace and pod labels or a node label.
+	CustomPods, CustomNodes map[string]string
+}
+
+// Merge fills in blank queries from another set.
+func (q PrometheusQueries) Merge(o PrometheusQueries) PrometheusQueries {
+	if q.PodCPU == "" {
+		q.PodCPU = o.PodCPU
+	}
+	if q.PodMEM == "" {
+		q.PodMEM = o.PodMEM
+	}
+	if q.NodeCPU == "" {
+		q.NodeCPU = o.NodeCPU
+	}
+	if q.NodeMEM == "" {
+		q.NodeMEM = o.NodeMEM
+	}
+
+	return q
+}
+
+// Prometheus serves cluster metrics from a Prometheus server.
+type Prometheus struct {
+	conn      Connection
+	endpoint  string
+	service   string
+	headers   map[string]string
+	queries   PrometheusQueries
+	available bool
+	probedAt  time.Time
+	mx        sync.Mutex
+}
+
+// NewPrometheus returns a new Prometheus metrics provider. The server is either reached directly
+// via its endpoint or proxied through the api server given a ns/name:port service.
+func NewPrometheus(conn Connection, endpoint, service string, headers map[string]string, qq PrometheusQueries) *Prometheus {
+	return &Prometheus{
+		conn:     conn,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		service:  service,
+		headers:  headers,
+		queries:  qq.Merge(DefaultPrometheusQueries),
+	}
+}
+
+// Available checks if the Prometheus server is reachable.
+func (p *Prometheus) Available() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if !p.probedAt.IsZero() && time.Since(p.probedAt) < promProbeExpiry {
+		return p.available
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), promTimeout)
+	defer cancel()
+	_, err := p.get(ctx, "/api/v1/status/buildinfo", nil)
+	if err != nil {
+		slog.Warn("Prometheus metrics provider unavailable", slogs.Error, err)
+	}
+	p.available, p.probedAt = err == nil, time.Now()
+
+	return p.available
+}
+
+// FetchNodesMetrics returns all nodes metrics.
+func (p *Prometheus) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	cpu, err := p.query(ctx, p.queries.NodeCPU)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, p.queries.NodeMEM)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[string]int)
+	mx := new(mv1beta1.NodeMetricsList)
+	add := func(ss []promSample, res v1.ResourceName) {
+		for _, s := range ss {
+			n := s.Metric["node"]
+			if n == "" {
+				continue
+			}
+			i, ok := idx[n]
+			if !ok {
+				i = len(mx.Items)
+				idx[n] = i
+				mx.Items = append(mx.Items, mv1beta1.NodeMetrics{
+					ObjectMeta: metav1.ObjectMeta{Name: n},
+					Timestamp:  metav1.NewTime(s.Time),
+					Window:     metav1.Duration{Duration: promWindow},
+					Usage:      make(v1.ResourceList, 2),
+				})
+			}
+			mx.Items[i].Usage[res] = toQuantity(res, s.Value)
+		}
+	}
+	add(cpu, v1.ResourceCPU)
+	add(mem, v1.ResourceMemory)
+
+	return mx, nil
+}
+
+// FetchPodsMetrics returns all pods metrics in a given namespace.
+func (p *Prometheus) FetchPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	cpu, err := p.query(ctx, p.queries.PodCPU)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, p.queries.PodMEM)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[string]int)
+	mx := new(mv1beta1.PodMetricsList)
+	add := func(ss []promSample, res v1.ResourceName) {
+		for _, s := range ss {
+			pns, n := s.Metric["namespace"], s.Metric["pod"]
+			if n == "" || (!IsAllNamespaces(ns) && pns != ns) {
+				continue
+			}
+			fqn := FQN(pns, n)
+			i, ok := idx[fqn]
+			if !ok {
+				i = len(mx.Items)
+				idx[fqn] = i
+				mx.Items = append(mx.Items, mv1beta1.PodMetrics{
+					ObjectMeta: metav1.ObjectMeta{Namespace: pns, Name: n},
+					Timestamp:  metav1.NewTime(s.Time),
+					Window:     metav1.Duration{Duration: promWindow},
+				})
+			}
+			po := &mx.Items[i]
+			co := s.Metric["container"]
+			j := slices.IndexFunc(po.Containers, func(c mv1beta1.ContainerMetrics) bool { return c.Name == co })
+			if j < 0 {
+				j = len(po.Containers)
+				po.Containers = append(po.Containers, mv1beta1.ContainerMetrics{Name: co, Usage: make(v1.ResourceList, 2)})
+			}
+			po.Containers[j].Usage[res] = toQuantity(res, s.Value)
+		}
+	}
+	add(cpu, v1.ResourceCPU)
+	add(mem, v1.ResourceMemory)
+
+	return mx, nil
+}
+
+// FetchPodsCustomMetrics returns pods custom metrics in a given namespace.
+func (p *Prometheus) FetchPodsCustomMetrics(ctx context.Context, ns string) (CustomMetrics, error) {
+	return p.customMetrics(ctx, p.queries.CustomPods, func(m map[string]string) string {
+		pns, n := m["namespace"], m["pod"]
+		if n == "" || (!IsAllNamespaces(ns) && pns != ns) {
+			return ""
+		}
+		return FQN(pns, n)
+	})
+}
+
+// FetchNodesCustomMetrics returns nodes custom metrics.
+func (p *Prometheus) FetchNodesCustomMetrics(ctx context.Context) (CustomMetrics, error) {
+	return p.customMetrics(ctx, p.queries.CustomNodes, func(m map[string]string) string {
+		return m["node"]
+	})
+}
+
+// Helpers...
+
+// customMetrics runs custom queries. Samples matching the same resource are summed.
+func (p *Prometheus) customMetrics(ctx context.Context, qq map[string]string, keyFn func(map[string]string) string) (CustomMetrics, error) {
+	if len(qq) == 0 {
+		return nil, nil
+	}
+	vv := make(map[string]map[string]float64)
+	for name, q := range qq {
+		ss, err := p.query(ctx, q)
+		if err != nil {
+			return nil, fmt.Errorf("custom metric %q failed: %w", name, err)
+		}
+		for _, s := range ss {
+			k := keyFn(s.Metric)
+			if k == "" {
+				continue
+			}
+			if vv[k] == nil {
+				vv[k] = make(map[string]float64, len(qq))
+			}
+			vv[k][name] += s.Value
+		}
+	}
+	mm := make(CustomMetrics, len(vv))
+	for k, m := range vv {
+		mm[k] = make(map[string]string, len(m))
+		for name, v := range m {
+			mm[k][name] = strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+		}
+	}
+
+	return mm, nil
+}
+
+type promSample struct {
+	Metric map[string]string
+	Time   time.Time
+	Value  float64
+}
+
+type promResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []any             `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (p *Prometheus) query(ctx context.Context, q string) ([]promSample, error) {
+	raw, err := p.get(ctx, "/api/v1/query", url.Values{"query": []string{q}})
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePromVector(raw)
+}
+
+func parsePromVector(raw []byte) ([]promSample, error) {
+	var resp promResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", resp.Error)
+	}
+	if resp.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("expected prometheus vector result but got %q", resp.Data.ResultType)
+	}
+
+	ss := make([]promSample, 0, len(resp.Data.Result))
+	for _, r := range resp.Data.Result {
+		if len(r.Value) != 2 {
+			continue
+		}
+		ts, ok := r.Value[0].(float64)
+		if !ok {
+			continue
+		}
+		sv, ok := r.Value[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(sv, 64)
+		if err != nil {
+			continue
+		}
+		sec := int64(ts)
+		ss = append(ss, promSample{
+			Metric: r.Metric,
+			Time:   time.Unix(sec, int64((ts-float64(sec))*float64(time.Second))),
+			Value:  v,
+		})
+	}
+
+	return ss, nil
+}
+
+func (p *Prometheus) get(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	base, hc, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	u := base + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode != http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf("prometheus request failed: %s", resp.Status)
+	}
+
+	return raw, nil
+}
+
+// dial returns the Prometheus base url along with an http client.
+func (p *Prometheus) dial() (string, *http.Client, error) {
+	if p.service == "" {
+		if p.endpoint == "" {
+			return "", nil, fmt.Errorf("no prometheus endpoint or service configured")
+		}
+		return p.endpoint, &http.Client{Timeout: promTimeout}, nil
+	}
+	if p.conn == nil {
+		return "", nil, fmt.Errorf("no connection available to proxy prometheus service %q", p.service)
+	}
+	ns, svc := Namespaced(p.service)
+	if ns == "" || svc == "" {
+		return "", nil, fmt.Errorf("invalid prometheus service %q. Expecting ns/name:port", p.service)
+	}
+	cfg, err := p.conn.RestConfig()
+	if err != nil {
+		return "", nil, err
+	}
+	hc, err := restclient.HTTPClientFor(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+	hc.Timeout = promTimeout
+
+	return fmt.Sprintf("%s/api/v1/namespaces/%s/services/%s/proxy", strings.TrimSuffix(cfg.Host, "/"), ns, svc), hc, nil
+}
+
+func toQuantity(res v1.ResourceName, v float64) resource.Quantity {
+	if res == v1.ResourceCPU {
+		return *resource.NewMilliQuantity(int64(v*1_000), resource.DecimalSI)
+	}
+
+	return *resource.NewQuantity(int64(v), resource.BinarySI)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPromServer(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"pod_cpu":  `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"namespace":"ns1","pod":"p1","container":"c1"},"value":[1700000000.5,"0.25"]},{"metric":{"namespace":"ns2","pod":"p2","container":"c1"},"value":[1700000000,"1"]}]}}`,
+		"pod_mem":  `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"namespace":"ns1","pod":"p1","container":"c1"},"value":[1700000000,"1048576"]}]}}`,
+		"node_cpu": `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"node":"n1"},"value":[1700000000,"1.5"]}]}}`,
+		"node_mem": `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"node":"n1"},"value":[1700000000,"2097152"]}]}}`,
+		"bad":      `{"status":"error","error":"parse error"}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer fred", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/v1/status/buildinfo":
+			w.WriteHeader(http.StatusOK)
+		case "/api/v1/query":
+			raw, ok := responses[r.URL.Query().Get("query")]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(raw))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestPrometheusFetchPodsMetrics(t *testing.T) {
+	srv := newPromServer(t)
+	defer srv.Close()
+
+	qq := client.PrometheusQueries{PodCPU: "pod_cpu", PodMEM: "pod_mem"}
+	p := client.NewPrometheus(nil, srv.URL+"/", "", map[string]string{"Authorization": "Bearer fred"}, qq)
+	assert.True(t, p.Available())
+
+	mx, err := p.FetchPodsMetrics(context.Background(), "ns1")
+	require.NoError(t, err)
+	require.Len(t, mx.Items, 1)
+	assert.Equal(t, "p1", mx.Items[0].Name)
+	require.Len(t, mx.Items[0].Containers, 1)
+	co := mx.Items[0].Containers[0]
+	assert.Equal(t, "c1", co.Name)
+	assert.Equal(t, int64(250), co.Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(client.MegaByte), co.Usage.Memory().Value())
+
+	mx, err = p.FetchPodsMetrics(context.Background(), client.NamespaceAll)
+	require.NoError(t, err)
+	assert.Len(t, mx.Items, 2)
+}
+
+func TestPrometheusFetchNodesMetrics(t *testing.T) {
+	srv := newPromServer(t)
+	defer srv.Close()
+
+	qq := client.PrometheusQueries{NodeCPU: "node_cpu", NodeMEM: "node_mem"}
+	p := client.NewPrometheus(nil, srv.URL, "", map[string]string{"Authorization": "Bearer fred"}, qq)
+
+	mx, err := p.FetchNodesMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, mx.Items, 1)
+	assert.Equal(t, "n1", mx.Items[0].Name)
+	assert.Equal(t, int64(1500), mx.Items[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(2*client.MegaByte), mx.Items[0].Usage.Memory().Value())
+}
+
+func TestPrometheusFetchCustomMetrics(t *testing.T) {
+	srv := newPromServer(t)
+	defer srv.Close()
+
+	qq := client.PrometheusQueries{
+		CustomPods:  map[string]string{"cpu": "pod_cpu"},
+		CustomNodes: map[string]string{"cpu": "node_cpu", "mem": "node_mem"},
+	}
+	p := client.NewPrometheus(nil, srv.URL, "", map[string]string{"Authorization": "Bearer fred"}, qq)
+
+	mm, err := p.FetchPodsCustomMetrics(context.Background(), "ns1")
+	require.NoError(t, err)
+	assert.Equal(t, client.CustomMetrics{"ns1/p1": {"cpu": "0.25"}}, mm)
+
+	mm, err = p.FetchNodesCustomMetrics(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, client.CustomMetrics{"n1": {"cpu": "1.5", "mem": "2097152"}}, mm)
+
+	p = client.NewPrometheus(nil, srv.URL, "", map[string]string{"Authorization": "Bearer fred"}, client.PrometheusQueries{
+		CustomPods: map[string]string{"blee": "bad"},
+	})
+	_, err = p.FetchPodsCustomMetrics(context.Background(), "ns1")
+	assert.ErrorContains(t, err, `custom metric "blee" failed`)
+}
+
+func TestMetricsProviderFor(t *testing.T) {
+	p := client.NewPrometheus(nil, "http://localhost:9090", "", nil, client.PrometheusQueries{})
+	client.SetMetricsProvider("ct1", p)
+	defer client.SetMetricsProvider("ct1", nil)
+
+	assert.Equal(t, p, client.MetricsProviderFor("ct1"))
+	assert.Nil(t, client.MetricsProviderFor("ct2"))
+}
+
+func TestPrometheusQueryErrors(t *testing.T) {
+	srv := newPromServer(t)
+	defer srv.Close()
+
+	hh := map[string]string{"Authorization": "Bearer fred"}
+	p := client.NewPrometheus(nil, srv.URL, "", hh, client.PrometheusQueries{NodeCPU: "bad"})
+	_, err := p.FetchNodesMetrics(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parse error")
+
+	p = client.NewPrometheus(nil, srv.URL, "", hh, client.PrometheusQueries{NodeCPU: "unknown"})
+	_, err = p.FetchNodesMetrics(context.Background())
+	require.Error(t, err)
+
+	p = client.NewPrometheus(nil, "", "", nil, client.PrometheusQueries{})
+	assert.False(t, p.Available())
+}
@@ -105,6 +105,9 @@ type Connection interface {
 	// SwitchContext switches cluster based on context.
 	SwitchContext(ctx string) error
 
+	// ReloadConfig reloads the kubeconfig files for the active context.
+	ReloadConfig() error
+
 	// CachedDiscovery connects to discovery client.
 	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
 
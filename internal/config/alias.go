@@ -193,9 +193,19 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare(client.PfGVR, "portforward", "pf")
 	a.declare(client.BeGVR, "benchmark", "bench")
 	a.declare(client.SdGVR, "screendump", "sd")
+	a.declare(client.MutGVR, "mutation", "mut", "journal")
+	a.declare(client.MonGVR, "monitor", "mon")
+	a.declare(client.AlGVR, "alert")
+	a.declare(client.AdmGVR, "adm", "webhooks")
+	a.declare(client.EsGVR, "evs", "firehose")
 	a.declare(client.PuGVR, "pulse", "pu", "hz")
 	a.declare(client.XGVR, "xray", "x")
 	a.declare(client.WkGVR, "workload", "wk")
+	a.declare(client.WkhGVR, "workloadhealth", "wkh")
+	a.declare(client.NocGVR, "nodecapacity", "noc")
+	a.declare(client.StoGVR, "storage", "sto")
+	a.declare(client.CexGVR, "certexpiry", "cex")
+	a.declare(client.RtGVR, "routing", "rtg")
 }
 
 // Save alias to disk.
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "fmt"
+
+const (
+	// AuditFileSink appends audit events to a local file.
+	AuditFileSink = "file"
+
+	// AuditSyslogSink forwards audit events to a syslog daemon.
+	AuditSyslogSink = "syslog"
+
+	// AuditWebhookSink posts audit events to a remote endpoint.
+	AuditWebhookSink = "webhook"
+)
+
+// AuditSink tracks an audit events destination.
+type AuditSink struct {
+	// Type names the sink kind ie file, syslog or webhook.
+	Type string `json:"type" yaml:"type"`
+
+	// Path locates the file sink audit log.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// Network and Address locate a remote syslog daemon. Defaults to the local one.
+	Network string `json:"network,omitempty" yaml:"network,omitempty"`
+	Address string `json:"address,omitempty" yaml:"address,omitempty"`
+
+	// Tag names the syslog entries emitter. Defaults to k9s.
+	Tag string `json:"tag,omitempty" yaml:"tag,omitempty"`
+
+	// URL locates the webhook endpoint events are posted to.
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+
+	// Headers lists additional webhook request headers ie Authorization.
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+}
+
+// Validate checks the sink configuration.
+func (s AuditSink) Validate() error {
+	switch s.Type {
+	case AuditFileSink:
+		if s.Path == "" {
+			return fmt.Errorf("audit %s sink requires a path", s.Type)
+		}
+	case AuditSyslogSink:
+	case AuditWebhookSink:
+		if s.URL == "" {
+			return fmt.Errorf("audit %s sink requires a url", s.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported audit sink type: %q", s.Type)
+	}
+
+	return nil
+}
+
+// Audit tracks user actions audit log options.
+type Audit struct {
+	Enabled bool        `json:"enabled" yaml:"enabled"`
+	Sinks   []AuditSink `json:"sinks" yaml:"sinks,omitempty"`
+}
@@ -6,6 +6,7 @@ package config
 import (
 	"net/http"
 	"os"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -48,16 +49,55 @@ type (
 		Headers http.Header `yaml:"headers"`
 	}
 
+	// GRPC represents a gRPC unary call.
+	GRPC struct {
+		// Method is the fully qualified method name ie pkg.Service/Method.
+		Method string `yaml:"method"`
+
+		// Data is the JSON encoded request message.
+		Data string `yaml:"data"`
+
+		// Protoset is a compiled FileDescriptorSet path. Server reflection is used when blank.
+		Protoset string `yaml:"protoset"`
+
+		// TLS dials the server using TLS.
+		TLS bool `yaml:"tls"`
+
+		// Insecure skips the server certificate verification.
+		Insecure bool `yaml:"insecure"`
+
+		// Metadata tracks the calls metadata.
+		Metadata map[string]string `yaml:"metadata"`
+	}
+
+	// TCP represents a raw TCP connect benchmark.
+	TCP struct {
+		// Timeout is the connect timeout. Default 5s.
+		Timeout string `yaml:"timeout"`
+	}
+
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int    `yaml:"concurrency"`
+		N        int    `yaml:"requests"`
+		Protocol string `yaml:"protocol"`
+		Auth     Auth   `yaml:"auth"`
+		HTTP     HTTP   `yaml:"http"`
+		GRPC     GRPC   `yaml:"grpc"`
+		TCP      TCP    `yaml:"tcp"`
 	}
 )
 
+const (
+	// BenchHTTP benchmarks HTTP endpoints.
+	BenchHTTP = "http"
+	// BenchGRPC benchmarks gRPC unary methods.
+	BenchGRPC = "grpc"
+	// BenchTCP benchmarks TCP connects.
+	BenchTCP = "tcp"
+)
+
 const (
 	// DefaultC default concurrency.
 	DefaultC = 1
@@ -79,6 +119,15 @@ func DefaultBenchSpec() BenchConfig {
 	}
 }
 
+// ProtocolOrDefault returns the benchmark protocol.
+func (b BenchConfig) ProtocolOrDefault() string {
+	if b.Protocol == "" {
+		return BenchHTTP
+	}
+
+	return strings.ToLower(b.Protocol)
+}
+
 func newBenchmark() Benchmark {
 	return Benchmark{
 		C: DefaultC,
@@ -192,3 +192,23 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchProtocolsLoad(t *testing.T) {
+	b, err := NewBench("testdata/benchmarks/b_protocols.yaml")
+	require.NoError(t, err)
+
+	c1 := b.Benchmarks.Containers["c1"]
+	assert.Equal(t, BenchGRPC, c1.ProtocolOrDefault())
+	assert.Equal(t, GRPC{
+		Method:   "grpc.health.v1.Health/Check",
+		Data:     `{"service": "fred"}`,
+		TLS:      true,
+		Metadata: map[string]string{"x-k9s": "bench"},
+	}, c1.GRPC)
+
+	c2 := b.Benchmarks.Containers["c2"]
+	assert.Equal(t, BenchTCP, c2.ProtocolOrDefault())
+	assert.Equal(t, "2s", c2.TCP.Timeout)
+
+	assert.Equal(t, BenchHTTP, b.Benchmarks.Containers["c3"].ProtocolOrDefault())
+}
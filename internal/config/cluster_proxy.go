@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultClusterProxyAddress tracks the default cluster proxy listen address.
+const DefaultClusterProxyAddress = "localhost:1080"
+
+// ClusterProxy tracks the local SOCKS5/HTTP cluster proxy settings.
+type ClusterProxy struct {
+	// Enable starts the proxy on launch. Default false.
+	Enable bool `json:"enable,omitempty" yaml:"enable,omitempty"`
+
+	// Address is the proxy listen address. Default localhost:1080.
+	Address string `json:"address,omitempty" yaml:"address,omitempty"`
+}
+
+// AddressOrDefault returns the proxy listen address.
+func (c ClusterProxy) AddressOrDefault() string {
+	if c.Address == "" {
+		return DefaultClusterProxyAddress
+	}
+
+	return c.Address
+}
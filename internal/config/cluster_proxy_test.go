@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterProxyAddress(t *testing.T) {
+	var c config.ClusterProxy
+	assert.Equal(t, config.DefaultClusterProxyAddress, c.AddressOrDefault())
+
+	c.Address = "127.0.0.1:8888"
+	assert.Equal(t, "127.0.0.1:8888", c.AddressOrDefault())
+}
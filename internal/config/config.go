@@ -9,6 +9,7 @@ import (
 	"io/fs"
 	"log/slog"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -181,6 +182,16 @@ func (c *Config) FavNamespaces() []string {
 	return ct.Namespace.Favorites
 }
 
+// FavNamespaceLanding returns a namespace default view in the current context if any.
+func (c *Config) FavNamespaceLanding(ns string) (data.Landing, bool) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return data.Landing{}, false
+	}
+
+	return ct.Namespace.Landing(ns)
+}
+
 // SetActiveNamespace set the active namespace in the current context.
 func (c *Config) SetActiveNamespace(ns string) error {
 	if ns == client.NotNamespaced {
@@ -234,6 +245,91 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// LastFilter returns the last saved filter used by a view in the current context.
+func (c *Config) LastFilter(view string) string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return ct.View.LastFilter(view)
+}
+
+// SetLastFilter tracks the last saved filter used by a view in the current context.
+func (c *Config) SetLastFilter(view, name string) {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.View.SetLastFilter(view, name)
+	}
+}
+
+// PortForwards returns the current context persisted port-forwards.
+func (c *Config) PortForwards() []data.PortForward {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return nil
+	}
+
+	return ct.GetPortForwards()
+}
+
+// AddPortForward persists a port-forward in the current context.
+func (c *Config) AddPortForward(pf data.PortForward) {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.AddPortForward(pf)
+	}
+}
+
+// RemovePortForward removes a persisted port-forward from the current context.
+func (c *Config) RemovePortForward(pf data.PortForward) bool {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return false
+	}
+
+	return ct.RemovePortForward(pf)
+}
+
+// ClearPortForwards removes all persisted port-forwards from the current context.
+func (c *Config) ClearPortForwards() {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.ClearPortForwards()
+	}
+}
+
+// Session returns the current context last saved views stack.
+func (c *Config) Session() []data.ViewState {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return nil
+	}
+
+	return slices.Clone(ct.View.Session)
+}
+
+// SetSession saves the current context views stack.
+func (c *Config) SetSession(ss []data.ViewState) {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.View.Session = ss
+	}
+}
+
+// SortSpec returns the manual sort spec of a view in the current context.
+func (c *Config) SortSpec(view string) string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return ct.View.SortSpec(view)
+}
+
+// SetSortSpec tracks the manual sort spec of a view in the current context.
+func (c *Config) SetSortSpec(view, spec string) {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.View.SetSortSpec(view, spec)
+	}
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.conn
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "time"
+
+const (
+	// DefaultSilenceDuration tracks the default alert silence duration.
+	DefaultSilenceDuration = 2 * time.Hour
+
+	// DefaultSilenceAuthor tracks the default silences creator.
+	DefaultSilenceAuthor = "k9s"
+)
+
+// Alertmanager tracks a context Alertmanager endpoint.
+type Alertmanager struct {
+	// URL is the Alertmanager base url ie http://alertmanager.monitoring:9093.
+	URL string `yaml:"url"`
+
+	// InCluster tunnels requests through a port-forward. The url host must be a cluster service.
+	InCluster bool `yaml:"inCluster,omitempty"`
+
+	// Headers are HTTP headers ie auth or tenancy. Values support environment variables expansion.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// SilenceDuration is the default duration of silences ie 2h.
+	SilenceDuration string `yaml:"silenceDuration,omitempty"`
+
+	// Author is the silences creator. Default k9s.
+	Author string `yaml:"author,omitempty"`
+}
+
+// SilenceDurationOrDefault returns the default duration of silences.
+func (a *Alertmanager) SilenceDurationOrDefault() time.Duration {
+	if a.SilenceDuration == "" {
+		return DefaultSilenceDuration
+	}
+	d, err := time.ParseDuration(a.SilenceDuration)
+	if err != nil || d <= 0 {
+		return DefaultSilenceDuration
+	}
+
+	return d
+}
+
+// AuthorOrDefault returns the silences creator.
+func (a *Alertmanager) AuthorOrDefault() string {
+	if a.Author == "" {
+		return DefaultSilenceAuthor
+	}
+
+	return a.Author
+}
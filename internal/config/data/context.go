@@ -13,13 +13,16 @@ import (
 
 // Context tracks K9s context configuration.
 type Context struct {
-	ClusterName  string       `yaml:"cluster,omitempty"`
-	ReadOnly     *bool        `yaml:"readOnly,omitempty"`
-	Skin         string       `yaml:"skin,omitempty"`
-	Namespace    *Namespace   `yaml:"namespace"`
-	View         *View        `yaml:"view"`
-	FeatureGates FeatureGates `yaml:"featureGates"`
-	Proxy        *Proxy       `yaml:"proxy"`
+	ClusterName  string        `yaml:"cluster,omitempty"`
+	ReadOnly     *bool         `yaml:"readOnly,omitempty"`
+	Skin         string        `yaml:"skin,omitempty"`
+	Namespace    *Namespace    `yaml:"namespace"`
+	View         *View         `yaml:"view"`
+	FeatureGates FeatureGates  `yaml:"featureGates"`
+	Proxy        *Proxy        `yaml:"proxy"`
+	LogBackend   *LogBackend   `yaml:"logBackend,omitempty"`
+	Alertmanager *Alertmanager `yaml:"alertmanager,omitempty"`
+	PortForwards []PortForward `yaml:"portForwards,omitempty"`
 	mx           sync.RWMutex
 }
 
@@ -60,6 +63,51 @@ func (c *Context) merge(old *Context) {
 	c.Namespace.merge(old.Namespace)
 }
 
+// GetPortForwards returns the persisted port-forwards.
+func (c *Context) GetPortForwards() []PortForward {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return append([]PortForward(nil), c.PortForwards...)
+}
+
+// AddPortForward persists a port-forward, replacing any forward on the same ports.
+func (c *Context) AddPortForward(pf PortForward) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for i := range c.PortForwards {
+		if c.PortForwards[i].Matches(pf) {
+			c.PortForwards[i] = pf
+			return
+		}
+	}
+	c.PortForwards = append(c.PortForwards, pf)
+}
+
+// ClearPortForwards removes all persisted port-forwards.
+func (c *Context) ClearPortForwards() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.PortForwards = nil
+}
+
+// RemovePortForward removes a persisted port-forward. It returns true if it was found.
+func (c *Context) RemovePortForward(pf PortForward) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for i := range c.PortForwards {
+		if c.PortForwards[i].Matches(pf) {
+			c.PortForwards = append(c.PortForwards[:i], c.PortForwards[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *Context) GetClusterName() string {
 	c.mx.RLock()
 	defer c.mx.RUnlock()
@@ -70,6 +70,35 @@ func Test_contextMerge(t *testing.T) {
 				},
 			},
 		},
+		"landings": {
+			c1: &Context{
+				Namespace: &Namespace{
+					Active:    "ns1",
+					Favorites: []string{"ns1"},
+					Landings:  map[string]Landing{"ns1": {View: "dp"}},
+				},
+			},
+			c2: &Context{
+				Namespace: &Namespace{
+					Active:    "ns2",
+					Favorites: []string{"ns2"},
+					Landings: map[string]Landing{
+						"ns1": {View: "po"},
+						"ns2": {View: "workload", Filter: "DEGRADED"},
+					},
+				},
+			},
+			e: &Context{
+				Namespace: &Namespace{
+					Active:    "ns1",
+					Favorites: []string{"ns1", "ns2"},
+					Landings: map[string]Landing{
+						"ns1": {View: "dp"},
+						"ns2": {View: "workload", Filter: "DEGRADED"},
+					},
+				},
+			},
+		},
 		"no-namespace": {
 			c1: NewContext(),
 			c2: &Context{},
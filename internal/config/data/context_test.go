@@ -30,3 +30,24 @@ func TestClusterValidateEmpty(t *testing.T) {
 	assert.Len(t, c.Namespace.Favorites, 1)
 	assert.Equal(t, []string{"default"}, c.Namespace.Favorites)
 }
+
+func TestContextPortForwards(t *testing.T) {
+	c := data.NewContext()
+	pf := data.PortForward{Namespace: "ns1", Pod: "p1", Container: "c1", LocalPort: "8080", ContainerPort: "80"}
+	c.AddPortForward(pf)
+	assert.Len(t, c.GetPortForwards(), 1)
+
+	pf2 := pf
+	pf2.Pod = "p2"
+	c.AddPortForward(pf2)
+	assert.Equal(t, []data.PortForward{pf2}, c.GetPortForwards())
+
+	pf3 := pf
+	pf3.LocalPort = "9090"
+	c.AddPortForward(pf3)
+	assert.Len(t, c.GetPortForwards(), 2)
+
+	assert.True(t, c.RemovePortForward(pf))
+	assert.False(t, c.RemovePortForward(pf))
+	assert.Equal(t, []data.PortForward{pf3}, c.GetPortForwards())
+}
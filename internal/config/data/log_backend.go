@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+const (
+	// LokiBackend tracks a Grafana Loki logs backend.
+	LokiBackend = "loki"
+
+	// ElasticBackend tracks an Elasticsearch/OpenSearch logs backend.
+	ElasticBackend = "elasticsearch"
+
+	// DefaultLokiQuery selects a container logs stream. $NAMESPACE, $POD and $CONTAINER are substituted.
+	DefaultLokiQuery = `{namespace="$NAMESPACE", pod="$POD", container="$CONTAINER"}`
+
+	// DefaultElasticIndex is the default Elasticsearch index pattern.
+	DefaultElasticIndex = "logs-*"
+
+	// DefaultLogBackendLimit caps the count of historical lines per query.
+	DefaultLogBackendLimit = 5_000
+)
+
+// LogBackendFields tracks Elasticsearch document fields. Defaults match fluent-bit kubernetes metadata.
+type LogBackendFields struct {
+	Namespace string `yaml:"namespace,omitempty"`
+	Pod       string `yaml:"pod,omitempty"`
+	Container string `yaml:"container,omitempty"`
+	Timestamp string `yaml:"timestamp,omitempty"`
+	Message   string `yaml:"message,omitempty"`
+}
+
+// LogBackend tracks a context historical logs backend.
+type LogBackend struct {
+	// Type is the backend type ie loki or elasticsearch.
+	Type string `yaml:"type"`
+
+	// URL is the backend base url.
+	URL string `yaml:"url"`
+
+	// Headers are HTTP headers ie auth or tenancy. Values support environment variables expansion.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Query is the Loki stream selector.
+	Query string `yaml:"query,omitempty"`
+
+	// Index is the Elasticsearch index pattern.
+	Index string `yaml:"index,omitempty"`
+
+	// Fields are the Elasticsearch document fields.
+	Fields LogBackendFields `yaml:"fields,omitempty"`
+
+	// Limit caps the count of historical lines per query.
+	Limit int `yaml:"limit,omitempty"`
+}
+
+// LokiQuery returns the Loki stream selector.
+func (b *LogBackend) LokiQuery() string {
+	if b.Query == "" {
+		return DefaultLokiQuery
+	}
+
+	return b.Query
+}
+
+// ElasticIndex returns the Elasticsearch index pattern.
+func (b *LogBackend) ElasticIndex() string {
+	if b.Index == "" {
+		return DefaultElasticIndex
+	}
+
+	return b.Index
+}
+
+// ElasticFields returns the Elasticsearch document fields.
+func (b *LogBackend) ElasticFields() LogBackendFields {
+	ff := b.Fields
+	if ff.Namespace == "" {
+		ff.Namespace = "kubernetes.namespace_name"
+	}
+	if ff.Pod == "" {
+		ff.Pod = "kubernetes.pod_name"
+	}
+	if ff.Container == "" {
+		ff.Container = "kubernetes.container_name"
+	}
+	if ff.Timestamp == "" {
+		ff.Timestamp = "@timestamp"
+	}
+	if ff.Message == "" {
+		ff.Message = "log"
+	}
+
+	return ff
+}
+
+// LimitOrDefault returns the count of historical lines per query.
+func (b *LogBackend) LimitOrDefault() int {
+	if b.Limit <= 0 {
+		return DefaultLogBackendLimit
+	}
+
+	return b.Limit
+}
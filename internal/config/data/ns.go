@@ -17,11 +17,18 @@ const (
 	MaxFavoritesNS = 9
 )
 
+// Landing tracks a favorite namespace default view and filter.
+type Landing struct {
+	View   string `yaml:"view"`
+	Filter string `yaml:"filter,omitempty"`
+}
+
 // Namespace tracks active and favorites namespaces.
 type Namespace struct {
-	Active        string   `yaml:"active"`
-	LockFavorites bool     `yaml:"lockFavorites"`
-	Favorites     []string `yaml:"favorites"`
+	Active        string             `yaml:"active"`
+	LockFavorites bool               `yaml:"lockFavorites"`
+	Favorites     []string           `yaml:"favorites"`
+	Landings      map[string]Landing `yaml:"landings,omitempty"`
 	mx            sync.RWMutex
 }
 
@@ -45,6 +52,15 @@ func (n *Namespace) merge(old *Namespace) {
 	n.mx.Lock()
 	defer n.mx.Unlock()
 
+	for ns, l := range old.Landings {
+		if _, ok := n.Landings[ns]; ok {
+			continue
+		}
+		if n.Landings == nil {
+			n.Landings = make(map[string]Landing, len(old.Landings))
+		}
+		n.Landings[ns] = l
+	}
 	if n.LockFavorites {
 		return
 	}
@@ -100,6 +116,16 @@ func (n *Namespace) SetActive(ns string, _ KubeSettings) error {
 	return nil
 }
 
+// Landing returns a namespace default view if any.
+func (n *Namespace) Landing(ns string) (Landing, bool) {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	l, ok := n.Landings[ns]
+
+	return l, ok && l.View != ""
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == client.NamespaceAll || n.Active == ""
 }
@@ -75,3 +75,19 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default", "fred"}, ns.Favorites)
 }
+
+func TestNSLanding(t *testing.T) {
+	ns := data.NewNamespace()
+	ns.Landings = map[string]data.Landing{
+		"team-a": {View: "workload", Filter: "DEGRADED"},
+		"team-b": {Filter: "fred"},
+	}
+
+	l, ok := ns.Landing("team-a")
+	assert.True(t, ok)
+	assert.Equal(t, data.Landing{View: "workload", Filter: "DEGRADED"}, l)
+	_, ok = ns.Landing("team-b")
+	assert.False(t, ok)
+	_, ok = ns.Landing("default")
+	assert.False(t, ok)
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+// PortForward tracks a persisted port-forward.
+type PortForward struct {
+	// Namespace is the forwarded pod namespace.
+	Namespace string `yaml:"namespace"`
+
+	// Pod is the last forwarded pod name.
+	Pod string `yaml:"pod"`
+
+	// Selector re-resolves a backing pod when the forwarded pod goes away ie app=fred.
+	Selector string `yaml:"selector,omitempty"`
+
+	// Container is the forwarded container name.
+	Container string `yaml:"container"`
+
+	// Address is the local address to bind to.
+	Address string `yaml:"address,omitempty"`
+
+	// LocalPort is the local port.
+	LocalPort string `yaml:"localPort"`
+
+	// ContainerPort is the container port.
+	ContainerPort string `yaml:"containerPort"`
+}
+
+// Matches checks if both forwards target the same container ports regardless of the pod.
+func (p PortForward) Matches(pf PortForward) bool {
+	return p.Namespace == pf.Namespace &&
+		p.Container == pf.Container &&
+		p.LocalPort == pf.LocalPort &&
+		p.ContainerPort == pf.ContainerPort
+}
@@ -5,9 +5,18 @@ package data
 
 const DefaultView = "po"
 
+// ViewState tracks a session view command and filter.
+type ViewState struct {
+	Command string `yaml:"command"`
+	Filter  string `yaml:"filter,omitempty"`
+}
+
 // View tracks view configuration options.
 type View struct {
-	Active string `yaml:"active"`
+	Active  string            `yaml:"active"`
+	Filters map[string]string `yaml:"filters,omitempty"`
+	Sorts   map[string]string `yaml:"sorts,omitempty"`
+	Session []ViewState       `yaml:"session,omitempty"`
 }
 
 // NewView creates a new view configuration.
@@ -21,3 +30,39 @@ func (v *View) Validate() {
 		v.Active = DefaultView
 	}
 }
+
+// LastFilter returns the last used saved filter for a given view.
+func (v *View) LastFilter(view string) string {
+	return v.Filters[view]
+}
+
+// SetLastFilter tracks the last used saved filter for a given view.
+// An empty name clears it.
+func (v *View) SetLastFilter(view, name string) {
+	if name == "" {
+		delete(v.Filters, view)
+		return
+	}
+	if v.Filters == nil {
+		v.Filters = make(map[string]string)
+	}
+	v.Filters[view] = name
+}
+
+// SortSpec returns the last manual sort spec for a given view.
+func (v *View) SortSpec(view string) string {
+	return v.Sorts[view]
+}
+
+// SetSortSpec tracks the manual sort spec for a given view.
+// An empty spec clears it.
+func (v *View) SetSortSpec(view, spec string) {
+	if spec == "" {
+		delete(v.Sorts, view)
+		return
+	}
+	if v.Sorts == nil {
+		v.Sorts = make(map[string]string)
+	}
+	v.Sorts[view] = spec
+}
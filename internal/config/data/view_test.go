@@ -26,3 +26,27 @@ func TestViewValidateBlank(t *testing.T) {
 	v.Validate()
 	assert.Equal(t, "po", v.Active)
 }
+
+func TestViewLastFilter(t *testing.T) {
+	v := data.NewView()
+	assert.Empty(t, v.LastFilter("v1/pods"))
+
+	v.SetLastFilter("v1/pods", "failing")
+	assert.Equal(t, "failing", v.LastFilter("v1/pods"))
+	assert.Empty(t, v.LastFilter("v1/services"))
+
+	v.SetLastFilter("v1/pods", "")
+	assert.Empty(t, v.LastFilter("v1/pods"))
+	assert.Empty(t, v.Filters)
+}
+
+func TestViewSortSpec(t *testing.T) {
+	v := data.NewView()
+	assert.Empty(t, v.SortSpec("v1/pods"))
+
+	v.SetSortSpec("v1/pods", "STATUS:asc,AGE:desc")
+	assert.Equal(t, "STATUS:asc,AGE:desc", v.SortSpec("v1/pods"))
+
+	v.SetSortSpec("v1/pods", "")
+	assert.Empty(t, v.Sorts)
+}
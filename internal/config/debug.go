@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Debug tracks ephemeral debug containers settings.
+type Debug struct {
+	// Image names the debug container image. Defaults to busybox.
+	Image string `json:"image,omitempty" yaml:"image,omitempty"`
+
+	// Command overrides the debug container entrypoint.
+	Command []string `json:"command,omitempty" yaml:"command,omitempty"`
+
+	// Profile names the debug container security profile ie general, sysadmin or netadmin.
+	Profile string `json:"profile,omitempty" yaml:"profile,omitempty"`
+}
+
+// ImageOrDefault returns the debug container image.
+func (d Debug) ImageOrDefault() string {
+	if d.Image == "" {
+		return defaultDockerShellImage
+	}
+
+	return d.Image
+}
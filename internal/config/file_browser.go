@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+const (
+	// DefaultFileBrowserMaxViewSize tracks the default viewable file size in kilobytes.
+	DefaultFileBrowserMaxViewSize = 512
+
+	// DefaultFileBrowserMaxTransferSize tracks the default transferable size in megabytes.
+	DefaultFileBrowserMaxTransferSize = 100
+)
+
+// FileBrowser tracks pod filesystem browser settings.
+type FileBrowser struct {
+	// MaxViewSize is the largest file size in kilobytes that can be viewed.
+	MaxViewSize int `json:"maxViewSize,omitempty" yaml:"maxViewSize,omitempty"`
+
+	// MaxTransferSize is the largest download or upload size in megabytes.
+	MaxTransferSize int `json:"maxTransferSize,omitempty" yaml:"maxTransferSize,omitempty"`
+}
+
+// MaxViewSizeOrDefault returns the viewable file size limit in bytes.
+func (f FileBrowser) MaxViewSizeOrDefault() int64 {
+	if f.MaxViewSize <= 0 {
+		return DefaultFileBrowserMaxViewSize * 1024
+	}
+
+	return int64(f.MaxViewSize) * 1024
+}
+
+// MaxTransferSizeOrDefault returns the transfer size limit in bytes.
+func (f FileBrowser) MaxTransferSizeOrDefault() int64 {
+	if f.MaxTransferSize <= 0 {
+		return DefaultFileBrowserMaxTransferSize * 1024 * 1024
+	}
+
+	return int64(f.MaxTransferSize) * 1024 * 1024
+}
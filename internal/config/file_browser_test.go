@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileBrowserDefaults(t *testing.T) {
+	var f config.FileBrowser
+	assert.Equal(t, int64(config.DefaultFileBrowserMaxViewSize*1024), f.MaxViewSizeOrDefault())
+	assert.Equal(t, int64(config.DefaultFileBrowserMaxTransferSize*1024*1024), f.MaxTransferSizeOrDefault())
+
+	f = config.FileBrowser{MaxViewSize: 1, MaxTransferSize: 2}
+	assert.Equal(t, int64(1024), f.MaxViewSizeOrDefault())
+	assert.Equal(t, int64(2*1024*1024), f.MaxTransferSizeOrDefault())
+}
@@ -63,6 +63,12 @@ var (
 	// AppContextsDir tracks contexts data directory.
 	AppContextsDir string
 
+	// AppLogCapturesDir tracks log captures directory.
+	AppLogCapturesDir string
+
+	// AppAuthDir tracks oidc login tokens cache directory.
+	AppAuthDir string
+
 	// AppConfigFile tracks k9s config file.
 	AppConfigFile string
 
@@ -128,6 +134,8 @@ func initK9sEnvLocs() error {
 	if err := data.EnsureFullPath(AppDumpsDir, data.DefaultDirMod); err != nil {
 		slog.Warn("Unable to create screen-dumps dir", slogs.Dir, AppDumpsDir, slogs.Error, err)
 	}
+	AppLogCapturesDir = filepath.Join(AppConfigDir, "log-captures")
+	AppAuthDir = filepath.Join(AppConfigDir, "auth")
 	AppBenchmarksDir = filepath.Join(AppConfigDir, "benchmarks")
 	if err := data.EnsureFullPath(AppBenchmarksDir, data.DefaultDirMod); err != nil {
 		slog.Warn("Unable to create benchmarks dir",
@@ -187,6 +195,16 @@ func initXDGLocs() error {
 		return err
 	}
 
+	AppLogCapturesDir, err = xdg.StateFile(filepath.Join(AppName, "log-captures"))
+	if err != nil {
+		return err
+	}
+
+	AppAuthDir, err = xdg.StateFile(filepath.Join(AppName, "auth"))
+	if err != nil {
+		return err
+	}
+
 	AppBenchmarksDir, err = xdg.StateFile(filepath.Join(AppName, "benchmarks"))
 	if err != nil {
 		slog.Warn("No benchmarks dir detected",
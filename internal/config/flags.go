@@ -25,6 +25,7 @@ type Flags struct {
 	AllNamespaces *bool
 	ReadOnly      *bool
 	Write         *bool
+	DryRun        *bool
 	Crumbsless    *bool
 	Splashless    *bool
 	Invert        *bool
@@ -43,6 +44,7 @@ func NewFlags() *Flags {
 		AllNamespaces: boolPtr(false),
 		ReadOnly:      boolPtr(false),
 		Write:         boolPtr(false),
+		DryRun:        boolPtr(false),
 		Crumbsless:    boolPtr(false),
 		Splashless:    boolPtr(false),
 		Invert:        boolPtr(false),
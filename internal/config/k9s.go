@@ -15,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal/cast"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/slogs"
@@ -34,25 +35,40 @@ var defaultGPUVendors = gpuVendors{
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	GPUVendors          gpuVendors `json:"gpuVendors" yaml:"gpuVendors"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         float32    `json:"refreshRate" yaml:"refreshRate"`
-	APIServerTimeout    string     `json:"apiServerTimeout" yaml:"apiServerTimeout"`
-	MaxConnRetry        int32      `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	PortForwardAddress  string     `yaml:"portForwardAddress"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            *ShellPod  `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
-	DefaultView         string     `json:"defaultView" yaml:"defaultView"`
+	LiveViewAutoRefresh bool          `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	GPUVendors          gpuVendors    `json:"gpuVendors" yaml:"gpuVendors"`
+	ScreenDumpDir       string        `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         float32       `json:"refreshRate" yaml:"refreshRate"`
+	APIServerTimeout    string        `json:"apiServerTimeout" yaml:"apiServerTimeout"`
+	MaxConnRetry        int32         `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ListPageSize        int64         `json:"listPageSize" yaml:"listPageSize,omitempty"`
+	ReadOnly            bool          `json:"readOnly" yaml:"readOnly"`
+	DryRun              bool          `json:"dryRun" yaml:"dryRun,omitempty"`
+	NoExitOnCtrlC       bool          `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	PortForwardAddress  string        `yaml:"portForwardAddress"`
+	UI                  UI            `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool          `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool          `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            *ShellPod     `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans    `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger        `json:"logger" yaml:"logger"`
+	Thresholds          Threshold     `json:"thresholds" yaml:"thresholds"`
+	DefaultView         string        `json:"defaultView" yaml:"defaultView"`
+	Workload            Workload      `json:"workload" yaml:"workload,omitempty"`
+	Audit               Audit         `json:"audit" yaml:"audit,omitempty"`
+	Metrics             Metrics       `json:"metrics" yaml:"metrics,omitempty"`
+	DebugContainer      Debug         `json:"debugContainer" yaml:"debugContainer,omitempty"`
+	Recording           Recording     `json:"recording" yaml:"recording,omitempty"`
+	FileBrowser         FileBrowser   `json:"fileBrowser" yaml:"fileBrowser,omitempty"`
+	PortForward         PortForward   `json:"portForward" yaml:"portForward,omitempty"`
+	Session             Session       `json:"session" yaml:"session,omitempty"`
+	ClusterProxy        ClusterProxy  `json:"clusterProxy" yaml:"clusterProxy,omitempty"`
+	Monitor             Monitor       `json:"monitor" yaml:"monitor,omitempty"`
+	Notifications       Notifications `json:"notifications" yaml:"notifications,omitempty"`
+	Registries          Registries    `json:"registries" yaml:"registries,omitempty"`
 	manualRefreshRate   float32
 	manualReadOnly      *bool
+	manualDryRun        *bool
 	manualCommand       *string
 	manualScreenDumpDir *string
 	refreshRateWarned   bool
@@ -141,7 +157,20 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.RefreshRate = k1.RefreshRate
 	k.APIServerTimeout = k1.APIServerTimeout
 	k.MaxConnRetry = k1.MaxConnRetry
+	k.ListPageSize = k1.ListPageSize
 	k.ReadOnly = k1.ReadOnly
+	k.DryRun = k1.DryRun
+	k.Audit = k1.Audit
+	k.Metrics = k1.Metrics
+	k.DebugContainer = k1.DebugContainer
+	k.Recording = k1.Recording
+	k.FileBrowser = k1.FileBrowser
+	k.PortForward = k1.PortForward
+	k.Session = k1.Session
+	k.ClusterProxy = k1.ClusterProxy
+	k.Monitor = k1.Monitor
+	k.Notifications = k1.Notifications
+	k.Registries = k1.Registries
 	k.NoExitOnCtrlC = k1.NoExitOnCtrlC
 	k.PortForwardAddress = k1.PortForwardAddress
 	k.UI = k1.UI
@@ -150,6 +179,7 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
+	k.Workload = k1.Workload
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
@@ -174,6 +204,23 @@ func (k *K9s) ContextScreenDumpDir() string {
 	return filepath.Join(k.AppScreenDumpDir(), k.contextPath())
 }
 
+// ContextLogCaptureDir fetch context specific log captures dir.
+func (k *K9s) ContextLogCaptureDir() string {
+	d := k.Logger.Capture.Dir
+	if d == "" {
+		d = AppLogCapturesDir
+	}
+
+	return filepath.Join(d, k.contextPath())
+}
+
+// ContextRecordingPath returns a new session recording path in the context screen dumps dir.
+func (k *K9s) ContextRecordingPath(name string) string {
+	f := fmt.Sprintf("%s-%d%s", name, time.Now().UnixNano(), cast.Ext)
+
+	return filepath.Join(k.ContextScreenDumpDir(), data.SanitizeFileName(f))
+}
+
 func (k *K9s) contextPath() string {
 	if k.getActiveConfig() == nil {
 		return "na"
@@ -332,6 +379,9 @@ func (k *K9s) Override(k9sFlags *Flags) {
 		var falseVal bool
 		k.manualReadOnly = &falseVal
 	}
+	if k9sFlags.DryRun != nil && *k9sFlags.DryRun {
+		k.manualDryRun = k9sFlags.DryRun
+	}
 	k.manualCommand = k9sFlags.Command
 	k.manualScreenDumpDir = k9sFlags.ScreenDumpDir
 }
@@ -402,6 +452,19 @@ func (k *K9s) GetRefreshRate() float32 {
 	return rate
 }
 
+// GetListPageSize returns the number of resources to fetch per list request.
+// A negative page size disables pagination.
+func (k *K9s) GetListPageSize() int64 {
+	switch {
+	case k.ListPageSize < 0:
+		return 0
+	case k.ListPageSize == 0:
+		return defaultListPageSize
+	default:
+		return k.ListPageSize
+	}
+}
+
 // RefreshDuration returns the refresh rate as a time.Duration.
 func (k *K9s) RefreshDuration() time.Duration {
 	return time.Duration(k.GetRefreshRate() * float32(time.Second))
@@ -420,6 +483,23 @@ func (k *K9s) IsReadOnly() bool {
 	return ro
 }
 
+// IsDryRun returns the dry run setting.
+func (k *K9s) IsDryRun() bool {
+	if k.manualDryRun != nil {
+		return *k.manualDryRun
+	}
+
+	return k.DryRun
+}
+
+// ToggleDryRun toggles the dry run setting for the current session.
+func (k *K9s) ToggleDryRun() bool {
+	dry := !k.IsDryRun()
+	k.manualDryRun = &dry
+
+	return dry
+}
+
 // Validate the current configuration.
 func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 	if k.RefreshRate <= 0 {
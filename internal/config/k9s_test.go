@@ -147,3 +147,37 @@ func TestAppScreenDumpDir(t *testing.T) {
 	require.NoError(t, cfg.Load("testdata/configs/k9s.yaml", true))
 	assert.Equal(t, "/tmp/k9s-test/screen-dumps", cfg.K9s.AppScreenDumpDir())
 }
+
+func TestListPageSize(t *testing.T) {
+	uu := map[string]struct {
+		size, e int64
+	}{
+		"default":  {e: 500},
+		"custom":   {size: 100, e: 100},
+		"disabled": {size: -1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.K9s{ListPageSize: u.size}
+			assert.Equal(t, u.e, k.GetListPageSize())
+		})
+	}
+}
+
+func TestK9sDryRun(t *testing.T) {
+	var k config.K9s
+	assert.False(t, k.IsDryRun())
+
+	k.DryRun = true
+	assert.True(t, k.IsDryRun())
+	assert.False(t, k.ToggleDryRun())
+	assert.False(t, k.IsDryRun())
+
+	flags := config.NewFlags()
+	flags.DryRun = new(bool)
+	*flags.DryRun = true
+	k.Override(flags)
+	assert.True(t, k.IsDryRun())
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+const (
+	// DefaultLogCaptureMaxSize tracks the default capture file size in megabytes.
+	DefaultLogCaptureMaxSize = 10
+
+	// DefaultLogCaptureMaxFiles tracks the default number of rotated capture files to keep.
+	DefaultLogCaptureMaxFiles = 5
+)
+
+// LogCapture tracks log streams disk capture settings.
+type LogCapture struct {
+	// Enabled mirrors tailed log streams to disk.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// Dir is the captures root directory. Defaults to the k9s state log-captures dir.
+	Dir string `json:"dir,omitempty" yaml:"dir,omitempty"`
+
+	// MaxSize is the capture file size in megabytes before it gets rotated.
+	MaxSize int `json:"maxSize,omitempty" yaml:"maxSize,omitempty"`
+
+	// MaxFiles is the number of rotated files to keep per container.
+	MaxFiles int `json:"maxFiles,omitempty" yaml:"maxFiles,omitempty"`
+}
+
+// MaxSizeOrDefault returns the capture file max size in megabytes.
+func (c LogCapture) MaxSizeOrDefault() int {
+	if c.MaxSize <= 0 {
+		return DefaultLogCaptureMaxSize
+	}
+
+	return c.MaxSize
+}
+
+// MaxFilesOrDefault returns the number of capture files to keep.
+func (c LogCapture) MaxFilesOrDefault() int {
+	if c.MaxFiles <= 0 {
+		return DefaultLogCaptureMaxFiles
+	}
+
+	return c.MaxFiles
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"log/slog"
+	"regexp"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DefaultHighlightColor tracks the default log highlight color.
+const DefaultHighlightColor = "red"
+
+// LogHighlight represents a log line highlight rule.
+type LogHighlight struct {
+	// Pattern is a regular expression matched against each log line.
+	Pattern string `json:"pattern" yaml:"pattern"`
+
+	// Color is the matching line background color.
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+
+	// Beep rings the terminal bell when a new line matches.
+	Beep bool `json:"beep,omitempty" yaml:"beep,omitempty"`
+
+	// Notify flags the logged resource in the status bar when a new line matches.
+	Notify bool `json:"notify,omitempty" yaml:"notify,omitempty"`
+
+	// Flag marks the logged pods as invalid in the pods view when a new line matches.
+	Flag bool `json:"flag,omitempty" yaml:"flag,omitempty"`
+}
+
+// IsAlert checks if the rule raises alerts.
+func (h LogHighlight) IsAlert() bool {
+	return h.Beep || h.Notify || h.Flag
+}
+
+func validHighlights(hh []LogHighlight) []LogHighlight {
+	out := make([]LogHighlight, 0, len(hh))
+	for _, h := range hh {
+		if _, err := regexp.Compile(h.Pattern); err != nil || h.Pattern == "" {
+			slog.Warn("Skipping invalid log highlight pattern",
+				slogs.Pattern, h.Pattern,
+				slogs.Error, err,
+			)
+			continue
+		}
+		if h.Color == "" {
+			h.Color = DefaultHighlightColor
+		}
+		out = append(out, h)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
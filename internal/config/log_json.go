@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"slices"
+	"strings"
+)
+
+// LogLevels tracks structured log levels by increasing severity.
+var LogLevels = []string{"trace", "debug", "info", "warn", "error", "fatal"}
+
+// LogJSON tracks structured (JSON) log rendering options.
+type LogJSON struct {
+	// Enabled renders JSON log lines in structured mode by default.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// Fields lists the only fields to show. All fields are shown when empty.
+	Fields []string `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// HiddenFields lists fields to hide.
+	HiddenFields []string `json:"hiddenFields,omitempty" yaml:"hiddenFields,omitempty"`
+
+	// Level hides lines below the given level.
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+
+	// Pretty indents the payloads.
+	Pretty bool `json:"pretty,omitempty" yaml:"pretty,omitempty"`
+
+	// Containers tracks per container overrides keyed by container name.
+	Containers map[string]LogJSON `json:"containers,omitempty" yaml:"containers,omitempty"`
+}
+
+// Validate ensures the level is a known one.
+func (l LogJSON) Validate() LogJSON {
+	l.Level = strings.ToLower(l.Level)
+	if l.Level != "" && !slices.Contains(LogLevels, l.Level) {
+		l.Level = ""
+	}
+	for k, v := range l.Containers {
+		l.Containers[k] = v.Validate()
+	}
+
+	return l
+}
+
+// ForContainer returns the settings for a given container.
+func (l LogJSON) ForContainer(co string) LogJSON {
+	c, ok := l.Containers[co]
+	if !ok {
+		c = l
+	}
+	c.Containers = nil
+
+	return c
+}
+
+// LevelRank returns the level severity or -1 if unknown.
+func LevelRank(level string) int {
+	level = strings.ToLower(level)
+	switch level {
+	case "warning":
+		level = "warn"
+	case "err":
+		level = "error"
+	case "critical", "panic":
+		level = "fatal"
+	}
+
+	return slices.Index(LogLevels, level)
+}
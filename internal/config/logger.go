@@ -16,13 +16,16 @@ const (
 
 // Logger tracks logger options.
 type Logger struct {
-	TailCount         int64 `json:"tail" yaml:"tail"`
-	BufferSize        int   `json:"buffer" yaml:"buffer"`
-	SinceSeconds      int64 `json:"sinceSeconds" yaml:"sinceSeconds"`
-	TextWrap          bool  `json:"textWrap" yaml:"textWrap"`
-	DisableAutoscroll bool  `json:"disableAutoscroll" yaml:"disableAutoscroll"`
-	ColumnLock        bool  `json:"columnLock" yaml:"columnLock"`
-	ShowTime          bool  `json:"showTime" yaml:"showTime"`
+	TailCount         int64          `json:"tail" yaml:"tail"`
+	BufferSize        int            `json:"buffer" yaml:"buffer"`
+	SinceSeconds      int64          `json:"sinceSeconds" yaml:"sinceSeconds"`
+	TextWrap          bool           `json:"textWrap" yaml:"textWrap"`
+	DisableAutoscroll bool           `json:"disableAutoscroll" yaml:"disableAutoscroll"`
+	ColumnLock        bool           `json:"columnLock" yaml:"columnLock"`
+	ShowTime          bool           `json:"showTime" yaml:"showTime"`
+	JSON              LogJSON        `json:"json,omitempty" yaml:"json,omitempty"`
+	Highlights        []LogHighlight `json:"highlights,omitempty" yaml:"highlights,omitempty"`
+	Capture           LogCapture     `json:"capture,omitempty" yaml:"capture,omitempty"`
 }
 
 // NewLogger returns a new instance.
@@ -48,6 +51,8 @@ func (l Logger) Validate() Logger {
 	if l.SinceSeconds == 0 {
 		l.SinceSeconds = DefaultSinceSeconds
 	}
+	l.JSON = l.JSON.Validate()
+	l.Highlights = validHighlights(l.Highlights)
 
 	return l
 }
@@ -25,3 +25,46 @@ func TestLoggerValidate(t *testing.T) {
 	assert.Equal(t, int64(100), l.TailCount)
 	assert.Equal(t, 5000, l.BufferSize)
 }
+
+func TestLogJSONValidate(t *testing.T) {
+	l := config.Logger{
+		JSON: config.LogJSON{
+			Level: "Blee",
+			Containers: map[string]config.LogJSON{
+				"c1": {Level: "WARN"},
+			},
+		},
+	}
+	l = l.Validate()
+
+	assert.Empty(t, l.JSON.Level)
+	assert.Equal(t, "warn", l.JSON.ForContainer("c1").Level)
+	assert.Empty(t, l.JSON.ForContainer("c2").Level)
+}
+
+func TestLogHighlightsValidate(t *testing.T) {
+	l := config.Logger{
+		Highlights: []config.LogHighlight{
+			{Pattern: "panic:", Beep: true},
+			{Pattern: "[a-"},
+			{Pattern: ""},
+			{Pattern: "warn", Color: "orange"},
+		},
+	}
+	l = l.Validate()
+
+	assert.Equal(t, []config.LogHighlight{
+		{Pattern: "panic:", Color: config.DefaultHighlightColor, Beep: true},
+		{Pattern: "warn", Color: "orange"},
+	}, l.Highlights)
+}
+
+func TestLogCaptureDefaults(t *testing.T) {
+	var c config.LogCapture
+	assert.Equal(t, config.DefaultLogCaptureMaxSize, c.MaxSizeOrDefault())
+	assert.Equal(t, config.DefaultLogCaptureMaxFiles, c.MaxFilesOrDefault())
+
+	c = config.LogCapture{MaxSize: 1, MaxFiles: 2}
+	assert.Equal(t, 1, c.MaxSizeOrDefault())
+	assert.Equal(t, 2, c.MaxFilesOrDefault())
+}
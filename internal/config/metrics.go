@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// MetricsServerProvider sources metrics from the cluster metrics-server.
+	MetricsServerProvider = "metrics-server"
+
+	// PrometheusProvider sources metrics from a Prometheus server.
+	PrometheusProvider = "prometheus"
+)
+
+// PrometheusQueries tracks PromQL overrides used to compute pods and nodes usage.
+type PrometheusQueries struct {
+	PodCPU  string `json:"podCPU,omitempty" yaml:"podCPU,omitempty"`
+	PodMEM  string `json:"podMEM,omitempty" yaml:"podMEM,omitempty"`
+	NodeCPU string `json:"nodeCPU,omitempty" yaml:"nodeCPU,omitempty"`
+	NodeMEM string `json:"nodeMEM,omitempty" yaml:"nodeMEM,omitempty"`
+}
+
+// PrometheusCustom tracks custom metrics PromQL queries keyed by metric name.
+// Pods queries must yield namespace and pod labels and nodes queries a node label.
+type PrometheusCustom struct {
+	Pods  map[string]string `json:"pods,omitempty" yaml:"pods,omitempty"`
+	Nodes map[string]string `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+}
+
+// Prometheus tracks a Prometheus metrics provider options.
+type Prometheus struct {
+	// Endpoint locates the Prometheus server ie http://localhost:9090.
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+
+	// Service proxies an in cluster Prometheus through the api server ie monitoring/prometheus:9090.
+	Service string `json:"service,omitempty" yaml:"service,omitempty"`
+
+	// Headers lists additional request headers ie Authorization.
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+
+	// Queries overrides the default cadvisor based queries.
+	Queries PrometheusQueries `json:"queries,omitempty" yaml:"queries,omitempty"`
+
+	// Custom lists custom metrics exposed to views custom columns as .metrics.<name>.
+	Custom PrometheusCustom `json:"custom,omitempty" yaml:"custom,omitempty"`
+}
+
+// Metrics tracks cluster metrics provider options.
+type Metrics struct {
+	// Provider names the metrics source ie metrics-server or prometheus. Defaults to metrics-server.
+	Provider   string      `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Prometheus *Prometheus `json:"prometheus,omitempty" yaml:"prometheus,omitempty"`
+}
+
+// Validate checks the metrics configuration.
+func (m Metrics) Validate() error {
+	switch m.Provider {
+	case "", MetricsServerProvider:
+	case PrometheusProvider:
+		if m.Prometheus == nil || (m.Prometheus.Endpoint == "" && m.Prometheus.Service == "") {
+			return fmt.Errorf("%s metrics provider requires an endpoint or a service", m.Provider)
+		}
+	default:
+		return fmt.Errorf("unsupported metrics provider: %q", m.Provider)
+	}
+
+	return nil
+}
+
+// NewProvider returns the configured metrics provider or nil when metrics-server is used.
+func (m Metrics) NewProvider(conn client.Connection) (client.MetricsProvider, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	if m.Provider != PrometheusProvider {
+		return nil, nil
+	}
+	p, q := m.Prometheus, m.Prometheus.Queries
+
+	return client.NewPrometheus(conn, p.Endpoint, p.Service, p.Headers, client.PrometheusQueries{
+		PodCPU:      q.PodCPU,
+		PodMEM:      q.PodMEM,
+		NodeCPU:     q.NodeCPU,
+		NodeMEM:     q.NodeMEM,
+		CustomPods:  p.Custom.Pods,
+		CustomNodes: p.Custom.Nodes,
+	}), nil
+}
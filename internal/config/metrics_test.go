@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsValidate(t *testing.T) {
+	uu := map[string]struct {
+		m   config.Metrics
+		err string
+	}{
+		"default": {},
+		"metrics-server": {
+			m: config.Metrics{Provider: config.MetricsServerProvider},
+		},
+		"prometheus": {
+			m: config.Metrics{Provider: config.PrometheusProvider, Prometheus: &config.Prometheus{Endpoint: "http://localhost:9090"}},
+		},
+		"prometheus-service": {
+			m: config.Metrics{Provider: config.PrometheusProvider, Prometheus: &config.Prometheus{Service: "monitoring/prometheus:9090"}},
+		},
+		"prometheus-blank": {
+			m:   config.Metrics{Provider: config.PrometheusProvider},
+			err: "prometheus metrics provider requires an endpoint or a service",
+		},
+		"unknown": {
+			m:   config.Metrics{Provider: "fred"},
+			err: `unsupported metrics provider: "fred"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.m.Validate()
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMetricsNewProvider(t *testing.T) {
+	p, err := config.Metrics{}.NewProvider(nil)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = config.Metrics{Provider: config.PrometheusProvider, Prometheus: &config.Prometheus{Endpoint: "http://localhost:9090"}}.NewProvider(nil)
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+}
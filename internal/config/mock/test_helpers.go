@@ -146,6 +146,9 @@ func (mockConnection) DialLogs() (kubernetes.Interface, error) {
 func (mockConnection) SwitchContext(string) error {
 	return nil
 }
+func (mockConnection) ReloadConfig() error {
+	return nil
+}
 func (mockConnection) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
 	return nil, nil
 }
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+const (
+	// DefaultMonitorInterval tracks the default health probes interval.
+	DefaultMonitorInterval = 30 * time.Second
+
+	// DefaultMonitorTimeout tracks the default health probe timeout.
+	DefaultMonitorTimeout = 5 * time.Second
+
+	// DefaultMonitorPath tracks the default health probe url path.
+	DefaultMonitorPath = "/"
+)
+
+// Monitor tracks services and ingresses synthetic health checks settings.
+type Monitor struct {
+	// Interval is the delay between probes ie 30s.
+	Interval string `json:"interval,omitempty" yaml:"interval,omitempty"`
+
+	// Timeout caps a probe duration ie 5s.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Path is the probed url path unless overridden by the k9scli.io/monitor-path annotation. Default /.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// IntervalOrDefault returns the probes interval.
+func (m Monitor) IntervalOrDefault() time.Duration {
+	return durationOrDefault(m.Interval, DefaultMonitorInterval)
+}
+
+// TimeoutOrDefault returns the probe timeout.
+func (m Monitor) TimeoutOrDefault() time.Duration {
+	return durationOrDefault(m.Timeout, DefaultMonitorTimeout)
+}
+
+// PathOrDefault returns the probed url path.
+func (m Monitor) PathOrDefault() string {
+	if m.Path == "" {
+		return DefaultMonitorPath
+	}
+
+	return m.Path
+}
+
+func durationOrDefault(s string, def time.Duration) time.Duration {
+	if s == "" {
+		return def
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return def
+	}
+
+	return d
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorDefaults(t *testing.T) {
+	var m config.Monitor
+	assert.Equal(t, config.DefaultMonitorInterval, m.IntervalOrDefault())
+	assert.Equal(t, config.DefaultMonitorTimeout, m.TimeoutOrDefault())
+	assert.Equal(t, config.DefaultMonitorPath, m.PathOrDefault())
+
+	m = config.Monitor{Interval: "1m", Timeout: "-2s", Path: "/healthz"}
+	assert.Equal(t, time.Minute, m.IntervalOrDefault())
+	assert.Equal(t, config.DefaultMonitorTimeout, m.TimeoutOrDefault())
+	assert.Equal(t, "/healthz", m.PathOrDefault())
+
+	m.Interval = "bozo"
+	assert.Equal(t, config.DefaultMonitorInterval, m.IntervalOrDefault())
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DefaultNotifyInterval tracks the default delay between conditions evaluations.
+const DefaultNotifyInterval = 15 * time.Second
+
+const (
+	// CondCrashLoop triggers on pods with containers in CrashLoopBackOff.
+	CondCrashLoop = "crashLoop"
+
+	// CondNodeNotReady triggers on nodes not reporting ready.
+	CondNodeNotReady = "nodeNotReady"
+
+	// CondPVCPending triggers on unbound persistent volume claims.
+	CondPVCPending = "pvcPending"
+)
+
+const (
+	// NotifyBell rings the terminal bell.
+	NotifyBell = "bell"
+
+	// NotifyBanner flashes a status bar banner.
+	NotifyBanner = "banner"
+
+	// NotifyExec runs the rule command.
+	NotifyExec = "exec"
+)
+
+var (
+	knownConditions    = []string{CondCrashLoop, CondNodeNotReady, CondPVCPending}
+	knownNotifyActions = []string{NotifyBell, NotifyBanner, NotifyExec}
+)
+
+// Notifications tracks watched conditions subscriptions.
+type Notifications struct {
+	// Interval is the delay between conditions evaluations ie 15s.
+	Interval string `json:"interval,omitempty" yaml:"interval,omitempty"`
+
+	// Rules lists the watched conditions.
+	Rules []NotifyRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// NotifyRule represents a condition subscription.
+type NotifyRule struct {
+	// Name identifies the rule in notifications.
+	Name string `json:"name" yaml:"name"`
+
+	// Condition is one of crashLoop, nodeNotReady or pvcPending.
+	Condition string `json:"condition" yaml:"condition"`
+
+	// Namespaces scopes namespaced conditions. Default all namespaces.
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+
+	// For is how long a condition must hold before notifying ie 2m.
+	For string `json:"for,omitempty" yaml:"for,omitempty"`
+
+	// Actions lists bell, banner and/or exec. Default banner.
+	Actions []string `json:"actions,omitempty" yaml:"actions,omitempty"`
+
+	// Command runs on exec actions with K9S_NOTIFY_* env vars set.
+	Command string `json:"command,omitempty" yaml:"command,omitempty"`
+
+	// Args are the command arguments.
+	Args []string `json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+// IntervalOrDefault returns the evaluations interval.
+func (n Notifications) IntervalOrDefault() time.Duration {
+	return durationOrDefault(n.Interval, DefaultNotifyInterval)
+}
+
+// ValidRules returns the rules with known conditions and actions.
+func (n Notifications) ValidRules() []NotifyRule {
+	rr := make([]NotifyRule, 0, len(n.Rules))
+	for _, r := range n.Rules {
+		if !slices.Contains(knownConditions, r.Condition) {
+			slog.Warn("Skipping notification rule with unknown condition",
+				slogs.Name, r.Name,
+				slogs.Condition, r.Condition,
+			)
+			continue
+		}
+		aa := r.ActionsOrDefault()
+		if slices.ContainsFunc(aa, func(a string) bool { return !slices.Contains(knownNotifyActions, a) }) {
+			slog.Warn("Skipping notification rule with unknown action",
+				slogs.Name, r.Name,
+				slogs.Action, aa,
+			)
+			continue
+		}
+		if slices.Contains(aa, NotifyExec) && r.Command == "" {
+			slog.Warn("Skipping exec notification rule with no command", slogs.Name, r.Name)
+			continue
+		}
+		rr = append(rr, r)
+	}
+
+	return rr
+}
+
+// ActionsOrDefault returns the rule notification actions.
+func (r NotifyRule) ActionsOrDefault() []string {
+	if len(r.Actions) == 0 {
+		return []string{NotifyBanner}
+	}
+
+	return r.Actions
+}
+
+// ForOrDefault returns how long a condition must hold before notifying.
+func (r NotifyRule) ForOrDefault() time.Duration {
+	return durationOrDefault(r.For, 0)
+}
+
+// NameOrDefault returns the rule name.
+func (r NotifyRule) NameOrDefault() string {
+	if r.Name == "" {
+		return r.Condition
+	}
+
+	return r.Name
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsDefaults(t *testing.T) {
+	var n config.Notifications
+	assert.Equal(t, config.DefaultNotifyInterval, n.IntervalOrDefault())
+
+	r := config.NotifyRule{Condition: config.CondPVCPending}
+	assert.Equal(t, []string{config.NotifyBanner}, r.ActionsOrDefault())
+	assert.Equal(t, time.Duration(0), r.ForOrDefault())
+	assert.Equal(t, config.CondPVCPending, r.NameOrDefault())
+
+	r = config.NotifyRule{Name: "fred", For: "2m", Actions: []string{config.NotifyBell}}
+	assert.Equal(t, 2*time.Minute, r.ForOrDefault())
+	assert.Equal(t, "fred", r.NameOrDefault())
+}
+
+func TestNotificationsValidRules(t *testing.T) {
+	n := config.Notifications{Rules: []config.NotifyRule{
+		{Name: "crash", Condition: config.CondCrashLoop, Namespaces: []string{"ns1"}},
+		{Name: "bozo", Condition: "bozo"},
+		{Name: "blee", Condition: config.CondNodeNotReady, Actions: []string{"shout"}},
+		{Name: "nocmd", Condition: config.CondNodeNotReady, Actions: []string{config.NotifyExec}},
+		{Name: "hook", Condition: config.CondPVCPending, Actions: []string{config.NotifyBell, config.NotifyExec}, Command: "curl"},
+	}}
+
+	rr := n.ValidRules()
+	assert.Len(t, rr, 2)
+	assert.Equal(t, "crash", rr[0].Name)
+	assert.Equal(t, "hook", rr[1].Name)
+}
@@ -64,6 +64,28 @@ type Plugin struct {
 	Dangerous       bool          `yaml:"dangerous"`
 	OverwriteOutput bool          `yaml:"overwriteOutput"`
 	Inputs          []PluginInput `yaml:"inputs"`
+	Sink            *PluginSink   `yaml:"sink"`
+}
+
+// PluginSink describes a log sink plugin. Logs are either posted to an HTTP endpoint
+// or piped to the plugin command standard input.
+type PluginSink struct {
+	// URL is the HTTP endpoint. When blank logs are piped to the plugin command.
+	URL string `yaml:"url"`
+
+	// Method is the HTTP method. Defaults to POST.
+	Method string `yaml:"method"`
+
+	// Headers are HTTP headers. Values support environment variables expansion.
+	Headers map[string]string `yaml:"headers"`
+
+	// Stream continuously mirrors tailed logs when set. Otherwise the current buffer is sent.
+	Stream bool `yaml:"stream"`
+}
+
+// IsSink checks if the plugin is a log sink.
+func (p Plugin) IsSink() bool {
+	return p.Sink != nil
 }
 
 func (p Plugin) String() string {
@@ -81,6 +103,9 @@ func (p *Plugin) ShouldConfirm() bool {
 
 // Validate checks the plugin configuration for errors.
 func (p *Plugin) Validate() error {
+	if p.Sink != nil && p.Sink.URL == "" && p.Command == "" {
+		return errors.New("log sink requires either a url or a command")
+	}
 	seen := make(map[string]struct{}, len(p.Inputs))
 	for _, input := range p.Inputs {
 		if _, ok := seen[input.Name]; ok {
@@ -71,6 +71,37 @@ func TestPluginLoad(t *testing.T) {
 			},
 		},
 
+		"sink": {
+			path: "testdata/plugins/sink.yaml",
+			ee: Plugins{
+				Plugins: plugins{
+					"loki": Plugin{
+						Scopes:      []string{"logs"},
+						ShortCut:    "Shift-L",
+						Description: "Push to Loki",
+						Sink: &PluginSink{
+							URL:     "http://loki:3100/loki/api/v1/push",
+							Headers: map[string]string{"X-Scope-OrgID": "$CONTEXT"},
+							Stream:  true,
+						},
+					},
+					"paste": Plugin{
+						Scopes:      []string{"logs"},
+						ShortCut:    "Shift-P",
+						Description: "Pastebin",
+						Command:     "pastebinit",
+						Sink:        &PluginSink{},
+					},
+				},
+			},
+		},
+
+		"toast-sink": {
+			path: "testdata/plugins/sink-toast.yaml",
+			ee:   NewPlugins(),
+			err:  "plugin \"bozo\" validation failed for testdata/plugins/sink-toast.yaml: log sink requires either a url or a command",
+		},
+
 		"toast-no-file": {
 			path: "testdata/plugins/plugins-bozo.yaml",
 			ee:   NewPlugins(),
@@ -79,7 +110,7 @@ func TestPluginLoad(t *testing.T) {
 		"toast-invalid": {
 			path: "testdata/plugins/plugins-toast.yaml",
 			ee:   NewPlugins(),
-			err:  "plugin validation failed for testdata/plugins/plugins-toast.yaml: scopes is required\nAdditional property plugins is not allowed\ncommand is required\ndescription is required\nscopes is required\nshortCut is required\ncommand is required\ndescription is required\nscopes is required\nshortCut is required",
+			err:  "plugin validation failed for testdata/plugins/plugins-toast.yaml: scopes is required\nAdditional property plugins is not allowed\nMust validate at least one schema (anyOf)\ncommand is required\ndescription is required\nscopes is required\nshortCut is required\nMust validate at least one schema (anyOf)\ncommand is required\ndescription is required\nscopes is required\nshortCut is required",
 		},
 	}
 
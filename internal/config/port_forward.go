@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultPortForwardMaxRetries tracks the default count of reconnect attempts.
+const DefaultPortForwardMaxRetries = 10
+
+// PortForward tracks port-forwards settings.
+type PortForward struct {
+	// Persist saves port-forwards per context and restores them on launch. Default false.
+	Persist bool `json:"persist,omitempty" yaml:"persist,omitempty"`
+
+	// DisableReconnect stops re-establishing forwards when their pod goes away. Default false.
+	DisableReconnect bool `json:"disableReconnect,omitempty" yaml:"disableReconnect,omitempty"`
+
+	// MaxRetries is the count of reconnect attempts before a forward is dropped.
+	MaxRetries int `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+}
+
+// MaxRetriesOrDefault returns the count of reconnect attempts.
+func (p PortForward) MaxRetriesOrDefault() int {
+	if p.MaxRetries <= 0 {
+		return DefaultPortForwardMaxRetries
+	}
+
+	return p.MaxRetries
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortForwardMaxRetries(t *testing.T) {
+	var p config.PortForward
+	assert.Equal(t, config.DefaultPortForwardMaxRetries, p.MaxRetriesOrDefault())
+
+	p.MaxRetries = 3
+	assert.Equal(t, 3, p.MaxRetriesOrDefault())
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+// DefaultRecordingMaxIdle caps recordings idle pauses on replay.
+const DefaultRecordingMaxIdle = 2 * time.Second
+
+// Recording tracks interactive sessions recording settings.
+type Recording struct {
+	// Enabled records shell and attach sessions to the screen dumps dir. Default false.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// MaxIdle caps idle pauses on replay ie 2s. Zero keeps the original timing.
+	MaxIdle string `json:"maxIdle,omitempty" yaml:"maxIdle,omitempty"`
+}
+
+// MaxIdleOrDefault returns the replay idle pauses cap.
+func (r Recording) MaxIdleOrDefault() time.Duration {
+	if r.MaxIdle == "" {
+		return DefaultRecordingMaxIdle
+	}
+	d, err := time.ParseDuration(r.MaxIdle)
+	if err != nil || d < 0 {
+		return DefaultRecordingMaxIdle
+	}
+
+	return d
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRegistryTimeout tracks the default registry tags query timeout.
+	DefaultRegistryTimeout = 5 * time.Second
+
+	// DefaultRegistryMaxTags tracks the default number of suggested image tags.
+	DefaultRegistryMaxTags = 20
+)
+
+// Registries tracks the container registries queried for image tags suggestions.
+type Registries struct {
+	// Disable turns off image tags suggestions.
+	Disable bool `json:"disable,omitempty" yaml:"disable,omitempty"`
+
+	// Timeout bounds a registry tags query ie 5s.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// MaxTags caps the number of suggested tags. Default 20.
+	MaxTags int `json:"maxTags,omitempty" yaml:"maxTags,omitempty"`
+
+	// Credentials lists registries credentials.
+	Credentials []RegistryCredential `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+}
+
+// RegistryCredential represents a registry credentials. Values support env vars ie $REGISTRY_TOKEN.
+type RegistryCredential struct {
+	// Host is the registry host ie ghcr.io.
+	Host string `json:"host" yaml:"host"`
+
+	// Username and Password are used for basic auth and token exchanges.
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+
+	// Token is a bearer token used as is.
+	Token string `json:"token,omitempty" yaml:"token,omitempty"`
+}
+
+// TimeoutOrDefault returns a registry tags query timeout.
+func (r Registries) TimeoutOrDefault() time.Duration {
+	return durationOrDefault(r.Timeout, DefaultRegistryTimeout)
+}
+
+// MaxTagsOrDefault returns the number of suggested tags.
+func (r Registries) MaxTagsOrDefault() int {
+	if r.MaxTags <= 0 {
+		return DefaultRegistryMaxTags
+	}
+
+	return r.MaxTags
+}
+
+// CredentialFor returns a registry credentials with env vars expanded.
+func (r Registries) CredentialFor(host string) (RegistryCredential, bool) {
+	for _, c := range r.Credentials {
+		if !strings.EqualFold(c.Host, host) {
+			continue
+		}
+		return RegistryCredential{
+			Host:     c.Host,
+			Username: os.ExpandEnv(c.Username),
+			Password: os.ExpandEnv(c.Password),
+			Token:    os.ExpandEnv(c.Token),
+		}, true
+	}
+
+	return RegistryCredential{}, false
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistriesDefaults(t *testing.T) {
+	var r config.Registries
+	assert.Equal(t, config.DefaultRegistryTimeout, r.TimeoutOrDefault())
+	assert.Equal(t, config.DefaultRegistryMaxTags, r.MaxTagsOrDefault())
+
+	r = config.Registries{Timeout: "2s", MaxTags: 5}
+	assert.Equal(t, 2*time.Second, r.TimeoutOrDefault())
+	assert.Equal(t, 5, r.MaxTagsOrDefault())
+}
+
+func TestRegistriesCredentialFor(t *testing.T) {
+	t.Setenv("K9S_TEST_REGISTRY_TOKEN", "s3cr3t")
+	r := config.Registries{Credentials: []config.RegistryCredential{
+		{Host: "ghcr.io", Username: "fred", Token: "$K9S_TEST_REGISTRY_TOKEN"},
+	}}
+
+	c, ok := r.CredentialFor("GHCR.io")
+	assert.True(t, ok)
+	assert.Equal(t, "fred", c.Username)
+	assert.Equal(t, "s3cr3t", c.Token)
+
+	_, ok = r.CredentialFor("quay.io")
+	assert.False(t, ok)
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Session tracks UI session persistence settings.
+type Session struct {
+	// Persist saves the views stack, filters and port-forwards per context and offers to restore them on launch. Default false.
+	Persist bool `json:"persist,omitempty" yaml:"persist,omitempty"`
+
+	// AutoRestore restores the last session on launch without prompting. Default false.
+	AutoRestore bool `json:"autoRestore,omitempty" yaml:"autoRestore,omitempty"`
+}
@@ -4,11 +4,36 @@
 package config
 
 import (
+	"log/slog"
+	"slices"
+
+	"github.com/derailed/k9s/internal/slogs"
 	v1 "k8s.io/api/core/v1"
 )
 
 const defaultDockerShellImage = "busybox:1.37.0"
 
+const (
+	// ShellProfileLegacy runs a privileged shell pod with the node root mounted read-only.
+	ShellProfileLegacy = "legacy"
+
+	// ShellProfileGeneral runs an unprivileged shell pod sharing the node namespaces.
+	ShellProfileGeneral = "general"
+
+	// ShellProfileSysadmin runs a privileged shell pod with the node root mounted read-write.
+	ShellProfileSysadmin = "sysadmin"
+
+	// ShellProfileNetadmin runs a shell pod with network administration capabilities.
+	ShellProfileNetadmin = "netadmin"
+)
+
+var shellProfiles = []string{
+	ShellProfileLegacy,
+	ShellProfileGeneral,
+	ShellProfileSysadmin,
+	ShellProfileNetadmin,
+}
+
 // Limits represents resource limits.
 type Limits map[v1.ResourceName]string
 
@@ -24,6 +49,8 @@ type ShellPod struct {
 	ImagePullPolicy  v1.PullPolicy             `json:"imagePullPolicy,omitempty" yaml:"imagePullPolicy,omitempty"`
 	TTY              bool                      `json:"tty,omitempty" yaml:"tty,omitempty"`
 	HostPathVolume   []hostPathVolume          `json:"hostPathVolume,omitempty" yaml:"hostPathVolume,omitempty"`
+	Profile          string                    `json:"profile,omitempty" yaml:"profile,omitempty"`
+	NSEnter          bool                      `json:"nsenter,omitempty" yaml:"nsenter,omitempty"`
 }
 
 type hostPathVolume struct {
@@ -50,6 +77,20 @@ func (s *ShellPod) Validate() {
 	if len(s.Limits) == 0 {
 		s.Limits = defaultLimits()
 	}
+	if s.Profile != "" && !slices.Contains(shellProfiles, s.Profile) {
+		slog.Warn("Invalid shell pod profile. Using legacy", slogs.Name, s.Profile)
+		s.Profile = ShellProfileLegacy
+	}
+}
+
+// IsPrivileged checks if the shell pod runs privileged.
+func (s *ShellPod) IsPrivileged() bool {
+	switch s.Profile {
+	case ShellProfileGeneral, ShellProfileNetadmin:
+		return false
+	default:
+		return true
+	}
 }
 
 func defaultLimits() Limits {
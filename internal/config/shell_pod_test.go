@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellPodValidateProfile(t *testing.T) {
+	uu := map[string]struct {
+		profile, e string
+		priv       bool
+	}{
+		"default": {
+			priv: true,
+		},
+		"sysadmin": {
+			profile: config.ShellProfileSysadmin,
+			e:       config.ShellProfileSysadmin,
+			priv:    true,
+		},
+		"general": {
+			profile: config.ShellProfileGeneral,
+			e:       config.ShellProfileGeneral,
+		},
+		"toast": {
+			profile: "blee",
+			e:       config.ShellProfileLegacy,
+			priv:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewShellPod()
+			s.Profile = u.profile
+			s.Validate()
+			assert.Equal(t, u.e, s.Profile)
+			assert.Equal(t, u.priv, s.IsPrivileged())
+		})
+	}
+}
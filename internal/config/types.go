@@ -6,6 +6,7 @@ package config
 const (
 	defaultRefreshRate  = 2
 	defaultMaxConnRetry = 5
+	defaultListPageSize = 500
 
 	// CPU tracks cpu usage.
 	CPU = "cpu"
@@ -50,6 +51,9 @@ type UI struct {
 	// UseFullGVRTitle toggles the display of full GVR (group/version/resource) vs R in views title.
 	UseFullGVRTitle bool `json:"useFullGVRTitle" yaml:"useFullGVRTitle"`
 
+	// AutoWide shows wide columns as the terminal width permits.
+	AutoWide bool `json:"autoWide" yaml:"autoWide"`
+
 	manualHeadless   *bool
 	manualLogoless   *bool
 	manualCrumbsless *bool
@@ -31,10 +31,28 @@ type ViewConfigListener interface {
 	GetNamespace() string
 }
 
+// ExtraColumn represents a column computed from a resource manifest.
+type ExtraColumn struct {
+	// Name represents the column name.
+	Name string `yaml:"name"`
+
+	// JSONPath extracts the column value ie .status.phase.
+	JSONPath string `yaml:"jsonPath,omitempty"`
+
+	// CEL evaluates the column value given the resource as object.
+	CEL string `yaml:"cel,omitempty"`
+
+	// Wide only shows the column in wide mode.
+	Wide bool `yaml:"wide,omitempty"`
+}
+
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+	Columns      []string          `yaml:"columns"`
+	SortColumn   string            `yaml:"sortColumn"`
+	Filters      map[string]string `yaml:"filters,omitempty"`
+	ExtraColumns []ExtraColumn     `yaml:"extraColumns,omitempty"`
+	WidePriority []string          `yaml:"widePriority,omitempty"`
 }
 
 func (v *ViewSetting) HasCols() bool {
@@ -49,7 +67,8 @@ func (v *ViewSetting) SortCol() (name string, asc bool, err error) {
 	if v == nil || v.SortColumn == "" {
 		return "", false, fmt.Errorf("no sort column specified")
 	}
-	tt := strings.Split(v.SortColumn, ":")
+	first, _, _ := strings.Cut(v.SortColumn, ",")
+	tt := strings.Split(first, ":")
 	if len(tt) < 2 {
 		return "", false, fmt.Errorf("invalid sort column spec: %q. must be col-name:asc|desc", v.SortColumn)
 	}
@@ -70,9 +89,40 @@ func (v *ViewSetting) Equals(vs *ViewSetting) bool {
 		return false
 	}
 
+	if !maps.Equal(v.Filters, vs.Filters) {
+		return false
+	}
+
+	if !slices.Equal(v.ExtraColumns, vs.ExtraColumns) {
+		return false
+	}
+
+	if !slices.Equal(v.WidePriority, vs.WidePriority) {
+		return false
+	}
+
 	return cmp.Compare(v.SortColumn, vs.SortColumn) == 0
 }
 
+// FilterNames returns the sorted names of the saved filters.
+func (v *ViewSetting) FilterNames() []string {
+	if v == nil {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(v.Filters))
+}
+
+// Filter returns a saved filter expression given its name.
+func (v *ViewSetting) Filter(name string) (string, bool) {
+	if v == nil {
+		return "", false
+	}
+	f, ok := v.Filters[name]
+
+	return f, ok
+}
+
 // CustomView represents a collection of view customization.
 type CustomView struct {
 	Views     map[string]ViewSetting `yaml:"views"`
@@ -119,6 +169,24 @@ func (v *CustomView) Load(path string) error {
 	return nil
 }
 
+// Save persists view configurations to a given file.
+func (v *CustomView) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return data.SaveYAML(path, v)
+}
+
+// SetViewSetting updates the view settings for a given command and notifies listeners.
+func (v *CustomView) SetViewSetting(cmd string, vs ViewSetting) {
+	if v.Views == nil {
+		v.Views = make(map[string]ViewSetting)
+	}
+	v.Views[cmd] = vs
+	v.fireConfigChanged()
+}
+
 // AddListeners registers a new listener for various commands.
 func (v *CustomView) AddListeners(l ViewConfigListener, cmds ...string) {
 	for _, cmd := range cmds {
@@ -174,7 +242,28 @@ func (v *CustomView) fireConfigChanged() {
 	}
 }
 
+// ViewSetting returns the view settings for a given command and namespace if any.
+func (v *CustomView) ViewSetting(cmd, ns string) *ViewSetting {
+	return v.getVS(cmd, ns)
+}
+
+// ViewSettingKey returns the key of the view setting matching a command in a given namespace.
+func (v *CustomView) ViewSettingKey(cmd, ns string) (string, bool) {
+	return v.lookup(cmd, ns)
+}
+
 func (v *CustomView) getVS(gvr, ns string) *ViewSetting {
+	key, ok := v.lookup(gvr, ns)
+	if !ok {
+		return nil
+	}
+	vs := v.Views[key]
+
+	return &vs
+}
+
+// lookup returns the key of the view setting matching a gvr in a given namespace.
+func (v *CustomView) lookup(gvr, ns string) (string, bool) {
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
 	}
@@ -198,26 +287,23 @@ func (v *CustomView) getVS(gvr, ns string) *ViewSetting {
 				nsk += "@" + ns
 			}
 			if rx, err := regexp.Compile(tt[1]); err == nil && rx.MatchString(nsk) {
-				vs := v.Views[key]
-				return &vs
+				return key, true
 			}
 		case strings.HasPrefix(k, key):
 			kk := strings.Fields(k)
 			if len(kk) == 2 {
-				if v, ok := v.Views[kk[0]+"@"+kk[1]]; ok {
-					return &v
+				if _, ok := v.Views[kk[0]+"@"+kk[1]]; ok {
+					return kk[0] + "@" + kk[1], true
 				}
 				if key == kk[0] {
-					vs := v.Views[key]
-					return &vs
+					return key, true
 				}
 			}
 			fallthrough
 		case key == k:
-			vs := v.Views[key]
-			return &vs
+			return key, true
 		}
 	}
 
-	return nil
+	return "", false
 }
@@ -5,6 +5,7 @@ package config_test
 
 import (
 	"log/slog"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -112,6 +113,26 @@ func TestViewSettingEquals(t *testing.T) {
 				Columns: []string{"B"},
 			},
 		},
+
+		"filters": {
+			v1: &config.ViewSetting{
+				Columns: []string{"A"},
+				Filters: map[string]string{"fred": "-l app=fred"},
+			},
+			v2: &config.ViewSetting{
+				Columns: []string{"A"},
+				Filters: map[string]string{"fred": "-l app=blee"},
+			},
+		},
+
+		"extra-columns": {
+			v1: &config.ViewSetting{
+				ExtraColumns: []config.ExtraColumn{{Name: "PHASE", JSONPath: ".status.phase"}},
+			},
+			v2: &config.ViewSetting{
+				ExtraColumns: []config.ExtraColumn{{Name: "PHASE", CEL: "object.status.phase"}},
+			},
+		},
 	}
 
 	for k, u := range uu {
@@ -120,3 +141,59 @@ func TestViewSettingEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestViewSettingFilters(t *testing.T) {
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/filters.yaml"))
+
+	vs := cfg.Views[client.PodGVR.String()]
+	assert.Equal(t, []string{"failing", "web"}, vs.FilterNames())
+	f, ok := vs.Filter("web")
+	assert.True(t, ok)
+	assert.Equal(t, "-l app=web", f)
+	_, ok = vs.Filter("fred")
+	assert.False(t, ok)
+
+	var nilVS *config.ViewSetting
+	assert.Empty(t, nilVS.FilterNames())
+}
+
+func TestCustomViewSave(t *testing.T) {
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	cfg.SetViewSetting(client.SvcGVR.String(), config.ViewSetting{
+		Columns:    []string{"NAME", "TYPE|W", "APP:.metadata.labels.app|20"},
+		SortColumn: "NAME:asc",
+	})
+
+	path := filepath.Join(t.TempDir(), "views.yaml")
+	require.NoError(t, cfg.Save(path))
+
+	cfg1 := config.NewCustomView()
+	require.NoError(t, cfg1.Load(path))
+	assert.Equal(t, cfg.Views, cfg1.Views)
+}
+
+func TestCustomViewSettingKey(t *testing.T) {
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/views.yaml"))
+
+	uu := map[string]struct {
+		gvr, ns string
+		key     string
+		ok      bool
+	}{
+		"gvr":     {gvr: client.PodGVR.String(), key: client.PodGVR.String(), ok: true},
+		"gvr+ns":  {gvr: client.PodGVR.String(), ns: "default", key: "v1/pods@default", ok: true},
+		"gvr+rx":  {gvr: client.PodGVR.String(), ns: "ns1", key: "v1/pods@ns*", ok: true},
+		"missing": {gvr: client.SvcGVR.String()},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			key, ok := cfg.ViewSettingKey(u.gvr, u.ns)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.key, key)
+		})
+	}
+}
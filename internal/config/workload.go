@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultWorkloadGroupLabel represents the label used to group workloads by application.
+const DefaultWorkloadGroupLabel = "app.kubernetes.io/name"
+
+// WorkloadGVR tracks a workload resource and its status column mappings.
+type WorkloadGVR struct {
+	// Name represents a fully qualified resource name ie apps/v1/deployments.
+	Name string `json:"name" yaml:"name"`
+
+	// Status names the table column holding the resource status.
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
+
+	// HealthyStatus lists status values deemed healthy.
+	HealthyStatus []string `json:"healthyStatus,omitempty" yaml:"healthyStatus,omitempty"`
+
+	// Ready names the table column holding the resource readiness ie 1/1.
+	Ready string `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	// Desired names the table column holding the desired count when Ready is a plain count.
+	Desired string `json:"desired,omitempty" yaml:"desired,omitempty"`
+}
+
+// IsMapped checks if custom column mappings are defined.
+func (w WorkloadGVR) IsMapped() bool {
+	return w.Status != "" || w.Ready != ""
+}
+
+// CustomWorkload tracks a workload resource whose health is computed from CEL expressions.
+// Expressions are evaluated against the resource available as `object`.
+type CustomWorkload struct {
+	// Name represents a fully qualified resource name ie argoproj.io/v1alpha1/rollouts.
+	Name string `json:"name" yaml:"name"`
+
+	// Status evaluates to either a bool indicating health or a status string.
+	Status string `json:"status" yaml:"status"`
+
+	// Ready evaluates to the resource readiness ie 1/1.
+	Ready string `json:"ready,omitempty" yaml:"ready,omitempty"`
+
+	// Valid evaluates to either a bool or a validity message.
+	Valid string `json:"valid,omitempty" yaml:"valid,omitempty"`
+}
+
+// Workload tracks workload view options.
+type Workload struct {
+	GVRs   []WorkloadGVR    `json:"gvrs" yaml:"gvrs,omitempty"`
+	Custom []CustomWorkload `json:"custom" yaml:"custom,omitempty"`
+
+	// GroupLabel names the label used to group workloads by application.
+	GroupLabel string `json:"groupLabel" yaml:"groupLabel,omitempty"`
+}
+
+// GetGroupLabel returns the application grouping label.
+func (w Workload) GetGroupLabel() string {
+	if w.GroupLabel == "" {
+		return DefaultWorkloadGroupLabel
+	}
+
+	return w.GroupLabel
+}
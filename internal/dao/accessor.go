@@ -9,10 +9,26 @@ import (
 
 var accessors = Accessors{
 	client.WkGVR:  new(Workload),
+	client.WkhGVR: new(WorkloadHealth),
+	client.NocGVR: new(NodeCapacity),
+	client.StoGVR: new(Storage),
+	client.CexGVR: new(CertExpiry),
+	client.RtGVR:  new(Routing),
 	client.CtGVR:  new(Context),
 	client.CoGVR:  new(Container),
 	client.ScnGVR: new(ImageScan),
 	client.SdGVR:  new(ScreenDump),
+	client.MutGVR: new(Mutations),
+	client.MonGVR: new(Monitors),
+	client.AdmGVR: new(Admission),
+	client.AlGVR:  new(Alerts),
+	client.TlGVR:  new(Timeline),
+	client.RevGVR: new(RolloutHistory),
+	client.JrGVR:  new(CronJobRuns),
+	client.SkGVR:  new(SecretKeys),
+	client.SepGVR: new(ServiceEndpoints),
+	client.EsGVR:  new(EventStream),
+	client.FndGVR: new(Finder),
 	client.BeGVR:  new(Benchmark),
 	client.PfGVR:  new(PortForward),
 	client.DirGVR: new(Dir),
@@ -32,6 +48,11 @@ var accessors = Accessors{
 	client.CjGVR:  new(CronJob),
 	client.JobGVR: new(Job),
 
+	client.VsGVR: new(VolumeSnapshot),
+
+	client.ExsGVR:     new(ExternalSecret),
+	client.ExsBetaGVR: new(ExternalSecret),
+
 	client.HmGVR:  new(HelmChart),
 	client.HmhGVR: new(HelmHistory),
 
@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// admissionSep separates an admission entry id segments.
+	admissionSep = "|"
+
+	// defaultWebhookTimeout tracks the api server default webhook timeout in seconds.
+	defaultWebhookTimeout = 10
+)
+
+var (
+	webhookDeniedRx = regexp.MustCompile(`admission webhook "([^"]+)" denied the request`)
+	webhookFailedRx = regexp.MustCompile(`failed calling webhook "([^"]+)"`)
+	policyDeniedRx  = regexp.MustCompile(`ValidatingAdmissionPolicy '([^']+)'`)
+)
+
+var _ Accessor = (*Admission)(nil)
+
+// Admission represents the admission webhooks and policies intercepting api requests.
+type Admission struct {
+	NonResource
+}
+
+// admissionDenial tracks an interceptor recent denials.
+type admissionDenial struct {
+	count int
+	msg   string
+	at    time.Time
+}
+
+// List returns the admission webhooks and policies.
+func (a *Admission) List(context.Context, string) ([]runtime.Object, error) {
+	dd, err := a.denials()
+	if err != nil {
+		slog.Warn("Unable to collect admission denials", slogs.Error, err)
+	}
+
+	var aa []*render.AdmissionRes
+	vv, err := a.validatingWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	aa = append(aa, vv...)
+	mm, err := a.mutatingWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	aa = append(aa, mm...)
+	pp, err := a.policies()
+	if err != nil {
+		// Policies are GA as of 1.30. Older clusters may not serve them.
+		slog.Debug("Unable to list validating admission policies", slogs.Error, err)
+	}
+	aa = append(aa, pp...)
+
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, r := range aa {
+		if d, ok := dd[denialKey(r.Kind, r.Name)]; ok {
+			r.Denials, r.LastDenial, r.LastDenialAt = d.count, d.msg, d.at
+		}
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// AdmissionID returns an admission entry id.
+func AdmissionID(gvr *client.GVR, cfg, name string) string {
+	return strings.Join([]string{gvr.String(), cfg, name}, admissionSep)
+}
+
+// ParseAdmissionID returns an admission entry owning resource and name.
+func ParseAdmissionID(id string) (*client.GVR, string, bool) {
+	tt := strings.Split(id, admissionSep)
+	if len(tt) != 3 {
+		return nil, "", false
+	}
+
+	return client.NewGVR(tt[0]), tt[1], true
+}
+
+func (a *Admission) validatingWebhooks() ([]*render.AdmissionRes, error) {
+	oo, err := a.getFactory().List(client.VwcGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var aa []*render.AdmissionRes
+	for _, o := range oo {
+		var cfg admv1.ValidatingWebhookConfiguration
+		if err := toTyped(o, &cfg); err != nil {
+			return nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			aa = append(aa, &render.AdmissionRes{
+				ID:            AdmissionID(client.VwcGVR, cfg.Name, w.Name),
+				Kind:          render.AdmissionValidating,
+				Name:          w.Name,
+				Config:        cfg.Name,
+				Rules:         webhookRules(w.Rules),
+				Namespaces:    selectorToStr(w.NamespaceSelector),
+				Objects:       selectorToStr(w.ObjectSelector),
+				FailurePolicy: failurePolicy(w.FailurePolicy),
+				Timeout:       webhookTimeout(w.TimeoutSeconds),
+				CreatedAt:     cfg.CreationTimestamp.Time,
+			})
+		}
+	}
+
+	return aa, nil
+}
+
+func (a *Admission) mutatingWebhooks() ([]*render.AdmissionRes, error) {
+	oo, err := a.getFactory().List(client.MwcGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var aa []*render.AdmissionRes
+	for _, o := range oo {
+		var cfg admv1.MutatingWebhookConfiguration
+		if err := toTyped(o, &cfg); err != nil {
+			return nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			aa = append(aa, &render.AdmissionRes{
+				ID:            AdmissionID(client.MwcGVR, cfg.Name, w.Name),
+				Kind:          render.AdmissionMutating,
+				Name:          w.Name,
+				Config:        cfg.Name,
+				Rules:         webhookRules(w.Rules),
+				Namespaces:    selectorToStr(w.NamespaceSelector),
+				Objects:       selectorToStr(w.ObjectSelector),
+				FailurePolicy: failurePolicy(w.FailurePolicy),
+				Timeout:       webhookTimeout(w.TimeoutSeconds),
+				CreatedAt:     cfg.CreationTimestamp.Time,
+			})
+		}
+	}
+
+	return aa, nil
+}
+
+func (a *Admission) policies() ([]*render.AdmissionRes, error) {
+	oo, err := a.getFactory().List(client.VapGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	bb, err := a.getFactory().List(client.VapbGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	bindings := make(map[string][]admv1.ValidatingAdmissionPolicyBinding, len(bb))
+	for _, o := range bb {
+		var b admv1.ValidatingAdmissionPolicyBinding
+		if err := toTyped(o, &b); err != nil {
+			return nil, err
+		}
+		bindings[b.Spec.PolicyName] = append(bindings[b.Spec.PolicyName], b)
+	}
+
+	aa := make([]*render.AdmissionRes, 0, len(oo))
+	for _, o := range oo {
+		var p admv1.ValidatingAdmissionPolicy
+		if err := toTyped(o, &p); err != nil {
+			return nil, err
+		}
+		r := render.AdmissionRes{
+			ID:            AdmissionID(client.VapGVR, p.Name, p.Name),
+			Kind:          render.AdmissionPolicy,
+			Name:          p.Name,
+			Config:        p.Name,
+			Namespaces:    selectorToStr(nil),
+			Objects:       selectorToStr(nil),
+			FailurePolicy: failurePolicy(p.Spec.FailurePolicy),
+			CreatedAt:     p.CreationTimestamp.Time,
+		}
+		if mc := p.Spec.MatchConstraints; mc != nil {
+			r.Rules = namedRules(mc.ResourceRules)
+			r.Namespaces, r.Objects = selectorToStr(mc.NamespaceSelector), selectorToStr(mc.ObjectSelector)
+		}
+		var nss []string
+		for _, b := range bindings[p.Name] {
+			acts := make([]string, 0, len(b.Spec.ValidationActions))
+			for _, va := range b.Spec.ValidationActions {
+				acts = append(acts, string(va))
+			}
+			r.Bindings = append(r.Bindings, b.Name+"("+strings.Join(acts, "+")+")")
+			if mr := b.Spec.MatchResources; mr != nil && mr.NamespaceSelector != nil {
+				nss = append(nss, selectorToStr(mr.NamespaceSelector))
+			}
+		}
+		// Bindings further scope the policy namespaces.
+		if len(nss) > 0 {
+			r.Namespaces = strings.Join(slices.Compact(slices.Sorted(slices.Values(nss))), " | ")
+		}
+		aa = append(aa, &r)
+	}
+
+	return aa, nil
+}
+
+// denials collects recent admission denials reported by events.
+func (a *Admission) denials() (map[string]admissionDenial, error) {
+	oo, err := a.getFactory().List(client.EvGVR, client.NamespaceAll, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	dd := make(map[string]admissionDenial)
+	for _, o := range oo {
+		var ev eventsv1.Event
+		if err := toTyped(o, &ev); err != nil {
+			return nil, err
+		}
+		kind, name, ok := deniedBy(ev.Note)
+		if !ok {
+			continue
+		}
+		count := max(int(ev.DeprecatedCount), 1)
+		if ev.Series != nil {
+			count = max(int(ev.Series.Count), 1)
+		}
+		for _, k := range kind {
+			key := denialKey(k, name)
+			d := dd[key]
+			d.count += count
+			if t := eventTime(&ev); !t.Before(d.at) {
+				d.msg, d.at = strings.TrimSpace(ev.Note), t
+			}
+			dd[key] = d
+		}
+	}
+
+	return dd, nil
+}
+
+// deniedBy extracts the webhook or policy denying a request from an event message.
+func deniedBy(msg string) ([]string, string, bool) {
+	if m := webhookDeniedRx.FindStringSubmatch(msg); len(m) > 1 {
+		return []string{render.AdmissionValidating, render.AdmissionMutating}, m[1], true
+	}
+	if m := webhookFailedRx.FindStringSubmatch(msg); len(m) > 1 {
+		return []string{render.AdmissionValidating, render.AdmissionMutating}, m[1], true
+	}
+	if m := policyDeniedRx.FindStringSubmatch(msg); len(m) > 1 {
+		return []string{render.AdmissionPolicy}, m[1], true
+	}
+
+	return nil, "", false
+}
+
+func denialKey(kind, name string) string {
+	return kind + admissionSep + name
+}
+
+func webhookRules(rr []admv1.RuleWithOperations) []string {
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		ss = append(ss, ruleToStr(r))
+	}
+
+	return ss
+}
+
+func namedRules(rr []admv1.NamedRuleWithOperations) []string {
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		s := ruleToStr(r.RuleWithOperations)
+		if len(r.ResourceNames) > 0 {
+			s += "[" + strings.Join(r.ResourceNames, ",") + "]"
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+// ruleToStr renders a rule as OPS group/resources ie CREATE,UPDATE apps/deployments.
+func ruleToStr(r admv1.RuleWithOperations) string {
+	ops := make([]string, 0, len(r.Operations))
+	for _, o := range r.Operations {
+		ops = append(ops, string(o))
+	}
+	gg := r.APIGroups
+	if len(gg) == 0 {
+		gg = []string{""}
+	}
+	res := make([]string, 0, len(gg)*len(r.Resources))
+	for _, g := range gg {
+		for _, rs := range r.Resources {
+			if g == "" {
+				res = append(res, rs)
+				continue
+			}
+			res = append(res, g+"/"+rs)
+		}
+	}
+
+	return strings.Join(ops, ",") + " " + strings.Join(res, ",")
+}
+
+func selectorToStr(sel *metav1.LabelSelector) string {
+	if sel == nil || (len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0) {
+		return "all"
+	}
+
+	return metav1.FormatLabelSelector(sel)
+}
+
+func failurePolicy(p *admv1.FailurePolicyType) string {
+	if p == nil {
+		return string(admv1.Fail)
+	}
+
+	return string(*p)
+}
+
+func webhookTimeout(t *int32) int32 {
+	if t == nil {
+		return defaultWebhookTimeout
+	}
+
+	return *t
+}
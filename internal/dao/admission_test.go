@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAdmissionList(t *testing.T) {
+	f := admissionFactory()
+	var a dao.Admission
+	a.Init(&f, client.AdmGVR)
+
+	oo, err := a.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, oo, 3)
+
+	mm := make(map[string]*render.AdmissionRes, len(oo))
+	for _, o := range oo {
+		r := o.(*render.AdmissionRes)
+		mm[r.Name] = r
+	}
+
+	v := mm["validate.fred.io"]
+	require.NotNil(t, v)
+	assert.Equal(t, render.AdmissionValidating, v.Kind)
+	assert.Equal(t, "fred", v.Config)
+	assert.Equal(t, []string{"CREATE,UPDATE apps/deployments,apps/statefulsets"}, v.Rules)
+	assert.Equal(t, "team=fred", v.Namespaces)
+	assert.Equal(t, "all", v.Objects)
+	assert.Equal(t, "Fail", v.FailurePolicy)
+	assert.Equal(t, int32(5), v.Timeout)
+	assert.Equal(t, 4, v.Denials)
+	assert.Contains(t, v.LastDenial, "image not signed")
+
+	m := mm["mutate.blee.io"]
+	require.NotNil(t, m)
+	assert.Equal(t, render.AdmissionMutating, m.Kind)
+	assert.Equal(t, []string{"CREATE pods"}, m.Rules)
+	assert.Equal(t, "Ignore", m.FailurePolicy)
+	assert.Equal(t, int32(10), m.Timeout)
+	assert.Equal(t, 0, m.Denials)
+
+	p := mm["no-latest"]
+	require.NotNil(t, p)
+	assert.Equal(t, render.AdmissionPolicy, p.Kind)
+	assert.Equal(t, []string{"CREATE,UPDATE pods"}, p.Rules)
+	assert.Equal(t, []string{"no-latest-prod(Deny+Audit)"}, p.Bindings)
+	assert.Equal(t, "env=prod", p.Namespaces)
+	assert.Equal(t, 2, p.Denials)
+
+	gvr, n, ok := dao.ParseAdmissionID(v.ID)
+	require.True(t, ok)
+	assert.Equal(t, client.VwcGVR, gvr)
+	assert.Equal(t, "fred", n)
+}
+
+// Helpers...
+
+func admissionFactory() testFactory {
+	ev := func(n, note string, count int64, ts string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":                    "Event",
+			"metadata":                map[string]any{"name": n, "namespace": "ns1"},
+			"regarding":               map[string]any{"kind": "ReplicaSet", "name": "fred-1", "namespace": "ns1"},
+			"reason":                  "FailedCreate",
+			"type":                    "Warning",
+			"note":                    note,
+			"deprecatedCount":         count,
+			"deprecatedLastTimestamp": ts,
+		}}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		client.ClusterScope: {
+			client.VwcGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "ValidatingWebhookConfiguration",
+				"metadata": map[string]any{"name": "fred"},
+				"webhooks": []any{map[string]any{
+					"name":              "validate.fred.io",
+					"failurePolicy":     "Fail",
+					"timeoutSeconds":    int64(5),
+					"namespaceSelector": map[string]any{"matchLabels": map[string]any{"team": "fred"}},
+					"rules": []any{map[string]any{
+						"operations":  []any{"CREATE", "UPDATE"},
+						"apiGroups":   []any{"apps"},
+						"apiVersions": []any{"v1"},
+						"resources":   []any{"deployments", "statefulsets"},
+					}},
+				}},
+			}}},
+			client.MwcGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "MutatingWebhookConfiguration",
+				"metadata": map[string]any{"name": "blee"},
+				"webhooks": []any{map[string]any{
+					"name":          "mutate.blee.io",
+					"failurePolicy": "Ignore",
+					"rules": []any{map[string]any{
+						"operations": []any{"CREATE"},
+						"apiGroups":  []any{""},
+						"resources":  []any{"pods"},
+					}},
+				}},
+			}}},
+			client.VapGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "ValidatingAdmissionPolicy",
+				"metadata": map[string]any{"name": "no-latest"},
+				"spec": map[string]any{
+					"matchConstraints": map[string]any{"resourceRules": []any{map[string]any{
+						"operations": []any{"CREATE", "UPDATE"},
+						"apiGroups":  []any{""},
+						"resources":  []any{"pods"},
+					}}},
+				},
+			}}},
+			client.VapbGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "ValidatingAdmissionPolicyBinding",
+				"metadata": map[string]any{"name": "no-latest-prod"},
+				"spec": map[string]any{
+					"policyName":        "no-latest",
+					"validationActions": []any{"Deny", "Audit"},
+					"matchResources":    map[string]any{"namespaceSelector": map[string]any{"matchLabels": map[string]any{"env": "prod"}}},
+				},
+			}}},
+		},
+		client.NamespaceAll: {
+			client.EvGVR: {
+				ev("e1", `Error creating: admission webhook "validate.fred.io" denied the request: image not signed`, 3, "2026-10-14T11:00:00Z"),
+				ev("e2", `Error creating: Internal error occurred: failed calling webhook "validate.fred.io": context deadline exceeded`, 1, "2026-10-14T10:00:00Z"),
+				ev("e3", `Error creating: pods "fred-1-x" is forbidden: ValidatingAdmissionPolicy 'no-latest' with binding 'no-latest-prod' denied request: latest tag`, 2, "2026-10-14T10:00:00Z"),
+				ev("e4", `Created pod: fred-1-y`, 1, "2026-10-14T10:00:00Z"),
+			},
+		},
+	}}
+}
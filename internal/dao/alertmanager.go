@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// alertTargets maps well known alert labels to the resources they identify, most specific first.
+var alertTargets = []struct {
+	label string
+	gvr   *client.GVR
+}{
+	{label: "pod", gvr: client.PodGVR},
+	{label: "deployment", gvr: client.DpGVR},
+	{label: "statefulset", gvr: client.StsGVR},
+	{label: "daemonset", gvr: client.DsGVR},
+	{label: "job_name", gvr: client.JobGVR},
+	{label: "cronjob", gvr: client.CjGVR},
+	{label: "persistentvolumeclaim", gvr: client.PvcGVR},
+	{label: "service", gvr: client.SvcGVR},
+	{label: "node", gvr: client.NodeGVR},
+}
+
+// AlertTarget returns the resource identified by an alert labels if any.
+func AlertTarget(ll map[string]string) (*client.GVR, string, bool) {
+	ns := ll["namespace"]
+	for _, t := range alertTargets {
+		n, ok := ll[t.label]
+		if !ok || n == "" {
+			continue
+		}
+		if t.gvr == client.NodeGVR {
+			return t.gvr, n, true
+		}
+		if ns == "" {
+			continue
+		}
+		return t.gvr, client.FQN(ns, n), true
+	}
+	if ns != "" {
+		return client.NsGVR, ns, true
+	}
+
+	return nil, "", false
+}
+
+// Alertmanager represents an Alertmanager api client.
+type Alertmanager struct {
+	cfg    *data.Alertmanager
+	client *http.Client
+}
+
+// NewAlertmanager returns a new client. In cluster endpoints are reached via port-forward tunnels.
+func NewAlertmanager(f Factory, cfg *data.Alertmanager) (*Alertmanager, error) {
+	if cfg == nil || cfg.URL == "" {
+		return nil, errors.New("no alertmanager url configured for this context")
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alertmanager url %q: %w", cfg.URL, err)
+	}
+	c := http.Client{Timeout: defaultTimeout}
+	if cfg.InCluster {
+		if u.Port() == "" {
+			return nil, fmt.Errorf("in cluster alertmanager url %q requires a port", cfg.URL)
+		}
+		c.Transport = &http.Transport{
+			DialContext:       NewClusterDialer(f, client.DefaultNamespace).DialContext,
+			DisableKeepAlives: true,
+		}
+	}
+
+	return &Alertmanager{cfg: cfg, client: &c}, nil
+}
+
+type gettableAlert struct {
+	Fingerprint string            `json:"fingerprint"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+	Status      struct {
+		State       string   `json:"state"`
+		SilencedBy  []string `json:"silencedBy"`
+		InhibitedBy []string `json:"inhibitedBy"`
+	} `json:"status"`
+	Receivers []struct {
+		Name string `json:"name"`
+	} `json:"receivers"`
+}
+
+type silenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+type postableSilence struct {
+	Matchers  []silenceMatcher `json:"matchers"`
+	StartsAt  time.Time        `json:"startsAt"`
+	EndsAt    time.Time        `json:"endsAt"`
+	CreatedBy string           `json:"createdBy"`
+	Comment   string           `json:"comment"`
+}
+
+// Alerts returns the firing alerts including silenced and inhibited ones.
+func (a *Alertmanager) Alerts(ctx context.Context) ([]*render.AlertRes, error) {
+	raw, err := a.request(ctx, http.MethodGet, "/api/v2/alerts?active=true&silenced=true&inhibited=true", nil)
+	if err != nil {
+		return nil, err
+	}
+	var gg []gettableAlert
+	if err := json.Unmarshal(raw, &gg); err != nil {
+		return nil, fmt.Errorf("invalid alertmanager alerts response: %w", err)
+	}
+
+	aa := make([]*render.AlertRes, 0, len(gg))
+	for _, g := range gg {
+		r := render.AlertRes{
+			Fingerprint: g.Fingerprint,
+			Labels:      g.Labels,
+			Annotations: g.Annotations,
+			State:       g.Status.State,
+			SilencedBy:  g.Status.SilencedBy,
+			StartsAt:    g.StartsAt,
+		}
+		for _, rc := range g.Receivers {
+			r.Receivers = append(r.Receivers, rc.Name)
+		}
+		if gvr, fqn, ok := AlertTarget(g.Labels); ok {
+			r.Resource, r.FQN = gvr.String(), fqn
+		}
+		aa = append(aa, &r)
+	}
+
+	return aa, nil
+}
+
+// Silence silences alerts matching all of the given labels and returns the silence id.
+func (a *Alertmanager) Silence(ctx context.Context, ll map[string]string, d time.Duration, comment string) (string, error) {
+	s := postableSilence{
+		StartsAt:  time.Now().UTC(),
+		EndsAt:    time.Now().UTC().Add(d),
+		CreatedBy: a.cfg.AuthorOrDefault(),
+		Comment:   comment,
+	}
+	for _, k := range slices.Sorted(maps.Keys(ll)) {
+		s.Matchers = append(s.Matchers, silenceMatcher{Name: k, Value: ll[k], IsEqual: true})
+	}
+	bb, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	raw, err := a.request(ctx, http.MethodPost, "/api/v2/silences", bytes.NewReader(bb))
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return "", fmt.Errorf("invalid alertmanager silence response: %w", err)
+	}
+
+	return resp.SilenceID, nil
+}
+
+// Expire expires a silence.
+func (a *Alertmanager) Expire(ctx context.Context, id string) error {
+	_, err := a.request(ctx, http.MethodDelete, "/api/v2/silence/"+url.PathEscape(id), nil)
+
+	return err
+}
+
+func (a *Alertmanager) request(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(a.cfg.URL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range a.cfg.Headers {
+		req.Header.Set(k, os.ExpandEnv(v))
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("alertmanager %s %s failed: %s %s", method, path, resp.Status, truncateOutput(string(raw)))
+	}
+
+	return raw, nil
+}
+
+// ----------------------------------------------------------------------------
+
+var _ Accessor = (*Alerts)(nil)
+
+// Alerts represents the active context Alertmanager alerts.
+type Alerts struct {
+	NonResource
+}
+
+// Client returns an Alertmanager client for the context configuration.
+func (a *Alerts) Client(ctx context.Context) (*Alertmanager, error) {
+	cfg, _ := ctx.Value(internal.KeyAlertmanager).(*data.Alertmanager)
+
+	return NewAlertmanager(a.getFactory(), cfg)
+}
+
+// List returns the firing alerts.
+func (a *Alerts) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	c, err := a.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aa, err := c.Alerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, a := range aa {
+		oo = append(oo, a)
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertTarget(t *testing.T) {
+	uu := map[string]struct {
+		ll  map[string]string
+		gvr *client.GVR
+		fqn string
+		ok  bool
+	}{
+		"pod": {
+			ll:  map[string]string{"namespace": "ns1", "pod": "fred", "deployment": "blee"},
+			gvr: client.PodGVR,
+			fqn: "ns1/fred",
+			ok:  true,
+		},
+		"deployment": {
+			ll:  map[string]string{"namespace": "ns1", "deployment": "blee"},
+			gvr: client.DpGVR,
+			fqn: "ns1/blee",
+			ok:  true,
+		},
+		"node": {
+			ll:  map[string]string{"node": "n1"},
+			gvr: client.NodeGVR,
+			fqn: "n1",
+			ok:  true,
+		},
+		"namespace": {
+			ll:  map[string]string{"namespace": "ns1", "job": "kubelet"},
+			gvr: client.NsGVR,
+			fqn: "ns1",
+			ok:  true,
+		},
+		"none": {
+			ll: map[string]string{"alertname": "Watchdog", "pod": "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, fqn, ok := dao.AlertTarget(u.ll)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.gvr, gvr)
+			assert.Equal(t, u.fqn, fqn)
+		})
+	}
+}
+
+func TestAlertmanagerAlerts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/alerts", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("silenced"))
+		assert.Equal(t, "Bearer fred", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[{
+			"fingerprint": "abc123",
+			"labels": {"alertname": "KubePodCrashLooping", "severity": "warning", "namespace": "ns1", "pod": "fred"},
+			"annotations": {"summary": "Pod is crash looping"},
+			"startsAt": "2026-10-14T10:00:00Z",
+			"status": {"state": "suppressed", "silencedBy": ["s1"], "inhibitedBy": []},
+			"receivers": [{"name": "slack"}]
+		}]`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("AM_TOKEN", "fred")
+	c, err := dao.NewAlertmanager(nil, &data.Alertmanager{URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer $AM_TOKEN"}})
+	require.NoError(t, err)
+	aa, err := c.Alerts(context.Background())
+	require.NoError(t, err)
+	require.Len(t, aa, 1)
+
+	a := aa[0]
+	assert.Equal(t, "abc123", a.Fingerprint)
+	assert.Equal(t, "suppressed", a.State)
+	assert.Equal(t, []string{"s1"}, a.SilencedBy)
+	assert.Equal(t, []string{"slack"}, a.Receivers)
+	assert.Equal(t, client.PodGVR.String(), a.Resource)
+	assert.Equal(t, "ns1/fred", a.FQN)
+}
+
+func TestAlertmanagerSilence(t *testing.T) {
+	var (
+		body    map[string]any
+		expired string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/api/v2/silences", r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			_, _ = w.Write([]byte(`{"silenceID": "s1"}`))
+		case http.MethodDelete:
+			expired = r.URL.Path
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := dao.NewAlertmanager(nil, &data.Alertmanager{URL: srv.URL + "/"})
+	require.NoError(t, err)
+	id, err := c.Silence(context.Background(), map[string]string{"pod": "fred", "alertname": "Bozo"}, time.Hour, "on it")
+	require.NoError(t, err)
+	assert.Equal(t, "s1", id)
+	assert.Equal(t, "k9s", body["createdBy"])
+	assert.Equal(t, "on it", body["comment"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "alertname", "value": "Bozo", "isRegex": false, "isEqual": true},
+		map[string]any{"name": "pod", "value": "fred", "isRegex": false, "isEqual": true},
+	}, body["matchers"])
+
+	require.NoError(t, c.Expire(context.Background(), "s1"))
+	assert.Equal(t, "/api/v2/silence/s1", expired)
+}
+
+func TestAlertmanagerErrors(t *testing.T) {
+	_, err := dao.NewAlertmanager(nil, nil)
+	require.Error(t, err)
+	_, err = dao.NewAlertmanager(nil, &data.Alertmanager{URL: "http://alertmanager.monitoring", InCluster: true})
+	require.Error(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+	c, err := dao.NewAlertmanager(nil, &data.Alertmanager{URL: srv.URL})
+	require.NoError(t, err)
+	_, err = c.Alerts(context.Background())
+	require.Error(t, err)
+}
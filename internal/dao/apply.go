@@ -0,0 +1,455 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ApplyFieldManager tracks the field manager used for server side applies.
+const ApplyFieldManager = "k9s"
+
+var conflictManagerRX = regexp.MustCompile(`conflict with "([^"]+)"`)
+
+// ApplyConflict represents a field owned by another manager.
+type ApplyConflict struct {
+	Field, Manager, Message string
+}
+
+// ApplyConflicts represents a server side apply conflicts error.
+type ApplyConflicts []ApplyConflict
+
+// Error returns the conflicts summary.
+func (cc ApplyConflicts) Error() string {
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		ss = append(ss, fmt.Sprintf("%s (%s)", c.Field, c.Manager))
+	}
+
+	return "apply conflicts on " + strings.Join(ss, ", ")
+}
+
+// ServerApply server side applies an edited manifest using the k9s field manager.
+// Only the fields changed from the live resource, along with the fields k9s already
+// manages, are sent so k9s does not claim fields owned by other managers.
+// Conflicts with other managers are reported unless force is set.
+func ServerApply(ctx context.Context, c client.Connection, gvr *client.GVR, fqn string, live, edited *unstructured.Unstructured, force bool) error {
+	if edited.GetName() != live.GetName() || edited.GetNamespace() != live.GetNamespace() {
+		return errors.New("resource name and namespace can't be changed")
+	}
+	o, err := applyConfig(live, edited)
+	if err != nil {
+		return err
+	}
+	raw, err := o.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	d, err := c.DynDial()
+	if err != nil {
+		return err
+	}
+	opts := metav1.PatchOptions{
+		DryRun:          dryRunOpts(),
+		FieldManager:    ApplyFieldManager,
+		FieldValidation: metav1.FieldValidationStrict,
+		Force:           &force,
+	}
+	ns, n := client.Namespaced(fqn)
+	if client.IsClusterScoped(ns) {
+		_, err = d.Resource(gvr.GVR()).Patch(ctx, n, types.ApplyPatchType, raw, opts)
+	} else {
+		_, err = d.Resource(gvr.GVR()).Namespace(ns).Patch(ctx, n, types.ApplyPatchType, raw, opts)
+	}
+	if err != nil {
+		if cc := applyConflicts(err); len(cc) > 0 {
+			return cc
+		}
+		return err
+	}
+	journalMutation(JournalEdit, gvr, fqn, live)
+
+	return nil
+}
+
+// FieldManagers returns a resource field managers along with their operations.
+func FieldManagers(o *unstructured.Unstructured) []string {
+	mm := make([]string, 0, len(o.GetManagedFields()))
+	for _, f := range o.GetManagedFields() {
+		m := fmt.Sprintf("%s (%s", f.Manager, f.Operation)
+		if f.Subresource != "" {
+			m += " " + f.Subresource
+		}
+		if f.Time != nil {
+			m += " " + f.Time.UTC().Format("2006-01-02T15:04:05Z")
+		}
+		mm = append(mm, m+")")
+	}
+
+	return mm
+}
+
+// Helpers...
+
+// applyConfig returns the apply configuration for an edited manifest.
+func applyConfig(live, edited *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	l, e := applyable(live), applyable(edited)
+	owned, hints := managedSets(live)
+	if rr := removedFields("", l.Object, e.Object, owned, hints); len(rr) > 0 {
+		return nil, fmt.Errorf("unable to remove fields managed by others, use edit instead: %s", strings.Join(rr, ", "))
+	}
+
+	cfg, _ := applyFields(l.Object, e.Object, owned, hints)
+	o := unstructured.Unstructured{Object: cfg.(map[string]any)}
+	o.SetAPIVersion(edited.GetAPIVersion())
+	o.SetKind(edited.GetKind())
+	o.SetName(edited.GetName())
+	if ns := edited.GetNamespace(); ns != "" {
+		o.SetNamespace(ns)
+	}
+
+	return &o, nil
+}
+
+// applyable strips server populated fields from a manifest.
+func applyable(o *unstructured.Unstructured) *unstructured.Unstructured {
+	c := o.DeepCopy()
+	for _, f := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink"} {
+		unstructured.RemoveNestedField(c.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(c.Object, "status")
+
+	return c
+}
+
+// managedSets returns the fieldset k9s applied along with all managers fieldsets merged.
+// The merged fieldset hints at which lists the server merges by keys.
+func managedSets(live *unstructured.Unstructured) (owned, hints map[string]any) {
+	hints = make(map[string]any)
+	for _, f := range live.GetManagedFields() {
+		if f.FieldsV1 == nil {
+			continue
+		}
+		var set map[string]any
+		if err := json.Unmarshal(f.FieldsV1.Raw, &set); err != nil {
+			continue
+		}
+		if f.Manager == ApplyFieldManager && f.Operation == metav1.ManagedFieldsOperationApply {
+			owned = set
+		}
+		mergeSets(hints, set)
+	}
+
+	return owned, hints
+}
+
+func mergeSets(dst, src map[string]any) {
+	for k, v := range src {
+		sv, _ := v.(map[string]any)
+		dv, ok := dst[k].(map[string]any)
+		if !ok {
+			dv = make(map[string]any, len(sv))
+			dst[k] = dv
+		}
+		mergeSets(dv, sv)
+	}
+}
+
+// applyFields returns the edited fields that differ from live or that k9s already owns.
+// A nil fieldset means the field is not owned.
+func applyFields(live, edited any, owned, hints map[string]any) (any, bool) {
+	if owned != nil && len(owned) == 0 {
+		return edited, true
+	}
+	switch ev := edited.(type) {
+	case map[string]any:
+		lv, ok := live.(map[string]any)
+		if !ok {
+			return ev, true
+		}
+		out := make(map[string]any)
+		for k, v := range ev {
+			lvv, ok := lv[k]
+			if !ok {
+				out[k] = v
+				continue
+			}
+			if sub, ok := applyFields(lvv, v, childSet(owned, "f:"+k), childSet(hints, "f:"+k)); ok {
+				out[k] = sub
+			}
+		}
+		return out, len(out) > 0 || ownsSelf(owned)
+	case []any:
+		lv, ok := live.([]any)
+		keys := listKeys(hints)
+		if !ok || len(keys) == 0 {
+			if !ok || owned != nil || !reflect.DeepEqual(lv, ev) {
+				return ev, true
+			}
+			return nil, false
+		}
+		var out []any
+		for i, item := range ev {
+			im, ok := item.(map[string]any)
+			if !ok {
+				return ev, true
+			}
+			litem, ok := findItem(lv, im, keys)
+			if !ok {
+				out = append(out, item)
+				continue
+			}
+			sub, ok := applyFields(litem, im, itemSet(owned, i, item), itemSet(hints, i, item))
+			if !ok {
+				continue
+			}
+			sm := sub.(map[string]any)
+			for _, k := range keys {
+				if v, ok := im[k]; ok {
+					sm[k] = v
+				}
+			}
+			out = append(out, sm)
+		}
+		return out, len(out) > 0 || ownsSelf(owned)
+	default:
+		return edited, owned != nil || !reflect.DeepEqual(live, edited)
+	}
+}
+
+// removedFields returns the fields removed from live that k9s does not own.
+// Server side apply can only remove fields the applier manages.
+func removedFields(path string, live, edited any, owned, hints map[string]any) []string {
+	var rr []string
+	switch lv := live.(type) {
+	case map[string]any:
+		ev, ok := edited.(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, k := range slices.Sorted(maps.Keys(lv)) {
+			child := childSet(owned, "f:"+k)
+			evk, ok := ev[k]
+			if !ok {
+				if child == nil {
+					rr = append(rr, fieldPath(path, k))
+				}
+				continue
+			}
+			rr = append(rr, removedFields(fieldPath(path, k), lv[k], evk, child, childSet(hints, "f:"+k))...)
+		}
+	case []any:
+		ev, ok := edited.([]any)
+		keys := listKeys(hints)
+		if !ok || len(keys) == 0 {
+			return nil
+		}
+		for i, item := range lv {
+			im, ok := item.(map[string]any)
+			if !ok {
+				return rr
+			}
+			p := path + "[" + itemID(im, keys) + "]"
+			child := itemSet(owned, i, item)
+			eitem, ok := findItem(ev, im, keys)
+			if !ok {
+				if child == nil {
+					rr = append(rr, p)
+				}
+				continue
+			}
+			rr = append(rr, removedFields(p, im, eitem, child, itemSet(hints, i, item))...)
+		}
+	}
+
+	return rr
+}
+
+// ownsSelf checks if a fieldset owns a field itself rather than only some of its children.
+func ownsSelf(set map[string]any) bool {
+	_, ok := set["."]
+
+	return ok
+}
+
+func childSet(set map[string]any, k string) map[string]any {
+	if set == nil {
+		return nil
+	}
+	c, _ := set[k].(map[string]any)
+
+	return c
+}
+
+// itemSet returns a list item fieldset.
+func itemSet(set map[string]any, index int, item any) map[string]any {
+	for k, v := range set {
+		if c, ok := v.(map[string]any); ok && matchItem(k, index, item) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// listKeys returns the keys a server merges a list items by if any.
+func listKeys(hints map[string]any) []string {
+	for k := range hints {
+		spec, ok := strings.CutPrefix(k, "k:")
+		if !ok {
+			continue
+		}
+		var kk map[string]any
+		if err := json.Unmarshal([]byte(spec), &kk); err != nil {
+			return nil
+		}
+		return slices.Sorted(maps.Keys(kk))
+	}
+
+	return nil
+}
+
+func findItem(items []any, item map[string]any, keys []string) (map[string]any, bool) {
+	id := itemID(item, keys)
+	for _, it := range items {
+		if m, ok := it.(map[string]any); ok && itemID(m, keys) == id {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+func itemID(item map[string]any, keys []string) string {
+	ss := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ss = append(ss, k+"="+fmt.Sprint(item[k]))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func applyConflicts(err error) ApplyConflicts {
+	var status apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+	var cc ApplyConflicts
+	for _, c := range status.Status().Details.Causes {
+		if c.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		ac := ApplyConflict{Field: c.Field, Message: c.Message}
+		if mm := conflictManagerRX.FindStringSubmatch(c.Message); len(mm) == 2 {
+			ac.Manager = mm[1]
+		}
+		cc = append(cc, ac)
+	}
+
+	return cc
+}
+
+// fieldOwners returns the managers owning a given field path on a live resource.
+func fieldOwners(live *unstructured.Unstructured, segs []any) []string {
+	var oo []string
+	for _, f := range live.GetManagedFields() {
+		if f.FieldsV1 == nil {
+			continue
+		}
+		var set map[string]any
+		if err := json.Unmarshal(f.FieldsV1.Raw, &set); err != nil {
+			continue
+		}
+		if ownsField(set, live.Object, segs) && !slices.Contains(oo, f.Manager) {
+			oo = append(oo, f.Manager)
+		}
+	}
+
+	return oo
+}
+
+// ownsField walks a managed fieldset alongside the live object to check a field ownership.
+func ownsField(set map[string]any, o any, segs []any) bool {
+	if len(segs) == 0 {
+		return true
+	}
+	switch s := segs[0].(type) {
+	case string:
+		child, ok := set["f:"+s].(map[string]any)
+		if !ok {
+			return false
+		}
+		var next any
+		if m, ok := o.(map[string]any); ok {
+			next = m[s]
+		}
+		return ownsField(child, next, segs[1:])
+	case int:
+		items, ok := o.([]any)
+		if !ok || s >= len(items) {
+			return false
+		}
+		for k, v := range set {
+			child, ok := v.(map[string]any)
+			if !ok || !matchItem(k, s, items[s]) {
+				continue
+			}
+			return ownsField(child, items[s], segs[1:])
+		}
+	}
+
+	return false
+}
+
+// matchItem checks if a fieldset list key designates a given list item.
+func matchItem(key string, index int, item any) bool {
+	kind, spec, ok := strings.Cut(key, ":")
+	if !ok {
+		return false
+	}
+	switch kind {
+	case "i":
+		i, err := strconv.Atoi(spec)
+		return err == nil && i == index
+	case "v":
+		var v any
+		if err := json.Unmarshal([]byte(spec), &v); err != nil {
+			return false
+		}
+		return fmt.Sprint(v) == fmt.Sprint(item)
+	case "k":
+		var kk map[string]any
+		if err := json.Unmarshal([]byte(spec), &kk); err != nil {
+			return false
+		}
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range kk {
+			if fmt.Sprint(m[k]) != fmt.Sprint(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
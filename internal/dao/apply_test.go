@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFieldOwners(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "dp1", "namespace": "ns1"},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{"spec": map[string]any{
+				"containers": []any{
+					map[string]any{"name": "nginx", "image": "nginx:1.0"},
+				},
+			}},
+		},
+	}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:   "kubectl",
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+		},
+		{
+			Manager:   "hpa",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+		},
+	})
+
+	uu := map[string]struct {
+		segs []any
+		e    []string
+	}{
+		"shared": {
+			segs: []any{"spec", "replicas"},
+			e:    []string{"kubectl", "hpa"},
+		},
+		"keyed": {
+			segs: []any{"spec", "template", "spec", "containers", 0, "image"},
+			e:    []string{"kubectl"},
+		},
+		"new-item": {
+			segs: []any{"spec", "template", "spec", "containers", 1},
+		},
+		"unowned": {
+			segs: []any{"metadata", "labels"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, fieldOwners(live, u.segs))
+		})
+	}
+}
+
+func TestMatchItem(t *testing.T) {
+	uu := map[string]struct {
+		key   string
+		index int
+		item  any
+		e     bool
+	}{
+		"index": {
+			key: "i:1", index: 1,
+			e: true,
+		},
+		"index-toast": {
+			key: "i:1",
+		},
+		"value": {
+			key: `v:"blee"`, item: "blee",
+			e: true,
+		},
+		"key": {
+			key:  `k:{"containerPort":80,"protocol":"TCP"}`,
+			item: map[string]any{"containerPort": int64(80), "protocol": "TCP", "name": "http"},
+			e:    true,
+		},
+		"key-toast": {
+			key:  `k:{"containerPort":81,"protocol":"TCP"}`,
+			item: map[string]any{"containerPort": int64(80), "protocol": "TCP"},
+		},
+		"toast": {
+			key: "blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, matchItem(u.key, u.index, u.item))
+		})
+	}
+}
+
+func TestApplyConflicts(t *testing.T) {
+	err := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+			Field:   ".spec.replicas",
+		},
+	}, "Apply failed with 1 conflict")
+
+	cc := applyConflicts(err)
+	assert.Equal(t, ApplyConflicts{
+		{
+			Field:   ".spec.replicas",
+			Manager: "kubectl-client-side-apply",
+			Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+		},
+	}, cc)
+	assert.Equal(t, "apply conflicts on .spec.replicas (kubectl-client-side-apply)", cc.Error())
+	assert.Nil(t, applyConflicts(errors.New("blee")))
+}
+
+func TestFieldManagers(t *testing.T) {
+	o := newDeployment(1)
+	o.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+		{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status"},
+	})
+
+	assert.Equal(t, []string{"kubectl (Apply)", "kube-controller-manager (Update status)"}, FieldManagers(o))
+}
+
+func TestApplyConfig(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":            "dp1",
+			"namespace":       "ns1",
+			"resourceVersion": "42",
+			"labels":          map[string]any{"app": "fred", "team": "blee"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"template": map[string]any{"spec": map[string]any{
+				"containers": []any{
+					map[string]any{"name": "nginx", "image": "nginx:1.0", "args": []any{"run"}},
+					map[string]any{"name": "sidecar", "image": "envoy:1.0"},
+				},
+			}},
+		},
+		"status": map[string]any{"replicas": int64(3)},
+	}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:   "helm",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:template":{"f:spec":{"f:containers":{` +
+				`"k:{\"name\":\"nginx\"}":{".":{},"f:args":{},"f:image":{},"f:name":{}},` +
+				`"k:{\"name\":\"sidecar\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+		},
+		{
+			Manager:   "hpa",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+		},
+		{
+			Manager:   ApplyFieldManager,
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{}}}}`)},
+		},
+	})
+
+	uu := map[string]struct {
+		edit func(*unstructured.Unstructured)
+		e    map[string]any
+		err  string
+	}{
+		"image": {
+			edit: func(o *unstructured.Unstructured) {
+				cc, _, _ := unstructured.NestedSlice(o.Object, "spec", "template", "spec", "containers")
+				cc[1].(map[string]any)["image"] = "envoy:2.0"
+				_ = unstructured.SetNestedSlice(o.Object, cc, "spec", "template", "spec", "containers")
+			},
+			e: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":      "dp1",
+					"namespace": "ns1",
+					"labels":    map[string]any{"team": "blee"},
+				},
+				"spec": map[string]any{
+					"template": map[string]any{"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "sidecar", "image": "envoy:2.0"},
+						},
+					}},
+				},
+			},
+		},
+		"drop-owned": {
+			edit: func(o *unstructured.Unstructured) {
+				unstructured.RemoveNestedField(o.Object, "metadata", "labels", "team")
+				o.SetAnnotations(map[string]string{"note": "fred"})
+			},
+			e: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":        "dp1",
+					"namespace":   "ns1",
+					"annotations": map[string]any{"note": "fred"},
+				},
+			},
+		},
+		"drop-unowned": {
+			edit: func(o *unstructured.Unstructured) {
+				unstructured.RemoveNestedField(o.Object, "spec", "replicas")
+				cc, _, _ := unstructured.NestedSlice(o.Object, "spec", "template", "spec", "containers")
+				_ = unstructured.SetNestedSlice(o.Object, cc[:1], "spec", "template", "spec", "containers")
+			},
+			err: "unable to remove fields managed by others, use edit instead: spec.replicas, spec.template.spec.containers[name=sidecar]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			edited := live.DeepCopy()
+			u.edit(edited)
+			o, err := applyConfig(live, edited)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, o.Object)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// certificateNameAnnotation tracks the certificate managed TLS secrets belong to.
+const certificateNameAnnotation = "cert-manager.io/certificate-name"
+
+var _ Accessor = (*CertExpiry)(nil)
+
+// CertExpiry scans cert-manager certificates and TLS secrets for expiry.
+type CertExpiry struct {
+	NonResource
+}
+
+// certificate represents the cert-manager.io Certificate fields k9s tracks.
+type certificate struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		SecretName string   `json:"secretName"`
+		CommonName string   `json:"commonName"`
+		DNSNames   []string `json:"dnsNames"`
+		IssuerRef  struct {
+			Name string `json:"name"`
+			Kind string `json:"kind"`
+		} `json:"issuerRef"`
+	} `json:"spec"`
+	Status struct {
+		NotAfter    *metav1.Time `json:"notAfter"`
+		RenewalTime *metav1.Time `json:"renewalTime"`
+		Conditions  []struct {
+			Type    string `json:"type"`
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+// List returns certificates and TLS secrets expiry.
+func (c *CertExpiry) List(_ context.Context, ns string) ([]runtime.Object, error) {
+	f := c.getFactory()
+	ss, err := f.List(client.SecGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var cc []runtime.Object
+	if _, err := MetaAccess.MetaFor(client.CertGVR); err == nil {
+		if cc, err = f.List(client.CertGVR, ns, true, labels.Everything()); err != nil {
+			slog.Warn("Unable to list cert-manager certificates",
+				slogs.GVR, client.CertGVR,
+				slogs.Error, err,
+			)
+		}
+	}
+
+	return certExpiries(cc, ss)
+}
+
+// Renew triggers a cert-manager certificate re-issuance the way cmctl renew does.
+func (c *CertExpiry) Renew(ctx context.Context, path string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, client.CertGVR.WithSubResource("status"), n, []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to renew certificate %s", path)
+	}
+
+	dial, err := c.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	res := dial.Resource(client.CertGVR.GVR()).Namespace(ns)
+	u, err := res.Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := markIssuing(u, time.Now()); err != nil {
+		return err
+	}
+	if _, err := res.UpdateStatus(ctx, u, metav1.UpdateOptions{DryRun: dryRunOpts()}); err != nil {
+		return fmt.Errorf("unable to renew certificate %s: %w", n, err)
+	}
+
+	return nil
+}
+
+// Helpers...
+
+func certExpiries(cc, ss []runtime.Object) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(cc)+len(ss))
+	managed := make(map[string]struct{}, len(cc))
+	secrets := make(map[string]*v1.Secret, len(ss))
+	for _, o := range ss {
+		var sec v1.Secret
+		if err := toTyped(o, &sec); err != nil {
+			return nil, err
+		}
+		if sec.Type == v1.SecretTypeTLS {
+			secrets[client.FQN(sec.Namespace, sec.Name)] = &sec
+		}
+	}
+
+	for _, o := range cc {
+		var cert certificate
+		if err := toTyped(o, &cert); err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(cert.Namespace, cert.Spec.SecretName)
+		managed[fqn] = struct{}{}
+		oo = append(oo, certificateExpiry(&cert, secrets[fqn]))
+	}
+	for fqn, sec := range secrets {
+		if _, ok := managed[fqn]; ok {
+			continue
+		}
+		if _, ok := sec.Annotations[certificateNameAnnotation]; ok {
+			continue
+		}
+		oo = append(oo, secretExpiry(sec))
+	}
+
+	return oo, nil
+}
+
+func certificateExpiry(cert *certificate, sec *v1.Secret) *render.CertExpiryRes {
+	issuer := cert.Spec.IssuerRef.Name
+	if k := cert.Spec.IssuerRef.Kind; k != "" {
+		issuer = strings.ToLower(k) + "/" + issuer
+	}
+	res := render.CertExpiryRes{
+		GVR:       client.CertGVR.String(),
+		Kind:      render.CertKindCertificate,
+		Namespace: cert.Namespace,
+		Name:      cert.Name,
+		Issuer:    issuer,
+		DNSNames:  cert.Spec.DNSNames,
+		Ready:     render.MissingValue,
+		Secret:    cert.Spec.SecretName,
+		Created:   cert.CreationTimestamp.Time,
+	}
+	if len(res.DNSNames) == 0 && cert.Spec.CommonName != "" {
+		res.DNSNames = []string{cert.Spec.CommonName}
+	}
+	if t := cert.Status.NotAfter; t != nil {
+		res.NotAfter = t.Time
+	} else if x, err := parseTLSCert(sec); err == nil {
+		res.NotAfter = x.NotAfter
+	}
+	if t := cert.Status.RenewalTime; t != nil {
+		res.RenewalTime = t.Time
+	}
+	for _, c := range cert.Status.Conditions {
+		if c.Type == "Ready" {
+			res.Ready, res.Message = c.Status, c.Message
+		}
+	}
+
+	return &res
+}
+
+func secretExpiry(sec *v1.Secret) *render.CertExpiryRes {
+	res := render.CertExpiryRes{
+		GVR:       client.SecGVR.String(),
+		Kind:      render.CertKindSecret,
+		Namespace: sec.Namespace,
+		Name:      sec.Name,
+		Issuer:    render.MissingValue,
+		Ready:     render.NAValue,
+		Secret:    sec.Name,
+		Created:   sec.CreationTimestamp.Time,
+	}
+	x, err := parseTLSCert(sec)
+	if err != nil {
+		res.Ready, res.Message = "False", err.Error()
+		return &res
+	}
+	res.NotAfter, res.DNSNames = x.NotAfter, x.DNSNames
+	if x.Issuer.CommonName != "" {
+		res.Issuer = x.Issuer.CommonName
+	}
+	if len(res.DNSNames) == 0 && x.Subject.CommonName != "" {
+		res.DNSNames = []string{x.Subject.CommonName}
+	}
+
+	return &res
+}
+
+// parseTLSCert returns a TLS secret leaf certificate.
+func parseTLSCert(sec *v1.Secret) (*x509.Certificate, error) {
+	if sec == nil {
+		return nil, errors.New("no tls secret found")
+	}
+	b, _ := pem.Decode(sec.Data[v1.TLSCertKey])
+	if b == nil {
+		return nil, errors.New("no pem certificate found")
+	}
+
+	return x509.ParseCertificate(b.Bytes)
+}
+
+// markIssuing flags a certificate for re-issuance.
+func markIssuing(u *unstructured.Unstructured, now time.Time) error {
+	cc, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(cc, func(c any) bool {
+		m, ok := c.(map[string]any)
+		return ok && m["type"] == "Issuing" && m["status"] == string(metav1.ConditionTrue)
+	}) {
+		return fmt.Errorf("certificate %s is already being issued", u.GetName())
+	}
+	cc = slices.DeleteFunc(cc, func(c any) bool {
+		m, ok := c.(map[string]any)
+		return ok && m["type"] == "Issuing"
+	})
+	cc = append(cc, map[string]any{
+		"type":               "Issuing",
+		"status":             string(metav1.ConditionTrue),
+		"reason":             "ManuallyTriggered",
+		"message":            "Certificate re-issuance manually triggered",
+		"lastTransitionTime": now.UTC().Format(time.RFC3339),
+	})
+
+	return unstructured.SetNestedSlice(u.Object, cc, "status", "conditions")
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCertExpiries(t *testing.T) {
+	notAfter := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	crt := makeTLSCert(t, "fred.io", notAfter)
+
+	cc := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+			"spec": map[string]any{
+				"secretName": "fred-tls",
+				"dnsNames":   []any{"fred.io", "www.fred.io"},
+				"issuerRef":  map[string]any{"name": "letsencrypt", "kind": "ClusterIssuer"},
+			},
+			"status": map[string]any{
+				"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+			},
+		}},
+	}
+	ss := []runtime.Object{
+		tlsSecret("fred-tls", crt, nil),
+		tlsSecret("blee-tls", crt, nil),
+		tlsSecret("zorg-tls", crt, map[string]any{certificateNameAnnotation: "zorg"}),
+		&unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "opaque", "namespace": "ns1"},
+			"type":     "Opaque",
+		}},
+	}
+
+	oo, err := certExpiries(cc, ss)
+	require.NoError(t, err)
+	require.Len(t, oo, 2)
+
+	cert, ok := oo[0].(*render.CertExpiryRes)
+	require.True(t, ok)
+	assert.Equal(t, client.CertGVR.String()+"|ns1|fred", cert.Path())
+	assert.Equal(t, "clusterissuer/letsencrypt", cert.Issuer)
+	assert.Equal(t, []string{"fred.io", "www.fred.io"}, cert.DNSNames)
+	assert.Equal(t, "True", cert.Ready)
+	assert.True(t, notAfter.Equal(cert.NotAfter))
+
+	sec, ok := oo[1].(*render.CertExpiryRes)
+	require.True(t, ok)
+	assert.Equal(t, client.SecGVR.String()+"|ns1|blee-tls", sec.Path())
+	assert.Equal(t, "fred.io", sec.Issuer)
+	assert.Equal(t, []string{"fred.io"}, sec.DNSNames)
+	assert.True(t, notAfter.Equal(sec.NotAfter))
+}
+
+func TestCertExpiriesBadSecret(t *testing.T) {
+	oo, err := certExpiries(nil, []runtime.Object{tlsSecret("bad-tls", []byte("bozo"), nil)})
+	require.NoError(t, err)
+	require.Len(t, oo, 1)
+
+	sec, ok := oo[0].(*render.CertExpiryRes)
+	require.True(t, ok)
+	assert.Equal(t, "False", sec.Ready)
+	assert.Equal(t, "no pem certificate found", sec.Message)
+}
+
+func TestMarkIssuing(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+				map[string]any{"type": "Issuing", "status": "False"},
+			},
+		},
+	}}
+
+	require.NoError(t, markIssuing(&u, time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)))
+	cc, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"type": "Ready", "status": "True"},
+		map[string]any{
+			"type":               "Issuing",
+			"status":             "True",
+			"reason":             "ManuallyTriggered",
+			"message":            "Certificate re-issuance manually triggered",
+			"lastTransitionTime": "2026-10-14T00:00:00Z",
+		},
+	}, cc)
+
+	require.EqualError(t, markIssuing(&u, time.Now()), "certificate fred is already being issued")
+}
+
+func makeTLSCert(t *testing.T, cn string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func tlsSecret(name string, crt []byte, annotations map[string]any) *unstructured.Unstructured {
+	md := map[string]any{"name": name, "namespace": "ns1"}
+	if annotations != nil {
+		md["annotations"] = annotations
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": md,
+		"type":     "kubernetes.io/tls",
+		"data":     map[string]any{"tls.crt": base64.StdEncoding.EncodeToString(crt)},
+	}}
+}
@@ -31,6 +31,7 @@ type RefScanner interface {
 type Ref struct {
 	GVR string
 	FQN string
+	Via []string
 }
 
 // Refs represents a collection of resource references.
@@ -42,6 +43,7 @@ var (
 	_ RefScanner = (*DaemonSet)(nil)
 	_ RefScanner = (*Job)(nil)
 	_ RefScanner = (*CronJob)(nil)
+	_ RefScanner = (*Pod)(nil)
 )
 
 func scanners() map[*client.GVR]RefScanner {
@@ -51,6 +53,7 @@ func scanners() map[*client.GVR]RefScanner {
 		client.StsGVR: new(StatefulSet),
 		client.CjGVR:  new(CronJob),
 		client.JobGVR: new(Job),
+		client.PodGVR: new(Pod),
 	}
 }
 
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// ClusterTarget represents a resolved cluster endpoint.
+type ClusterTarget struct {
+	// Path is the backing pod path.
+	Path string
+
+	// Port is the backing pod port.
+	Port int32
+}
+
+// ClusterDialer dials cluster services and pods via port-forward tunnels.
+// Hosts are resolved as svc[.ns[.svc[.cluster.local]]], pod.ns.pod[.cluster.local],
+// pod.svc.ns.svc[.cluster.local] or a service cluster IP or pod IP.
+type ClusterDialer struct {
+	Factory
+
+	namespace string
+	requestID atomic.Int32
+}
+
+// NewClusterDialer returns a new dialer resolving bare names in a given namespace.
+func NewClusterDialer(f Factory, ns string) *ClusterDialer {
+	if client.IsAllNamespaces(ns) {
+		ns = client.DefaultNamespace
+	}
+
+	return &ClusterDialer{Factory: f, namespace: ns}
+}
+
+// DialContext opens a tunnel to a cluster address.
+func (d *ClusterDialer) DialContext(ctx context.Context, _, addr string) (net.Conn, error) {
+	t, err := d.Resolve(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	type dial struct {
+		conn net.Conn
+		err  error
+	}
+	res := make(chan dial, 1)
+	go func() {
+		conn, err := d.tunnel(t)
+		res <- dial{conn: conn, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		go func() {
+			if r := <-res; r.conn != nil {
+				_ = r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-res:
+		return r.conn, r.err
+	}
+}
+
+// Resolve resolves a host:port address to a backing pod port.
+func (d *ClusterDialer) Resolve(addr string) (ClusterTarget, error) {
+	host, sport, err := net.SplitHostPort(addr)
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	p, err := strconv.ParseInt(sport, 10, 32)
+	if err != nil {
+		return ClusterTarget{}, fmt.Errorf("invalid port %q", sport)
+	}
+	port := int32(p)
+
+	if ip := net.ParseIP(host); ip != nil {
+		return d.resolveIP(host, port)
+	}
+	ll := strings.Split(strings.TrimSuffix(strings.TrimSuffix(host, "."), ".cluster.local"), ".")
+	switch {
+	case len(ll) == 1:
+		return d.resolveSvc(d.namespace, ll[0], port)
+	case len(ll) == 2 || (len(ll) == 3 && ll[2] == "svc"):
+		return d.resolveSvc(ll[1], ll[0], port)
+	case len(ll) == 3 && ll[2] == "pod":
+		return d.resolvePod(client.FQN(ll[1], ll[0]), port)
+	case len(ll) == 4 && ll[3] == "svc":
+		return d.resolvePod(client.FQN(ll[2], ll[0]), port)
+	default:
+		return ClusterTarget{}, fmt.Errorf("unable to resolve cluster host %q", host)
+	}
+}
+
+func (d *ClusterDialer) resolveIP(ip string, port int32) (ClusterTarget, error) {
+	oo, err := d.List(client.SvcGVR, client.NamespaceAll, true, labels.Everything())
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	for _, o := range oo {
+		var svc v1.Service
+		if err := toTyped(o, &svc); err != nil {
+			return ClusterTarget{}, err
+		}
+		if svc.Spec.ClusterIP == ip {
+			return d.svcTarget(&svc, port)
+		}
+	}
+	oo, err = d.List(client.PodGVR, client.NamespaceAll, true, labels.Everything())
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return ClusterTarget{}, err
+		}
+		if po.Status.PodIP == ip {
+			return ClusterTarget{Path: client.FQN(po.Namespace, po.Name), Port: port}, nil
+		}
+	}
+
+	return ClusterTarget{}, fmt.Errorf("no service or pod matching ip %s", ip)
+}
+
+func (d *ClusterDialer) resolveSvc(ns, n string, port int32) (ClusterTarget, error) {
+	o, err := d.Get(client.SvcGVR, client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	var svc v1.Service
+	if err := toTyped(o, &svc); err != nil {
+		return ClusterTarget{}, fmt.Errorf("unable to locate service %s: %w", client.FQN(ns, n), err)
+	}
+
+	return d.svcTarget(&svc, port)
+}
+
+func (d *ClusterDialer) svcTarget(svc *v1.Service, port int32) (ClusterTarget, error) {
+	fqn := client.FQN(svc.Namespace, svc.Name)
+	if len(svc.Spec.Selector) == 0 {
+		return ClusterTarget{}, fmt.Errorf("service %s has no pods selector", fqn)
+	}
+	var sp *v1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == port {
+			sp = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if sp == nil {
+		return ClusterTarget{}, fmt.Errorf("service %s does not expose port %d", fqn, port)
+	}
+	path, err := ReadyPod(d, svc.Namespace, labels.Set(svc.Spec.Selector).String(), "")
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	if sp.TargetPort.StrVal == "" {
+		if sp.TargetPort.IntVal == 0 {
+			return ClusterTarget{Path: path, Port: sp.Port}, nil
+		}
+		return ClusterTarget{Path: path, Port: sp.TargetPort.IntVal}, nil
+	}
+
+	po, err := fetchPodSpec(d, path)
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	for _, co := range po.Spec.Containers {
+		for _, cp := range co.Ports {
+			if cp.Name == sp.TargetPort.StrVal {
+				return ClusterTarget{Path: path, Port: cp.ContainerPort}, nil
+			}
+		}
+	}
+
+	return ClusterTarget{}, fmt.Errorf("no container port named %q on pod %s", sp.TargetPort.StrVal, path)
+}
+
+func (d *ClusterDialer) resolvePod(fqn string, port int32) (ClusterTarget, error) {
+	po, err := fetchPodSpec(d, fqn)
+	if err != nil {
+		return ClusterTarget{}, err
+	}
+	if !isPodServing(po) {
+		return ClusterTarget{}, fmt.Errorf("pod %s is not ready", fqn)
+	}
+
+	return ClusterTarget{Path: fqn, Port: port}, nil
+}
+
+func (d *ClusterDialer) tunnel(t ClusterTarget) (net.Conn, error) {
+	ns, n := client.Namespaced(t.Path)
+	auth, err := d.Client().CanI(ns, client.PodGVR.WithSubResource("portforward"), "", []string{client.CreateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to port-forward pod %s", t.Path)
+	}
+	u, err := podPortForwardURL(d.Client(), ns, n)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := d.Client().Config().RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u)
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+	headers.Set(v1.StreamType, v1.StreamTypeError)
+	headers.Set(v1.PortHeader, strconv.Itoa(int(t.Port)))
+	headers.Set(v1.PortForwardRequestIDHeader, strconv.Itoa(int(d.requestID.Add(1))))
+	errStream, err := conn.CreateStream(headers)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = errStream.Close()
+
+	headers.Set(v1.StreamType, v1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	go func() {
+		if msg, _ := io.ReadAll(errStream); len(msg) > 0 {
+			_ = dataStream.Reset()
+			_ = conn.Close()
+		}
+	}()
+
+	return &streamConn{Stream: dataStream, conn: conn, target: t}, nil
+}
+
+func fetchPodSpec(f Factory, fqn string) (*v1.Pod, error) {
+	o, err := f.Get(client.PodGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := toTyped(o, &po); err != nil {
+		return nil, fmt.Errorf("unable to locate pod %s: %w", fqn, err)
+	}
+
+	return &po, nil
+}
+
+// streamConn adapts a port-forward data stream to a net connection.
+type streamConn struct {
+	httpstream.Stream
+
+	conn   httpstream.Connection
+	target ClusterTarget
+}
+
+func (s *streamConn) Close() error {
+	_ = s.Stream.Close()
+
+	return s.conn.Close()
+}
+
+func (*streamConn) LocalAddr() net.Addr { return clusterAddr("k9s") }
+
+func (s *streamConn) RemoteAddr() net.Addr {
+	return clusterAddr(s.target.Path + ":" + strconv.Itoa(int(s.target.Port)))
+}
+
+func (*streamConn) SetDeadline(time.Time) error      { return nil }
+func (*streamConn) SetReadDeadline(time.Time) error  { return nil }
+func (*streamConn) SetWriteDeadline(time.Time) error { return nil }
+
+type clusterAddr string
+
+func (clusterAddr) Network() string  { return "k8s" }
+func (c clusterAddr) String() string { return string(c) }
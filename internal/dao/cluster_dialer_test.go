@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestClusterDialerResolve(t *testing.T) {
+	f := resolverFactory()
+	f.inventory["ns1"][client.SvcGVR] = append(f.inventory["ns1"][client.SvcGVR], &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "blee", "namespace": "ns1"},
+		"spec": map[string]any{
+			"selector": map[string]any{"app": "fred"},
+			"ports": []any{
+				map[string]any{"port": int64(80), "targetPort": int64(8080)},
+				map[string]any{"port": int64(443), "targetPort": "https"},
+				map[string]any{"port": int64(9090)},
+			},
+		},
+	}})
+	po := f.inventory["ns1"][client.PodGVR][1].(*unstructured.Unstructured)
+	_ = unstructured.SetNestedSlice(po.Object, []any{
+		map[string]any{"name": "c1", "ports": []any{map[string]any{"name": "https", "containerPort": int64(8443)}}},
+	}, "spec", "containers")
+
+	uu := map[string]struct {
+		addr string
+		e    dao.ClusterTarget
+		err  bool
+	}{
+		"bare": {
+			addr: "blee:80",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-b", Port: 8080},
+		},
+		"namespaced": {
+			addr: "blee.ns1:80",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-b", Port: 8080},
+		},
+		"fqdn": {
+			addr: "blee.ns1.svc.cluster.local:443",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-b", Port: 8443},
+		},
+		"no-target-port": {
+			addr: "blee.ns1.svc:9090",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-b", Port: 9090},
+		},
+		"pod": {
+			addr: "fred-1-c.ns1.pod:7000",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-c", Port: 7000},
+		},
+		"headless": {
+			addr: "fred-1-c.blee.ns1.svc.cluster.local:7000",
+			e:    dao.ClusterTarget{Path: "ns1/fred-1-c", Port: 7000},
+		},
+		"not-ready-pod": {
+			addr: "fred-1-a.ns1.pod:7000",
+			err:  true,
+		},
+		"no-port": {
+			addr: "blee.ns1:81",
+			err:  true,
+		},
+		"no-svc": {
+			addr: "zorg.ns1:80",
+			err:  true,
+		},
+		"bad-host": {
+			addr: "a.b.c.d.e:80",
+			err:  true,
+		},
+	}
+
+	d := dao.NewClusterDialer(&f, "ns1")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tg, err := d.Resolve(u.addr)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, tg)
+		})
+	}
+}
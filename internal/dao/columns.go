@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/kubectl/pkg/cmd/get"
+)
+
+// maxColumnValues tracks the maximum number of cached column values.
+const maxColumnValues = 10_000
+
+// columns caches computed column values.
+var columns = newColumnCache()
+
+// columnCache tracks extra columns parsers and computed values.
+type columnCache struct {
+	parsers map[string]*jsonpath.JSONPath
+	values  map[string]string
+	mx      sync.Mutex
+}
+
+func newColumnCache() *columnCache {
+	return &columnCache{
+		parsers: make(map[string]*jsonpath.JSONPath),
+		values:  make(map[string]string),
+	}
+}
+
+// extendTable appends extra columns computed from the rows manifest to a table.
+func extendTable(t *metav1.Table, cc []config.ExtraColumn) {
+	if t == nil || len(cc) == 0 {
+		return
+	}
+	for _, c := range cc {
+		var priority int32
+		if c.Wide {
+			priority = 1
+		}
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1.TableColumnDefinition{
+			Name:     c.Name,
+			Type:     "string",
+			Priority: priority,
+		})
+	}
+	for i := range t.Rows {
+		row := &t.Rows[i]
+		for _, c := range cc {
+			row.Cells = append(row.Cells, columns.value(row.Object.Object, c))
+		}
+	}
+}
+
+// value returns an extra column value for a given resource.
+// Values are cached per resource version.
+func (c *columnCache) value(o runtime.Object, col config.ExtraColumn) string {
+	if o == nil {
+		return render.NAValue
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return render.NAValue
+	}
+	key := strings.Join([]string{string(m.GetUID()), m.GetResourceVersion(), col.JSONPath, col.CEL}, "|")
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if v, ok := c.values[key]; ok {
+		return v
+	}
+	u, ok := o.(runtime.Unstructured)
+	if !ok {
+		return render.NAValue
+	}
+	v, err := c.eval(u.UnstructuredContent(), col)
+	if err != nil {
+		slog.Debug("Unable to compute extra column",
+			slogs.Name, col.Name,
+			slogs.Error, err,
+		)
+		v = render.MissingValue
+	}
+	if len(c.values) >= maxColumnValues {
+		clear(c.values)
+	}
+	c.values[key] = v
+
+	return v
+}
+
+func (c *columnCache) eval(o map[string]any, col config.ExtraColumn) (string, error) {
+	switch {
+	case col.CEL != "":
+		p, err := celProgram(col.CEL)
+		if err != nil {
+			return "", err
+		}
+		v, err := evalProgram(p, map[string]any{"object": o})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v", v.Value()), nil
+	case col.JSONPath != "":
+		p, err := c.parser(col.JSONPath)
+		if err != nil {
+			return "", err
+		}
+		var buff bytes.Buffer
+		if err := p.Execute(&buff, o); err != nil {
+			return "", err
+		}
+		if buff.Len() == 0 {
+			return render.MissingValue, nil
+		}
+		return buff.String(), nil
+	default:
+		return "", fmt.Errorf("no expression defined for column %s", col.Name)
+	}
+}
+
+func (c *columnCache) parser(expr string) (*jsonpath.JSONPath, error) {
+	if p, ok := c.parsers[expr]; ok {
+		return p, nil
+	}
+	spec, err := get.RelaxedJSONPathExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := jsonpath.New(expr).AllowMissingKeys(true)
+	if err := p.Parse(spec); err != nil {
+		return nil, err
+	}
+	c.parsers[expr] = p
+
+	return p, nil
+}
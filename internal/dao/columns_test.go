@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExtendTable(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Blee",
+		"metadata":   map[string]any{"name": "b1", "namespace": "ns1", "uid": "b1-uid", "resourceVersion": "1"},
+		"spec":       map[string]any{"issuerRef": map[string]any{"name": "zorg"}},
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+	table := metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}},
+		Rows: []metav1.TableRow{
+			{Cells: []any{"b1"}, Object: runtime.RawExtension{Object: &o}},
+			{Cells: []any{"b2"}},
+		},
+	}
+	cc := []config.ExtraColumn{
+		{Name: "Issuer", JSONPath: ".spec.issuerRef.name"},
+		{Name: "Healthy", CEL: "object.status.conditions.exists(c, c.type == 'Ready' && c.status == 'True')", Wide: true},
+		{Name: "Missing", JSONPath: ".status.fred"},
+		{Name: "Toast", CEL: "object.status.fred"},
+	}
+
+	extendTable(&table, cc)
+	assert.Len(t, table.ColumnDefinitions, 5)
+	assert.Equal(t, int32(1), table.ColumnDefinitions[2].Priority)
+	assert.Equal(t, []any{"b1", "zorg", "true", render.MissingValue, render.MissingValue}, table.Rows[0].Cells)
+	assert.Equal(t, []any{"b2", render.NAValue, render.NAValue, render.NAValue, render.NAValue}, table.Rows[1].Cells)
+}
+
+func TestColumnCacheValue(t *testing.T) {
+	c := newColumnCache()
+	o := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "b1", "uid": "b1-uid", "resourceVersion": "1"},
+		"status":   map[string]any{"phase": "Running"},
+	}}
+	col := config.ExtraColumn{Name: "Phase", JSONPath: ".status.phase"}
+
+	assert.Equal(t, "Running", c.value(&o, col))
+	o.Object["status"] = map[string]any{"phase": "Failed"}
+	assert.Equal(t, "Running", c.value(&o, col))
+	o.SetResourceVersion("2")
+	assert.Equal(t, "Failed", c.value(&o, col))
+	assert.Len(t, c.values, 2)
+}
@@ -44,7 +44,10 @@ func (c *Context) List(context.Context, string) ([]runtime.Object, error) {
 	}
 	cc := make([]runtime.Object, 0, len(ctxs))
 	for k, v := range ctxs {
-		cc = append(cc, render.NewNamedContext(c.config(), k, v))
+		nc := render.NewNamedContext(c.config(), k, v)
+		h := c.config().ContextHealth(k)
+		nc.Health = &h
+		cc = append(cc, nc)
 	}
 
 	return cc, nil
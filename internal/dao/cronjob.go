@@ -33,6 +33,34 @@ type CronJob struct {
 	Generic
 }
 
+// List returns a collection of cronjobs along with their jobs runs summary.
+func (c *CronJob) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.Generic.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	jj, jErr := listJobs(c.getFactory(), ns)
+	if jErr != nil {
+		slog.Warn("Unable to list cronjobs runs", slogs.Error, jErr)
+	}
+	runs := jobsByOwner(jj)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		cjr := render.CronJobWithRuns{Raw: u}
+		if jErr == nil {
+			cjr.Runs = summarizeRuns(runs[u.GetUID()])
+		}
+		res = append(res, &cjr)
+	}
+
+	return res, nil
+}
+
 // ListImages lists container images.
 func (c *CronJob) ListImages(_ context.Context, fqn string) ([]string, error) {
 	cj, err := c.GetInstance(fqn)
@@ -93,7 +121,7 @@ func (c *CronJob) Run(path string) error {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.Client().Config().CallTimeout())
 	defer cancel()
-	_, err = dial.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
+	_, err = dial.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{DryRun: dryRunOpts()})
 
 	return err
 }
@@ -166,7 +194,7 @@ func (c *CronJob) ToggleSuspend(ctx context.Context, path string) error {
 		trueVal := true
 		cj.Spec.Suspend = &trueVal
 	}
-	_, err = dial.BatchV1().CronJobs(ns).Update(ctx, cj, metav1.UpdateOptions{})
+	_, err = dial.BatchV1().CronJobs(ns).Update(ctx, cj, metav1.UpdateOptions{DryRun: dryRunOpts()})
 
 	return err
 }
@@ -188,15 +216,17 @@ func (c *CronJob) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
+			via := configMapRefs(&cj.Spec.JobTemplate.Spec.Template.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: c.GVR(),
 				FQN: client.FQN(cj.Namespace, cj.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
+			via, err := secretRefs(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Failed to locate secret",
 					slogs.FQN, fqn,
@@ -204,12 +234,13 @@ func (c *CronJob) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: c.GVR(),
 				FQN: client.FQN(cj.Namespace, cj.Name),
+				Via: via,
 			})
 		case client.PcGVR:
 			if !hasPC(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
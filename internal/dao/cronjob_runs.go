@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ Accessor = (*CronJobRuns)(nil)
+
+// CronJobRuns represents a cronjob jobs runs history.
+type CronJobRuns struct {
+	NonResource
+}
+
+// List returns a cronjob jobs runs, most recent first.
+func (c *CronJobRuns) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+	u, err := getUnstructured(c.getFactory(), client.CjGVR, fqn)
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(fqn)
+	jj, err := listJobs(c.getFactory(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := jobsByOwner(jj)[u.GetUID()]
+	oo := make([]runtime.Object, 0, len(runs))
+	for _, j := range runs {
+		oo = append(oo, jobRun(j))
+	}
+
+	return oo, nil
+}
+
+// Helpers...
+
+func listJobs(f Factory, ns string) ([]*batchv1.Job, error) {
+	oo, err := f.List(client.JobGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	jj := make([]*batchv1.Job, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var j batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &j); err != nil {
+			return nil, errors.New("expecting Job resource")
+		}
+		jj = append(jj, &j)
+	}
+
+	return jj, nil
+}
+
+// jobsByOwner groups cronjobs jobs by owner, most recent first.
+func jobsByOwner(jj []*batchv1.Job) map[types.UID][]*batchv1.Job {
+	mm := make(map[types.UID][]*batchv1.Job)
+	for _, j := range jj {
+		if ref := metav1.GetControllerOf(j); ref != nil && ref.Kind == "CronJob" {
+			mm[ref.UID] = append(mm[ref.UID], j)
+		}
+	}
+	for _, runs := range mm {
+		sort.SliceStable(runs, func(i, j int) bool {
+			return jobStart(runs[i]).After(jobStart(runs[j]))
+		})
+	}
+
+	return mm
+}
+
+// summarizeRuns aggregates a cronjob jobs runs listed most recent first.
+func summarizeRuns(jj []*batchv1.Job) *render.JobRuns {
+	var (
+		runs    render.JobRuns
+		total   time.Duration
+		settled bool
+	)
+	for _, j := range jj {
+		if start := jobStart(j); start.After(runs.LastRun) {
+			runs.LastRun = start
+		}
+		status, _, end := jobRunStatus(j)
+		switch status {
+		case render.JobRunRunning:
+			runs.Active++
+			continue
+		case render.JobRunSucceeded:
+			runs.Succeeded++
+			if end.After(runs.LastSuccess) {
+				runs.LastSuccess = end
+			}
+			if j.Status.StartTime != nil {
+				total += end.Sub(j.Status.StartTime.Time)
+			}
+			settled = true
+		case render.JobRunFailed:
+			runs.Failed++
+			if !settled {
+				runs.FailureStreak++
+			}
+		}
+	}
+	if runs.Succeeded > 0 {
+		runs.AvgDuration = total / time.Duration(runs.Succeeded)
+	}
+
+	return &runs
+}
+
+func jobRun(j *batchv1.Job) *render.JobRunRes {
+	status, reason, end := jobRunStatus(j)
+	completions := int32(1)
+	if j.Spec.Completions != nil {
+		completions = *j.Spec.Completions
+	}
+	run := render.JobRunRes{
+		Namespace:   j.Namespace,
+		Name:        j.Name,
+		Status:      status,
+		Completions: fmt.Sprintf("%d/%d", j.Status.Succeeded, completions),
+		Reason:      reason,
+		Created:     j.CreationTimestamp.Time,
+	}
+	if j.Status.StartTime != nil {
+		run.Started = j.Status.StartTime.Time
+		if !end.IsZero() {
+			run.Duration = end.Sub(run.Started)
+		}
+	}
+
+	return &run
+}
+
+// jobRunStatus returns a job run status, failure reason and end time.
+func jobRunStatus(j *batchv1.Job) (status, reason string, end time.Time) {
+	for _, c := range j.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			end = c.LastTransitionTime.Time
+			if j.Status.CompletionTime != nil {
+				end = j.Status.CompletionTime.Time
+			}
+			return render.JobRunSucceeded, "", end
+		case batchv1.JobFailed:
+			return render.JobRunFailed, c.Reason, c.LastTransitionTime.Time
+		}
+	}
+
+	return render.JobRunRunning, "", time.Time{}
+}
+
+func jobStart(j *batchv1.Job) time.Time {
+	if j.Status.StartTime != nil {
+		return j.Status.StartTime.Time
+	}
+
+	return j.CreationTimestamp.Time
+}
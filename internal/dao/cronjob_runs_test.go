@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSummarizeRuns(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	jj := []*batchv1.Job{
+		makeRun("fred-1", "cj1", now.Add(-4*time.Hour), time.Minute, batchv1.JobComplete),
+		makeRun("fred-2", "cj1", now.Add(-3*time.Hour), 3*time.Minute, batchv1.JobComplete),
+		makeRun("fred-3", "cj1", now.Add(-2*time.Hour), time.Minute, batchv1.JobFailed),
+		makeRun("fred-4", "cj1", now.Add(-time.Hour), time.Minute, batchv1.JobFailed),
+		makeRun("fred-5", "cj1", now, 0, ""),
+		makeRun("blee-1", "cj2", now, time.Minute, batchv1.JobComplete),
+	}
+
+	mm := jobsByOwner(jj)
+	require.Len(t, mm, 2)
+	runs := mm["cj1"]
+	require.Len(t, runs, 5)
+	assert.Equal(t, "fred-5", runs[0].Name)
+
+	assert.Equal(t, &render.JobRuns{
+		Succeeded:     2,
+		Failed:        2,
+		Active:        1,
+		LastRun:       now,
+		LastSuccess:   now.Add(-3 * time.Hour).Add(3 * time.Minute),
+		FailureStreak: 2,
+		AvgDuration:   2 * time.Minute,
+	}, summarizeRuns(runs))
+	assert.Equal(t, 50, summarizeRuns(runs).SuccessRate())
+	assert.Equal(t, -1, summarizeRuns(nil).SuccessRate())
+}
+
+func TestJobRun(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	run := jobRun(makeRun("fred-1", "cj1", now, 2*time.Minute, batchv1.JobFailed))
+	assert.Equal(t, render.JobRunFailed, run.Status)
+	assert.Equal(t, "BackoffLimitExceeded", run.Reason)
+	assert.Equal(t, "0/1", run.Completions)
+	assert.Equal(t, 2*time.Minute, run.Duration)
+
+	run = jobRun(makeRun("fred-2", "cj1", now, 0, ""))
+	assert.Equal(t, render.JobRunRunning, run.Status)
+	assert.Zero(t, run.Duration)
+}
+
+// Helpers...
+
+func makeRun(n, owner string, start time.Time, d time.Duration, cond batchv1.JobConditionType) *batchv1.Job {
+	yes := true
+	j := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              n,
+			Namespace:         "ns1",
+			CreationTimestamp: metav1.NewTime(start),
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "CronJob", Name: owner, UID: types.UID(owner), Controller: &yes},
+			},
+		},
+		Status: batchv1.JobStatus{StartTime: &metav1.Time{Time: start}},
+	}
+	end := metav1.NewTime(start.Add(d))
+	switch cond {
+	case batchv1.JobComplete:
+		j.Status.Succeeded, j.Status.CompletionTime = 1, &end
+		j.Status.Conditions = []batchv1.JobCondition{{Type: cond, Status: v1.ConditionTrue, LastTransitionTime: end}}
+	case batchv1.JobFailed:
+		j.Status.Conditions = []batchv1.JobCondition{{Type: cond, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded", LastTransitionTime: end}}
+	}
+
+	return &j
+}
@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// DiffKind represents a side by side diff row kind.
+type DiffKind int
+
+const (
+	// DiffSame indicates a line present on both sides.
+	DiffSame DiffKind = iota
+
+	// DiffChanged indicates a line changed between both sides.
+	DiffChanged
+
+	// DiffAdded indicates a line only present on the right side.
+	DiffAdded
+
+	// DiffRemoved indicates a line only present on the left side.
+	DiffRemoved
+)
+
+// DiffRow represents a side by side diff row.
+type DiffRow struct {
+	Left, Right string
+	Kind        DiffKind
+}
+
+// SideBySide tracks two manifests side by side comparison.
+type SideBySide struct {
+	Left, Right string
+	Rows        []DiffRow
+
+	// Revision tracks the compared revision for revisions diffs.
+	Revision int64
+
+	// Revisions tracks the available revisions, most recent first.
+	Revisions []int64
+}
+
+// Changes returns the number of rows that differ.
+func (s *SideBySide) Changes() int {
+	var n int
+	for _, r := range s.Rows {
+		if r.Kind != DiffSame {
+			n++
+		}
+	}
+
+	return n
+}
+
+// WorkloadRevision represents a workload controller history revision.
+type WorkloadRevision struct {
+	Number      int64
+	Name        string
+	ChangeCause string
+	Created     time.Time
+	Template    map[string]any
+
+	// Ready and Desired track the revision replicas progress.
+	Ready, Desired int64
+}
+
+// DiffResources compares two resources manifests stripped of their server populated fields.
+func DiffResources(f Factory, gvr *client.GVR, left, right string) (*SideBySide, error) {
+	l, err := cleanedManifest(f, gvr, left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := cleanedManifest(f, gvr, right)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SideBySide{Left: left, Right: right, Rows: DiffText(l, r)}, nil
+}
+
+// DiffRevision compares a workload live pod template against a revision from its
+// controller history. A zero revision designates the previous revision.
+func DiffRevision(f Factory, gvr *client.GVR, fqn string, rev int64) (*SideBySide, error) {
+	u, err := getUnstructured(f, gvr, fqn)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := WorkloadRevisions(f, gvr, u)
+	if err != nil {
+		return nil, err
+	}
+	if rev == 0 {
+		if len(rr) < 2 {
+			return nil, fmt.Errorf("no previous revision found for %s", fqn)
+		}
+		rev = rr[1].Number
+	}
+	idx := slices.IndexFunc(rr, func(r WorkloadRevision) bool { return r.Number == rev })
+	if idx < 0 {
+		return nil, fmt.Errorf("revision %d not found for %s", rev, fqn)
+	}
+
+	live, _, err := unstructured.NestedMap(u.Object, "spec", "template")
+	if err != nil {
+		return nil, err
+	}
+	l, err := templateYAML(rr[idx].Template)
+	if err != nil {
+		return nil, err
+	}
+	r, err := templateYAML(live)
+	if err != nil {
+		return nil, err
+	}
+	nn := make([]int64, 0, len(rr))
+	for _, r := range rr {
+		nn = append(nn, r.Number)
+	}
+
+	return &SideBySide{
+		Left:      fmt.Sprintf("Revision %d (%s)", rev, rr[idx].Name),
+		Right:     "Live " + fqn,
+		Rows:      DiffText(l, r),
+		Revision:  rev,
+		Revisions: nn,
+	}, nil
+}
+
+// WorkloadRevisions returns a deployment, statefulset or daemonset pod template
+// revisions from its controller history, most recent first.
+func WorkloadRevisions(f Factory, gvr *client.GVR, u *unstructured.Unstructured) ([]WorkloadRevision, error) {
+	var (
+		rr  []WorkloadRevision
+		err error
+	)
+	switch gvr {
+	case client.DpGVR:
+		rr, err = rsRevisions(f, u)
+	case client.StsGVR, client.DsGVR:
+		rr, err = crevRevisions(f, gvr, u)
+	default:
+		return nil, fmt.Errorf("no revisions history for %s", gvr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(rr, func(a, b WorkloadRevision) int {
+		return cmp.Compare(b.Number, a.Number)
+	})
+
+	return rr, nil
+}
+
+// DiffText aligns two texts lines side by side based on their longest common
+// lines subsequence. Consecutive removed and added lines are paired as changes.
+func DiffText(left, right string) []DiffRow {
+	ll, rr := splitLines(left), splitLines(right)
+	lcs := make([][]int, len(ll)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(rr)+1)
+	}
+	for i := len(ll) - 1; i >= 0; i-- {
+		for j := len(rr) - 1; j >= 0; j-- {
+			if ll[i] == rr[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var (
+		rows          = make([]DiffRow, 0, max(len(ll), len(rr)))
+		removed, adds []string
+	)
+	flush := func() {
+		for k := range max(len(removed), len(adds)) {
+			switch {
+			case k >= len(adds):
+				rows = append(rows, DiffRow{Left: removed[k], Kind: DiffRemoved})
+			case k >= len(removed):
+				rows = append(rows, DiffRow{Right: adds[k], Kind: DiffAdded})
+			default:
+				rows = append(rows, DiffRow{Left: removed[k], Right: adds[k], Kind: DiffChanged})
+			}
+		}
+		removed, adds = removed[:0], adds[:0]
+	}
+	var i, j int
+	for i < len(ll) || j < len(rr) {
+		switch {
+		case i < len(ll) && j < len(rr) && ll[i] == rr[j]:
+			flush()
+			rows = append(rows, DiffRow{Left: ll[i], Right: rr[j], Kind: DiffSame})
+			i, j = i+1, j+1
+		case j >= len(rr) || (i < len(ll) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, ll[i])
+			i++
+		default:
+			adds = append(adds, rr[j])
+			j++
+		}
+	}
+	flush()
+
+	return rows
+}
+
+// Helpers...
+
+func getUnstructured(f Factory, gvr *client.GVR, fqn string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return nil, fmt.Errorf("unable to locate %s %s", gvr, fqn)
+	}
+
+	return u, nil
+}
+
+func cleanedManifest(f Factory, gvr *client.GVR, fqn string) (string, error) {
+	u, err := getUnstructured(f, gvr, fqn)
+	if err != nil {
+		return "", err
+	}
+	o := u.DeepCopy()
+	CleanManifest(o.Object)
+	bb, err := yaml.Marshal(o.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func templateYAML(tpl map[string]any) (string, error) {
+	t := map[string]any{"template": tpl}
+	cleanPodSpecs(t)
+	cleanEmpty(t)
+	bb, err := yaml.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func rsRevisions(f Factory, dp *unstructured.Unstructured) ([]WorkloadRevision, error) {
+	oo, err := f.List(client.RsGVR, dp.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	owners := map[types.UID]*client.GVR{dp.GetUID(): client.DpGVR}
+	rr := make([]WorkloadRevision, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !ownedBy(u.GetOwnerReferences(), owners) {
+			continue
+		}
+		n, err := strconv.ParseInt(u.GetAnnotations()[revisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		tpl, _, err := unstructured.NestedMap(u.Object, "spec", "template")
+		if err != nil {
+			return nil, err
+		}
+		unstructured.RemoveNestedField(tpl, "metadata", "labels", "pod-template-hash")
+		desired, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+		rr = append(rr, WorkloadRevision{
+			Number:      n,
+			Name:        u.GetName(),
+			ChangeCause: u.GetAnnotations()[changeCauseAnnotation],
+			Created:     u.GetCreationTimestamp().Time,
+			Template:    tpl,
+			Ready:       ready,
+			Desired:     desired,
+		})
+	}
+
+	return rr, nil
+}
+
+func crevRevisions(f Factory, gvr *client.GVR, w *unstructured.Unstructured) ([]WorkloadRevision, error) {
+	oo, err := f.List(crevGVR, w.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	owners := map[types.UID]*client.GVR{w.GetUID(): gvr}
+	pods, err := f.List(client.PodGVR, w.GetNamespace(), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]WorkloadRevision, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !ownedBy(u.GetOwnerReferences(), owners) {
+			continue
+		}
+		n, _, err := unstructured.NestedInt64(u.Object, "revision")
+		if err != nil {
+			return nil, err
+		}
+		tpl, _, err := unstructured.NestedMap(u.Object, "data", "spec", "template")
+		if err != nil {
+			return nil, err
+		}
+		delete(tpl, "$patch")
+		r := WorkloadRevision{
+			Number:      n,
+			Name:        u.GetName(),
+			ChangeCause: u.GetAnnotations()[changeCauseAnnotation],
+			Created:     u.GetCreationTimestamp().Time,
+			Template:    tpl,
+		}
+		r.Ready, r.Desired = revisionPods(pods, owners, u)
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+// revisionPods counts a controller revision ready and total pods. Statefulset pods
+// are labeled with the revision name while daemonset pods carry its hash.
+func revisionPods(pods []runtime.Object, owners map[types.UID]*client.GVR, cr *unstructured.Unstructured) (ready, total int64) {
+	hash := cr.GetLabels()[revisionHashLabel]
+	for _, o := range pods {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !ownedBy(u.GetOwnerReferences(), owners) {
+			continue
+		}
+		if h := u.GetLabels()[revisionHashLabel]; h == "" || (h != cr.GetName() && h != hash) {
+			continue
+		}
+		total++
+		var po v1.Pod
+		if err := toTyped(u, &po); err == nil && isPodReady(&po) {
+			ready++
+		}
+	}
+
+	return
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDiffText(t *testing.T) {
+	uu := map[string]struct {
+		l, r string
+		e    []dao.DiffRow
+	}{
+		"same": {
+			l: "a\nb\n",
+			r: "a\nb\n",
+			e: []dao.DiffRow{
+				{Left: "a", Right: "a"},
+				{Left: "b", Right: "b"},
+			},
+		},
+		"changed": {
+			l: "a\nb\nc\n",
+			r: "a\nB\nc\n",
+			e: []dao.DiffRow{
+				{Left: "a", Right: "a"},
+				{Left: "b", Right: "B", Kind: dao.DiffChanged},
+				{Left: "c", Right: "c"},
+			},
+		},
+		"added-removed": {
+			l: "a\nb\nc\n",
+			r: "a\nc\nd\n",
+			e: []dao.DiffRow{
+				{Left: "a", Right: "a"},
+				{Left: "b", Kind: dao.DiffRemoved},
+				{Left: "c", Right: "c"},
+				{Right: "d", Kind: dao.DiffAdded},
+			},
+		},
+		"uneven": {
+			l: "a\nb\n",
+			r: "a\nB1\nB2\n",
+			e: []dao.DiffRow{
+				{Left: "a", Right: "a"},
+				{Left: "b", Right: "B1", Kind: dao.DiffChanged},
+				{Right: "B2", Kind: dao.DiffAdded},
+			},
+		},
+		"empty": {
+			r: "a\n",
+			e: []dao.DiffRow{
+				{Right: "a", Kind: dao.DiffAdded},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.DiffText(u.l, u.r))
+		})
+	}
+}
+
+func TestDiffResources(t *testing.T) {
+	cm := func(ns, v string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "fred", "namespace": ns, "uid": ns, "resourceVersion": "1"},
+			"data":       map[string]any{"k1": "v1", "k2": v},
+		}}
+	}
+	f := testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {client.CmGVR: {cm("ns1", "v2")}},
+		"ns2": {client.CmGVR: {cm("ns2", "v3")}},
+	}}
+
+	d, err := dao.DiffResources(&f, client.CmGVR, "ns1/fred", "ns2/fred")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fred", d.Left)
+	assert.Equal(t, 2, d.Changes())
+	assert.Equal(t, dao.DiffRow{Left: "  k2: v2", Right: "  k2: v3", Kind: dao.DiffChanged}, d.Rows[3])
+
+	_, err = dao.DiffResources(&f, client.CmGVR, "ns1/fred", "ns3/fred")
+	assert.Error(t, err)
+}
+
+func TestDiffRevision(t *testing.T) {
+	f := timelineFactory()
+	f.inventory["ns1"][client.DpGVR] = []runtime.Object{&unstructured.Unstructured{Object: map[string]any{
+		"kind":     "Deployment",
+		"metadata": map[string]any{"name": "fred", "namespace": "ns1", "uid": "dp"},
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"containers": []any{map[string]any{"name": "c1", "image": "nginx:2.0"}},
+		}}},
+	}}}
+
+	d, err := dao.DiffRevision(&f, client.DpGVR, "ns1/fred", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), d.Revision)
+	assert.Equal(t, []int64{2, 1}, d.Revisions)
+	assert.Equal(t, "Revision 1 (fred-1)", d.Left)
+	assert.Equal(t, 1, d.Changes())
+
+	d, err = dao.DiffRevision(&f, client.DpGVR, "ns1/fred", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, d.Changes())
+
+	_, err = dao.DiffRevision(&f, client.DpGVR, "ns1/fred", 5)
+	require.Error(t, err)
+	_, err = dao.DiffRevision(&f, client.CmGVR, "ns1/fred", 0)
+	assert.Error(t, err)
+}
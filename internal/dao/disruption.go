@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+var (
+	_ Disruptor = (*Pod)(nil)
+	_ Disruptor = (*Node)(nil)
+)
+
+// DisruptionViolation represents a disruption budget exceeded by a disruptive operation.
+type DisruptionViolation struct {
+	PDB     string
+	Allowed int32
+	Pods    []string
+}
+
+// Disruptions returns the disruption budgets violated by deleting the given pods.
+func (p *Pod) Disruptions(paths []string) ([]DisruptionViolation, error) {
+	f := p.getFactory()
+	pods := make([]*v1.Pod, 0, len(paths))
+	for _, path := range paths {
+		o, err := f.Get(client.PodGVR, path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, &po)
+	}
+
+	return disruptions(f, pods)
+}
+
+// Disruptions returns the disruption budgets violated by draining the given nodes.
+func (n *Node) Disruptions(paths []string) ([]DisruptionViolation, error) {
+	var pods []*v1.Pod
+	for _, path := range paths {
+		_, name := client.Namespaced(path)
+		pp, err := n.GetPods(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, po := range pp {
+			if isEvictable(po) {
+				pods = append(pods, po)
+			}
+		}
+	}
+
+	return disruptions(n.getFactory(), pods)
+}
+
+// DisruptionsSummary describes a collection of disruption budgets violations.
+func DisruptionsSummary(vv []DisruptionViolation) string {
+	if len(vv) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("PodDisruptionBudget violations:\n")
+	for _, v := range vv {
+		fmt.Fprintf(&b, "  %s allows %d disruption(s) but %d pod(s) would be disrupted\n", v.PDB, v.Allowed, len(v.Pods))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Helpers...
+
+// disruptions evaluates the disruption budgets covering the given pods.
+func disruptions(f Factory, pods []*v1.Pod) ([]DisruptionViolation, error) {
+	nss := make(map[string][]*v1.Pod)
+	for _, po := range pods {
+		nss[po.Namespace] = append(nss[po.Namespace], po)
+	}
+
+	var vv []DisruptionViolation
+	for ns, pp := range nss {
+		oo, err := f.List(client.PdbGVR, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			var pdb policyv1.PodDisruptionBudget
+			if err := toTyped(o, &pdb); err != nil {
+				return nil, err
+			}
+			if v, ok := violation(&pdb, pp); ok {
+				vv = append(vv, v)
+			}
+		}
+	}
+
+	return vv, nil
+}
+
+// violation checks if disrupting the given pods exceeds a disruption budget.
+func violation(pdb *policyv1.PodDisruptionBudget, pods []*v1.Pod) (DisruptionViolation, bool) {
+	sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || sel.Empty() {
+		return DisruptionViolation{}, false
+	}
+	v := DisruptionViolation{
+		PDB:     client.FQN(pdb.Namespace, pdb.Name),
+		Allowed: pdb.Status.DisruptionsAllowed,
+	}
+	for _, po := range pods {
+		// Only healthy pods consume the disruption budget.
+		if isPodReady(po) && sel.Matches(labels.Set(po.Labels)) {
+			v.Pods = append(v.Pods, client.FQN(po.Namespace, po.Name))
+		}
+	}
+
+	return v, int32(len(v.Pods)) > v.Allowed
+}
+
+// isEvictable checks if a pod would be evicted by a node drain.
+func isEvictable(po *v1.Pod) bool {
+	if _, ok := po.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+		return false
+	}
+	if ref := metav1.GetControllerOf(po); ref != nil && ref.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
+
+func isPodReady(po *v1.Pod) bool {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func toTyped(o runtime.Object, obj any) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
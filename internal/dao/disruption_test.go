@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDisruptionViolation(t *testing.T) {
+	newPod := func(n string, ready bool, ll map[string]string) *v1.Pod {
+		st := v1.ConditionFalse
+		if ready {
+			st = v1.ConditionTrue
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n, Labels: ll},
+			Status:     v1.PodStatus{Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: st}}},
+		}
+	}
+	pdb := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "fred"},
+		Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}}},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+	fred := map[string]string{"app": "fred"}
+
+	uu := map[string]struct {
+		pods []*v1.Pod
+		ok   bool
+		e    []string
+	}{
+		"within": {
+			pods: []*v1.Pod{newPod("p1", true, fred)},
+			e:    []string{"ns1/p1"},
+		},
+		"exceeds": {
+			pods: []*v1.Pod{newPod("p1", true, fred), newPod("p2", true, fred)},
+			ok:   true,
+			e:    []string{"ns1/p1", "ns1/p2"},
+		},
+		"unhealthy": {
+			pods: []*v1.Pod{newPod("p1", true, fred), newPod("p2", false, fred)},
+			e:    []string{"ns1/p1"},
+		},
+		"unmatched": {
+			pods: []*v1.Pod{newPod("p1", true, nil), newPod("p2", true, map[string]string{"app": "blee"})},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, ok := violation(&pdb, u.pods)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, "ns1/fred", v.PDB)
+			assert.Equal(t, u.e, v.Pods)
+		})
+	}
+}
+
+func TestIsEvictable(t *testing.T) {
+	ctrl := true
+	uu := map[string]struct {
+		po *v1.Pod
+		e  bool
+	}{
+		"plain": {
+			po: &v1.Pod{},
+			e:  true,
+		},
+		"mirror": {
+			po: &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{mirrorPodAnnotation: "x"}}},
+		},
+		"done": {
+			po: &v1.Pod{Status: v1.PodStatus{Phase: v1.PodSucceeded}},
+		},
+		"daemonset": {
+			po: &v1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Controller: &ctrl}}}},
+		},
+		"replicaset": {
+			po: &v1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Controller: &ctrl}}}},
+			e:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isEvictable(u.po))
+		})
+	}
+}
+
+func TestDisruptionsSummary(t *testing.T) {
+	assert.Empty(t, DisruptionsSummary(nil))
+	assert.Equal(t,
+		"PodDisruptionBudget violations:\n  ns1/fred allows 0 disruption(s) but 2 pod(s) would be disrupted",
+		DisruptionsSummary([]DisruptionViolation{{PDB: "ns1/fred", Pods: []string{"ns1/p1", "ns1/p2"}}}),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
@@ -29,6 +30,7 @@ var (
 	_ Nuker           = (*Deployment)(nil)
 	_ Loggable        = (*Deployment)(nil)
 	_ Restartable     = (*Deployment)(nil)
+	_ Pausable        = (*Deployment)(nil)
 	_ Scalable        = (*Deployment)(nil)
 	_ Controller      = (*Deployment)(nil)
 	_ ContainsPodSpec = (*Deployment)(nil)
@@ -60,6 +62,38 @@ func (d *Deployment) Restart(ctx context.Context, path string, opts *metav1.Patc
 	return restartRes[*appsv1.Deployment](ctx, d.getFactory(), client.DpGVR, path, opts)
 }
 
+// Pause pauses or resumes a Deployment rollout.
+func (d *Deployment) Pause(ctx context.Context, path string, paused bool) error {
+	ns, n := client.Namespaced(path)
+	auth, err := d.Client().CanI(ns, client.DpGVR, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %q", client.DpGVR)
+	}
+
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	prior := snapshot(ctx, d.Client(), client.DpGVR, path)
+	patch := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	_, err = dial.AppsV1().Deployments(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, client.DpGVR, path, prior)
+
+	return nil
+}
+
 // TailLogs tail logs for all pods represented by this Deployment.
 func (d *Deployment) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
 	dp, err := d.GetInstance(opts.Path)
@@ -83,6 +117,16 @@ func (d *Deployment) Pod(fqn string) (string, error) {
 	return podFromSelector(d.Factory, dp.Namespace, dp.Spec.Selector.MatchLabels)
 }
 
+// Selector returns the deployment pods selector.
+func (d *Deployment) Selector(fqn string) (string, error) {
+	dp, err := d.GetInstance(fqn)
+	if err != nil {
+		return "", err
+	}
+
+	return selectorString(dp.Spec.Selector)
+}
+
 // GetInstance fetch a matching deployment.
 func (d *Deployment) GetInstance(fqn string) (*appsv1.Deployment, error) {
 	o, err := d.Factory.Get(d.gvr, fqn, true, labels.Everything())
@@ -142,15 +186,17 @@ func (d *Deployment) Scan(_ context.Context, gvr *client.GVR, fqn string, wait b
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&dp.Spec.Template.Spec, n) {
+			via := configMapRefs(&dp.Spec.Template.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: d.GVR(),
 				FQN: client.FQN(dp.Namespace, dp.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
+			via, err := secretRefs(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Fail to locate secret",
 					slogs.FQN, fqn,
@@ -158,12 +204,13 @@ func (d *Deployment) Scan(_ context.Context, gvr *client.GVR, fqn string, wait b
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: d.GVR(),
 				FQN: client.FQN(dp.Namespace, dp.Name),
+				Via: via,
 			})
 		case client.PvcGVR:
 			if !hasPVC(&dp.Spec.Template.Spec, n) {
@@ -215,14 +262,20 @@ func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs Imag
 	if err != nil {
 		return err
 	}
+	prior := snapshot(ctx, d.Client(), d.gvr, path)
 	_, err = dial.AppsV1().Deployments(ns).Patch(
 		ctx,
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, d.gvr, path, prior)
+
+	return nil
 }
 
 // Helpers...
@@ -240,122 +293,117 @@ func hasPC(spec *v1.PodSpec, name string) bool {
 	return spec.PriorityClassName == name
 }
 
-func hasConfigMap(spec *v1.PodSpec, name string) bool {
+// podEnv tracks a container env sources.
+type podEnv struct {
+	container string
+	envFrom   []v1.EnvFromSource
+	env       []v1.EnvVar
+}
+
+func podEnvs(spec *v1.PodSpec) []podEnv {
+	ee := make([]podEnv, 0, len(spec.InitContainers)+len(spec.Containers)+len(spec.EphemeralContainers))
 	for i := range spec.InitContainers {
-		if containerHasConfigMap(spec.InitContainers[i].EnvFrom, spec.InitContainers[i].Env, name) {
-			return true
-		}
+		co := &spec.InitContainers[i]
+		ee = append(ee, podEnv{container: co.Name, envFrom: co.EnvFrom, env: co.Env})
 	}
 	for i := range spec.Containers {
-		if containerHasConfigMap(spec.Containers[i].EnvFrom, spec.Containers[i].Env, name) {
-			return true
-		}
+		co := &spec.Containers[i]
+		ee = append(ee, podEnv{container: co.Name, envFrom: co.EnvFrom, env: co.Env})
 	}
 	for i := range spec.EphemeralContainers {
-		if containerHasConfigMap(spec.EphemeralContainers[i].EnvFrom, spec.EphemeralContainers[i].Env, name) {
-			return true
-		}
+		co := &spec.EphemeralContainers[i]
+		ee = append(ee, podEnv{container: co.Name, envFrom: co.EnvFrom, env: co.Env})
 	}
 
-	for i := range spec.Volumes {
-		if cm := spec.Volumes[i].ConfigMap; cm != nil {
-			if cm.Name == name {
-				return true
-			}
-		}
-	}
-	return false
+	return ee
 }
 
-func hasSecret(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (bool, error) {
-	for i := range spec.InitContainers {
-		if containerHasSecret(spec.InitContainers[i].EnvFrom, spec.InitContainers[i].Env, name) {
-			return true, nil
-		}
-	}
-
-	for i := range spec.Containers {
-		if containerHasSecret(spec.Containers[i].EnvFrom, spec.Containers[i].Env, name) {
-			return true, nil
+// configMapRefs returns how a pod spec references a given configmap.
+func configMapRefs(spec *v1.PodSpec, name string) []string {
+	var via []string
+	for _, e := range podEnvs(spec) {
+		if slices.ContainsFunc(e.envFrom, func(s v1.EnvFromSource) bool {
+			return s.ConfigMapRef != nil && s.ConfigMapRef.Name == name
+		}) {
+			via = append(via, "envFrom:"+e.container)
 		}
-	}
-
-	for i := range spec.EphemeralContainers {
-		if containerHasSecret(spec.EphemeralContainers[i].EnvFrom, spec.EphemeralContainers[i].Env, name) {
-			return true, nil
+		if slices.ContainsFunc(e.env, func(v v1.EnvVar) bool {
+			return v.ValueFrom != nil && v.ValueFrom.ConfigMapKeyRef != nil && v.ValueFrom.ConfigMapKeyRef.Name == name
+		}) {
+			via = append(via, "env:"+e.container)
 		}
 	}
-
-	for _, s := range spec.ImagePullSecrets {
-		if s.Name == name {
-			return true, nil
+	for i := range spec.Volumes {
+		vol := &spec.Volumes[i]
+		switch {
+		case vol.ConfigMap != nil && vol.ConfigMap.Name == name:
+			via = append(via, "volume:"+vol.Name)
+		case vol.Projected != nil && slices.ContainsFunc(vol.Projected.Sources, func(s v1.VolumeProjection) bool {
+			return s.ConfigMap != nil && s.ConfigMap.Name == name
+		}):
+			via = append(via, "projected:"+vol.Name)
 		}
 	}
 
-	if saName := spec.ServiceAccountName; saName != "" {
-		o, err := f.Get(client.SaGVR, client.FQN(ns, saName), wait, labels.Everything())
-		if err != nil {
-			return false, err
-		}
+	return via
+}
 
-		var sa v1.ServiceAccount
-		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sa)
-		if err != nil {
-			return false, errors.New("expecting ServiceAccount resource")
+// secretRefs returns how a pod spec references a given secret.
+func secretRefs(f Factory, spec *v1.PodSpec, ns, name string, wait bool) ([]string, error) {
+	var via []string
+	for _, e := range podEnvs(spec) {
+		if slices.ContainsFunc(e.envFrom, func(s v1.EnvFromSource) bool {
+			return s.SecretRef != nil && s.SecretRef.Name == name
+		}) {
+			via = append(via, "envFrom:"+e.container)
 		}
-
-		for _, ref := range sa.Secrets {
-			if ref.Namespace == ns && ref.Name == name {
-				return true, nil
-			}
+		if slices.ContainsFunc(e.env, func(v v1.EnvVar) bool {
+			return v.ValueFrom != nil && v.ValueFrom.SecretKeyRef != nil && v.ValueFrom.SecretKeyRef.Name == name
+		}) {
+			via = append(via, "env:"+e.container)
 		}
 	}
-
 	for i := range spec.Volumes {
-		if sec := spec.Volumes[i].Secret; sec != nil {
-			if sec.SecretName == name {
-				return true, nil
-			}
+		vol := &spec.Volumes[i]
+		switch {
+		case vol.Secret != nil && vol.Secret.SecretName == name:
+			via = append(via, "volume:"+vol.Name)
+		case vol.Projected != nil && slices.ContainsFunc(vol.Projected.Sources, func(s v1.VolumeProjection) bool {
+			return s.Secret != nil && s.Secret.Name == name
+		}):
+			via = append(via, "projected:"+vol.Name)
+		case vol.CSI != nil && vol.CSI.NodePublishSecretRef != nil && vol.CSI.NodePublishSecretRef.Name == name:
+			via = append(via, "csi:"+vol.Name)
 		}
 	}
+	if slices.ContainsFunc(spec.ImagePullSecrets, func(s v1.LocalObjectReference) bool { return s.Name == name }) {
+		via = append(via, "imagePullSecrets")
+	}
 
-	return false, nil
-}
-
-func containerHasSecret(envFrom []v1.EnvFromSource, env []v1.EnvVar, name string) bool {
-	for _, e := range envFrom {
-		if e.SecretRef != nil && e.SecretRef.Name == name {
-			return true
-		}
+	saName := spec.ServiceAccountName
+	if saName == "" {
+		return via, nil
 	}
-	for _, e := range env {
-		if e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil {
-			continue
-		}
-		if e.ValueFrom.SecretKeyRef.Name == name {
-			return true
-		}
+	o, err := f.Get(client.SaGVR, client.FQN(ns, saName), wait, labels.Everything())
+	if err != nil {
+		slog.Warn("Unable to get service account for secret refs",
+			slogs.FQN, client.FQN(ns, saName),
+			slogs.Error, err,
+		)
+		return via, nil
 	}
-
-	return false
-}
-
-func containerHasConfigMap(envFrom []v1.EnvFromSource, env []v1.EnvVar, name string) bool {
-	for _, e := range envFrom {
-		if e.ConfigMapRef != nil && e.ConfigMapRef.Name == name {
-			return true
-		}
+	var sa v1.ServiceAccount
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sa)
+	if err != nil {
+		return nil, errors.New("expecting ServiceAccount resource")
 	}
-	for _, e := range env {
-		if e.ValueFrom == nil || e.ValueFrom.ConfigMapKeyRef == nil {
-			continue
-		}
-		if e.ValueFrom.ConfigMapKeyRef.Name == name {
-			return true
-		}
+	if slices.ContainsFunc(sa.Secrets, func(ref v1.ObjectReference) bool {
+		return (ref.Namespace == "" || ref.Namespace == ns) && ref.Name == name
+	}) {
+		via = append(via, "serviceAccount:"+saName)
 	}
 
-	return false
+	return via, nil
 }
 
 func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, replicas int32) error {
@@ -373,6 +421,7 @@ func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, repl
 		return err
 	}
 
+	prior := snapshot(ctx, f.Client(), gvr, path)
 	switch gvr {
 	case client.DpGVR:
 		scale, e := dial.AppsV1().Deployments(ns).GetScale(ctx, n, metav1.GetOptions{})
@@ -380,19 +429,23 @@ func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, repl
 			return e
 		}
 		scale.Spec.Replicas = replicas
-		_, e = dial.AppsV1().Deployments(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
-		return e
+		_, err = dial.AppsV1().Deployments(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{DryRun: dryRunOpts()})
 	case client.StsGVR:
 		scale, e := dial.AppsV1().StatefulSets(ns).GetScale(ctx, n, metav1.GetOptions{})
 		if e != nil {
 			return e
 		}
 		scale.Spec.Replicas = replicas
-		_, e = dial.AppsV1().StatefulSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
-		return e
+		_, err = dial.AppsV1().StatefulSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{DryRun: dryRunOpts()})
 	default:
 		return fmt.Errorf("unsupported resource for scaling: %s", gvr)
 	}
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalScale, gvr, path, prior)
+
+	return nil
 }
 
 func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GVR, path string, opts *metav1.PatchOptions) error {
@@ -432,6 +485,11 @@ func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GV
 	if err != nil {
 		return err
 	}
+	if dd := dryRunOpts(); dd != nil {
+		o := *opts
+		o.DryRun, opts = dd, &o
+	}
+	prior := snapshot(ctx, f.Client(), gvr, path)
 
 	switch gvr {
 	case client.DpGVR:
@@ -461,6 +519,10 @@ func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GV
 			*opts,
 		)
 	}
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalRestart, gvr, path, prior)
 
-	return err
+	return nil
 }
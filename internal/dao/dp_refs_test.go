@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestConfigMapRefs(t *testing.T) {
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{{
+			Name:    "i1",
+			EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}},
+		}},
+		Containers: []v1.Container{{
+			Name: "c1",
+			Env: []v1.EnvVar{
+				{Name: "A", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}, Key: "a"}}},
+				{Name: "B", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}, Key: "b"}}},
+			},
+		}},
+		Volumes: []v1.Volume{
+			{Name: "v1", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}},
+			{Name: "v2", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}},
+				{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}},
+			}}}},
+		},
+	}
+
+	assert.Equal(t, []string{"envFrom:i1", "env:c1", "volume:v1", "projected:v2"}, configMapRefs(&spec, "fred"))
+	assert.Empty(t, configMapRefs(&spec, "blee"))
+}
+
+func TestSecretRefs(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{{
+			Name:    "c1",
+			EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}},
+			Env: []v1.EnvVar{
+				{Name: "A", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}, Key: "a"}}},
+			},
+		}},
+		Volumes: []v1.Volume{
+			{Name: "v1", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}},
+			}}}},
+			{Name: "v2", VolumeSource: v1.VolumeSource{CSI: &v1.CSIVolumeSource{Driver: "d1", NodePublishSecretRef: &v1.LocalObjectReference{Name: "fred"}}}},
+			{Name: "v3", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "blee"}}},
+		},
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "fred"}},
+	}
+
+	via, err := secretRefs(nil, &spec, "ns1", "fred", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"envFrom:c1", "env:c1", "projected:v1", "csi:v2", "imagePullSecrets"}, via)
+
+	via, err = secretRefs(nil, &spec, "ns1", "blee", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"volume:v3"}, via)
+}
+
+func TestSecretRefsNoServiceAccount(t *testing.T) {
+	spec := v1.PodSpec{
+		ServiceAccountName: "sa1",
+		ImagePullSecrets:   []v1.LocalObjectReference{{Name: "fred"}},
+	}
+
+	via, err := secretRefs(getFailedFactory{}, &spec, "ns1", "fred", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"imagePullSecrets"}, via)
+}
+
+type getFailedFactory struct {
+	Factory
+}
+
+func (getFailedFactory) Get(*client.GVR, string, bool, labels.Selector) (runtime.Object, error) {
+	return nil, errors.New("forbidden")
+}
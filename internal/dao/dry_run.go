@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"sync/atomic"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// dryRun tracks whether mutating operations are sent as server side dry runs.
+var dryRun atomic.Bool
+
+// SetDryRun toggles server side dry runs for delete, scale and patch operations.
+func SetDryRun(b bool) {
+	dryRun.Store(b)
+}
+
+// IsDryRun checks if mutating operations are sent as server side dry runs.
+func IsDryRun() bool {
+	return dryRun.Load()
+}
+
+// dryRunOpts returns the dry run directives to send along mutating requests.
+func dryRunOpts() []string {
+	if !IsDryRun() {
+		return nil
+	}
+
+	return []string{metav1.DryRunAll}
+}
+
+// dryRunStrategy returns the kubectl dry run strategy matching the dry run mode.
+func dryRunStrategy() cmdutil.DryRunStrategy {
+	if !IsDryRun() {
+		return cmdutil.DryRunNone
+	}
+
+	return cmdutil.DryRunServer
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+func TestDryRunScale(t *testing.T) {
+	SetDryRun(true)
+	defer SetDryRun(false)
+
+	dyn := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	dyn.PrependReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, newScale(3), nil
+	})
+	var dryRun []string
+	dyn.PrependReactor("patch", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		dryRun = a.(k8stesting.PatchActionImpl).GetPatchOptions().DryRun
+		return true, newScale(5), nil
+	})
+
+	var w Workload
+	w.Init(connFactory{conn: dynConn{dyn: dyn}}, client.WkGVR)
+
+	require.NoError(t, w.Scale(context.Background(), client.DpGVR, "ns1/dp1", 5))
+	assert.Equal(t, []string{metav1.DryRunAll}, dryRun)
+}
+
+func TestDryRunOpts(t *testing.T) {
+	assert.Empty(t, dryRunOpts())
+
+	SetDryRun(true)
+	defer SetDryRun(false)
+	assert.True(t, IsDryRun())
+	assert.Equal(t, []string{metav1.DryRunAll}, dryRunOpts())
+}
+
+func TestDryRunStrategy(t *testing.T) {
+	assert.Equal(t, cmdutil.DryRunNone, dryRunStrategy())
+
+	SetDryRun(true)
+	defer SetDryRun(false)
+	assert.Equal(t, cmdutil.DryRunServer, dryRunStrategy())
+}
@@ -120,6 +120,16 @@ func (d *DaemonSet) Pod(fqn string) (string, error) {
 	return podFromSelector(d.Factory, ds.Namespace, ds.Spec.Selector.MatchLabels)
 }
 
+// Selector returns the daemonset pods selector.
+func (d *DaemonSet) Selector(fqn string) (string, error) {
+	ds, err := d.GetInstance(fqn)
+	if err != nil {
+		return "", err
+	}
+
+	return selectorString(ds.Spec.Selector)
+}
+
 // GetInstance returns a daemonset instance.
 func (d *DaemonSet) GetInstance(fqn string) (*appsv1.DaemonSet, error) {
 	o, err := d.getFactory().Get(d.gvr, fqn, true, labels.Everything())
@@ -179,15 +189,17 @@ func (d *DaemonSet) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bo
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&ds.Spec.Template.Spec, n) {
+			via := configMapRefs(&ds.Spec.Template.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: d.GVR(),
 				FQN: client.FQN(ds.Namespace, ds.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
+			via, err := secretRefs(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Unable to locate secret",
 					slogs.FQN, fqn,
@@ -195,12 +207,13 @@ func (d *DaemonSet) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bo
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: d.GVR(),
 				FQN: client.FQN(ds.Namespace, ds.Name),
+				Via: via,
 			})
 		case client.PvcGVR:
 			if !hasPVC(&ds.Spec.Template.Spec, n) {
@@ -252,14 +265,20 @@ func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs Image
 	if err != nil {
 		return err
 	}
+	prior := snapshot(ctx, d.Client(), d.gvr, path)
 	_, err = dial.AppsV1().DaemonSets(ns).Patch(
 		ctx,
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, d.gvr, path, prior)
+
+	return nil
 }
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/kubectl/pkg/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestChange represents a manifest field change.
+type ManifestChange struct {
+	Path     string
+	Old, New any
+	Added    bool
+	Removed  bool
+
+	// Owners tracks the field managers owning the live field if any.
+	Owners []string
+
+	segs []any
+}
+
+// String returns the change as a single line.
+func (c ManifestChange) String() string {
+	var s string
+	switch {
+	case c.Added:
+		s = fmt.Sprintf("+ %s: %s", c.Path, fmtField(c.New))
+	case c.Removed:
+		s = fmt.Sprintf("- %s: %s", c.Path, fmtField(c.Old))
+	default:
+		s = fmt.Sprintf("~ %s: %s -> %s", c.Path, fmtField(c.Old), fmtField(c.New))
+	}
+	if len(c.Owners) > 0 {
+		s += "  # managed by " + strings.Join(c.Owners, ", ")
+	}
+
+	return s
+}
+
+// ManifestChanges represents a collection of manifest changes.
+type ManifestChanges []ManifestChange
+
+// String returns the changes one per line.
+func (cc ManifestChanges) String() string {
+	ll := make([]string, 0, len(cc))
+	for _, c := range cc {
+		ll = append(ll, c.String())
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+var schemas = struct {
+	mx      sync.Mutex
+	parsers map[string]*openapi.CachedOpenAPIParser
+}{parsers: make(map[string]*openapi.CachedOpenAPIParser)}
+
+// openAPIResources lazily parses a cluster OpenAPI schema.
+type openAPIResources struct {
+	parser *openapi.CachedOpenAPIParser
+}
+
+// OpenAPISchema returns the parsed schema resources.
+func (r openAPIResources) OpenAPISchema() (openapi.Resources, error) {
+	return r.parser.Parse()
+}
+
+// FetchManifest returns a resource live state.
+func FetchManifest(ctx context.Context, c client.Connection, gvr *client.GVR, fqn string) (*unstructured.Unstructured, error) {
+	d, err := c.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(fqn)
+	if client.IsClusterScoped(ns) {
+		return d.Resource(gvr.GVR()).Get(ctx, n, metav1.GetOptions{})
+	}
+
+	return d.Resource(gvr.GVR()).Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+}
+
+// EditableManifest returns a resource manifest without its server managed fields.
+func EditableManifest(o *unstructured.Unstructured) ([]byte, error) {
+	e := o.DeepCopy()
+	unstructured.RemoveNestedField(e.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(e.Object, "status")
+
+	return yaml.Marshal(e.Object)
+}
+
+// ParseManifest parses an edited yaml manifest.
+func ParseManifest(raw []byte) (*unstructured.Unstructured, error) {
+	bb, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	var o unstructured.Unstructured
+	if err := o.UnmarshalJSON(bb); err != nil {
+		return nil, err
+	}
+
+	return &o, nil
+}
+
+// ValidateManifest validates a yaml manifest against the cluster OpenAPI schema.
+// Unknown fields, type mismatches and duplicated keys are reported.
+func ValidateManifest(c client.Connection, raw []byte) error {
+	disc, err := c.CachedDiscovery()
+	if err != nil {
+		return err
+	}
+	schemas.mx.Lock()
+	p, ok := schemas.parsers[c.ActiveContext()]
+	if !ok {
+		p = openapi.NewOpenAPIParser(disc)
+		schemas.parsers[c.ActiveContext()] = p
+	}
+	schemas.mx.Unlock()
+
+	v := validation.ConjunctiveSchema{
+		validation.NewSchemaValidation(openAPIResources{parser: p}),
+		validation.NoDoubleKeySchema{},
+	}
+
+	return v.ValidateBytes(raw)
+}
+
+// DiffManifests returns the fields changed between a live and an edited manifest.
+func DiffManifests(live, edited *unstructured.Unstructured) ManifestChanges {
+	l := live.DeepCopy()
+	unstructured.RemoveNestedField(l.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(l.Object, "status")
+
+	cc := diffFields("", nil, l.Object, true, edited.Object, true, nil)
+	for i := range cc {
+		cc[i].Owners = fieldOwners(live, cc[i].segs)
+	}
+
+	return cc
+}
+
+// ApplyManifest updates a resource from an edited manifest and journals its prior state.
+func ApplyManifest(ctx context.Context, c client.Connection, gvr *client.GVR, fqn string, live, edited *unstructured.Unstructured) error {
+	if edited.GetName() != live.GetName() || edited.GetNamespace() != live.GetNamespace() {
+		return errors.New("resource name and namespace can't be changed")
+	}
+	if edited.GetKind() != live.GetKind() || edited.GetAPIVersion() != live.GetAPIVersion() {
+		return errors.New("resource kind and apiVersion can't be changed")
+	}
+	o := edited.DeepCopy()
+	if st, ok := live.Object["status"]; ok {
+		o.Object["status"] = st
+	}
+	d, err := c.DynDial()
+	if err != nil {
+		return err
+	}
+	opts := metav1.UpdateOptions{
+		DryRun:          dryRunOpts(),
+		FieldValidation: metav1.FieldValidationStrict,
+	}
+	ns, _ := client.Namespaced(fqn)
+	if client.IsClusterScoped(ns) {
+		_, err = d.Resource(gvr.GVR()).Update(ctx, o, opts)
+	} else {
+		_, err = d.Resource(gvr.GVR()).Namespace(ns).Update(ctx, o, opts)
+	}
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalEdit, gvr, fqn, live)
+
+	return nil
+}
+
+// Helpers...
+
+func diffFields(path string, segs []any, a any, aok bool, b any, bok bool, cc ManifestChanges) ManifestChanges {
+	switch {
+	case !aok && !bok:
+		return cc
+	case !aok:
+		return append(cc, ManifestChange{Path: path, New: b, Added: true, segs: segs})
+	case !bok:
+		return append(cc, ManifestChange{Path: path, Old: a, Removed: true, segs: segs})
+	}
+
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			kk := maps.Clone(av)
+			maps.Copy(kk, bv)
+			for _, k := range slices.Sorted(maps.Keys(kk)) {
+				v1, ok1 := av[k]
+				v2, ok2 := bv[k]
+				cc = diffFields(fieldPath(path, k), append(slices.Clip(segs), k), v1, ok1, v2, ok2, cc)
+			}
+			return cc
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			for i := range max(len(av), len(bv)) {
+				var v1, v2 any
+				if i < len(av) {
+					v1 = av[i]
+				}
+				if i < len(bv) {
+					v2 = bv[i]
+				}
+				cc = diffFields(path+"["+strconv.Itoa(i)+"]", append(slices.Clip(segs), i), v1, i < len(av), v2, i < len(bv), cc)
+			}
+			return cc
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		cc = append(cc, ManifestChange{Path: path, Old: a, New: b, segs: segs})
+	}
+
+	return cc
+}
+
+func fieldPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+func fmtField(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strconv.Quote(t)
+	case map[string]any, []any:
+		bb, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(bb)
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
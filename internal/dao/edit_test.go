@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestEditableManifest(t *testing.T) {
+	o := newDeployment(1)
+	o.Object["status"] = map[string]any{"replicas": int64(1)}
+	require.NoError(t, unstructured.SetNestedSlice(o.Object, []any{map[string]any{"manager": "k9s"}}, "metadata", "managedFields"))
+
+	raw, err := EditableManifest(o)
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dp1\n  namespace: ns1\nspec:\n  replicas: 1\n", string(raw))
+
+	e, err := ParseManifest(raw)
+	require.NoError(t, err)
+	assert.Empty(t, DiffManifests(o, e))
+}
+
+func TestParseManifestFailed(t *testing.T) {
+	_, err := ParseManifest([]byte("spec:\n  replicas: [1\n"))
+	assert.Error(t, err)
+}
+
+func TestDiffManifests(t *testing.T) {
+	uu := map[string]struct {
+		edit string
+		e    []string
+	}{
+		"changed": {
+			edit: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dp1\n  namespace: ns1\nspec:\n  replicas: 3\n",
+			e:    []string{"~ spec.replicas: 1 -> 3"},
+		},
+		"added": {
+			edit: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dp1\n  namespace: ns1\n  labels:\n    app: blee\nspec:\n  replicas: 1\n  paused: true\n",
+			e: []string{
+				`+ metadata.labels: {"app":"blee"}`,
+				"+ spec.paused: true",
+			},
+		},
+		"removed": {
+			edit: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dp1\n  namespace: ns1\nspec: {}\n",
+			e:    []string{"- spec.replicas: 1"},
+		},
+		"typo": {
+			edit: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dp1\n  namespace: ns1\nspec:\n  replcas: 1\n",
+			e: []string{
+				"+ spec.replcas: 1",
+				"- spec.replicas: 1",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := ParseManifest([]byte(u.edit))
+			require.NoError(t, err)
+			cc := DiffManifests(newDeployment(1), e)
+			ss := make([]string, 0, len(cc))
+			for _, c := range cc {
+				ss = append(ss, c.String())
+			}
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
+
+func TestDiffManifestsLists(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"args": []any{"a", "b"}},
+	}}
+	edited := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"args": []any{"a", "c", "d"}},
+	}}
+
+	assert.Equal(t, "~ spec.args[1]: \"b\" -> \"c\"\n+ spec.args[2]: \"d\"", DiffManifests(live, edited).String())
+}
+
+func TestApplyManifest(t *testing.T) {
+	ctx := context.Background()
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil, newDeployment(1))
+	conn := dynConn{dyn: dyn}
+
+	live, err := FetchManifest(ctx, conn, client.DpGVR, "ns1/dp1")
+	require.NoError(t, err)
+	edited := live.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(edited.Object, int64(3), "spec", "replicas"))
+	require.NoError(t, ApplyManifest(ctx, conn, client.DpGVR, "ns1/dp1", live, edited))
+
+	curr, err := FetchManifest(ctx, conn, client.DpGVR, "ns1/dp1")
+	require.NoError(t, err)
+	r, _, _ := unstructured.NestedInt64(curr.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), r)
+
+	renamed := edited.DeepCopy()
+	renamed.SetName("dp2")
+	assert.Error(t, ApplyManifest(ctx, conn, client.DpGVR, "ns1/dp1", live, renamed))
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // only used to derive short event keys.
+	"encoding/hex"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// StreamFilter represents an event stream severity filter.
+type StreamFilter string
+
+const (
+	// StreamAll shows all events.
+	StreamAll StreamFilter = "all"
+
+	// StreamWarnings shows warning events only.
+	StreamWarnings StreamFilter = "warnings"
+
+	// StreamWatched shows events with watched reasons only.
+	StreamWatched StreamFilter = "watched"
+)
+
+// Next returns the following filter in the cycle.
+func (f StreamFilter) Next() StreamFilter {
+	switch f {
+	case StreamAll:
+		return StreamWarnings
+	case StreamWarnings:
+		return StreamWatched
+	default:
+		return StreamAll
+	}
+}
+
+// eventKeySep separates an event stream key segments.
+const eventKeySep = "|"
+
+var _ Accessor = (*EventStream)(nil)
+
+// EventStream aggregates recurring events across all namespaces.
+type EventStream struct {
+	NonResource
+}
+
+// List returns deduplicated events matching the stream filter.
+func (e *EventStream) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	f, ok := ctx.Value(internal.KeyStreamFilter).(StreamFilter)
+	if !ok {
+		f = StreamAll
+	}
+	oo, err := e.getFactory().List(client.EvGVR, client.NamespaceAll, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee, err := dedupEvents(oo, EventWatch())
+	if err != nil {
+		return nil, err
+	}
+	res := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		if !f.accept(e) {
+			continue
+		}
+		res = append(res, e)
+	}
+
+	return res, nil
+}
+
+func (f StreamFilter) accept(e *render.EventStreamRes) bool {
+	switch f {
+	case StreamWarnings:
+		return e.Type == v1.EventTypeWarning
+	case StreamWatched:
+		return e.Watched
+	default:
+		return true
+	}
+}
+
+// dedupEvents folds events for the same object, type, reason and message into a single entry.
+func dedupEvents(oo []runtime.Object, w *EventWatchList) ([]*render.EventStreamRes, error) {
+	mm := make(map[string]*render.EventStreamRes, len(oo))
+	for _, o := range oo {
+		var ev eventsv1.Event
+		if err := toTyped(o, &ev); err != nil {
+			return nil, err
+		}
+		ns := ev.Regarding.Namespace
+		if ns == "" {
+			ns = ev.Namespace
+		}
+		note := strings.TrimSpace(ev.Note)
+		id := EventStreamID(ns, ev.Regarding.APIVersion, ev.Regarding.Kind, ev.Regarding.Name, ev.Type, ev.Reason, note)
+		count := max(int(ev.DeprecatedCount), 1)
+		if ev.Series != nil {
+			count = max(int(ev.Series.Count), 1)
+		}
+		last, first := eventTime(&ev), ev.DeprecatedFirstTimestamp.Time
+		if first.IsZero() || first.After(last) {
+			first = last
+		}
+		if r, ok := mm[id]; ok {
+			r.Count += count
+			r.FirstSeen = minTime(r.FirstSeen, first)
+			r.LastSeen = maxTime(r.LastSeen, last)
+			continue
+		}
+		mm[id] = &render.EventStreamRes{
+			ID:         id,
+			Namespace:  ns,
+			APIVersion: ev.Regarding.APIVersion,
+			Kind:       ev.Regarding.Kind,
+			Name:       ev.Regarding.Name,
+			Type:       ev.Type,
+			Reason:     ev.Reason,
+			Message:    note,
+			Count:      count,
+			Watched:    w.Has(ev.Reason),
+			FirstSeen:  first,
+			LastSeen:   last,
+		}
+	}
+
+	ee := make([]*render.EventStreamRes, 0, len(mm))
+	for _, e := range mm {
+		ee = append(ee, e)
+	}
+	slices.SortFunc(ee, func(a, b *render.EventStreamRes) int {
+		return b.LastSeen.Compare(a.LastSeen)
+	})
+
+	return ee, nil
+}
+
+// EventStreamID returns a stream entry key for a given event.
+func EventStreamID(ns, apiVersion, kind, name, typ, reason, note string) string {
+	h := sha1.Sum([]byte(note)) //nolint:gosec
+
+	return strings.Join([]string{ns, apiVersion, kind, name, typ, reason, hex.EncodeToString(h[:])[:7]}, eventKeySep)
+}
+
+// EventStreamRef represents an event stream entry origin.
+type EventStreamRef struct {
+	Namespace, APIVersion, Kind, Name string
+	Type, Reason                      string
+}
+
+// ParseEventStreamID returns the origin of an event stream entry.
+func ParseEventStreamID(id string) (EventStreamRef, bool) {
+	tt := strings.Split(id, eventKeySep)
+	if len(tt) != 7 {
+		return EventStreamRef{}, false
+	}
+
+	return EventStreamRef{
+		Namespace:  tt[0],
+		APIVersion: tt[1],
+		Kind:       tt[2],
+		Name:       tt[3],
+		Type:       tt[4],
+		Reason:     tt[5],
+	}, true
+}
+
+// ----------------------------------------------------------------------------
+
+// eventWatch tracks the session watched event reasons.
+var eventWatch = NewEventWatchList()
+
+// EventWatch returns the session event reasons watch list.
+func EventWatch() *EventWatchList {
+	return eventWatch
+}
+
+// EventWatchList tracks event reasons pinned by the user.
+type EventWatchList struct {
+	reasons sets.Set[string]
+	mx      sync.RWMutex
+}
+
+// NewEventWatchList returns a new instance.
+func NewEventWatchList() *EventWatchList {
+	return &EventWatchList{reasons: sets.New[string]()}
+}
+
+// Toggle adds or removes a reason from the watch list. It returns true if the reason is now watched.
+func (w *EventWatchList) Toggle(reason string) bool {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	if w.reasons.Has(reason) {
+		w.reasons.Delete(reason)
+		return false
+	}
+	w.reasons.Insert(reason)
+
+	return true
+}
+
+// Has checks if a reason is watched.
+func (w *EventWatchList) Has(reason string) bool {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	return w.reasons.Has(reason)
+}
+
+// Reasons returns the watched reasons.
+func (w *EventWatchList) Reasons() []string {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	return sets.List(w.reasons)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
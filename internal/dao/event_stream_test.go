@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestEventStreamList(t *testing.T) {
+	f := streamFactory()
+	var es dao.EventStream
+	es.Init(&f, client.EsGVR)
+
+	require.True(t, dao.EventWatch().Toggle("FailedMount"))
+	defer dao.EventWatch().Toggle("FailedMount")
+
+	uu := map[string]struct {
+		f dao.StreamFilter
+		e []string
+	}{
+		"all": {
+			f: dao.StreamAll,
+			e: []string{"ns2/FailedMount/1/true", "ns1/BackOff/7/false", "ns1/Pulled/1/false"},
+		},
+		"warnings": {
+			f: dao.StreamWarnings,
+			e: []string{"ns2/FailedMount/1/true", "ns1/BackOff/7/false"},
+		},
+		"watched": {
+			f: dao.StreamWatched,
+			e: []string{"ns2/FailedMount/1/true"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), internal.KeyStreamFilter, u.f)
+			oo, err := es.List(ctx, "")
+			require.NoError(t, err)
+			aa := make([]string, 0, len(oo))
+			for _, o := range oo {
+				e := o.(*render.EventStreamRes)
+				aa = append(aa, e.Namespace+"/"+e.Reason+"/"+strconv.Itoa(e.Count)+"/"+strconv.FormatBool(e.Watched))
+			}
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
+
+func TestEventStreamDedup(t *testing.T) {
+	f := streamFactory()
+	var es dao.EventStream
+	es.Init(&f, client.EsGVR)
+
+	oo, err := es.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, oo, 3)
+
+	e := oo[1].(*render.EventStreamRes)
+	assert.Equal(t, "BackOff", e.Reason)
+	assert.Equal(t, 7, e.Count)
+	assert.Equal(t, "2026-10-14T10:00:00Z", e.FirstSeen.UTC().Format("2006-01-02T15:04:05Z"))
+	assert.Equal(t, "2026-10-14T11:00:00Z", e.LastSeen.UTC().Format("2006-01-02T15:04:05Z"))
+
+	ref, ok := dao.ParseEventStreamID(e.ID)
+	require.True(t, ok)
+	assert.Equal(t, dao.EventStreamRef{
+		Namespace:  "ns1",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       "fred",
+		Type:       "Warning",
+		Reason:     "BackOff",
+	}, ref)
+}
+
+func TestStreamFilterNext(t *testing.T) {
+	assert.Equal(t, dao.StreamWarnings, dao.StreamAll.Next())
+	assert.Equal(t, dao.StreamWatched, dao.StreamWarnings.Next())
+	assert.Equal(t, dao.StreamAll, dao.StreamWatched.Next())
+}
+
+// Helpers...
+
+func streamFactory() testFactory {
+	ev := func(n, ns, name, typ, reason, note string, count int64, first, last string) runtime.Object {
+		o := map[string]any{
+			"kind":                    "Event",
+			"metadata":                map[string]any{"name": n, "namespace": ns},
+			"regarding":               map[string]any{"apiVersion": "v1", "kind": "Pod", "name": name, "namespace": ns},
+			"reason":                  reason,
+			"type":                    typ,
+			"note":                    note,
+			"deprecatedCount":         count,
+			"deprecatedLastTimestamp": last,
+		}
+		if first != "" {
+			o["deprecatedFirstTimestamp"] = first
+		}
+		return &unstructured.Unstructured{Object: o}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		client.NamespaceAll: {
+			client.EvGVR: {
+				ev("e1", "ns1", "fred", "Warning", "BackOff", "Back-off restarting failed container", 4, "2026-10-14T10:00:00Z", "2026-10-14T10:30:00Z"),
+				ev("e2", "ns1", "fred", "Warning", "BackOff", "Back-off restarting failed container", 3, "2026-10-14T10:40:00Z", "2026-10-14T11:00:00Z"),
+				ev("e3", "ns1", "fred", "Normal", "Pulled", "Container image pulled", 1, "", "2026-10-14T09:00:00Z"),
+				ev("e4", "ns2", "blee", "Warning", "FailedMount", "Unable to attach volume", 1, "", "2026-10-14T12:00:00Z"),
+			},
+		},
+	}}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ForceSyncAnnotation triggers an external secret refresh when changed.
+const ForceSyncAnnotation = "force-sync"
+
+var _ Accessor = (*ExternalSecret)(nil)
+
+// ExternalSecret represents an external-secrets.io external secret.
+type ExternalSecret struct {
+	Resource
+}
+
+// Refresh forces an external secret to resync from its store.
+func (e *ExternalSecret) Refresh(ctx context.Context, path string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := e.Client().CanI(ns, e.gvr, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %q", e.gvr)
+	}
+
+	dial, err := e.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.Resource(e.gvr.GVR()).Namespace(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		forceSyncPatch(time.Now()),
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to refresh external secret %s: %w", n, err)
+	}
+
+	return nil
+}
+
+// Helpers...
+
+func forceSyncPatch(t time.Time) []byte {
+	return fmt.Appendf(nil, `{"metadata":{"annotations":{%q:%q}}}`, ForceSyncAnnotation, strconv.FormatInt(t.Unix(), 10))
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceSyncPatch(t *testing.T) {
+	p := forceSyncPatch(time.Unix(1791936000, 0))
+
+	assert.JSONEq(t, `{"metadata":{"annotations":{"force-sync":"1791936000"}}}`, string(p))
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// filterFields filters cached resources given a field selector. It backs field
+// selectors the api server does not support, matching fields against the resources manifests.
+func filterFields(oo []runtime.Object, sel string) ([]runtime.Object, error) {
+	if sel == "" {
+		return oo, nil
+	}
+	fsel, err := fields.ParseSelector(sel)
+	if err != nil {
+		return nil, err
+	}
+	if fsel.Empty() {
+		return oo, nil
+	}
+
+	rr := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if matchFields(o, fsel) {
+			rr = append(rr, o)
+		}
+	}
+
+	return rr, nil
+}
+
+func matchFields(o runtime.Object, sel fields.Selector) bool {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return true
+	}
+	ff := make(fields.Set, len(sel.Requirements()))
+	for _, r := range sel.Requirements() {
+		if v, ok, _ := unstructured.NestedFieldNoCopy(u.Object, strings.Split(r.Field, ".")...); ok {
+			ff[r.Field] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return sel.Matches(ff)
+}
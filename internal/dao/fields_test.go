@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newFieldsPod(n, node, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]any{"name": n, "namespace": "ns1"},
+		"spec":       map[string]any{"nodeName": node},
+		"status":     map[string]any{"phase": phase},
+	}}
+}
+
+type cacheFactory struct {
+	connFactory
+	oo []runtime.Object
+}
+
+func (f cacheFactory) List(*client.GVR, string, bool, labels.Selector) ([]runtime.Object, error) {
+	return f.oo, nil
+}
+
+func TestResourceListFields(t *testing.T) {
+	cached := []runtime.Object{
+		newFieldsPod("p1", "node-1", "Running"),
+		newFieldsPod("p2", "node-2", "Pending"),
+	}
+	uu := map[string]struct {
+		sel    string
+		fail   bool
+		listed int
+		e      []string
+	}{
+		"cached": {
+			e: []string{"p1", "p2"},
+		},
+		"server": {
+			sel:    "spec.nodeName=node-1",
+			listed: 1,
+			e:      []string{"p3"},
+		},
+		"fallback": {
+			sel:    "status.phase=Pending",
+			fail:   true,
+			listed: 1,
+			e:      []string{"p2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				client.PodGVR.GVR(): "PodList",
+			})
+			var listed int
+			dyn.PrependReactor("list", "pods", func(a k8stesting.Action) (bool, runtime.Object, error) {
+				listed++
+				assert.Equal(t, u.sel, a.(k8stesting.ListActionImpl).GetListRestrictions().Fields.String())
+				if u.fail {
+					return true, nil, apierrors.NewBadRequest("field label not supported")
+				}
+				return true, &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*newFieldsPod("p3", "node-1", "Running")}}, nil
+			})
+
+			var r Resource
+			r.Init(cacheFactory{connFactory: connFactory{conn: dynConn{dyn: dyn}}, oo: cached}, client.PodGVR)
+			ctx := context.WithValue(context.Background(), internal.KeyFields, u.sel)
+			oo, err := r.List(ctx, "ns1")
+			require.NoError(t, err)
+			assert.Equal(t, u.listed, listed)
+			nn := make([]string, 0, len(oo))
+			for _, o := range oo {
+				nn = append(nn, o.(*unstructured.Unstructured).GetName())
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestFilterFields(t *testing.T) {
+	newPod := func(n, node, phase string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": n, "namespace": "ns1"},
+			"spec":     map[string]any{"nodeName": node},
+			"status":   map[string]any{"phase": phase},
+		}}
+	}
+	oo := []runtime.Object{
+		newPod("p1", "node-1", "Running"),
+		newPod("p2", "node-2", "Pending"),
+		newPod("p3", "node-1", "Pending"),
+	}
+
+	uu := map[string]struct {
+		sel string
+		e   []string
+		err bool
+	}{
+		"none":     {e: []string{"p1", "p2", "p3"}},
+		"phase":    {sel: "status.phase=Pending", e: []string{"p2", "p3"}},
+		"multi":    {sel: "status.phase=Pending,spec.nodeName=node-1", e: []string{"p3"}},
+		"not-eq":   {sel: "spec.nodeName!=node-1", e: []string{"p2"}},
+		"name":     {sel: "metadata.name==p1", e: []string{"p1"}},
+		"missing":  {sel: "spec.fred=blee", e: []string{}},
+		"not-miss": {sel: "spec.fred!=blee", e: []string{"p1", "p2", "p3"}},
+		"toast":    {sel: "status.phase", err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			rr, err := filterFields(oo, u.sel)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			nn := make([]string, 0, len(rr))
+			for _, r := range rr {
+				nn = append(nn, r.(*unstructured.Unstructured).GetName())
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
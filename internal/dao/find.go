@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/sahilm/fuzzy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// maxFindResults tracks the maximum number of search results.
+const maxFindResults = 500
+
+// findSkip tracks resources excluded from searches.
+var findSkip = sets.New(client.EvGVR, client.NewGVR("v1/events"))
+
+var _ Accessor = (*Finder)(nil)
+
+// Finder searches resources names and labels across cached informers.
+type Finder struct {
+	NonResource
+}
+
+// List returns resources fuzzy matching the find term.
+func (f *Finder) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	term, _ := ctx.Value(internal.KeyFind).(string)
+	if term == "" {
+		return nil, errors.New("no search term specified")
+	}
+
+	fac := f.getFactory()
+	var rr []*render.FindRes
+	for _, target := range findTargets(fac, ns) {
+		oo, err := fac.List(target.gvr, target.ns, false, labels.Everything())
+		if err != nil {
+			slog.Debug("Find list failed",
+				slogs.GVR, target.gvr,
+				slogs.Namespace, target.ns,
+				slogs.Error, err,
+			)
+			continue
+		}
+		for _, o := range oo {
+			if r, ok := findMatch(term, target.gvr, o); ok {
+				rr = append(rr, r)
+			}
+		}
+	}
+	slices.SortFunc(rr, func(a, b *render.FindRes) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.GVR, b.GVR); c != 0 {
+			return c
+		}
+		return cmp.Compare(client.FQN(a.Namespace, a.Name), client.FQN(b.Namespace, b.Name))
+	})
+	if len(rr) > maxFindResults {
+		rr = rr[:maxFindResults]
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Helpers...
+
+type findTarget struct {
+	gvr *client.GVR
+	ns  string
+}
+
+// findTargets returns the cached resources along with the workloads in the given namespace.
+// Resources cached cluster wide supersede namespaced ones.
+func findTargets(f Factory, ns string) []findTarget {
+	if client.IsAllNamespace(ns) {
+		ns = client.BlankNamespace
+	}
+	cached := make(map[string][]*client.GVR)
+	if c, ok := f.(Cacher); ok {
+		cached = c.CachedGVRs()
+	}
+	cached[ns] = append(cached[ns], resList...)
+
+	wide := sets.New(cached[client.BlankNamespace]...)
+	seen := make(sets.Set[findTarget])
+	tt := make([]findTarget, 0, len(cached))
+	for n, gg := range cached {
+		for _, gvr := range gg {
+			t := findTarget{gvr: gvr, ns: n}
+			if findSkip.Has(gvr) || seen.Has(t) || (n != client.BlankNamespace && wide.Has(gvr)) {
+				continue
+			}
+			seen.Insert(t)
+			tt = append(tt, t)
+		}
+	}
+	slices.SortFunc(tt, func(a, b findTarget) int {
+		if c := cmp.Compare(a.gvr.String(), b.gvr.String()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ns, b.ns)
+	})
+
+	return tt
+}
+
+// findMatch fuzzy matches a term against the resource name and labels.
+func findMatch(term string, gvr *client.GVR, o runtime.Object) (*render.FindRes, bool) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, false
+	}
+	cc := make([]string, 0, len(m.GetLabels())+1)
+	cc = append(cc, m.GetName())
+	for k, v := range m.GetLabels() {
+		cc = append(cc, k+"="+v)
+	}
+	mm := fuzzy.Find(term, cc)
+	if len(mm) == 0 {
+		return nil, false
+	}
+	best := mm[0]
+	field := render.FindLabel
+	if best.Index == 0 {
+		field = render.FindName
+	}
+
+	return &render.FindRes{
+		GVR:       gvr.String(),
+		Namespace: m.GetNamespace(),
+		Name:      m.GetName(),
+		Field:     field,
+		Match:     best.Str,
+		Score:     best.Score,
+	}, true
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFinderList(t *testing.T) {
+	f := cachedFactory{
+		testFactory: &testFactory{
+			inventory: map[string]map[*client.GVR][]runtime.Object{
+				"ns1": {
+					client.DpGVR: {newFindRes("fred", nil)},
+					client.PodGVR: {
+						newFindRes("fred-a1", nil),
+						newFindRes("blee", map[string]string{"app": "fred"}),
+						newFindRes("zorg", nil),
+					},
+					client.CmGVR: {newFindRes("fred-cfg", nil)},
+				},
+			},
+		},
+		cached: map[string][]*client.GVR{"ns1": {client.CmGVR, client.PodGVR}},
+	}
+	var finder dao.Finder
+	finder.Init(f, client.FndGVR)
+
+	_, err := finder.List(context.Background(), "ns1")
+	require.Error(t, err)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFind, "fred")
+	oo, err := finder.List(ctx, "ns1")
+	require.NoError(t, err)
+
+	ee := map[string]string{
+		"apps/v1/deployments|ns1|fred": render.FindName,
+		"v1/pods|ns1|fred-a1":          render.FindName,
+		"v1/pods|ns1|blee":             render.FindLabel,
+		"v1/configmaps|ns1|fred-cfg":   render.FindName,
+	}
+	assert.Len(t, oo, len(ee))
+	for _, o := range oo {
+		r := o.(*render.FindRes)
+		key := r.GVR + "|" + r.Namespace + "|" + r.Name
+		assert.Equal(t, ee[key], r.Field, key)
+	}
+	assert.Equal(t, "fred", oo[0].(*render.FindRes).Name)
+}
+
+// Helpers...
+
+type cachedFactory struct {
+	*testFactory
+	cached map[string][]*client.GVR
+}
+
+func (f cachedFactory) CachedGVRs() map[string][]*client.GVR {
+	return f.cached
+}
+
+func newFindRes(n string, ll map[string]string) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]any{}}
+	o.SetName(n)
+	o.SetNamespace("ns1")
+	o.SetLabels(ll)
+
+	return &o
+}
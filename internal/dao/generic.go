@@ -52,7 +52,8 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 		return nil, err
 	}
 
-	opts := metav1.ListOptions{LabelSelector: labelSel.String()}
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
+	opts := metav1.ListOptions{LabelSelector: labelSel.String(), FieldSelector: fieldSel}
 	var ll *unstructured.UnstructuredList
 	if client.IsClusterScoped(ns) {
 		ll, err = dial.List(ctx, opts)
@@ -124,19 +125,27 @@ func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.D
 	opts := metav1.DeleteOptions{
 		PropagationPolicy:  propagation,
 		GracePeriodSeconds: gracePeriod,
+		DryRun:             dryRunOpts(),
 	}
 
 	dial, err := g.dynClient()
 	if err != nil {
 		return err
 	}
+	prior := snapshot(ctx, g.Client(), g.gvr, path)
 	if client.IsClusterScoped(ns) {
-		return dial.Delete(ctx, n, opts)
+		err = dial.Delete(ctx, n, opts)
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+		defer cancel()
+		err = dial.Namespace(ns).Delete(ctx, n, opts)
+	}
+	if err != nil {
+		return err
 	}
-	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
-	defer cancel()
+	journalMutation(JournalDelete, g.gvr, path, prior)
 
-	return dial.Namespace(ns).Delete(ctx, n, opts)
+	return nil
 }
 
 func (g *Generic) dynClient() (dynamic.NamespaceableResourceInterface, error) {
@@ -131,7 +131,7 @@ func (h *HelmChart) Uninstall(path string, keepHist bool) error {
 	}
 
 	u := action.NewUninstall(cfg)
-	u.KeepHistory = keepHist
+	u.KeepHistory, u.DryRun = keepHist, IsDryRun()
 	res, err := u.Run(n)
 	if err != nil {
 		return err
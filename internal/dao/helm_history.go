@@ -147,7 +147,7 @@ func (h *HelmHistory) Rollback(_ context.Context, path, rev string) error {
 		return fmt.Errorf("could not convert revision to a number: %w", err)
 	}
 	clt := action.NewRollback(cfg)
-	clt.Version = ver
+	clt.Version, clt.DryRun = ver, IsDryRun()
 
 	return clt.Run(n)
 }
@@ -160,7 +160,9 @@ func (h *HelmHistory) Delete(_ context.Context, path string, _ *metav1.DeletionP
 		return err
 	}
 
-	res, err := action.NewUninstall(cfg).Run(n)
+	u := action.NewUninstall(cfg)
+	u.DryRun = IsDryRun()
+	res, err := u.Run(n)
 	if err != nil {
 		return err
 	}
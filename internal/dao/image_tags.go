@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const (
+	dockerHubHost     = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+	maxTagPages       = 10
+	imageTagsTTL      = 5 * time.Minute
+)
+
+var (
+	imageTagsCache   = map[string]imageTagsEntry{}
+	imageTagsCacheMx sync.Mutex
+
+	challengeRX = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	linkRX      = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+	tagChunkRX  = regexp.MustCompile(`\d+|\D+`)
+)
+
+type imageTagsEntry struct {
+	tags    []string
+	expires time.Time
+}
+
+// ImageRef represents a container image reference.
+type ImageRef struct {
+	// Name is the image name as written sans tag or digest.
+	Name string
+
+	// Host and Repo locate the image repository.
+	Host, Repo string
+
+	// Tag is the image tag if any.
+	Tag string
+}
+
+// ParseImageRef parses an image reference, defaulting to Docker Hub.
+func ParseImageRef(image string) ImageRef {
+	name, _, _ := strings.Cut(image, "@")
+	var tag string
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	ref := ImageRef{Name: name, Host: dockerHubHost, Repo: name, Tag: tag}
+	if host, repo, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		ref.Host, ref.Repo = host, repo
+	}
+	if ref.Host == dockerHubHost && !strings.Contains(ref.Repo, "/") {
+		ref.Repo = "library/" + ref.Repo
+	}
+
+	return ref
+}
+
+// WithTag returns the image reference for a given tag.
+func (r ImageRef) WithTag(tag string) string {
+	return r.Name + ":" + tag
+}
+
+// ImageTags lists an image repository tags, most recent versions first.
+func ImageTags(ctx context.Context, cfg config.Registries, image string) ([]string, error) {
+	ref := ParseImageRef(image)
+	key := ref.Host + "/" + ref.Repo
+	imageTagsCacheMx.Lock()
+	e, ok := imageTagsCache[key]
+	imageTagsCacheMx.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.tags, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.TimeoutOrDefault())
+	defer cancel()
+	c := newRegistryClient(cfg, ref.Host)
+	tags, err := c.tags(ctx, ref.Repo)
+	if err != nil {
+		return nil, err
+	}
+	tags = SortImageTags(tags)
+	if n := cfg.MaxTagsOrDefault(); len(tags) > n {
+		tags = tags[:n]
+	}
+	imageTagsCacheMx.Lock()
+	imageTagsCache[key] = imageTagsEntry{tags: tags, expires: time.Now().Add(imageTagsTTL)}
+	imageTagsCacheMx.Unlock()
+
+	return tags, nil
+}
+
+// SortImageTags sorts tags by descending versions, versioned tags first.
+func SortImageTags(tags []string) []string {
+	tags = slices.Clone(tags)
+	slices.SortStableFunc(tags, func(a, b string) int {
+		va, vb := isVersionTag(a), isVersionTag(b)
+		switch {
+		case va && !vb:
+			return -1
+		case !va && vb:
+			return 1
+		}
+		return compareTags(b, a)
+	})
+
+	return tags
+}
+
+// Helpers...
+
+type registryClient struct {
+	host   string
+	base   string
+	cred   config.RegistryCredential
+	auth   string
+	client *http.Client
+}
+
+func newRegistryClient(cfg config.Registries, host string) *registryClient {
+	c := registryClient{host: host, client: http.DefaultClient}
+	scheme, target := "https", host
+	if host == dockerHubHost {
+		target = dockerHubRegistry
+	}
+	if strings.HasPrefix(host, "localhost") || strings.HasPrefix(host, "127.0.0.1") {
+		scheme = "http"
+	}
+	c.base = scheme + "://" + target
+	if cred, ok := cfg.CredentialFor(host); ok {
+		c.cred = cred
+		if cred.Token != "" {
+			c.auth = "Bearer " + cred.Token
+		}
+	}
+
+	return &c
+}
+
+type tagsList struct {
+	Tags []string `json:"tags"`
+}
+
+func (c *registryClient) tags(ctx context.Context, repo string) ([]string, error) {
+	u := c.base + "/v2/" + repo + "/tags/list"
+	var tags []string
+	for range maxTagPages {
+		raw, hh, err := c.get(ctx, u, repo)
+		if err != nil {
+			return nil, err
+		}
+		var l tagsList
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return nil, fmt.Errorf("invalid registry tags response: %w", err)
+		}
+		tags = append(tags, l.Tags...)
+		next := nextPage(c.base, hh.Get("Link"))
+		if next == "" {
+			break
+		}
+		u = next
+	}
+
+	return tags, nil
+}
+
+// get issues a registry request, answering an auth challenge once.
+func (c *registryClient) get(ctx context.Context, u, repo string) ([]byte, http.Header, error) {
+	for retry := true; ; retry = false {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+		if err != nil {
+			return nil, nil, err
+		}
+		if c.auth != "" {
+			req.Header.Set("Authorization", c.auth)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		raw, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && retry {
+			if err := c.authorize(ctx, resp.Header.Get("Www-Authenticate"), repo); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, nil, fmt.Errorf("registry %s tags query failed: %s %s", c.host, resp.Status, truncateOutput(string(raw)))
+		}
+
+		return raw, resp.Header, nil
+	}
+}
+
+type registryToken struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// authorize answers a basic or bearer auth challenge.
+func (c *registryClient) authorize(ctx context.Context, challenge, repo string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.cred.Username == "" {
+			return fmt.Errorf("registry %s requires credentials", c.host)
+		}
+		c.auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(c.cred.Username+":"+c.cred.Password))
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("registry %s unsupported auth challenge %q", c.host, challenge)
+	}
+
+	pp := make(map[string]string)
+	for _, m := range challengeRX.FindAllStringSubmatch(params, -1) {
+		pp[m[1]] = m[2]
+	}
+	if pp["realm"] == "" {
+		return fmt.Errorf("registry %s auth challenge has no realm", c.host)
+	}
+	vv := url.Values{}
+	if s := pp["service"]; s != "" {
+		vv.Set("service", s)
+	}
+	scope := pp["scope"]
+	if scope == "" {
+		scope = "repository:" + repo + ":pull"
+	}
+	vv.Set("scope", scope)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pp["realm"]+"?"+vv.Encode(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	if c.cred.Username != "" {
+		req.SetBasicAuth(c.cred.Username, c.cred.Password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("registry %s token request failed: %s %s", c.host, resp.Status, truncateOutput(string(raw)))
+	}
+	var t registryToken
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return fmt.Errorf("invalid registry token response: %w", err)
+	}
+	tok := t.Token
+	if tok == "" {
+		tok = t.AccessToken
+	}
+	c.auth = "Bearer " + tok
+
+	return nil
+}
+
+// nextPage extracts the next tags page url from a Link header.
+func nextPage(base, link string) string {
+	m := linkRX.FindStringSubmatch(link)
+	if m == nil {
+		return ""
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	u, err := b.Parse(m[1])
+	if err != nil {
+		return ""
+	}
+
+	return u.String()
+}
+
+func isVersionTag(t string) bool {
+	t = strings.TrimPrefix(t, "v")
+	return t != "" && t[0] >= '0' && t[0] <= '9'
+}
+
+// compareTags compares tags numeric chunks by value ie 1.10 > 1.9.
+func compareTags(a, b string) int {
+	ca, cb := tagChunkRX.FindAllString(a, -1), tagChunkRX.FindAllString(b, -1)
+	for i := range min(len(ca), len(cb)) {
+		na, ea := strconv.Atoi(ca[i])
+		nb, eb := strconv.Atoi(cb[i])
+		if ea == nil && eb == nil {
+			if na != nb {
+				return na - nb
+			}
+			continue
+		}
+		if c := strings.Compare(ca[i], cb[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(ca) - len(cb)
+}
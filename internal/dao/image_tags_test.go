@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageRef(t *testing.T) {
+	uu := map[string]struct {
+		image string
+		e     dao.ImageRef
+	}{
+		"hub-official": {
+			image: "nginx:1.25",
+			e:     dao.ImageRef{Name: "nginx", Host: "docker.io", Repo: "library/nginx", Tag: "1.25"},
+		},
+		"hub-user": {
+			image: "fred/blee",
+			e:     dao.ImageRef{Name: "fred/blee", Host: "docker.io", Repo: "fred/blee"},
+		},
+		"registry-port": {
+			image: "localhost:5000/fred/blee:v1@sha256:abc",
+			e:     dao.ImageRef{Name: "localhost:5000/fred/blee", Host: "localhost:5000", Repo: "fred/blee", Tag: "v1"},
+		},
+		"registry": {
+			image: "ghcr.io/fred/blee:2.0",
+			e:     dao.ImageRef{Name: "ghcr.io/fred/blee", Host: "ghcr.io", Repo: "fred/blee", Tag: "2.0"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.ParseImageRef(u.image))
+		})
+	}
+}
+
+func TestSortImageTags(t *testing.T) {
+	tt := dao.SortImageTags([]string{"latest", "1.9", "v1.10.0", "1.10", "alpine", "1.9.1"})
+	assert.Equal(t, []string{"v1.10.0", "1.10", "1.9.1", "1.9", "latest", "alpine"}, tt)
+}
+
+func TestImageTags(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			if u, p, ok := r.BasicAuth(); !ok || u != "fred" || p != "blee" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			assert.Equal(t, "repository:fred/blee:pull", r.URL.Query().Get("scope"))
+			_, _ = fmt.Fprint(w, `{"token":"t1"}`)
+		case r.Header.Get("Authorization") != "Bearer t1":
+			w.Header().Set("Www-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/fred/blee/tags/list?last=1.1&n=2>; rel="next"`)
+			_, _ = fmt.Fprint(w, `{"name":"fred/blee","tags":["1.0","1.1"]}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"name":"fred/blee","tags":["1.2","latest"]}`)
+		}
+	}))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	cfg := config.Registries{
+		MaxTags:     3,
+		Credentials: []config.RegistryCredential{{Host: host, Username: "fred", Password: "blee"}},
+	}
+	tt, err := dao.ImageTags(context.Background(), cfg, host+"/fred/blee:1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.2", "1.1", "1.0"}, tt)
+}
+
+func TestImageTagsFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Www-Authenticate", `Basic realm="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	_, err := dao.ImageTags(context.Background(), config.Registries{}, strings.TrimPrefix(srv.URL, "http://")+"/fred/blee")
+	require.Error(t, err)
+}
@@ -150,15 +150,17 @@ func (j *Job) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool) (R
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&job.Spec.Template.Spec, n) {
+			via := configMapRefs(&job.Spec.Template.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: j.GVR(),
 				FQN: client.FQN(job.Namespace, job.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
+			via, err := secretRefs(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Locate secret failed",
 					slogs.FQN, fqn,
@@ -166,12 +168,13 @@ func (j *Job) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool) (R
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: j.GVR(),
 				FQN: client.FQN(job.Namespace, job.Name),
+				Via: via,
 			})
 		case client.PcGVR:
 			if !hasPC(&job.Spec.Template.Spec, n) {
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// ClonedFromAnnotation tracks the job a clone was created from.
+const ClonedFromAnnotation = "k9scli.io/cloned-from"
+
+// jobControllerLabels lists the labels the job controller manages.
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	"batch.kubernetes.io/controller-uid",
+	"batch.kubernetes.io/job-name",
+}
+
+// jobCloneSkippedAnnotations lists the annotations a clone does not carry over.
+var jobCloneSkippedAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"batch.kubernetes.io/cronjob-scheduled-timestamp",
+}
+
+// JobOverrides represents a job clone container overrides.
+type JobOverrides struct {
+	// Container names the overridden container. Default the first container.
+	Container string
+
+	// Env lists the env vars to add or replace.
+	Env []v1.EnvVar
+
+	// Args replaces the container args when set.
+	Args []string
+}
+
+// Clone re-runs a Job as a new Job with optional container overrides.
+func (j *Job) Clone(ctx context.Context, path string, oo JobOverrides) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := j.Client().CanI(ns, client.JobGVR, "", []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create jobs")
+	}
+
+	src, err := j.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+	job, err := cloneJob(src, oo)
+	if err != nil {
+		return "", err
+	}
+	dial, err := j.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	if _, err := dial.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", fmt.Errorf("unable to clone job %s: %w", n, err)
+	}
+
+	return client.FQN(ns, job.Name), nil
+}
+
+// ParseEnvOverrides parses space or comma separated KEY=VALUE pairs.
+func ParseEnvOverrides(s string) ([]v1.EnvVar, error) {
+	ff := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+	ee := make([]v1.EnvVar, 0, len(ff))
+	for _, f := range ff {
+		k, v, ok := strings.Cut(f, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid env override %q, expecting KEY=VALUE", f)
+		}
+		ee = append(ee, v1.EnvVar{Name: k, Value: v})
+	}
+
+	return ee, nil
+}
+
+// ParseArgs splits a command line into arguments honoring quotes.
+func ParseArgs(s string) ([]string, error) {
+	var (
+		args  []string
+		arg   strings.Builder
+		quote rune
+		inArg bool
+	)
+	for _, r := range s {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args, inArg = append(args, arg.String()), false
+				arg.Reset()
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote in args")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return args, nil
+}
+
+// Helpers...
+
+// cloneJob returns a standalone copy of a job with the controller managed fields stripped.
+func cloneJob(src *batchv1.Job, oo JobOverrides) (*batchv1.Job, error) {
+	name := src.Name
+	if len(name) >= maxJobNameSize {
+		name = name[:maxJobNameSize]
+	}
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + "-rerun-" + rand.String(3),
+			Namespace:   src.Namespace,
+			Labels:      withoutKeys(src.Labels, jobControllerLabels),
+			Annotations: withoutKeys(src.Annotations, jobCloneSkippedAnnotations),
+		},
+		Spec: *src.Spec.DeepCopy(),
+	}
+	job.Annotations[ClonedFromAnnotation] = src.Name
+	if job.Spec.ManualSelector == nil || !*job.Spec.ManualSelector {
+		job.Spec.Selector = nil
+		job.Spec.Template.Labels = withoutKeys(job.Spec.Template.Labels, jobControllerLabels)
+	}
+	if err := overrideContainer(&job.Spec.Template.Spec, oo); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func overrideContainer(spec *v1.PodSpec, oo JobOverrides) error {
+	if len(oo.Env) == 0 && len(oo.Args) == 0 {
+		return nil
+	}
+	if len(spec.Containers) == 0 {
+		return errors.New("job has no containers")
+	}
+	idx := 0
+	if oo.Container != "" {
+		idx = slices.IndexFunc(spec.Containers, func(c v1.Container) bool { return c.Name == oo.Container })
+		if idx < 0 {
+			return fmt.Errorf("no container named %q", oo.Container)
+		}
+	}
+	co := &spec.Containers[idx]
+	for _, e := range oo.Env {
+		if i := slices.IndexFunc(co.Env, func(v v1.EnvVar) bool { return v.Name == e.Name }); i >= 0 {
+			co.Env[i] = e
+			continue
+		}
+		co.Env = append(co.Env, e)
+	}
+	if len(oo.Args) > 0 {
+		co.Args = oo.Args
+	}
+
+	return nil
+}
+
+func withoutKeys(m map[string]string, kk []string) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if !slices.Contains(kk, k) {
+			out[k] = v
+		}
+	}
+
+	return out
+}
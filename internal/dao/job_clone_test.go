@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCloneJob(t *testing.T) {
+	src := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fred-28001",
+			Namespace: "ns1",
+			Labels:    map[string]string{"app": "fred", "job-name": "fred-28001", "controller-uid": "u1"},
+			Annotations: map[string]string{
+				"batch.kubernetes.io/cronjob-scheduled-timestamp": "2026-10-14T00:00:00Z",
+				"team": "blee",
+			},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob", Name: "fred"}},
+			UID:             "u1",
+		},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"batch.kubernetes.io/controller-uid": "u1"}},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "fred", "batch.kubernetes.io/controller-uid": "u1"}},
+				Spec: v1.PodSpec{Containers: []v1.Container{
+					{Name: "c1", Args: []string{"run"}, Env: []v1.EnvVar{{Name: "MODE", Value: "full"}}},
+					{Name: "c2"},
+				}},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		oo   JobOverrides
+		co   int
+		env  []v1.EnvVar
+		args []string
+		err  string
+	}{
+		"plain": {
+			env:  []v1.EnvVar{{Name: "MODE", Value: "full"}},
+			args: []string{"run"},
+		},
+		"overrides": {
+			oo: JobOverrides{
+				Env:  []v1.EnvVar{{Name: "MODE", Value: "dry"}, {Name: "DEBUG", Value: "1"}},
+				Args: []string{"run", "--verbose"},
+			},
+			env:  []v1.EnvVar{{Name: "MODE", Value: "dry"}, {Name: "DEBUG", Value: "1"}},
+			args: []string{"run", "--verbose"},
+		},
+		"container": {
+			oo:   JobOverrides{Container: "c2", Args: []string{"check"}},
+			co:   1,
+			args: []string{"check"},
+		},
+		"no-container": {
+			oo:  JobOverrides{Container: "bozo", Args: []string{"check"}},
+			err: `no container named "bozo"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			job, err := cloneJob(&src, u.oo)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(job.Name, "fred-28001-rerun-"))
+			assert.Equal(t, map[string]string{"app": "fred"}, job.Labels)
+			assert.Equal(t, map[string]string{"team": "blee", ClonedFromAnnotation: "fred-28001"}, job.Annotations)
+			assert.Empty(t, job.OwnerReferences)
+			assert.Nil(t, job.Spec.Selector)
+			assert.Equal(t, map[string]string{"app": "fred"}, job.Spec.Template.Labels)
+
+			co := job.Spec.Template.Spec.Containers[u.co]
+			assert.Equal(t, u.env, co.Env)
+			assert.Equal(t, u.args, co.Args)
+			if u.co == 1 {
+				assert.Equal(t, []string{"run"}, job.Spec.Template.Spec.Containers[0].Args)
+			}
+		})
+	}
+	assert.Equal(t, []string{"run"}, src.Spec.Template.Spec.Containers[0].Args)
+	assert.NotNil(t, src.Spec.Selector)
+}
+
+func TestParseEnvOverrides(t *testing.T) {
+	ee, err := ParseEnvOverrides("MODE=dry, DEBUG=1,URL=http://fred?a=b")
+	require.NoError(t, err)
+	assert.Equal(t, []v1.EnvVar{
+		{Name: "MODE", Value: "dry"},
+		{Name: "DEBUG", Value: "1"},
+		{Name: "URL", Value: "http://fred?a=b"},
+	}, ee)
+
+	_, err = ParseEnvOverrides("MODE")
+	require.Error(t, err)
+}
+
+func TestParseArgs(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   []string
+		err bool
+	}{
+		"empty": {},
+		"plain": {
+			s: "run --verbose  -n 3",
+			e: []string{"run", "--verbose", "-n", "3"},
+		},
+		"quotes": {
+			s: `sh -c "echo 'hello world'" ''`,
+			e: []string{"sh", "-c", "echo 'hello world'", ""},
+		},
+		"unterminated": {
+			s:   `sh -c "echo`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			aa, err := ParseArgs(u.s)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
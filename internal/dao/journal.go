@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// journalSize tracks the maximum number of mutations kept in the journal.
+const journalSize = 100
+
+// JournalOp represents a journaled mutation.
+type JournalOp string
+
+const (
+	// JournalDelete tracks resource deletions.
+	JournalDelete JournalOp = "Delete"
+
+	// JournalScale tracks replicas changes.
+	JournalScale JournalOp = "Scale"
+
+	// JournalRestart tracks rollout restarts.
+	JournalRestart JournalOp = "Restart"
+
+	// JournalRollback tracks rollout rollbacks.
+	JournalRollback JournalOp = "Rollback"
+
+	// JournalPatch tracks resource patches.
+	JournalPatch JournalOp = "Patch"
+
+	// JournalEdit tracks resource manifest edits.
+	JournalEdit JournalOp = "Edit"
+)
+
+// JournalEntry represents a mutation along with the resource prior state.
+type JournalEntry struct {
+	ID     int
+	Time   time.Time
+	Op     JournalOp
+	GVR    *client.GVR
+	FQN    string
+	Prior  *unstructured.Unstructured
+	Undone bool
+
+	// after tracks the resource state prior to an undo so it can be redone.
+	after    *unstructured.Unstructured
+	undoneAt int
+}
+
+// MutationJournal tracks mutations performed during a session.
+type MutationJournal struct {
+	entries []*JournalEntry
+	seq     int
+	mx      sync.RWMutex
+
+	// ops serializes undo and redo so the journal is not locked during api calls.
+	ops sync.Mutex
+}
+
+// journal tracks the session mutations.
+var journal = NewMutationJournal()
+
+// NewMutationJournal returns a new journal.
+func NewMutationJournal() *MutationJournal {
+	return &MutationJournal{}
+}
+
+// Journal returns the session mutations journal.
+func Journal() *MutationJournal {
+	return journal
+}
+
+// Entries returns the journaled mutations, most recent first.
+func (j *MutationJournal) Entries() []JournalEntry {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	ee := make([]JournalEntry, 0, len(j.entries))
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		ee = append(ee, *j.entries[i])
+	}
+
+	return ee
+}
+
+// Entry returns a journaled mutation given its id.
+func (j *MutationJournal) Entry(id int) (JournalEntry, bool) {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	for _, e := range j.entries {
+		if e.ID == id {
+			return *e, true
+		}
+	}
+
+	return JournalEntry{}, false
+}
+
+// Clear resets the journal.
+func (j *MutationJournal) Clear() {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	j.entries = nil
+}
+
+func (j *MutationJournal) record(op JournalOp, gvr *client.GVR, fqn string, prior *unstructured.Unstructured) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	// A new mutation invalidates the undone mutations redo state.
+	for _, e := range j.entries {
+		if e.Undone {
+			e.undoneAt, e.after = 0, nil
+		}
+	}
+	j.seq++
+	j.entries = append(j.entries, &JournalEntry{
+		ID:    j.seq,
+		Time:  time.Now(),
+		Op:    op,
+		GVR:   gvr,
+		FQN:   fqn,
+		Prior: prior,
+	})
+	if len(j.entries) > journalSize {
+		j.entries = j.entries[len(j.entries)-journalSize:]
+	}
+}
+
+// Undo reverts the most recent mutation by re-applying the resource prior manifest.
+// The entry is returned alongside any error once a mutation to undo was found.
+func (j *MutationJournal) Undo(ctx context.Context, c client.Connection) (*JournalEntry, error) {
+	j.ops.Lock()
+	defer j.ops.Unlock()
+
+	e, ok := j.find(func(en, e *JournalEntry) bool { return !en.Undone && (e == nil || en.ID > e.ID) })
+	if !ok {
+		return nil, errors.New("nothing to undo")
+	}
+
+	dial, err := journalDial(c, &e)
+	if err != nil {
+		return &e, err
+	}
+	_, n := client.Namespaced(e.FQN)
+	var after *unstructured.Unstructured
+	if e.Op == JournalDelete {
+		if ref, ok := controllerRef(e.Prior.GetOwnerReferences()); ok {
+			return &e, fmt.Errorf("unable to undo %s deletion: managed by %s/%s", e.FQN, ref.Kind, ref.Name)
+		}
+		if _, err := dial.Create(ctx, restorable(e.Prior), metav1.CreateOptions{DryRun: dryRunOpts()}); err != nil {
+			return &e, err
+		}
+	} else {
+		curr, err := dial.Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return &e, err
+		}
+		after = curr.DeepCopy()
+		if err := applySpec(ctx, dial, curr, e.Prior); err != nil {
+			return &e, err
+		}
+	}
+	if IsDryRun() {
+		return &e, nil
+	}
+	j.update(e.ID, func(en *JournalEntry) {
+		j.seq++
+		en.Undone, en.undoneAt, en.after = true, j.seq, after
+		e = *en
+	})
+	slog.Debug("Undo mutation", slogs.GVR, e.GVR, slogs.FQN, e.FQN)
+
+	return &e, nil
+}
+
+// Redo re-applies the most recently undone mutation.
+// The entry is returned alongside any error once a mutation to redo was found.
+func (j *MutationJournal) Redo(ctx context.Context, c client.Connection) (*JournalEntry, error) {
+	j.ops.Lock()
+	defer j.ops.Unlock()
+
+	e, ok := j.find(func(en, e *JournalEntry) bool {
+		return en.Undone && en.undoneAt > 0 && (e == nil || en.undoneAt > e.undoneAt)
+	})
+	if !ok {
+		return nil, errors.New("nothing to redo")
+	}
+
+	dial, err := journalDial(c, &e)
+	if err != nil {
+		return &e, err
+	}
+	_, n := client.Namespaced(e.FQN)
+	if e.Op == JournalDelete {
+		if err := dial.Delete(ctx, n, metav1.DeleteOptions{DryRun: dryRunOpts()}); err != nil {
+			return &e, err
+		}
+	} else {
+		curr, err := dial.Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return &e, err
+		}
+		if err := applySpec(ctx, dial, curr, e.after); err != nil {
+			return &e, err
+		}
+	}
+	if IsDryRun() {
+		return &e, nil
+	}
+	j.update(e.ID, func(en *JournalEntry) {
+		en.Undone, en.undoneAt, en.after = false, 0, nil
+		e = *en
+	})
+	slog.Debug("Redo mutation", slogs.GVR, e.GVR, slogs.FQN, e.FQN)
+
+	return &e, nil
+}
+
+// find returns a copy of the entry best matching the given predicate.
+func (j *MutationJournal) find(better func(en, e *JournalEntry) bool) (JournalEntry, bool) {
+	j.mx.RLock()
+	defer j.mx.RUnlock()
+
+	var e *JournalEntry
+	for _, en := range j.entries {
+		if better(en, e) {
+			e = en
+		}
+	}
+	if e == nil {
+		return JournalEntry{}, false
+	}
+
+	return *e, true
+}
+
+// update mutates a journaled entry if it is still tracked.
+func (j *MutationJournal) update(id int, f func(*JournalEntry)) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	for _, e := range j.entries {
+		if e.ID == id {
+			f(e)
+			return
+		}
+	}
+}
+
+// Helpers...
+
+// snapshot returns a resource current state prior to a mutation.
+// Returns nil when the state could not be retrieved or in dry run mode.
+func snapshot(ctx context.Context, c client.Connection, gvr *client.GVR, fqn string) *unstructured.Unstructured {
+	if IsDryRun() {
+		return nil
+	}
+	d, err := c.DynDial()
+	if err != nil {
+		return nil
+	}
+	ns, n := client.Namespaced(fqn)
+	dial := d.Resource(gvr.GVR())
+	var o *unstructured.Unstructured
+	if client.IsClusterScoped(ns) {
+		o, err = dial.Get(ctx, n, metav1.GetOptions{})
+	} else {
+		o, err = dial.Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+	}
+	if err != nil {
+		slog.Warn("Unable to journal mutation",
+			slogs.GVR, gvr,
+			slogs.FQN, fqn,
+			slogs.Error, err,
+		)
+		return nil
+	}
+
+	return o
+}
+
+// journalMutation records a successful mutation given the resource prior state.
+// Dry run mutations are never journaled since they did not alter the cluster.
+func journalMutation(op JournalOp, gvr *client.GVR, fqn string, prior *unstructured.Unstructured) {
+	if prior == nil || IsDryRun() {
+		return
+	}
+	journal.record(op, gvr, fqn, prior)
+}
+
+type resourceDial interface {
+	Get(context.Context, string, metav1.GetOptions, ...string) (*unstructured.Unstructured, error)
+	Create(context.Context, *unstructured.Unstructured, metav1.CreateOptions, ...string) (*unstructured.Unstructured, error)
+	Update(context.Context, *unstructured.Unstructured, metav1.UpdateOptions, ...string) (*unstructured.Unstructured, error)
+	Delete(context.Context, string, metav1.DeleteOptions, ...string) error
+}
+
+func journalDial(c client.Connection, e *JournalEntry) (resourceDial, error) {
+	d, err := c.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(e.FQN)
+	if client.IsClusterScoped(ns) {
+		return d.Resource(e.GVR.GVR()), nil
+	}
+
+	return d.Resource(e.GVR.GVR()).Namespace(ns), nil
+}
+
+// applySpec restores a resource spec from a given manifest.
+func applySpec(ctx context.Context, dial resourceDial, curr, from *unstructured.Unstructured) error {
+	if from == nil {
+		return errors.New("no manifest available")
+	}
+	spec, ok, err := unstructured.NestedFieldCopy(from.Object, "spec")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no spec found for %s", from.GetName())
+	}
+	if err := unstructured.SetNestedField(curr.Object, spec, "spec"); err != nil {
+		return err
+	}
+	_, err = dial.Update(ctx, curr, metav1.UpdateOptions{DryRun: dryRunOpts()})
+
+	return err
+}
+
+// restorable strips server populated fields so a manifest can be recreated.
+func restorable(o *unstructured.Unstructured) *unstructured.Unstructured {
+	r := o.DeepCopy()
+	for _, f := range []string{"resourceVersion", "uid", "creationTimestamp", "generation", "managedFields", "deletionTimestamp", "deletionGracePeriodSeconds", "selfLink"} {
+		unstructured.RemoveNestedField(r.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(r.Object, "status")
+
+	return r
+}
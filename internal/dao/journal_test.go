@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestJournalUndoRedo(t *testing.T) {
+	ctx := context.Background()
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil, newDeployment(1))
+	conn := dynConn{dyn: dyn}
+	dial := dyn.Resource(client.DpGVR.GVR()).Namespace("ns1")
+
+	j := NewMutationJournal()
+	prior := snapshot(ctx, conn, client.DpGVR, "ns1/dp1")
+	require.NotNil(t, prior)
+	curr := prior.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(curr.Object, int64(3), "spec", "replicas"))
+	_, err := dial.Update(ctx, curr, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	j.record(JournalScale, client.DpGVR, "ns1/dp1", prior)
+
+	replicas := func() int64 {
+		o, err := dial.Get(ctx, "dp1", metav1.GetOptions{})
+		require.NoError(t, err)
+		r, _, _ := unstructured.NestedInt64(o.Object, "spec", "replicas")
+		return r
+	}
+
+	e, err := j.Undo(ctx, conn)
+	require.NoError(t, err)
+	assert.Equal(t, JournalScale, e.Op)
+	assert.Equal(t, int64(1), replicas())
+	assert.True(t, j.Entries()[0].Undone)
+
+	_, err = j.Undo(ctx, conn)
+	require.EqualError(t, err, "nothing to undo")
+
+	_, err = j.Redo(ctx, conn)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), replicas())
+	assert.False(t, j.Entries()[0].Undone)
+
+	_, err = j.Redo(ctx, conn)
+	require.EqualError(t, err, "nothing to redo")
+}
+
+func TestJournalUndoDelete(t *testing.T) {
+	ctx := context.Background()
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil, newDeployment(1))
+	conn := dynConn{dyn: dyn}
+	dial := dyn.Resource(client.DpGVR.GVR()).Namespace("ns1")
+
+	j := NewMutationJournal()
+	prior := snapshot(ctx, conn, client.DpGVR, "ns1/dp1")
+	require.NotNil(t, prior)
+	require.NoError(t, dial.Delete(ctx, "dp1", metav1.DeleteOptions{}))
+	j.record(JournalDelete, client.DpGVR, "ns1/dp1", prior)
+
+	_, err := j.Undo(ctx, conn)
+	require.NoError(t, err)
+	_, err = dial.Get(ctx, "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	_, err = j.Redo(ctx, conn)
+	require.NoError(t, err)
+	_, err = dial.Get(ctx, "dp1", metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+func TestJournalUndoManaged(t *testing.T) {
+	yes := true
+	po := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]any{"name": "p1", "namespace": "ns1"},
+	}}
+	po.SetOwnerReferences([]metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs1", Controller: &yes}})
+
+	j := NewMutationJournal()
+	j.record(JournalDelete, client.PodGVR, "ns1/p1", po)
+
+	_, err := j.Undo(context.Background(), dynConn{dyn: fake.NewSimpleDynamicClient(runtime.NewScheme())})
+	require.EqualError(t, err, "unable to undo ns1/p1 deletion: managed by ReplicaSet/rs1")
+}
+
+func TestJournalSize(t *testing.T) {
+	j := NewMutationJournal()
+	for range journalSize + 5 {
+		j.record(JournalPatch, client.DpGVR, "ns1/dp1", newDeployment(1))
+	}
+
+	ee := j.Entries()
+	assert.Len(t, ee, journalSize)
+	assert.Equal(t, journalSize+5, ee[0].ID)
+}
+
+func TestJournalDryRun(t *testing.T) {
+	SetDryRun(true)
+	defer SetDryRun(false)
+
+	n := len(Journal().Entries())
+	journalMutation(JournalScale, client.DpGVR, "ns1/dp1", newDeployment(1))
+	assert.Len(t, Journal().Entries(), n)
+}
+
+func TestJournalRecordClearsRedo(t *testing.T) {
+	ctx := context.Background()
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil, newDeployment(1))
+	conn := dynConn{dyn: dyn}
+
+	j := NewMutationJournal()
+	prior := snapshot(ctx, conn, client.DpGVR, "ns1/dp1")
+	require.NotNil(t, prior)
+	j.record(JournalScale, client.DpGVR, "ns1/dp1", prior)
+	_, err := j.Undo(ctx, conn)
+	require.NoError(t, err)
+
+	j.record(JournalPatch, client.DpGVR, "ns1/dp1", prior)
+	_, err = j.Redo(ctx, conn)
+	require.EqualError(t, err, "nothing to redo")
+
+	e, err := j.Undo(ctx, conn)
+	require.NoError(t, err)
+	assert.Equal(t, JournalPatch, e.Op)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// LabelIndex returns the label keys and their sorted values for the cached resources of a given kind.
+// Resources not currently watched by the factory yield no labels.
+func LabelIndex(f Factory, gvr *client.GVR, ns string) map[string][]string {
+	c, ok := f.(Cacher)
+	if !ok {
+		return nil
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.BlankNamespace
+	}
+	cached, listNS := c.CachedGVRs(), ns
+	switch {
+	case slices.Contains(cached[ns], gvr):
+	case slices.Contains(cached[client.BlankNamespace], gvr):
+		listNS = client.BlankNamespace
+	default:
+		return nil
+	}
+	oo, err := f.List(gvr, listNS, false, labels.Everything())
+	if err != nil {
+		return nil
+	}
+
+	idx := make(map[string]sets.Set[string])
+	for _, o := range oo {
+		m, err := meta.Accessor(o)
+		if err != nil || (listNS != ns && m.GetNamespace() != ns) {
+			continue
+		}
+		for k, v := range m.GetLabels() {
+			if _, ok := idx[k]; !ok {
+				idx[k] = sets.New[string]()
+			}
+			idx[k].Insert(v)
+		}
+	}
+
+	ll := make(map[string][]string, len(idx))
+	for k, vv := range idx {
+		ll[k] = sets.List(vv)
+	}
+
+	return ll
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLabelIndex(t *testing.T) {
+	f := cachedFactory{
+		testFactory: &testFactory{
+			inventory: map[string]map[*client.GVR][]runtime.Object{
+				"ns1": {
+					client.PodGVR: {
+						newFindRes("fred", map[string]string{"app": "fred", "tier": "web"}),
+						newFindRes("blee", map[string]string{"app": "blee", "tier": "web"}),
+						newFindRes("zorg", nil),
+					},
+					client.CmGVR: {newFindRes("cfg", map[string]string{"app": "cfg"})},
+				},
+			},
+		},
+		cached: map[string][]*client.GVR{"ns1": {client.PodGVR}},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"app":  {"blee", "fred"},
+		"tier": {"web"},
+	}, dao.LabelIndex(f, client.PodGVR, "ns1"))
+	assert.Empty(t, dao.LabelIndex(f, client.CmGVR, "ns1"))
+	assert.Empty(t, dao.LabelIndex(f.testFactory, client.PodGVR, "ns1"))
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/tview"
+)
+
+// LogQuery represents a historical logs query.
+type LogQuery struct {
+	Namespace, Pod, Container string
+	From, Until               time.Time
+	Limit                     int
+}
+
+// LogBackend represents a historical logs store.
+type LogBackend interface {
+	// Name returns the backend name.
+	Name() string
+
+	// Query returns historical log lines in chronological order.
+	Query(ctx context.Context, q LogQuery) ([]*LogItem, error)
+}
+
+// NewLogBackend returns a historical logs backend for a context configuration.
+func NewLogBackend(cfg *data.LogBackend) (LogBackend, error) {
+	if cfg == nil || cfg.URL == "" {
+		return nil, fmt.Errorf("no log backend url configured")
+	}
+	switch strings.ToLower(cfg.Type) {
+	case data.LokiBackend:
+		return &lokiBackend{cfg: cfg}, nil
+	case data.ElasticBackend:
+		return &elasticBackend{cfg: cfg}, nil
+	default:
+		return nil, fmt.Errorf("unsupported log backend type %q", cfg.Type)
+	}
+}
+
+// newBackendLogItem returns a log item formatted as kubelet timestamped lines.
+func newBackendLogItem(ts time.Time, line, co string) *LogItem {
+	line = strings.TrimRight(line, "\n")
+	item := NewLogItem([]byte(ts.UTC().Format(time.RFC3339Nano) + " " + tview.Escape(line) + "\n"))
+	item.Container = co
+
+	return item
+}
+
+func backendRequest(ctx context.Context, cfg *data.LogBackend, method, u string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, os.ExpandEnv(v))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s log backend query failed: %s %s", cfg.Type, resp.Status, truncateOutput(string(raw)))
+	}
+
+	return raw, nil
+}
+
+// ----------------------------------------------------------------------------
+// Loki...
+
+type lokiBackend struct {
+	cfg *data.LogBackend
+}
+
+type lokiResponse struct {
+	Data struct {
+		Result []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Name returns the backend name.
+func (*lokiBackend) Name() string { return data.LokiBackend }
+
+// Query fetches logs via the Loki query_range api.
+func (b *lokiBackend) Query(ctx context.Context, q LogQuery) ([]*LogItem, error) {
+	params := url.Values{}
+	params.Set("query", lokiSelector(b.cfg.LokiQuery(), q))
+	params.Set("start", strconv.FormatInt(q.From.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(q.Until.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(q.Limit))
+	params.Set("direction", "forward")
+	u := strings.TrimSuffix(b.cfg.URL, "/") + "/loki/api/v1/query_range?" + params.Encode()
+
+	raw, err := backendRequest(ctx, b.cfg, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp lokiResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("invalid loki response: %w", err)
+	}
+
+	type entry struct {
+		ts   time.Time
+		item *LogItem
+	}
+	ee := make([]entry, 0, q.Limit)
+	for _, r := range resp.Data.Result {
+		co := r.Stream["container"]
+		for _, v := range r.Values {
+			ns, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			ts := time.Unix(0, ns)
+			ee = append(ee, entry{ts: ts, item: newBackendLogItem(ts, v[1], co)})
+		}
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].ts.Before(ee[j].ts)
+	})
+	ll := make([]*LogItem, 0, len(ee))
+	for _, e := range ee {
+		ll = append(ll, e.item)
+	}
+
+	return ll, nil
+}
+
+// lokiSelector substitutes a query placeholders. Blank matchers are dropped.
+func lokiSelector(query string, q LogQuery) string {
+	if q.Container == "" {
+		for _, m := range []string{`, container="$CONTAINER"`, `container="$CONTAINER", `, `container="$CONTAINER"`} {
+			query = strings.ReplaceAll(query, m, "")
+		}
+	}
+
+	return strings.NewReplacer(
+		"$NAMESPACE", q.Namespace,
+		"$POD", q.Pod,
+		"$CONTAINER", q.Container,
+	).Replace(query)
+}
+
+// ----------------------------------------------------------------------------
+// Elasticsearch...
+
+type elasticBackend struct {
+	cfg *data.LogBackend
+}
+
+type elasticResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source map[string]any `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// Name returns the backend name.
+func (*elasticBackend) Name() string { return data.ElasticBackend }
+
+// Query fetches logs via the Elasticsearch search api.
+func (b *elasticBackend) Query(ctx context.Context, q LogQuery) ([]*LogItem, error) {
+	ff := b.cfg.ElasticFields()
+	filters := []any{
+		map[string]any{"term": map[string]any{ff.Namespace: q.Namespace}},
+		map[string]any{"term": map[string]any{ff.Pod: q.Pod}},
+		map[string]any{"range": map[string]any{ff.Timestamp: map[string]any{
+			"gte": q.From.UTC().Format(time.RFC3339Nano),
+			"lte": q.Until.UTC().Format(time.RFC3339Nano),
+		}}},
+	}
+	if q.Container != "" {
+		filters = append(filters, map[string]any{"term": map[string]any{ff.Container: q.Container}})
+	}
+	body, err := json.Marshal(map[string]any{
+		"size":  q.Limit,
+		"sort":  []any{map[string]any{ff.Timestamp: "asc"}},
+		"query": map[string]any{"bool": map[string]any{"filter": filters}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	u := strings.TrimSuffix(b.cfg.URL, "/") + "/" + url.PathEscape(b.cfg.ElasticIndex()) + "/_search"
+
+	raw, err := backendRequest(ctx, b.cfg, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	var resp elasticResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("invalid elasticsearch response: %w", err)
+	}
+
+	ll := make([]*LogItem, 0, len(resp.Hits.Hits))
+	for _, h := range resp.Hits.Hits {
+		ts, err := time.Parse(time.RFC3339Nano, fmt.Sprintf("%v", sourceField(h.Source, ff.Timestamp)))
+		if err != nil {
+			continue
+		}
+		msg, _ := sourceField(h.Source, ff.Message).(string)
+		co, _ := sourceField(h.Source, ff.Container).(string)
+		ll = append(ll, newBackendLogItem(ts, msg, co))
+	}
+
+	return ll, nil
+}
+
+// sourceField resolves a document field either flat or dotted ie kubernetes.pod_name.
+func sourceField(src map[string]any, field string) any {
+	if v, ok := src[field]; ok {
+		return v
+	}
+	head, tail, ok := strings.Cut(field, ".")
+	if !ok {
+		return nil
+	}
+	m, ok := src[head].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return sourceField(m, tail)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogBackend(t *testing.T) {
+	uu := map[string]struct {
+		cfg  *data.LogBackend
+		name string
+		err  string
+	}{
+		"none": {
+			err: "no log backend url configured",
+		},
+		"loki": {
+			cfg:  &data.LogBackend{Type: "Loki", URL: "http://loki"},
+			name: data.LokiBackend,
+		},
+		"elastic": {
+			cfg:  &data.LogBackend{Type: "elasticsearch", URL: "http://es"},
+			name: data.ElasticBackend,
+		},
+		"toast": {
+			cfg: &data.LogBackend{Type: "splunk", URL: "http://splunk"},
+			err: `unsupported log backend type "splunk"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b, err := dao.NewLogBackend(u.cfg)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.name, b.Name())
+		})
+	}
+}
+
+func TestLokiBackendQuery(t *testing.T) {
+	var (
+		query, limit string
+		org          string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/loki/api/v1/query_range", r.URL.Path)
+		query, limit, org = r.URL.Query().Get("query"), r.URL.Query().Get("limit"), r.Header.Get("X-Scope-OrgID")
+		_, _ = io.WriteString(w, `{"data": {"result": [
+			{"stream": {"container": "c2"}, "values": [["1704067202000000000", "duh"]]},
+			{"stream": {"container": "c1"}, "values": [["1704067200000000000", "[blee]"], ["1704067201000000000", "zorg"]]}
+		]}}`)
+	}))
+	defer srv.Close()
+
+	b, err := dao.NewLogBackend(&data.LogBackend{
+		Type:    data.LokiBackend,
+		URL:     srv.URL + "/",
+		Headers: map[string]string{"X-Scope-OrgID": "fred"},
+	})
+	require.NoError(t, err)
+	ll, err := b.Query(context.Background(), dao.LogQuery{
+		Namespace: "ns1",
+		Pod:       "p1",
+		From:      time.Unix(1704067200, 0),
+		Until:     time.Unix(1704070800, 0),
+		Limit:     10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `{namespace="ns1", pod="p1"}`, query)
+	assert.Equal(t, "10", limit)
+	assert.Equal(t, "fred", org)
+	require.Len(t, ll, 3)
+	assert.Equal(t, "2024-01-01T00:00:00Z [blee[]\n", string(ll[0].Bytes))
+	assert.Equal(t, "c1", ll[0].Container)
+	assert.Equal(t, "2024-01-01T00:00:01Z", ll[1].GetTimestamp())
+	assert.Equal(t, "c2", ll[2].Container)
+}
+
+func TestLokiBackendQueryFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "no org id", http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	b, err := dao.NewLogBackend(&data.LogBackend{Type: data.LokiBackend, URL: srv.URL})
+	require.NoError(t, err)
+	_, err = b.Query(context.Background(), dao.LogQuery{Namespace: "ns1", Pod: "p1", Container: "c1"})
+
+	assert.ErrorContains(t, err, "loki log backend query failed: 401 Unauthorized no org id")
+}
+
+func TestElasticBackendQuery(t *testing.T) {
+	var req map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/fluent-bit/_search", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = io.WriteString(w, `{"hits": {"hits": [
+			{"_source": {"@timestamp": "2024-01-01T00:00:00.5Z", "log": "blee\n", "kubernetes": {"container_name": "c1"}}},
+			{"_source": {"@timestamp": "bozo", "log": "toast"}},
+			{"_source": {"@timestamp": "2024-01-01T00:00:01Z", "log": "duh", "kubernetes.container_name": "c1"}}
+		]}}`)
+	}))
+	defer srv.Close()
+
+	b, err := dao.NewLogBackend(&data.LogBackend{Type: data.ElasticBackend, URL: srv.URL, Index: "fluent-bit"})
+	require.NoError(t, err)
+	ll, err := b.Query(context.Background(), dao.LogQuery{
+		Namespace: "ns1",
+		Pod:       "p1",
+		Container: "c1",
+		From:      time.Unix(1704067200, 0),
+		Until:     time.Unix(1704070800, 0),
+		Limit:     10,
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 10, req["size"], 0)
+	filters := req["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	assert.Len(t, filters, 4)
+	require.Len(t, ll, 2)
+	assert.Equal(t, "2024-01-01T00:00:00.5Z blee\n", string(ll[0].Bytes))
+	assert.Equal(t, "c1", ll[0].Container)
+	assert.Equal(t, "c1", ll[1].Container)
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+)
+
+const (
+	logCaptureExt = ".log"
+	megaByte      = 1024 * 1024
+)
+
+// LogCapture mirrors log lines into rotated files named after their pod and container.
+type LogCapture struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	files    map[string]*captureFile
+	last     map[string]time.Time
+	mx       sync.Mutex
+}
+
+type captureFile struct {
+	*os.File
+
+	size int64
+}
+
+// NewLogCapture returns a new log capture rooted at the given directory.
+func NewLogCapture(dir string, maxSizeMB, maxFiles int) *LogCapture {
+	return &LogCapture{
+		dir:      dir,
+		maxSize:  int64(maxSizeMB) * megaByte,
+		maxFiles: maxFiles,
+		files:    make(map[string]*captureFile),
+		last:     make(map[string]time.Time),
+	}
+}
+
+// Dir returns the capture directory.
+func (c *LogCapture) Dir() string {
+	return c.dir
+}
+
+// Write appends a log line to its capture file. Stream errors are not captured and
+// lines already captured ie when a stream restarts are skipped.
+func (c *LogCapture) Write(path string, item *LogItem) error {
+	if item == nil || item.IsEmpty() || item.IsError {
+		return nil
+	}
+	bb := item.Raw()
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	fpath := c.filePath(path, item)
+	if ts, err := time.Parse(time.RFC3339Nano, item.GetTimestamp()); err == nil {
+		if !ts.After(c.last[fpath]) {
+			return nil
+		}
+		c.last[fpath] = ts
+	}
+	f, err := c.open(fpath)
+	if err != nil {
+		return err
+	}
+	if c.maxSize > 0 && f.size > 0 && f.size+int64(len(bb)) > c.maxSize {
+		if f, err = c.rotate(fpath); err != nil {
+			return err
+		}
+	}
+	n, err := f.Write(bb)
+	f.size += int64(n)
+
+	return err
+}
+
+// Close closes all capture files.
+func (c *LogCapture) Close() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	var errs []string
+	for k, f := range c.files {
+		if err := f.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		delete(c.files, k)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("log capture close failed: %s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// filePath returns the capture file path ie dir/ns/pod/container.log.
+func (c *LogCapture) filePath(path string, item *LogItem) string {
+	ns, n := client.Namespaced(path)
+	if item.Pod != "" {
+		n = item.Pod
+	}
+	co := item.Container
+	if co == "" {
+		co = n
+	}
+
+	return filepath.Join(c.dir, data.SanitizeFileName(ns), data.SanitizeFileName(n), data.SanitizeFileName(co)+logCaptureExt)
+}
+
+func (c *LogCapture) open(fpath string) (*captureFile, error) {
+	if f, ok := c.files[fpath]; ok {
+		return f, nil
+	}
+	if err := data.EnsureFullPath(filepath.Dir(fpath), data.DefaultDirMod); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(fpath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, data.DefaultFileMod)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	cf := captureFile{File: f, size: info.Size()}
+	c.files[fpath] = &cf
+
+	return &cf, nil
+}
+
+// rotate shifts capture files ie c.log -> c.log.1 and drops files past the max.
+func (c *LogCapture) rotate(fpath string) (*captureFile, error) {
+	if f, ok := c.files[fpath]; ok {
+		_ = f.Close()
+		delete(c.files, fpath)
+	}
+	if c.maxFiles <= 1 {
+		if err := os.Remove(fpath); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return c.open(fpath)
+	}
+
+	_ = os.Remove(rotatedName(fpath, c.maxFiles-1))
+	for i := c.maxFiles - 2; i >= 1; i-- {
+		if err := os.Rename(rotatedName(fpath, i), rotatedName(fpath, i+1)); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	if err := os.Rename(fpath, rotatedName(fpath, 1)); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return c.open(fpath)
+}
+
+func rotatedName(fpath string, i int) string {
+	return fmt.Sprintf("%s.%d", fpath, i)
+}
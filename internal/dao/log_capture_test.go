@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCaptureWrite(t *testing.T) {
+	dir := t.TempDir()
+	c := NewLogCapture(dir, 1, 3)
+
+	require.NoError(t, c.Write("ns1/p1", &LogItem{Container: "c1", Bytes: []byte("2024-01-01T00:00:01Z [blee[] line1\n")}))
+	require.NoError(t, c.Write("ns1/p1", &LogItem{Container: "c1", Bytes: []byte("2024-01-01T00:00:02Z line2")}))
+	// Already captured lines are skipped.
+	require.NoError(t, c.Write("ns1/p1", &LogItem{Container: "c1", Bytes: []byte("2024-01-01T00:00:01Z [blee[] line1\n")}))
+	require.NoError(t, c.Write("ns1/p1", &LogItem{Container: "c1", Bytes: []byte("boom\n"), IsError: true}))
+	require.NoError(t, c.Write("ns1/deploy", &LogItem{Pod: "p2", Container: "c2", Bytes: []byte("2024-01-01T00:00:01Z line1\n")}))
+	require.NoError(t, c.Close())
+
+	bb, err := os.ReadFile(filepath.Join(dir, "ns1", "p1", "c1.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:01Z [blee] line1\n2024-01-01T00:00:02Z line2\n", string(bb))
+
+	bb, err = os.ReadFile(filepath.Join(dir, "ns1", "p2", "c2.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:01Z line1\n", string(bb))
+}
+
+func TestLogCaptureRotate(t *testing.T) {
+	dir := t.TempDir()
+	c := NewLogCapture(dir, 1, 3)
+	c.maxSize = 10
+	defer func() {
+		_ = c.Close()
+	}()
+
+	for _, l := range []string{"line-1", "line-2", "line-3", "line-4"} {
+		require.NoError(t, c.Write("ns1/p1", &LogItem{Container: "c1", Bytes: []byte(l)}))
+	}
+
+	fpath := filepath.Join(dir, "ns1", "p1", "c1.log")
+	for f, e := range map[string]string{
+		fpath:        "line-4\n",
+		fpath + ".1": "line-3\n",
+		fpath + ".2": "line-2\n",
+	} {
+		bb, err := os.ReadFile(f)
+		require.NoError(t, err)
+		assert.Equal(t, e, string(bb))
+	}
+	_, err := os.Stat(fpath + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+var (
+	// crashRX tracks log lines likely reporting a container crash.
+	crashRX = regexp.MustCompile(`(?i)(panic|fatal|segmentation fault|sigsegv|traceback|exception|oomkilled|killed|error)`)
+
+	// digitsRX normalizes volatile numbers ie durations, ids or ports when comparing lines.
+	digitsRX = regexp.MustCompile(`[0-9]+`)
+)
+
+// LogDiffLine represents a log line in a previous vs current instance logs comparison.
+type LogDiffLine struct {
+	// Text is the raw log line.
+	Text string
+
+	// Unique indicates the line does not appear in the other instance logs.
+	Unique bool
+}
+
+// LogDiff tracks a container previous vs current instance logs comparison.
+type LogDiff struct {
+	Previous, Current []LogDiffLine
+
+	// Crash is the index of the probable crash line in the previous logs or -1.
+	Crash int
+}
+
+// FetchLogs fetches a snapshot of a container current or previous instance timestamped logs.
+func (p *Pod) FetchLogs(ctx context.Context, path, co string, previous bool, lines int64) ([]string, error) {
+	req, err := p.Logs(path, &v1.PodLogOptions{
+		Container:  co,
+		Previous:   previous,
+		Timestamps: true,
+		TailLines:  &lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := req.DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ll := make([]string, 0, lines)
+	s := bufio.NewScanner(bytes.NewReader(raw))
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		ll = append(ll, s.Text())
+	}
+
+	return ll, s.Err()
+}
+
+// DiffLogs compares a container previous and current instance logs. Lines are compared
+// without their timestamps and volatile numbers.
+func DiffLogs(prev, curr []string) *LogDiff {
+	d := LogDiff{
+		Previous: diffLines(prev, normalizedSet(curr)),
+		Current:  diffLines(curr, normalizedSet(prev)),
+		Crash:    crashLine(prev),
+	}
+
+	return &d
+}
+
+func diffLines(ll []string, other map[string]struct{}) []LogDiffLine {
+	dd := make([]LogDiffLine, 0, len(ll))
+	for _, l := range ll {
+		_, ok := other[normalizeLogLine(l)]
+		dd = append(dd, LogDiffLine{Text: l, Unique: !ok})
+	}
+
+	return dd
+}
+
+func normalizedSet(ll []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ll))
+	for _, l := range ll {
+		set[normalizeLogLine(l)] = struct{}{}
+	}
+
+	return set
+}
+
+func normalizeLogLine(l string) string {
+	if _, msg, ok := strings.Cut(l, " "); ok {
+		l = msg
+	}
+
+	return digitsRX.ReplaceAllString(strings.TrimSpace(l), "#")
+}
+
+// crashLine returns the first line of the last run of crash like lines or the last line.
+func crashLine(ll []string) int {
+	crash := -1
+	for i := len(ll) - 1; i >= 0; i-- {
+		if crashRX.MatchString(ll[i]) {
+			crash = i
+			continue
+		}
+		if crash >= 0 {
+			break
+		}
+	}
+	if crash < 0 {
+		return len(ll) - 1
+	}
+
+	return crash
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLogs(t *testing.T) {
+	prev := []string{
+		"2024-01-01T00:00:00Z starting server on :8080",
+		"2024-01-01T00:00:01Z request took 12ms",
+		"2024-01-01T00:00:02Z cache warmed",
+		"2024-01-01T00:00:03Z panic: nil pointer dereference",
+		"2024-01-01T00:00:03Z goroutine 1 [running]: fatal",
+	}
+	curr := []string{
+		"2024-01-01T00:01:00Z starting server on :8080",
+		"2024-01-01T00:01:01Z request took 3ms",
+		"2024-01-01T00:01:02Z ready",
+	}
+
+	d := dao.DiffLogs(prev, curr)
+
+	assert.Equal(t, 3, d.Crash)
+	uu := make([]bool, 0, len(d.Previous))
+	for _, l := range d.Previous {
+		uu = append(uu, l.Unique)
+	}
+	assert.Equal(t, []bool{false, false, true, true, true}, uu)
+	uu = uu[:0]
+	for _, l := range d.Current {
+		uu = append(uu, l.Unique)
+	}
+	assert.Equal(t, []bool{false, false, true}, uu)
+}
+
+func TestDiffLogsNoCrash(t *testing.T) {
+	d := dao.DiffLogs([]string{"2024-01-01T00:00:00Z a", "2024-01-01T00:00:00Z b"}, nil)
+	assert.Equal(t, 1, d.Crash)
+
+	d = dao.DiffLogs(nil, nil)
+	assert.Equal(t, -1, d.Crash)
+}
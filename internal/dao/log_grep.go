@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// logGrepWorkers caps the count of concurrent container logs fetches.
+const logGrepWorkers = 8
+
+// LogMatch represents a log line matching a pattern.
+type LogMatch struct {
+	// Path is the pod fully qualified name.
+	Path string
+
+	// Container is the container name.
+	Container string
+
+	// Line is the timestamped log line.
+	Line string
+}
+
+// Timestamp returns the match timestamp.
+func (m LogMatch) Timestamp() string {
+	return NewLogItemFromString(m.Line).GetTimestamp()
+}
+
+// GrepLogs concurrently searches the logs of all containers of the pods matching a selector.
+// Matches are sorted by time. Containers logs that can't be fetched are reported as errors.
+func GrepLogs(ctx context.Context, f Factory, ns string, sel labels.Selector, rx *regexp.Regexp, lines int64) ([]LogMatch, error) {
+	oo, err := f.List(client.PodGVR, ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	var p Pod
+	p.Init(f, client.PodGVR)
+
+	type target struct {
+		path, co string
+	}
+	tt := make([]target, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expected unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		for _, co := range po.Spec.Containers {
+			tt = append(tt, target{path: client.FQN(po.Namespace, po.Name), co: co.Name})
+		}
+	}
+
+	var (
+		matches []LogMatch
+		errs    error
+		mx      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, logGrepWorkers)
+	)
+	for _, t := range tt {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ll, err := p.FetchLogs(ctx, t.path, t.co, false, lines)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s:%s logs failed: %w", t.path, t.co, err))
+				return
+			}
+			for _, l := range ll {
+				if rx.MatchString(l) {
+					matches = append(matches, LogMatch{Path: t.path, Container: t.co, Line: l})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Timestamp() < matches[j].Timestamp()
+	})
+
+	return matches, errs
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+// logFlagTTL tracks how long a pod stays flagged once its logs last matched a flagging rule.
+const logFlagTTL = 15 * time.Minute
+
+var (
+	logFlags   = map[string]logFlag{}
+	logFlagsMx sync.RWMutex
+)
+
+type logFlag struct {
+	pattern string
+	at      time.Time
+}
+
+type highlightRule struct {
+	config.LogHighlight
+
+	rx *regexp.Regexp
+}
+
+// LogHighlighter matches log lines against highlight rules.
+type LogHighlighter struct {
+	rules []highlightRule
+}
+
+// NewLogHighlighter returns a new highlighter. Invalid patterns are skipped.
+func NewLogHighlighter(hh []config.LogHighlight) *LogHighlighter {
+	h := LogHighlighter{rules: make([]highlightRule, 0, len(hh))}
+	for _, r := range hh {
+		rx, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			continue
+		}
+		if r.Color == "" {
+			r.Color = config.DefaultHighlightColor
+		}
+		h.rules = append(h.rules, highlightRule{LogHighlight: r, rx: rx})
+	}
+
+	return &h
+}
+
+// IsEmpty checks if there are any rules.
+func (h *LogHighlighter) IsEmpty() bool {
+	return h == nil || len(h.rules) == 0
+}
+
+// Match returns the first rule matching the log line.
+func (h *LogHighlighter) Match(item *LogItem) (config.LogHighlight, bool) {
+	if h.IsEmpty() {
+		return config.LogHighlight{}, false
+	}
+	bb := tviewUnescapeRX.ReplaceAll(item.Bytes, []byte(`[$1$2]`))
+	for _, r := range h.rules {
+		if r.rx.Match(bb) {
+			return r.LogHighlight, true
+		}
+	}
+
+	return config.LogHighlight{}, false
+}
+
+// highlight paints a rendered line background.
+func highlight(color string, line []byte, bb *bytes.Buffer) {
+	if len(line) == 0 {
+		return
+	}
+	eol := bytes.HasSuffix(line, []byte{'\n'})
+	bb.WriteString("[:" + color + ":]")
+	bb.Write(bytes.TrimSuffix(line, []byte{'\n'}))
+	bb.WriteString("[:-:]")
+	if eol {
+		bb.WriteByte('\n')
+	}
+}
+
+// FlagLogPod flags a pod whose logs matched a highlight rule.
+func FlagLogPod(fqn, pattern string) {
+	logFlagsMx.Lock()
+	defer logFlagsMx.Unlock()
+
+	logFlags[fqn] = logFlag{pattern: pattern, at: time.Now()}
+}
+
+// LogPodFlag returns the pattern a flagged pod logs last matched.
+func LogPodFlag(fqn string) (string, bool) {
+	logFlagsMx.RLock()
+	f, ok := logFlags[fqn]
+	logFlagsMx.RUnlock()
+	if !ok {
+		return "", false
+	}
+	if time.Since(f.at) > logFlagTTL {
+		logFlagsMx.Lock()
+		delete(logFlags, fqn)
+		logFlagsMx.Unlock()
+		return "", false
+	}
+
+	return f.pattern, true
+}
+
+// ClearLogFlags clears all pods flags.
+func ClearLogFlags() {
+	logFlagsMx.Lock()
+	defer logFlagsMx.Unlock()
+
+	clear(logFlags)
+}
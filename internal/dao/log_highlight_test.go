@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogPodFlag(t *testing.T) {
+	defer ClearLogFlags()
+
+	FlagLogPod("ns1/p1", "panic:")
+	p, ok := LogPodFlag("ns1/p1")
+	assert.True(t, ok)
+	assert.Equal(t, "panic:", p)
+
+	_, ok = LogPodFlag("ns1/p2")
+	assert.False(t, ok)
+
+	logFlagsMx.Lock()
+	logFlags["ns1/p1"] = logFlag{pattern: "panic:", at: time.Now().Add(-2 * logFlagTTL)}
+	logFlagsMx.Unlock()
+	_, ok = LogPodFlag("ns1/p1")
+	assert.False(t, ok)
+}
@@ -34,8 +34,11 @@ func NewLogItemFromString(s string) *LogItem {
 	}
 }
 
-// ID returns pod and or container based id.
+// ID returns the log line source id ie pod and or container.
 func (l *LogItem) ID() string {
+	if l.Pod != "" && l.Container != "" {
+		return l.Pod + "::" + l.Container
+	}
 	if l.Pod != "" {
 		return l.Pod
 	}
@@ -61,6 +64,16 @@ func (l *LogItem) IsEmpty() bool {
 	return len(l.Bytes) == 0
 }
 
+// Raw returns the original newline terminated log line ie without tview escapes.
+func (l *LogItem) Raw() []byte {
+	bb := tviewUnescapeRX.ReplaceAll(l.Bytes, []byte(`[$1$2]`))
+	if !bytes.HasSuffix(bb, []byte{'\n'}) {
+		bb = append(bb, '\n')
+	}
+
+	return bb
+}
+
 // Size returns the size of the item.
 func (l *LogItem) Size() int {
 	return 100 + len(l.Bytes) + len(l.Pod) + len(l.Container)
@@ -69,6 +82,16 @@ func (l *LogItem) Size() int {
 // Render returns a log line as string.
 func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 	index := bytes.Index(l.Bytes, []byte{' '})
+	l.renderPrefix(paint, showTime, index, bb)
+	if index > 0 {
+		bb.Write(l.Bytes[index+1:])
+	} else {
+		bb.Write(l.Bytes)
+	}
+}
+
+// renderPrefix renders the line timestamp and origin.
+func (l *LogItem) renderPrefix(paint string, showTime bool, index int, bb *bytes.Buffer) {
 	if showTime && index > 0 {
 		bb.WriteString("[gray::b]")
 		bb.Write(l.Bytes[:index])
@@ -91,10 +114,4 @@ func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 	} else if l.Pod != "" {
 		bb.WriteString("[-::] ")
 	}
-
-	if index > 0 {
-		bb.Write(l.Bytes[index+1:])
-	} else {
-		bb.Write(l.Bytes)
-	}
 }
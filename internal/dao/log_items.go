@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/sahilm/fuzzy"
 )
 
@@ -29,9 +30,11 @@ var podPalette = []string{
 
 // LogItems represents a collection of log items.
 type LogItems struct {
-	items     []*LogItem
-	podColors podColors
-	mx        sync.RWMutex
+	items       []*LogItem
+	podColors   podColors
+	json        *config.LogJSON
+	highlighter *LogHighlighter
+	mx          sync.RWMutex
 }
 
 // NewLogItems returns a new instance.
@@ -82,8 +85,10 @@ func (l *LogItems) Subset(index int) *LogItems {
 	defer l.mx.RUnlock()
 
 	return &LogItems{
-		items:     l.items[index:],
-		podColors: l.podColors,
+		items:       l.items[index:],
+		podColors:   l.podColors,
+		json:        l.json,
+		highlighter: l.highlighter,
 	}
 }
 
@@ -106,16 +111,48 @@ func (l *LogItems) Add(ii ...*LogItem) {
 	l.items = append(l.items, ii...)
 }
 
+// SetJSON sets the structured logs settings. Nil renders raw lines.
+func (l *LogItems) SetJSON(cfg *config.LogJSON) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.json = cfg
+}
+
+// SetHighlighter sets the log lines highlight rules.
+func (l *LogItems) SetHighlighter(h *LogHighlighter) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.highlighter = h
+}
+
+func (l *LogItems) render(item *LogItem, showTime bool, bb *bytes.Buffer) {
+	rule, ok := l.highlighter.Match(item)
+	if !ok {
+		l.renderLine(item, showTime, bb)
+		return
+	}
+	line := bytes.NewBuffer(make([]byte, 0, item.Size()))
+	l.renderLine(item, showTime, line)
+	highlight(rule.Color, line.Bytes(), bb)
+}
+
+func (l *LogItems) renderLine(item *LogItem, showTime bool, bb *bytes.Buffer) {
+	paint := l.podColorFor(item.ID())
+	if l.json != nil && item.RenderJSON(paint, showTime, l.json, bb) {
+		return
+	}
+	item.Render(paint, showTime, bb)
+}
+
 func (l *LogItems) podColorFor(id string) string {
 	color, ok := l.podColors[id]
 	if ok {
 		return color
 	}
-	var idx int
-	for i, r := range id {
-		idx += i * int(r)
-	}
-	l.podColors[id] = podPalette[idx%len(podPalette)]
+	// Assign colors in order of appearance so sources are told apart.
+	l.podColors[id] = podPalette[len(l.podColors)%len(podPalette)]
 
 	return l.podColors[id]
 }
@@ -127,7 +164,7 @@ func (l *LogItems) Lines(index int, showTime bool, ll [][]byte) {
 
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, showTime, bb)
 		ll[i] = bb.Bytes()
 	}
 }
@@ -140,7 +177,7 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 	ll := make([]string, len(l.items[index:]))
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, showTime, bb)
 		ll[i] = bb.String()
 	}
 
@@ -151,7 +188,7 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 func (l *LogItems) Render(index int, showTime bool, ll [][]byte) {
 	for i, item := range l.items[index:] {
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
-		item.Render(l.podColorFor(item.ID()), showTime, bb)
+		l.render(item, showTime, bb)
 		ll[i] = bb.Bytes()
 	}
 }
@@ -43,7 +43,7 @@ func TestLogItemsFilter(t *testing.T) {
 				Container: "c1",
 			},
 			e:       []int{0, 1, 2},
-			indices: [][]int{{26, 27}, {26, 27}, {26, 27}}, // matches container name "c1" at positions 26-27 in rendered format each line
+			indices: [][]int{{22, 23}, {22, 23}, {22, 23}}, // matches container name "c1" at positions 22-23 in rendered format each line
 		},
 		"message": {
 			q: "zorg",
@@ -68,7 +68,7 @@ func TestLogItemsFilter(t *testing.T) {
 				Container: "c1",
 			},
 			e:       []int{1, 2},
-			indices: [][]int{{45, 46, 47, 48, 59, 60, 61, 62}, {64, 65, 66, 67, 70, 71, 72, 73, 76, 77, 78, 79}},
+			indices: [][]int{{41, 42, 43, 44, 55, 56, 57, 58}, {60, 61, 62, 63, 66, 67, 68, 69, 72, 73, 74, 75}},
 		},
 	}
 
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogJSONAnnotation customizes structured logs for a pod. Suffix the key with .<container>
+// to target a given container. Values are either true, false or a JSON encoded settings object.
+const LogJSONAnnotation = "k9scli.io/log-json"
+
+var (
+	// tviewUnescapeRX reverts tview escaped tags.
+	tviewUnescapeRX = regexp.MustCompile(`\[([a-zA-Z0-9_,;: \-\."#]+)\[(\[*)\]`)
+
+	jsonTimeKeys  = []string{"time", "ts", "timestamp", "@timestamp"}
+	jsonLevelKeys = []string{"level", "lvl", "severity", "loglevel"}
+	jsonMsgKeys   = []string{"msg", "message"}
+
+	levelColors = map[string]string{
+		"trace": "gray",
+		"debug": "blue",
+		"info":  "green",
+		"warn":  "orange",
+		"error": "red",
+		"fatal": "red::b",
+	}
+)
+
+// ContainerLogJSON resolves structured logs settings for a container.
+// Pod annotations take precedence over the configuration.
+func ContainerLogJSON(m *metav1.ObjectMeta, co string, cfg config.LogJSON) config.LogJSON {
+	c := cfg.ForContainer(co)
+	if m == nil {
+		return c
+	}
+	kk := []string{LogJSONAnnotation}
+	if co != "" {
+		kk = append(kk, LogJSONAnnotation+"."+co)
+	}
+	for _, k := range kk {
+		v, ok := m.Annotations[k]
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true":
+			c.Enabled = true
+		case "false":
+			c.Enabled = false
+		default:
+			c.Enabled = true
+			if err := json.Unmarshal([]byte(v), &c); err != nil {
+				slog.Warn("Invalid structured logs annotation",
+					slogs.Annotation, k,
+					slogs.Error, err,
+				)
+			}
+		}
+	}
+
+	return c.Validate()
+}
+
+// RenderJSON renders a JSON log line using the structured settings. It returns false
+// when the line is not a JSON payload. Lines below the level threshold render empty.
+func (l *LogItem) RenderJSON(paint string, showTime bool, cfg *config.LogJSON, bb *bytes.Buffer) bool {
+	index := bytes.Index(l.Bytes, []byte{' '})
+	body := l.Bytes
+	if index > 0 && !bytes.HasPrefix(bytes.TrimSpace(l.Bytes), []byte("{")) {
+		body = l.Bytes[index+1:]
+	} else {
+		index = -1
+	}
+	fields, ok := parseJSONLog(body)
+	if !ok {
+		return false
+	}
+	if !levelAllowed(fields, cfg.Level) {
+		return true
+	}
+
+	l.renderPrefix(paint, showTime, index, bb)
+	keys := jsonFieldKeys(fields, cfg)
+	if cfg.Pretty {
+		renderPrettyJSON(fields, keys, bb)
+	} else {
+		renderFlatJSON(fields, keys, bb)
+	}
+	bb.WriteByte('\n')
+
+	return true
+}
+
+func parseJSONLog(bb []byte) (map[string]any, bool) {
+	bb = bytes.TrimSpace(bb)
+	if len(bb) < 2 || bb[0] != '{' {
+		return nil, false
+	}
+	bb = tviewUnescapeRX.ReplaceAll(bb, []byte(`[$1$2]`))
+
+	d := json.NewDecoder(bytes.NewReader(bb))
+	d.UseNumber()
+	var fields map[string]any
+	if err := d.Decode(&fields); err != nil {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// jsonLevel returns a normalized line level if any.
+func jsonLevel(fields map[string]any) (string, bool) {
+	for _, k := range jsonLevelKeys {
+		v, ok := fields[k]
+		if !ok {
+			continue
+		}
+		switch l := v.(type) {
+		case string:
+			if r := config.LevelRank(l); r >= 0 {
+				return config.LogLevels[r], true
+			}
+		case json.Number:
+			// Numeric levels ie pino/bunyan 10..60.
+			if n, err := l.Int64(); err == nil && n >= 10 && n <= 60 {
+				return config.LogLevels[n/10-1], true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// levelAllowed checks a line level against the threshold. Lines without a level pass.
+func levelAllowed(fields map[string]any, threshold string) bool {
+	if threshold == "" {
+		return true
+	}
+	level, ok := jsonLevel(fields)
+	if !ok {
+		return true
+	}
+
+	return config.LevelRank(level) >= config.LevelRank(threshold)
+}
+
+// jsonFieldKeys returns the fields to render. Well known fields come first.
+func jsonFieldKeys(fields map[string]any, cfg *config.LogJSON) []string {
+	if len(cfg.Fields) > 0 {
+		kk := make([]string, 0, len(cfg.Fields))
+		for _, k := range cfg.Fields {
+			if _, ok := fields[k]; ok && !slices.Contains(cfg.HiddenFields, k) {
+				kk = append(kk, k)
+			}
+		}
+		return kk
+	}
+
+	kk := make([]string, 0, len(fields))
+	for _, group := range [][]string{jsonTimeKeys, jsonLevelKeys, jsonMsgKeys} {
+		for _, k := range group {
+			if _, ok := fields[k]; ok {
+				kk = append(kk, k)
+			}
+		}
+	}
+	rest := make([]string, 0, len(fields))
+	for k := range fields {
+		if !slices.Contains(kk, k) {
+			rest = append(rest, k)
+		}
+	}
+	slices.Sort(rest)
+	kk = append(kk, rest...)
+
+	return slices.DeleteFunc(kk, func(k string) bool {
+		return slices.Contains(cfg.HiddenFields, k)
+	})
+}
+
+func renderFlatJSON(fields map[string]any, keys []string, bb *bytes.Buffer) {
+	for i, k := range keys {
+		if i > 0 {
+			bb.WriteByte(' ')
+		}
+		bb.WriteString("[gray::]" + tview.Escape(k) + "[-::]=")
+		v := flatJSONValue(fields[k])
+		if slices.Contains(jsonLevelKeys, k) {
+			if l, ok := jsonLevel(fields); ok {
+				bb.WriteString("[" + levelColors[l] + "]" + tview.Escape(v) + "[-::-]")
+				continue
+			}
+		}
+		bb.WriteString(tview.Escape(v))
+	}
+}
+
+func renderPrettyJSON(fields map[string]any, keys []string, bb *bytes.Buffer) {
+	bb.WriteString("{\n")
+	for i, k := range keys {
+		raw, err := json.MarshalIndent(fields[k], "  ", "  ")
+		if err != nil {
+			raw = []byte(strconv.Quote(fmt.Sprintf("%v", fields[k])))
+		}
+		bb.WriteString("  [gray::]" + tview.Escape(strconv.Quote(k)) + "[-::]: ")
+		bb.Write(tview.EscapeBytes(raw))
+		if i < len(keys)-1 {
+			bb.WriteByte(',')
+		}
+		bb.WriteByte('\n')
+	}
+	bb.WriteString("}")
+}
+
+func flatJSONValue(v any) string {
+	switch t := v.(type) {
+	case string:
+		if t == "" || strings.ContainsAny(t, " \t\n\"=") {
+			return strconv.Quote(t)
+		}
+		return t
+	case json.Number:
+		return t.String()
+	case nil:
+		return "null"
+	default:
+		raw, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(raw)
+	}
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogItemRenderJSON(t *testing.T) {
+	const ts = "2024-01-01T10:00:00.000000000Z "
+
+	uu := map[string]struct {
+		log  string
+		cfg  config.LogJSON
+		json bool
+		e    string
+	}{
+		"raw": {
+			log: ts + "not a json line\n",
+		},
+		"plain": {
+			log:  ts + `{"msg":"hello world","level":"info","user":"bob","n":1}` + "\n",
+			json: true,
+			e:    `[gray::]level[-::]=[green]info[-::-] [gray::]msg[-::]="hello world" [gray::]n[-::]=1 [gray::]user[-::]=bob` + "\n",
+		},
+		"fields": {
+			log:  ts + `{"msg":"hello","level":"info","user":"bob"}` + "\n",
+			cfg:  config.LogJSON{Fields: []string{"user", "msg", "blee"}},
+			json: true,
+			e:    "[gray::]user[-::]=bob [gray::]msg[-::]=hello\n",
+		},
+		"hidden": {
+			log:  ts + `{"msg":"hello","level":"info","user":"bob"}` + "\n",
+			cfg:  config.LogJSON{HiddenFields: []string{"level", "user"}},
+			json: true,
+			e:    "[gray::]msg[-::]=hello\n",
+		},
+		"below-level": {
+			log:  ts + `{"msg":"hello","level":"debug"}` + "\n",
+			cfg:  config.LogJSON{Level: "info"},
+			json: true,
+		},
+		"numeric-level": {
+			log:  ts + `{"msg":"boom","level":50}` + "\n",
+			cfg:  config.LogJSON{Level: "warn", Fields: []string{"level"}},
+			json: true,
+			e:    "[gray::]level[-::]=[red]50[-::-]\n",
+		},
+		"escaped": {
+			log:  tview.Escape(ts + `{"tags":["a"]}` + "\n"),
+			json: true,
+			e:    `[gray::]tags[-::]=["a"[]` + "\n",
+		},
+		"pretty": {
+			log:  ts + `{"msg":"hello","ctx":{"a":1}}` + "\n",
+			cfg:  config.LogJSON{Pretty: true},
+			json: true,
+			e:    "{\n  [gray::]\"msg\"[-::]: \"hello\",\n  [gray::]\"ctx\"[-::]: {\n    \"a\": 1\n  }\n}\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := dao.NewLogItemFromString(u.log)
+			bb := bytes.NewBuffer(nil)
+			assert.Equal(t, u.json, i.RenderJSON("yellow", false, &u.cfg, bb))
+			assert.Equal(t, u.e, bb.String())
+		})
+	}
+}
+
+func TestContainerLogJSON(t *testing.T) {
+	cfg := config.LogJSON{
+		Level: "info",
+		Containers: map[string]config.LogJSON{
+			"c1": {Enabled: true, Level: "error"},
+		},
+	}
+
+	uu := map[string]struct {
+		anns map[string]string
+		co   string
+		e    config.LogJSON
+	}{
+		"config": {
+			co: "c2",
+			e:  config.LogJSON{Level: "info"},
+		},
+		"config-container": {
+			co: "c1",
+			e:  config.LogJSON{Enabled: true, Level: "error"},
+		},
+		"pod-toggle": {
+			anns: map[string]string{dao.LogJSONAnnotation: "true"},
+			co:   "c2",
+			e:    config.LogJSON{Enabled: true, Level: "info"},
+		},
+		"container-annotation": {
+			anns: map[string]string{
+				dao.LogJSONAnnotation:         "false",
+				dao.LogJSONAnnotation + ".c2": `{"fields":["msg"],"level":"WARN"}`,
+			},
+			co: "c2",
+			e:  config.LogJSON{Enabled: true, Fields: []string{"msg"}, Level: "warn"},
+		},
+		"bad-annotation": {
+			anns: map[string]string{dao.LogJSONAnnotation: "{"},
+			co:   "c2",
+			e:    config.LogJSON{Enabled: true, Level: "info"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m := metav1.ObjectMeta{Annotations: u.anns}
+			assert.Equal(t, u.e, dao.ContainerLogJSON(&m, u.co, cfg))
+		})
+	}
+}
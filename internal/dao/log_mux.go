@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// LogMuxWindow represents how long lines are buffered to be ordered across streams.
+const LogMuxWindow = 250 * time.Millisecond
+
+// LogMux fans in multiple log streams into a single stream ordered by timestamp.
+type LogMux struct {
+	window time.Duration
+	last   map[string]time.Time
+}
+
+// NewLogMux returns a new log multiplexer.
+func NewLogMux(window time.Duration) *LogMux {
+	return &LogMux{
+		window: window,
+		last:   make(map[string]time.Time),
+	}
+}
+
+// Mux merges the given streams. Lines are buffered for the mux window so that lines
+// emitted concurrently by different sources get interleaved by timestamp.
+func (m *LogMux) Mux(ctx context.Context, cc []LogChan) LogChan {
+	if len(cc) == 1 {
+		return cc[0]
+	}
+
+	in := make(chan *LogItem, logChannelBuffer)
+	var wg sync.WaitGroup
+	for _, c := range cc {
+		wg.Add(1)
+		go func(c LogChan) {
+			defer wg.Done()
+			for item := range c {
+				select {
+				case in <- item:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(c)
+	}
+	go func() {
+		wg.Wait()
+		close(in)
+	}()
+
+	out := make(LogChan, logChannelBuffer)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(m.window)
+		defer ticker.Stop()
+
+		var buff []*LogItem
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					m.flush(ctx, buff, out)
+					return
+				}
+				if item == nil || item.IsEmpty() {
+					continue
+				}
+				buff = append(buff, item)
+			case <-ticker.C:
+				if !m.flush(ctx, buff, out) {
+					return
+				}
+				buff = buff[:0]
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func (m *LogMux) flush(ctx context.Context, buff []*LogItem, out chan<- *LogItem) bool {
+	for _, item := range m.sort(buff) {
+		select {
+		case out <- item:
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
+
+// sort orders lines by timestamp. Lines without a timestamp stick with their source previous line.
+func (m *LogMux) sort(buff []*LogItem) []*LogItem {
+	type entry struct {
+		item *LogItem
+		at   time.Time
+	}
+	ee := make([]entry, 0, len(buff))
+	for _, item := range buff {
+		id := item.ID()
+		at, err := time.Parse(time.RFC3339Nano, item.GetTimestamp())
+		if err != nil {
+			at = m.last[id]
+		}
+		m.last[id] = at
+		ee = append(ee, entry{item: item, at: at})
+	}
+	slices.SortStableFunc(ee, func(a, b entry) int {
+		return a.at.Compare(b.at)
+	})
+	for i := range ee {
+		buff[i] = ee[i].item
+	}
+
+	return buff
+}
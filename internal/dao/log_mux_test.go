@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogMux(t *testing.T) {
+	c1, c2 := make(dao.LogChan, 10), make(dao.LogChan, 10)
+	c1 <- &dao.LogItem{Container: "c1", Bytes: []byte("2026-01-01T10:00:00.000000001Z a\n")}
+	c1 <- &dao.LogItem{Container: "c1", Bytes: []byte("  more_a\n")}
+	c1 <- &dao.LogItem{Container: "c1", Bytes: []byte("2026-01-01T10:00:00.000000003Z c\n")}
+	c2 <- &dao.LogItem{Container: "c2", Bytes: []byte("2026-01-01T10:00:00.000000002Z b\n")}
+	c2 <- &dao.LogItem{Container: "c2", Bytes: []byte("2026-01-01T10:00:00.000000004Z d\n")}
+	close(c1)
+	close(c2)
+
+	out := dao.NewLogMux(time.Hour).Mux(context.Background(), []dao.LogChan{c1, c2})
+	var ll []string
+	for item := range out {
+		ll = append(ll, item.Container+":"+string(item.Bytes))
+	}
+
+	assert.Equal(t, []string{
+		"c1:2026-01-01T10:00:00.000000001Z a\n",
+		"c1:  more_a\n",
+		"c2:2026-01-01T10:00:00.000000002Z b\n",
+		"c1:2026-01-01T10:00:00.000000003Z c\n",
+		"c2:2026-01-01T10:00:00.000000004Z d\n",
+	}, ll)
+}
+
+func TestLogMuxSingle(t *testing.T) {
+	c := make(dao.LogChan)
+
+	assert.Equal(t, c, dao.NewLogMux(time.Second).Mux(context.Background(), []dao.LogChan{c}))
+}
+
+func TestLogItemsSourceColors(t *testing.T) {
+	ii := dao.NewLogItems()
+	ii.Add(
+		&dao.LogItem{Pod: "p1", Container: "c1", Bytes: []byte("blee\n")},
+		&dao.LogItem{Pod: "p1", Container: "c2", Bytes: []byte("blee\n")},
+		&dao.LogItem{Pod: "p1", Container: "c1", Bytes: []byte("blee\n")},
+	)
+	ll := ii.StrLines(0, false)
+
+	assert.Equal(t, "[teal::]p1 [teal::b]c1[-::-] blee\n", ll[0])
+	assert.Equal(t, "[green::]p1 [green::b]c2[-::-] blee\n", ll[1])
+	assert.Equal(t, ll[0], ll[2])
+}
@@ -19,6 +19,7 @@ type LogOptions struct {
 	Container        string
 	DefaultContainer string
 	SinceTime        string
+	From, Until      time.Time
 	Lines            int64
 	SinceSeconds     int64
 	Head             bool
@@ -51,6 +52,8 @@ func (o *LogOptions) Clone() *LogOptions {
 		MultiPods:        o.MultiPods,
 		ShowTimestamp:    o.ShowTimestamp,
 		SinceTime:        o.SinceTime,
+		From:             o.From,
+		Until:            o.Until,
 		SinceSeconds:     o.SinceSeconds,
 		AllContainers:    o.AllContainers,
 	}
@@ -93,6 +96,15 @@ func (o *LogOptions) ToPodLogOptions() *v1.PodLogOptions {
 		opts.LimitBytes = &maxBytes
 		return &opts
 	}
+	if o.HasTimeRange() {
+		if !o.From.IsZero() {
+			opts.TailLines, opts.SinceTime = nil, &metav1.Time{Time: o.From}
+		}
+		if !o.Until.IsZero() && o.Until.Before(time.Now()) {
+			opts.Follow = false
+		}
+		return &opts
+	}
 	if o.SinceSeconds < 0 {
 		return &opts
 	}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// logTimeLayouts tracks the supported absolute log time layouts.
+var logTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"15:04:05",
+	"15:04",
+}
+
+// ParseLogTime parses an absolute or relative log time. Relative times ie 30m, 2h or 1d
+// are relative to now. Absolute times without a timezone are local. Times without a date
+// refer to today.
+func ParseLogTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if s == "now" {
+		return now, nil
+	}
+	if d, err := parseLogDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, l := range logTimeLayouts {
+		t, err := time.ParseInLocation(l, s, now.Location())
+		if err != nil {
+			continue
+		}
+		if !strings.Contains(l, "2006") {
+			y, m, d := now.Date()
+			t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, now.Location())
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid log time %q. Use a duration ie 1h30m, 2d or a time ie 2006-01-02 15:04", s)
+}
+
+// parseLogDuration parses a duration with an optional days unit.
+func parseLogDuration(s string) (time.Duration, error) {
+	s = strings.TrimPrefix(s, "-")
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// HasTimeRange checks if logs are bound by a time range.
+func (o *LogOptions) HasTimeRange() bool {
+	return !o.From.IsZero() || !o.Until.IsZero()
+}
+
+// SetTimeRange bounds logs to a time range. Zero times leave the range open.
+func (o *LogOptions) SetTimeRange(from, until time.Time) error {
+	if !from.IsZero() && !until.IsZero() && !until.After(from) {
+		return fmt.Errorf("log time range end %s must be after its start %s", until.Format(time.DateTime), from.Format(time.DateTime))
+	}
+	o.From, o.Until, o.Head = from, until, false
+
+	return nil
+}
+
+// ClearTimeRange resets the logs time range.
+func (o *LogOptions) ClearTimeRange() {
+	o.From, o.Until = time.Time{}, time.Time{}
+}
+
+// IsPastRange checks if a log line timestamp is past the end of the time range.
+func (o *LogOptions) IsPastRange(ts string) bool {
+	if o.Until.IsZero() {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return false
+	}
+
+	return t.After(o.Until)
+}
+
+// TimeRange returns a human readable time range.
+func (o *LogOptions) TimeRange() string {
+	format := func(t time.Time, open string) string {
+		if t.IsZero() {
+			return open
+		}
+		return t.Local().Format("01-02 15:04:05")
+	}
+
+	return format(o.From, "...") + "→" + format(o.Until, "now")
+}
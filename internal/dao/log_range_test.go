@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 30, 0, 0, time.UTC)
+	uu := map[string]struct {
+		s   string
+		e   time.Time
+		err bool
+	}{
+		"blank":    {},
+		"now":      {s: "now", e: now},
+		"relative": {s: "1h30m", e: now.Add(-90 * time.Minute)},
+		"days":     {s: "2d", e: now.Add(-48 * time.Hour)},
+		"rfc3339":  {s: "2026-03-09T10:00:00Z", e: time.Date(2026, time.March, 9, 10, 0, 0, 0, time.UTC)},
+		"datetime": {s: "2026-03-09 10:15", e: time.Date(2026, time.March, 9, 10, 15, 0, 0, time.UTC)},
+		"clock":    {s: "08:05", e: time.Date(2026, time.March, 10, 8, 5, 0, 0, time.UTC)},
+		"toast":    {s: "yesterday", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ts, err := dao.ParseLogTime(u.s, now)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, u.e.Equal(ts), "expected %s got %s", u.e, ts)
+		})
+	}
+}
+
+func TestLogOptionsTimeRange(t *testing.T) {
+	from := time.Now().Add(-2 * time.Hour).UTC()
+	until := from.Add(time.Hour)
+
+	var o dao.LogOptions
+	o.Lines = 100
+	require.Error(t, o.SetTimeRange(until, from))
+	require.NoError(t, o.SetTimeRange(from, until))
+
+	opts := o.ToPodLogOptions()
+	assert.Nil(t, opts.TailLines)
+	assert.Nil(t, opts.SinceSeconds)
+	assert.True(t, from.Equal(opts.SinceTime.Time))
+	assert.False(t, opts.Follow)
+
+	assert.False(t, o.IsPastRange(until.Format(time.RFC3339Nano)))
+	assert.True(t, o.IsPastRange(until.Add(time.Second).Format(time.RFC3339Nano)))
+	assert.False(t, o.IsPastRange("toast"))
+
+	o.ClearTimeRange()
+	assert.False(t, o.HasTimeRange())
+	assert.Equal(t, int64(100), *o.ToPodLogOptions().TailLines)
+}
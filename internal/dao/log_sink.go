@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	// sinkQueueSize caps the count of pending lines when a sink lags behind.
+	sinkQueueSize = 1_000
+
+	// sinkErrMaxLen caps sink errors output.
+	sinkErrMaxLen = 256
+)
+
+// LogSink represents an external log lines destination.
+type LogSink interface {
+	// Write sends a log line.
+	Write(item *LogItem) error
+
+	// Close flushes the sink and reports delivery errors.
+	Close() error
+}
+
+// LogSinkSpec describes a log sink endpoint.
+type LogSinkSpec struct {
+	// URL is the HTTP endpoint. When blank lines are piped to the command.
+	URL     string
+	Method  string
+	Headers map[string]string
+
+	// Binary and Args describe the command to pipe lines to.
+	Binary string
+	Args   []string
+
+	// Env is the command environment ie KEY=value.
+	Env []string
+
+	// Stream drops lines rather than stalling log tailing when the sink lags.
+	// Dropped lines are reported on close.
+	Stream bool
+}
+
+// NewLogSink returns a new log sink streaming lines to either an HTTP endpoint
+// or a command standard input. Lines are queued so slow sinks don't stall log tailing.
+func NewLogSink(ctx context.Context, spec LogSinkSpec) (LogSink, error) {
+	pr, pw := io.Pipe()
+
+	var run func() error
+	if spec.URL != "" {
+		req, err := sinkRequest(ctx, spec, pr)
+		if err != nil {
+			return nil, err
+		}
+		run = func() error {
+			defer pr.Close()
+			return sendRequest(req)
+		}
+	} else {
+		if spec.Binary == "" {
+			return nil, fmt.Errorf("log sink requires either a url or a command")
+		}
+		cmd := exec.CommandContext(ctx, spec.Binary, spec.Args...)
+		cmd.Env = append(os.Environ(), spec.Env...)
+		cmd.Stdin = pr
+		var out bytes.Buffer
+		cmd.Stdout, cmd.Stderr = &out, &out
+		if err := cmd.Start(); err != nil {
+			return nil, err
+		}
+		run = func() error {
+			err := cmd.Wait()
+			// Unblock pending writes when the command exits early.
+			_ = pr.Close()
+			if err != nil {
+				return fmt.Errorf("log sink %s failed: %w %s", spec.Binary, err, truncateOutput(out.String()))
+			}
+			return nil
+		}
+	}
+	s := logSink{
+		ctx:    ctx,
+		w:      pw,
+		queue:  make(chan []byte, sinkQueueSize),
+		exited: make(chan struct{}),
+		lossy:  spec.Stream,
+	}
+	go func() {
+		s.err = run()
+		close(s.exited)
+	}()
+	s.wg.Add(1)
+	go s.drain()
+
+	return &s, nil
+}
+
+func sinkRequest(ctx context.Context, spec LogSinkSpec, body io.Reader) (*http.Request, error) {
+	method := strings.ToUpper(spec.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, spec.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	for k, v := range spec.Headers {
+		req.Header.Set(k, os.ExpandEnv(v))
+	}
+
+	return req, nil
+}
+
+func sendRequest(req *http.Request) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, sinkErrMaxLen))
+		return fmt.Errorf("log sink %s returned %s %s", req.URL.Redacted(), resp.Status, truncateOutput(string(raw)))
+	}
+
+	return nil
+}
+
+func truncateOutput(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > sinkErrMaxLen {
+		return s[:sinkErrMaxLen] + "..."
+	}
+
+	return s
+}
+
+type logSink struct {
+	ctx     context.Context
+	w       *io.PipeWriter
+	queue   chan []byte
+	exited  chan struct{}
+	err     error
+	lossy   bool
+	dropped int
+	closed  bool
+	wg      sync.WaitGroup
+	mx      sync.Mutex
+}
+
+// Write queues a log line. Writes block while the sink lags behind unless
+// streaming in which case lines are dropped.
+func (s *logSink) Write(item *LogItem) error {
+	if item == nil || item.IsEmpty() || item.IsError {
+		return nil
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.closed {
+		return io.ErrClosedPipe
+	}
+	select {
+	case <-s.exited:
+		return s.exitErr()
+	default:
+	}
+	if s.lossy {
+		select {
+		case s.queue <- item.Raw():
+		default:
+			s.dropped++
+		}
+		return nil
+	}
+	select {
+	case s.queue <- item.Raw():
+		return nil
+	case <-s.exited:
+		return s.exitErr()
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// exitErr returns why the sink stopped accepting lines.
+func (s *logSink) exitErr() error {
+	if s.err != nil {
+		return s.err
+	}
+
+	return io.ErrClosedPipe
+}
+
+// Close flushes pending lines and waits for the sink to complete.
+func (s *logSink) Close() error {
+	s.mx.Lock()
+	if s.closed {
+		s.mx.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.queue)
+	s.mx.Unlock()
+
+	s.wg.Wait()
+	_ = s.w.Close()
+	<-s.exited
+	if s.dropped > 0 {
+		slog.Warn("Log sink dropped lines", slogs.Count, s.dropped)
+		return errors.Join(s.err, fmt.Errorf("log sink dropped %d lines while lagging behind", s.dropped))
+	}
+
+	return s.err
+}
+
+func (s *logSink) drain() {
+	defer s.wg.Done()
+	for bb := range s.queue {
+		if _, err := s.w.Write(bb); err != nil {
+			// Sink is gone. Keep draining so writers never block.
+			continue
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSinkHTTP(t *testing.T) {
+	var (
+		body   string
+		method string
+		org    string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		body, method, org = string(raw), r.Method, r.Header.Get("X-Scope-OrgID")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{
+		URL:     srv.URL,
+		Method:  "put",
+		Headers: map[string]string{"X-Scope-OrgID": "fred"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Write(dao.NewLogItem([]byte(tview.Escape("2024-01-01T00:00:00Z [info] blee\n")))))
+	require.NoError(t, s.Write(dao.NewLogItem([]byte("2024-01-01T00:00:01Z duh"))))
+	require.NoError(t, s.Write(dao.NewLogItemFromString("")))
+	require.NoError(t, s.Close())
+
+	assert.Equal(t, "2024-01-01T00:00:00Z [info] blee\n2024-01-01T00:00:01Z duh\n", body)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "fred", org)
+}
+
+func TestLogSinkHTTPFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "nope", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{URL: srv.URL})
+	require.NoError(t, err)
+	require.NoError(t, s.Write(dao.NewLogItemFromString("blee")))
+
+	assert.ErrorContains(t, s.Close(), "403 Forbidden nope")
+}
+
+func TestLogSinkCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.log")
+	s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{
+		Binary: "sh",
+		Args:   []string{"-c", `cat > "$OUT"`},
+		Env:    []string{"OUT=" + out},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Write(dao.NewLogItemFromString("blee")))
+	require.NoError(t, s.Write(dao.NewLogItemFromString("duh")))
+	require.NoError(t, s.Close())
+	require.NoError(t, s.Close())
+
+	bb, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "blee\nduh\n", string(bb))
+	assert.Error(t, s.Write(dao.NewLogItemFromString("zorg")))
+}
+
+func TestLogSinkLagging(t *testing.T) {
+	line := strings.Repeat("x", 1_024)
+	uu := map[string]struct {
+		stream bool
+		err    string
+	}{
+		"blocking": {},
+		"stream": {
+			stream: true,
+			err:    "log sink dropped",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "out.log")
+			s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{
+				Binary: "sh",
+				Args:   []string{"-c", `sleep 0.2; cat > "$OUT"`},
+				Env:    []string{"OUT=" + out},
+				Stream: u.stream,
+			})
+			require.NoError(t, err)
+			for range 5_000 {
+				require.NoError(t, s.Write(dao.NewLogItemFromString(line)))
+			}
+			err = s.Close()
+			if u.err != "" {
+				assert.ErrorContains(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			bb, err := os.ReadFile(out)
+			require.NoError(t, err)
+			assert.Equal(t, 5_000, strings.Count(string(bb), "\n"))
+		})
+	}
+}
+
+func TestLogSinkWriteExited(t *testing.T) {
+	s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{
+		Binary: "sh",
+		Args:   []string{"-c", "echo boom; exit 1"},
+	})
+	require.NoError(t, err)
+
+	line := strings.Repeat("x", 1_024)
+	assert.Eventually(t, func() bool {
+		return s.Write(dao.NewLogItemFromString(line)) != nil
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.ErrorContains(t, s.Close(), "boom")
+}
+
+func TestLogSinkCommandFailed(t *testing.T) {
+	s, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{
+		Binary: "sh",
+		Args:   []string{"-c", "echo boom; exit 1"},
+	})
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, s.Close(), "boom")
+}
+
+func TestLogSinkNoEndpoint(t *testing.T) {
+	_, err := dao.NewLogSink(context.Background(), dao.LogSinkSpec{})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,489 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MonitorPathAnnotation overrides a service or ingress probed url path.
+const MonitorPathAnnotation = "k9scli.io/monitor-path"
+
+const (
+	probeHistory  = 100
+	probeBodySize = 64 * 1024
+)
+
+// ProbeResult represents a health check outcome.
+type ProbeResult struct {
+	Time    time.Time
+	Code    int
+	Latency time.Duration
+	Err     error
+}
+
+// OK checks if the probe succeeded.
+func (r ProbeResult) OK() bool {
+	return r.Err == nil
+}
+
+// MonitorListener gets notified when a probe goes down or recovers.
+type MonitorListener interface {
+	ProbeChanged(*render.ProbeRes)
+}
+
+// Probe periodically checks a http endpoint health.
+type Probe struct {
+	gvr      *client.GVR
+	fqn      string
+	url      string
+	client   *http.Client
+	results  []ProbeResult
+	checks   int
+	failures int
+	since    time.Time
+	cancelFn context.CancelFunc
+	mx       sync.RWMutex
+}
+
+// NewProbe returns a new probe.
+func NewProbe(gvr *client.GVR, fqn, url string, transport http.RoundTripper) *Probe {
+	return &Probe{
+		gvr: gvr,
+		fqn: fqn,
+		url: url,
+		client: &http.Client{
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// ProbeID returns a probe id for a given resource.
+func ProbeID(gvr *client.GVR, fqn string) string {
+	return gvr.R() + ":" + fqn
+}
+
+// ID returns the probe id.
+func (p *Probe) ID() string {
+	return ProbeID(p.gvr, p.fqn)
+}
+
+// URL returns the probed url.
+func (p *Probe) URL() string {
+	return p.url
+}
+
+// Check issues a single health check.
+func (p *Probe) Check(ctx context.Context, timeout time.Duration) ProbeResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := ProbeResult{Time: time.Now()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, http.NoBody)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	resp, err := p.client.Do(req)
+	r.Latency = time.Since(r.Time)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, probeBodySize))
+
+	r.Code = resp.StatusCode
+	if r.Code >= http.StatusBadRequest {
+		r.Err = fmt.Errorf("unexpected status %d", r.Code)
+	}
+
+	return r
+}
+
+// record tracks a check outcome and returns true if the probe state changed.
+func (p *Probe) record(r ProbeResult) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	was := p.stateLocked()
+	p.checks++
+	if !r.OK() {
+		p.failures++
+	}
+	p.results = append(p.results, r)
+	if len(p.results) > probeHistory {
+		p.results = p.results[len(p.results)-probeHistory:]
+	}
+	is := p.stateLocked()
+	if is != was {
+		p.since = r.Time
+	}
+
+	return is != was && (is == render.ProbeDown || was == render.ProbeDown)
+}
+
+func (p *Probe) stateLocked() string {
+	if len(p.results) == 0 {
+		return render.ProbePending
+	}
+	if p.results[len(p.results)-1].OK() {
+		return render.ProbeUp
+	}
+
+	return render.ProbeDown
+}
+
+// Stats returns the probe health summary.
+func (p *Probe) Stats() *render.ProbeRes {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	s := render.ProbeRes{
+		ID:       p.ID(),
+		Kind:     p.gvr.R(),
+		FQN:      p.fqn,
+		URL:      p.url,
+		State:    p.stateLocked(),
+		Checks:   p.checks,
+		Failures: p.failures,
+		Since:    p.since,
+	}
+	if len(p.results) == 0 {
+		return &s
+	}
+	var (
+		sum time.Duration
+		ok  int
+	)
+	for _, r := range p.results {
+		sum += r.Latency
+		if r.OK() {
+			ok++
+		}
+	}
+	last := p.results[len(p.results)-1]
+	s.Code, s.Latency, s.LastCheck = last.Code, last.Latency, last.Time
+	if last.Err != nil {
+		s.LastError = last.Err.Error()
+	}
+	s.AvgLatency = sum / time.Duration(len(p.results))
+	s.Uptime = float64(ok) * 100 / float64(len(p.results))
+
+	return &s
+}
+
+// HealthMonitor runs synthetic health checks.
+type HealthMonitor struct {
+	probes   map[string]*Probe
+	interval time.Duration
+	timeout  time.Duration
+	listener MonitorListener
+	mx       sync.RWMutex
+}
+
+// monitor tracks the session health probes.
+var monitor = NewHealthMonitor()
+
+// NewHealthMonitor returns a new monitor.
+func NewHealthMonitor() *HealthMonitor {
+	return &HealthMonitor{
+		probes:   make(map[string]*Probe),
+		interval: config.DefaultMonitorInterval,
+		timeout:  config.DefaultMonitorTimeout,
+	}
+}
+
+// Monitor returns the session health monitor.
+func Monitor() *HealthMonitor {
+	return monitor
+}
+
+// Configure sets the probes interval and timeout.
+func (m *HealthMonitor) Configure(interval, timeout time.Duration) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.interval, m.timeout = interval, timeout
+}
+
+// SetListener registers a probes state listener.
+func (m *HealthMonitor) SetListener(l MonitorListener) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.listener = l
+}
+
+// Has checks if a probe is active.
+func (m *HealthMonitor) Has(id string) bool {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	_, ok := m.probes[id]
+
+	return ok
+}
+
+// Add starts probing. An existing probe with the same id is replaced.
+func (m *HealthMonitor) Add(p *Probe) {
+	m.Remove(p.ID())
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(context.Background())
+	p.mx.Lock()
+	p.since = time.Now()
+	p.mx.Unlock()
+	m.mx.Lock()
+	m.probes[p.ID()] = p
+	interval, timeout := m.interval, m.timeout
+	m.mx.Unlock()
+
+	go m.run(ctx, p, interval, timeout)
+}
+
+// Remove stops a probe.
+func (m *HealthMonitor) Remove(id string) bool {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	p, ok := m.probes[id]
+	if !ok {
+		return false
+	}
+	p.cancelFn()
+	delete(m.probes, id)
+
+	return true
+}
+
+// Clear stops all probes.
+func (m *HealthMonitor) Clear() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for id, p := range m.probes {
+		p.cancelFn()
+		delete(m.probes, id)
+	}
+}
+
+// Stats returns all probes health summaries ordered by id.
+func (m *HealthMonitor) Stats() []*render.ProbeRes {
+	m.mx.RLock()
+	ss := make([]*render.ProbeRes, 0, len(m.probes))
+	for _, p := range m.probes {
+		ss = append(ss, p.Stats())
+	}
+	m.mx.RUnlock()
+	slices.SortFunc(ss, func(a, b *render.ProbeRes) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return ss
+}
+
+// Failing returns the count of probes currently down.
+func (m *HealthMonitor) Failing() int {
+	var n int
+	for _, s := range m.Stats() {
+		if s.State == render.ProbeDown {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (m *HealthMonitor) run(ctx context.Context, p *Probe, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r := p.Check(ctx, timeout)
+		if ctx.Err() != nil {
+			return
+		}
+		if !r.OK() {
+			slog.Debug("Health probe failed",
+				slogs.URL, p.url,
+				slogs.Error, r.Err,
+			)
+		}
+		if p.record(r) {
+			m.notify(p.Stats())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *HealthMonitor) notify(s *render.ProbeRes) {
+	m.mx.RLock()
+	l := m.listener
+	m.mx.RUnlock()
+	if l != nil {
+		l.ProbeChanged(s)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Probes...
+
+// NewServiceProbe returns a probe checking a service first port via a port-forward tunnel.
+func NewServiceProbe(f Factory, fqn, path string) (*Probe, error) {
+	o, err := f.Get(client.SvcGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var svc v1.Service
+	if err := toTyped(o, &svc); err != nil {
+		return nil, fmt.Errorf("unable to locate service %s: %w", fqn, err)
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return nil, fmt.Errorf("external service %s can not be monitored", fqn)
+	}
+	var sp *v1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Protocol == "" || svc.Spec.Ports[i].Protocol == v1.ProtocolTCP {
+			sp = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if sp == nil {
+		return nil, fmt.Errorf("service %s does not expose a tcp port", fqn)
+	}
+	scheme := "http"
+	if sp.Port == 443 || strings.Contains(sp.Name, "https") {
+		scheme = "https"
+	}
+	host := net.JoinHostPort(svc.Name+"."+svc.Namespace, strconv.Itoa(int(sp.Port)))
+	d := NewClusterDialer(f, svc.Namespace)
+	transport := &http.Transport{
+		DialContext:       d.DialContext,
+		DisableKeepAlives: true,
+		// Cluster certs seldom match tunneled hosts. Probes only assert availability.
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+	}
+
+	return NewProbe(client.SvcGVR, fqn, scheme+"://"+host+probePath(svc.Annotations, path), transport), nil
+}
+
+// NewIngressProbe returns a probe checking an ingress first rule.
+func NewIngressProbe(f Factory, fqn, path string) (*Probe, error) {
+	o, err := f.Get(client.IngGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ing netv1.Ingress
+	if err := toTyped(o, &ing); err != nil {
+		return nil, fmt.Errorf("unable to locate ingress %s: %w", fqn, err)
+	}
+	u, err := ingressURL(&ing)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		Proxy:             http.ProxyFromEnvironment,
+		DisableKeepAlives: true,
+	}
+	if pp, ok := ing.Annotations[MonitorPathAnnotation]; ok && pp != "" {
+		path = pp
+	} else if p := ingressPath(&ing); p != "" {
+		path = p
+	}
+
+	return NewProbe(client.IngGVR, fqn, u+probePath(nil, path), transport), nil
+}
+
+func ingressURL(ing *netv1.Ingress) (string, error) {
+	var host string
+	for _, r := range ing.Spec.Rules {
+		if r.Host != "" && !strings.HasPrefix(r.Host, "*") {
+			host = r.Host
+			break
+		}
+	}
+	if host == "" {
+		for _, lb := range ing.Status.LoadBalancer.Ingress {
+			if lb.Hostname != "" {
+				host = lb.Hostname
+				break
+			}
+			if lb.IP != "" {
+				host = lb.IP
+				break
+			}
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("no host or load balancer address found on ingress %s", client.FQN(ing.Namespace, ing.Name))
+	}
+	scheme := "http"
+	for _, t := range ing.Spec.TLS {
+		if len(t.Hosts) == 0 || slices.Contains(t.Hosts, host) {
+			scheme = "https"
+			break
+		}
+	}
+
+	return scheme + "://" + host, nil
+}
+
+// ingressPath returns the first literal rule path.
+func ingressPath(ing *netv1.Ingress) string {
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			if p.PathType != nil && *p.PathType == netv1.PathTypeImplementationSpecific {
+				continue
+			}
+			if p.Path != "" {
+				return p.Path
+			}
+		}
+	}
+
+	return ""
+}
+
+func probePath(annotations map[string]string, path string) string {
+	if p, ok := annotations[MonitorPathAnnotation]; ok && p != "" {
+		path = p
+	}
+	if path == "" {
+		return config.DefaultMonitorPath
+	}
+	if !strings.HasPrefix(path, "/") {
+		return "/" + path
+	}
+
+	return path
+}
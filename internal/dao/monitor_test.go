@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestProbeCheck(t *testing.T) {
+	var fail atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	p := dao.NewProbe(client.SvcGVR, "ns1/fred", srv.URL, http.DefaultTransport)
+	r := p.Check(context.Background(), time.Second)
+	require.True(t, r.OK())
+	assert.Equal(t, http.StatusNoContent, r.Code)
+
+	fail.Store(true)
+	r = p.Check(context.Background(), time.Second)
+	assert.False(t, r.OK())
+	assert.Equal(t, http.StatusServiceUnavailable, r.Code)
+}
+
+type probeListener struct {
+	events chan *render.ProbeRes
+}
+
+func (l *probeListener) ProbeChanged(p *render.ProbeRes) {
+	l.events <- p
+}
+
+func TestHealthMonitor(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	m := dao.NewHealthMonitor()
+	m.Configure(10*time.Millisecond, time.Second)
+	l := probeListener{events: make(chan *render.ProbeRes, 10)}
+	m.SetListener(&l)
+
+	p := dao.NewProbe(client.SvcGVR, "ns1/fred", srv.URL, http.DefaultTransport)
+	m.Add(p)
+	defer m.Clear()
+	assert.True(t, m.Has(p.ID()))
+
+	e := <-l.events
+	assert.Equal(t, render.ProbeDown, e.State)
+	assert.Equal(t, "unexpected status 500", e.LastError)
+	assert.Equal(t, 1, m.Failing())
+
+	fail.Store(false)
+	e = <-l.events
+	assert.Equal(t, render.ProbeUp, e.State)
+
+	ss := m.Stats()
+	require.Len(t, ss, 1)
+	assert.Equal(t, "services:ns1/fred", ss[0].ID)
+	assert.Equal(t, http.StatusOK, ss[0].Code)
+	assert.GreaterOrEqual(t, ss[0].Checks, 2)
+	assert.Positive(t, ss[0].Failures)
+	assert.Less(t, ss[0].Uptime, 100.0)
+
+	assert.True(t, m.Remove(p.ID()))
+	assert.False(t, m.Remove(p.ID()))
+	assert.Empty(t, m.Stats())
+}
+
+func TestNewServiceProbe(t *testing.T) {
+	f := monitorFactory()
+
+	uu := map[string]struct {
+		fqn, path, url string
+		err            bool
+	}{
+		"plain":      {fqn: "ns1/fred", path: "/", url: "http://fred.ns1:8080/"},
+		"annotation": {fqn: "ns1/blee", path: "/", url: "https://blee.ns1:443/healthz"},
+		"external":   {fqn: "ns1/ext", err: true},
+		"missing":    {fqn: "ns1/zorg", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := dao.NewServiceProbe(&f, u.fqn, u.path)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.url, p.URL())
+		})
+	}
+}
+
+func TestNewIngressProbe(t *testing.T) {
+	f := monitorFactory()
+
+	uu := map[string]struct {
+		fqn, url string
+		err      bool
+	}{
+		"rule": {fqn: "ns1/fred", url: "https://fred.example.com/api"},
+		"lb":   {fqn: "ns1/blee", url: "http://10.0.0.1/"},
+		"none": {fqn: "ns1/zorg", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := dao.NewIngressProbe(&f, u.fqn, "/")
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.url, p.URL())
+		})
+	}
+}
+
+// Helpers...
+
+func monitorFactory() testFactory {
+	newObj := func(n string, annotations map[string]any, spec, status map[string]any) *unstructured.Unstructured {
+		md := map[string]any{"name": n, "namespace": "ns1"}
+		if annotations != nil {
+			md["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]any{"metadata": md, "spec": spec, "status": status}}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {
+			client.SvcGVR: {
+				newObj("fred", nil, map[string]any{
+					"ports": []any{map[string]any{"name": "http", "port": int64(8080), "protocol": "TCP"}},
+				}, nil),
+				newObj("blee", map[string]any{dao.MonitorPathAnnotation: "healthz"}, map[string]any{
+					"ports": []any{
+						map[string]any{"name": "dns", "port": int64(53), "protocol": "UDP"},
+						map[string]any{"name": "https", "port": int64(443)},
+					},
+				}, nil),
+				newObj("ext", nil, map[string]any{"type": "ExternalName", "externalName": "example.com"}, nil),
+			},
+			client.IngGVR: {
+				newObj("fred", nil, map[string]any{
+					"tls": []any{map[string]any{"hosts": []any{"fred.example.com"}}},
+					"rules": []any{map[string]any{
+						"host": "fred.example.com",
+						"http": map[string]any{"paths": []any{map[string]any{"path": "/api", "pathType": "Prefix"}}},
+					}},
+				}, nil),
+				newObj("blee", nil, map[string]any{}, map[string]any{
+					"loadBalancer": map[string]any{"ingress": []any{map[string]any{"ip": "10.0.0.1"}}},
+				}),
+				newObj("zorg", nil, map[string]any{}, nil),
+			},
+		},
+	}}
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Monitors)(nil)
+	_ Nuker    = (*Monitors)(nil)
+)
+
+// Monitors represents the session health probes.
+type Monitors struct {
+	NonResource
+}
+
+// Delete stops a health probe.
+func (*Monitors) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	Monitor().Remove(path)
+
+	return nil
+}
+
+// List returns the health probes summaries.
+func (*Monitors) List(context.Context, string) ([]runtime.Object, error) {
+	ss := Monitor().Stats()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		oo = append(oo, s)
+	}
+
+	return oo, nil
+}
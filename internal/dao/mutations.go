@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Mutations)(nil)
+
+// Mutations represents the session mutations journal.
+type Mutations struct {
+	NonResource
+}
+
+// List returns the journaled mutations, most recent first.
+func (*Mutations) List(context.Context, string) ([]runtime.Object, error) {
+	ee := Journal().Entries()
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, &render.MutationRes{
+			ID:     e.ID,
+			Op:     string(e.Op),
+			GVR:    e.GVR.String(),
+			FQN:    e.FQN,
+			Undone: e.Undone,
+			Time:   e.Time,
+		})
+	}
+
+	return oo, nil
+}
+
+// Manifest returns the resource manifest prior to a given mutation.
+func (*Mutations) Manifest(path string) (string, error) {
+	id, err := strconv.Atoi(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid mutation id: %q", path)
+	}
+	e, ok := Journal().Entry(id)
+	if !ok {
+		return "", fmt.Errorf("no mutation found for id %d", id)
+	}
+
+	return ToYAML(e.Prior, false)
+}
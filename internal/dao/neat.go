@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// neatMetaFields tracks server populated metadata fields.
+	neatMetaFields = []string{
+		"managedFields",
+		"resourceVersion",
+		"uid",
+		"creationTimestamp",
+		"generation",
+		"selfLink",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"ownerReferences",
+	}
+
+	// neatAnnotations tracks server or client tooling annotations.
+	neatAnnotations = []string{
+		"kubectl.kubernetes.io/last-applied-configuration",
+		"deployment.kubernetes.io/revision",
+	}
+
+	// neatPodSpecDefaults tracks pod spec fields defaulted by the api server.
+	neatPodSpecDefaults = map[string]any{
+		"dnsPolicy":                     "ClusterFirst",
+		"restartPolicy":                 "Always",
+		"schedulerName":                 "default-scheduler",
+		"terminationGracePeriodSeconds": int64(30),
+		"enableServiceLinks":            true,
+		"preemptionPolicy":              "PreemptLowerPriority",
+		"priority":                      int64(0),
+	}
+
+	// neatContainerDefaults tracks container fields defaulted by the api server.
+	neatContainerDefaults = map[string]any{
+		"terminationMessagePath":   "/dev/termination-log",
+		"terminationMessagePolicy": "File",
+	}
+
+	// neatWorkloadDefaults tracks workload spec fields defaulted by the api server.
+	neatWorkloadDefaults = map[string]any{
+		"progressDeadlineSeconds": int64(600),
+		"revisionHistoryLimit":    int64(10),
+		"podManagementPolicy":     "OrderedReady",
+	}
+
+	// neatStrategyDefaults tracks workloads default update strategies.
+	neatStrategyDefaults = map[string]map[string]any{
+		"Deployment": {
+			"type":          "RollingUpdate",
+			"rollingUpdate": map[string]any{"maxSurge": "25%", "maxUnavailable": "25%"},
+		},
+		"DaemonSet": {
+			"type":          "RollingUpdate",
+			"rollingUpdate": map[string]any{"maxSurge": int64(0), "maxUnavailable": int64(1)},
+		},
+		"StatefulSet": {
+			"type":          "RollingUpdate",
+			"rollingUpdate": map[string]any{"partition": int64(0)},
+		},
+	}
+
+	// neatEmptyFields tracks fields safe to omit when empty.
+	neatEmptyFields = map[string]struct{}{
+		"annotations":     {},
+		"labels":          {},
+		"metadata":        {},
+		"resources":       {},
+		"securityContext": {},
+	}
+
+	// neatServiceDefaults tracks service spec fields defaulted or allocated by the api server.
+	neatServiceDefaults = map[string]any{
+		"type":                  "ClusterIP",
+		"sessionAffinity":       "None",
+		"ipFamilyPolicy":        "SingleStack",
+		"internalTrafficPolicy": "Cluster",
+	}
+)
+
+// CleanYAML returns a yaml manifest stripped of its server populated and defaulted fields.
+func CleanYAML(raw string) (string, error) {
+	o, err := ParseManifest([]byte(raw))
+	if err != nil {
+		return "", err
+	}
+	CleanManifest(o.Object)
+	bb, err := yaml.Marshal(o.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+// CleanManifest strips a manifest of its server populated and defaulted fields
+// so only meaningful specs remain, ie to recreate the resource elsewhere.
+func CleanManifest(o map[string]any) {
+	delete(o, "status")
+	if m, ok := o["metadata"].(map[string]any); ok {
+		cleanMeta(m)
+	}
+	spec, ok := o["spec"].(map[string]any)
+	if !ok {
+		cleanEmpty(o)
+		return
+	}
+	switch o["kind"] {
+	case "Service":
+		cleanService(spec)
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet":
+		cleanDefaults(spec, neatWorkloadDefaults)
+		for _, k := range []string{"strategy", "updateStrategy"} {
+			if fmt.Sprint(spec[k]) == fmt.Sprint(neatStrategyDefaults[fmt.Sprint(o["kind"])]) {
+				delete(spec, k)
+			}
+		}
+	}
+	cleanPodSpecs(spec)
+	cleanEmpty(o)
+}
+
+// Helpers...
+
+func cleanMeta(m map[string]any) {
+	for _, f := range neatMetaFields {
+		delete(m, f)
+	}
+	if aa, ok := m["annotations"].(map[string]any); ok {
+		for _, a := range neatAnnotations {
+			delete(aa, a)
+		}
+	}
+}
+
+// cleanPodSpecs walks a spec looking for pod specs, ie pod, job or workload templates.
+func cleanPodSpecs(m map[string]any) {
+	if _, ok := m["containers"].([]any); ok {
+		cleanPodSpec(m)
+		return
+	}
+	for k, v := range m {
+		if k == "metadata" {
+			if mm, ok := v.(map[string]any); ok {
+				delete(mm, "creationTimestamp")
+			}
+			continue
+		}
+		if mm, ok := v.(map[string]any); ok {
+			cleanPodSpecs(mm)
+		}
+	}
+}
+
+func cleanPodSpec(spec map[string]any) {
+	cleanDefaults(spec, neatPodSpecDefaults)
+	delete(spec, "nodeName")
+	if spec["serviceAccount"] == spec["serviceAccountName"] {
+		delete(spec, "serviceAccount")
+	}
+	if spec["serviceAccountName"] == "default" {
+		delete(spec, "serviceAccountName")
+	}
+
+	tokens := make(map[string]struct{})
+	if vv, ok := spec["volumes"].([]any); ok {
+		kept := make([]any, 0, len(vv))
+		for _, v := range vv {
+			if n, ok := apiAccessVolume(v); ok {
+				tokens[n] = struct{}{}
+				continue
+			}
+			kept = append(kept, v)
+		}
+		spec["volumes"] = kept
+	}
+	if tt, ok := spec["tolerations"].([]any); ok {
+		kept := make([]any, 0, len(tt))
+		for _, t := range tt {
+			if !isDefaultToleration(t) {
+				kept = append(kept, t)
+			}
+		}
+		spec["tolerations"] = kept
+	}
+	for _, k := range []string{"initContainers", "containers"} {
+		cc, ok := spec[k].([]any)
+		if !ok {
+			continue
+		}
+		for _, c := range cc {
+			if co, ok := c.(map[string]any); ok {
+				cleanContainer(co, tokens)
+			}
+		}
+	}
+}
+
+func cleanContainer(co map[string]any, tokens map[string]struct{}) {
+	cleanDefaults(co, neatContainerDefaults)
+	if image, ok := co["image"].(string); ok && co["imagePullPolicy"] == defaultPullPolicy(image) {
+		delete(co, "imagePullPolicy")
+	}
+	if pp, ok := co["ports"].([]any); ok {
+		for _, p := range pp {
+			if m, ok := p.(map[string]any); ok && m["protocol"] == "TCP" {
+				delete(m, "protocol")
+			}
+		}
+	}
+	mm, ok := co["volumeMounts"].([]any)
+	if !ok {
+		return
+	}
+	kept := make([]any, 0, len(mm))
+	for _, m := range mm {
+		if vm, ok := m.(map[string]any); ok {
+			if _, ok := tokens[fmt.Sprint(vm["name"])]; ok {
+				continue
+			}
+		}
+		kept = append(kept, m)
+	}
+	co["volumeMounts"] = kept
+}
+
+func cleanService(spec map[string]any) {
+	cleanDefaults(spec, neatServiceDefaults)
+	// Headless services must keep their None cluster ip on re-apply.
+	if spec["clusterIP"] != string(v1.ClusterIPNone) {
+		delete(spec, "clusterIP")
+		delete(spec, "clusterIPs")
+	}
+	delete(spec, "ipFamilies")
+	pp, ok := spec["ports"].([]any)
+	if !ok {
+		return
+	}
+	for _, p := range pp {
+		m, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m["protocol"] == "TCP" {
+			delete(m, "protocol")
+		}
+		if fmt.Sprint(m["targetPort"]) == fmt.Sprint(m["port"]) {
+			delete(m, "targetPort")
+		}
+	}
+}
+
+// apiAccessVolume checks for the service account token volume injected by the api server.
+func apiAccessVolume(v any) (string, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	n := fmt.Sprint(m["name"])
+	_, projected := m["projected"]
+
+	return n, projected && strings.HasPrefix(n, "kube-api-access-")
+}
+
+// isDefaultToleration checks for the not-ready/unreachable tolerations injected by the api server.
+func isDefaultToleration(t any) bool {
+	m, ok := t.(map[string]any)
+	if !ok {
+		return false
+	}
+	switch m["key"] {
+	case "node.kubernetes.io/not-ready", "node.kubernetes.io/unreachable":
+		return m["operator"] == "Exists" && m["effect"] == "NoExecute" && fmt.Sprint(m["tolerationSeconds"]) == "300"
+	default:
+		return false
+	}
+}
+
+// defaultPullPolicy returns the pull policy the api server defaults an image to.
+func defaultPullPolicy(image string) string {
+	tag := ParseImageRef(image).Tag
+	if tag == "latest" || (tag == "" && !strings.Contains(image, "@")) {
+		return string(v1.PullAlways)
+	}
+
+	return string(v1.PullIfNotPresent)
+}
+
+func cleanDefaults(m, defaults map[string]any) {
+	for k, v := range defaults {
+		if mv, ok := m[k]; ok && fmt.Sprint(mv) == fmt.Sprint(v) {
+			delete(m, k)
+		}
+	}
+}
+
+// cleanEmpty recursively removes nil values and empty lists along with
+// empty maps that are safe to omit.
+func cleanEmpty(m map[string]any) {
+	for k, v := range m {
+		switch t := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]any:
+			cleanEmpty(t)
+			if _, ok := neatEmptyFields[k]; ok && len(t) == 0 {
+				delete(m, k)
+			}
+		case []any:
+			for _, i := range t {
+				if mm, ok := i.(map[string]any); ok {
+					cleanEmpty(mm)
+				}
+			}
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+}
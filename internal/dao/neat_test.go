@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanYAML(t *testing.T) {
+	uu := map[string]struct {
+		raw, e string
+	}{
+		"deployment": {
+			raw: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    deployment.kubernetes.io/revision: "3"
+    kubectl.kubernetes.io/last-applied-configuration: '{}'
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  generation: 3
+  labels:
+    app: nginx
+  managedFields:
+  - manager: kubectl
+  name: nginx
+  namespace: default
+  resourceVersion: "42"
+  uid: 1234
+spec:
+  progressDeadlineSeconds: 600
+  replicas: 2
+  revisionHistoryLimit: 10
+  selector:
+    matchLabels:
+      app: nginx
+  strategy:
+    rollingUpdate:
+      maxSurge: 25%
+      maxUnavailable: 25%
+    type: RollingUpdate
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        app: nginx
+    spec:
+      containers:
+      - image: nginx:1.0
+        imagePullPolicy: IfNotPresent
+        name: nginx
+        ports:
+        - containerPort: 80
+          protocol: TCP
+        resources: {}
+        terminationMessagePath: /dev/termination-log
+        terminationMessagePolicy: File
+      dnsPolicy: ClusterFirst
+      restartPolicy: Always
+      schedulerName: default-scheduler
+      securityContext: {}
+      terminationGracePeriodSeconds: 30
+status:
+  replicas: 2
+`,
+			e: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app: nginx
+  name: nginx
+  namespace: default
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: nginx
+  template:
+    metadata:
+      labels:
+        app: nginx
+    spec:
+      containers:
+      - image: nginx:1.0
+        name: nginx
+        ports:
+        - containerPort: 80
+`,
+		},
+		"service": {
+			raw: `apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  clusterIP: 10.0.0.1
+  clusterIPs:
+  - 10.0.0.1
+  internalTrafficPolicy: Cluster
+  ipFamilies:
+  - IPv4
+  ipFamilyPolicy: SingleStack
+  ports:
+  - port: 80
+    protocol: TCP
+    targetPort: 80
+  - port: 443
+    protocol: TCP
+    targetPort: 8443
+  selector:
+    app: nginx
+  sessionAffinity: None
+  type: ClusterIP
+status:
+  loadBalancer: {}
+`,
+			e: `apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  ports:
+  - port: 80
+  - port: 443
+    targetPort: 8443
+  selector:
+    app: nginx
+`,
+		},
+		"headless-service": {
+			raw: `apiVersion: v1
+kind: Service
+metadata:
+  name: db
+  namespace: default
+spec:
+  clusterIP: None
+  clusterIPs:
+  - None
+  ports:
+  - port: 5432
+    protocol: TCP
+  selector:
+    app: db
+`,
+			e: `apiVersion: v1
+kind: Service
+metadata:
+  name: db
+  namespace: default
+spec:
+  clusterIP: None
+  clusterIPs:
+  - None
+  ports:
+  - port: 5432
+  selector:
+    app: db
+`,
+		},
+		"latest-image": {
+			raw: `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: default
+spec:
+  containers:
+  - image: fred:latest
+    imagePullPolicy: IfNotPresent
+    name: c1
+  - image: blee
+    imagePullPolicy: Always
+    name: c2
+`,
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: default
+spec:
+  containers:
+  - image: fred:latest
+    imagePullPolicy: IfNotPresent
+    name: c1
+  - image: blee
+    name: c2
+`,
+		},
+		"pod": {
+			raw: `apiVersion: v1
+kind: Pod
+metadata:
+  name: nginx
+  namespace: default
+  ownerReferences:
+  - kind: ReplicaSet
+    name: nginx-1234
+spec:
+  containers:
+  - image: nginx:1.0
+    imagePullPolicy: Always
+    name: nginx
+    volumeMounts:
+    - mountPath: /etc/nginx
+      name: config
+    - mountPath: /var/run/secrets/kubernetes.io/serviceaccount
+      name: kube-api-access-abcde
+      readOnly: true
+  enableServiceLinks: true
+  nodeName: node1
+  preemptionPolicy: PreemptLowerPriority
+  priority: 0
+  serviceAccount: default
+  serviceAccountName: default
+  tolerations:
+  - effect: NoExecute
+    key: node.kubernetes.io/not-ready
+    operator: Exists
+    tolerationSeconds: 300
+  - effect: NoExecute
+    key: node.kubernetes.io/unreachable
+    operator: Exists
+    tolerationSeconds: 300
+  - effect: NoSchedule
+    key: gpu
+    operator: Exists
+  volumes:
+  - configMap:
+      name: nginx
+    name: config
+  - name: kube-api-access-abcde
+    projected:
+      sources:
+      - serviceAccountToken:
+          path: token
+`,
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  containers:
+  - image: nginx:1.0
+    imagePullPolicy: Always
+    name: nginx
+    volumeMounts:
+    - mountPath: /etc/nginx
+      name: config
+  tolerations:
+  - effect: NoSchedule
+    key: gpu
+    operator: Exists
+  volumes:
+  - configMap:
+      name: nginx
+    name: config
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := CleanYAML(u.raw)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestCleanYAMLFails(t *testing.T) {
+	_, err := CleanYAML("fred: [")
+	assert.Error(t, err)
+}
+
+func TestDefaultPullPolicy(t *testing.T) {
+	uu := map[string]string{
+		"nginx":                      "Always",
+		"nginx:latest":               "Always",
+		"nginx:1.25":                 "IfNotPresent",
+		"localhost:5000/fred":        "Always",
+		"ghcr.io/fred/blee@sha256:a": "IfNotPresent",
+	}
+
+	for image, e := range uu {
+		t.Run(image, func(t *testing.T) {
+			assert.Equal(t, e, defaultPullPolicy(image))
+		})
+	}
+}
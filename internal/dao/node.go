@@ -69,7 +69,7 @@ func (n *Node) ToggleCordon(fqn string, cordon bool) error {
 		return err
 	}
 
-	err, patchErr := h.PatchOrReplace(dial, false)
+	err, patchErr := h.PatchOrReplace(dial, IsDryRun())
 	if patchErr != nil {
 		return patchErr
 	}
@@ -80,8 +80,9 @@ func (n *Node) ToggleCordon(fqn string, cordon bool) error {
 	return nil
 }
 
-func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
-	return drain.Helper{
+func (o DrainOptions) toDrainHelper(ctx context.Context, k kubernetes.Interface, node string, w io.Writer) drain.Helper {
+	h := drain.Helper{
+		Ctx:                 ctx,
 		Client:              k,
 		GracePeriodSeconds:  o.GracePeriodSeconds,
 		Timeout:             o.Timeout,
@@ -91,11 +92,27 @@ func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.H
 		Out:                 w,
 		ErrOut:              w,
 		Force:               o.Force,
+		DryRunStrategy:      dryRunStrategy(),
 	}
+	if o.OnProgress != nil {
+		h.OnPodDeletionOrEvictionStarted = func(po *v1.Pod, _ bool) {
+			o.OnProgress(DrainEvent{Node: node, Pod: client.FQN(po.Namespace, po.Name), State: DrainEvicting})
+		}
+		h.OnPodDeletionOrEvictionFinished = func(po *v1.Pod, _ bool, err error) {
+			evt := DrainEvent{Node: node, Pod: client.FQN(po.Namespace, po.Name), State: DrainEvicted}
+			if err != nil {
+				evt.State, evt.Err = DrainFailed, err
+			}
+			o.OnProgress(evt)
+		}
+	}
+
+	return h
 }
 
-// Drain drains a node.
-func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
+// Drain drains a node. Pods are evicted unless eviction is disabled, thus honoring
+// disruption budgets until the drain times out or the context is canceled.
+func (n *Node) Drain(ctx context.Context, path string, opts DrainOptions, w io.Writer) error {
 	cordoned, err := n.ensureCordoned(path)
 	if err != nil {
 		return err
@@ -111,7 +128,7 @@ func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
 	if err != nil {
 		return err
 	}
-	h := opts.toDrainHelper(dial, w)
+	h := opts.toDrainHelper(ctx, dial, path, w)
 	dd, errs := h.GetPodsForDeletion(path)
 	if len(errs) != 0 {
 		for _, e := range errs {
@@ -122,7 +139,16 @@ func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
 		return errors.Join(errs...)
 	}
 
-	if err := h.DeleteOrEvictPods(dd.Pods()); err != nil {
+	pods := dd.Pods()
+	if opts.OnProgress != nil {
+		for i := range pods {
+			opts.OnProgress(DrainEvent{Node: path, Pod: client.FQN(pods[i].Namespace, pods[i].Name), State: DrainPending})
+		}
+	}
+	if err := h.DeleteOrEvictPods(pods); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("drain of node %s canceled", path)
+		}
 		return err
 	}
 	_, _ = fmt.Fprintf(h.Out, "Node %s drained!", path)
@@ -169,9 +195,16 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	var nmx client.NodesMetricsMap
+	var (
+		nmx    client.NodesMetricsMap
+		custom client.CustomMetrics
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		nmx, _ = client.DialMetrics(n.Client()).FetchNodesMetricsMap(ctx)
+		dial := client.DialMetrics(n.Client())
+		nmx, _ = dial.FetchNodesMetricsMap(ctx)
+		if custom, err = dial.FetchNodesCustomMetrics(ctx); err != nil {
+			slog.Warn("Unable to fetch nodes custom metrics", slogs.Error, err)
+		}
 	}
 
 	shouldCountPods, _ := ctx.Value(internal.KeyPodCounting).(bool)
@@ -205,6 +238,7 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			Raw:      u,
 			MX:       nmx[name],
 			PodCount: podCount,
+			Custom:   custom[name],
 		})
 	}
 
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// NodeOp represents a node bulk operation.
+type NodeOp string
+
+const (
+	// NodeCordon cordons nodes.
+	NodeCordon NodeOp = "Cordon"
+
+	// NodeUncordon uncordons nodes.
+	NodeUncordon NodeOp = "Uncordon"
+
+	// NodeLabel adds, updates or removes node labels.
+	NodeLabel NodeOp = "Label"
+
+	// NodeTaint adds, updates or removes node taints.
+	NodeTaint NodeOp = "Taint"
+)
+
+// NodeTaintsTemplate represents the taints editor template.
+const NodeTaintsTemplate = `# Taints to apply to the selected nodes.
+# Taints matching an existing key and effect are updated.
+# Set remove: true to delete a taint by key and effect.
+# Valid effects are NoSchedule, PreferNoSchedule and NoExecute.
+taints:
+- key: dedicated
+  value: ""
+  effect: NoSchedule
+  remove: false
+`
+
+// NodeBulkOpts tracks node bulk operation options.
+type NodeBulkOpts struct {
+	Op     NodeOp
+	Labels map[string]*string
+	Taints []TaintSpec
+}
+
+// TaintSpec represents a taint edit.
+type TaintSpec struct {
+	Key    string         `json:"key"`
+	Value  string         `json:"value,omitempty"`
+	Effect v1.TaintEffect `json:"effect"`
+	Remove bool           `json:"remove,omitempty"`
+}
+
+// NodeResult tracks the outcome of an operation on a given node.
+type NodeResult struct {
+	Path string
+	Err  error
+}
+
+// ParseNodeLabels parses a label spec ie k1=v1,k2- into labels to set or remove.
+func ParseNodeLabels(spec string) (map[string]*string, error) {
+	ll := make(map[string]*string)
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if k, ok := strings.CutSuffix(s, "-"); ok && !strings.Contains(k, "=") {
+			if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, ", "))
+			}
+			ll[k] = nil
+			continue
+		}
+		k, v, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid label %q. Expecting key=value or key-", s)
+		}
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label value %q: %s", v, strings.Join(errs, ", "))
+		}
+		ll[k] = &v
+	}
+	if len(ll) == 0 {
+		return nil, fmt.Errorf("no labels specified")
+	}
+
+	return ll, nil
+}
+
+// ParseNodeTaints parses a taints editor document.
+func ParseNodeTaints(raw []byte) ([]TaintSpec, error) {
+	var doc struct {
+		Taints []TaintSpec `json:"taints"`
+	}
+	if err := yaml.UnmarshalStrict(raw, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Taints) == 0 {
+		return nil, fmt.Errorf("no taints specified")
+	}
+	for _, t := range doc.Taints {
+		if errs := validation.IsQualifiedName(t.Key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid taint key %q: %s", t.Key, strings.Join(errs, ", "))
+		}
+		if t.Value != "" {
+			if errs := validation.IsValidLabelValue(t.Value); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid taint value %q: %s", t.Value, strings.Join(errs, ", "))
+			}
+		}
+		switch t.Effect {
+		case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+		default:
+			return nil, fmt.Errorf("invalid taint effect %q for key %q", t.Effect, t.Key)
+		}
+	}
+
+	return doc.Taints, nil
+}
+
+// Bulk applies an operation to a collection of nodes.
+func (n *Node) Bulk(ctx context.Context, paths []string, opts *NodeBulkOpts) []NodeResult {
+	rr := make([]NodeResult, 0, len(paths))
+	for _, path := range paths {
+		rr = append(rr, NodeResult{Path: path, Err: n.apply(ctx, path, opts)})
+	}
+
+	return rr
+}
+
+func (n *Node) apply(ctx context.Context, path string, opts *NodeBulkOpts) error {
+	switch opts.Op {
+	case NodeCordon, NodeUncordon:
+		return n.patch(ctx, path, map[string]any{
+			"spec": map[string]any{"unschedulable": opts.Op == NodeCordon},
+		})
+	case NodeLabel:
+		return n.patch(ctx, path, map[string]any{
+			"metadata": map[string]any{"labels": opts.Labels},
+		})
+	case NodeTaint:
+		o, err := FetchNode(ctx, n.Factory, path)
+		if err != nil {
+			return err
+		}
+		return n.patch(ctx, path, map[string]any{
+			"spec": map[string]any{"taints": applyTaints(o.Spec.Taints, opts.Taints)},
+		})
+	default:
+		return fmt.Errorf("unsupported node operation: %q", opts.Op)
+	}
+}
+
+func (n *Node) patch(ctx context.Context, path string, patch map[string]any) error {
+	auth, err := n.Client().CanI(client.ClusterScope, client.NodeGVR, path, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch node %s", path)
+	}
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	d, err := n.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	prior := snapshot(ctx, n.Client(), client.NodeGVR, path)
+	_, err = d.Resource(client.NodeGVR.GVR()).Patch(ctx, path, types.MergePatchType, raw, metav1.PatchOptions{DryRun: dryRunOpts()})
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, client.NodeGVR, path, prior)
+
+	return nil
+}
+
+// applyTaints merges taints edits into a node taints.
+func applyTaints(tt []v1.Taint, ss []TaintSpec) []v1.Taint {
+	res := slices.Clone(tt)
+	for _, s := range ss {
+		res = slices.DeleteFunc(res, func(t v1.Taint) bool {
+			return t.Key == s.Key && t.Effect == s.Effect
+		})
+		if !s.Remove {
+			res = append(res, v1.Taint{Key: s.Key, Value: s.Value, Effect: s.Effect})
+		}
+	}
+	if res == nil {
+		res = []v1.Taint{}
+	}
+
+	return res
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseNodeLabels(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    map[string]*string
+		err  bool
+	}{
+		"set": {
+			spec: "a=1, b=2",
+			e:    map[string]*string{"a": ptr.To("1"), "b": ptr.To("2")},
+		},
+		"remove": {
+			spec: "a=1,b-",
+			e:    map[string]*string{"a": ptr.To("1"), "b": nil},
+		},
+		"empty-value": {
+			spec: "a=",
+			e:    map[string]*string{"a": ptr.To("")},
+		},
+		"no-value": {
+			spec: "a",
+			err:  true,
+		},
+		"bad-key": {
+			spec: "-a=1",
+			err:  true,
+		},
+		"blank": {
+			spec: " , ",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll, err := ParseNodeLabels(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestParseNodeTaints(t *testing.T) {
+	tt, err := ParseNodeTaints([]byte(NodeTaintsTemplate))
+	require.NoError(t, err)
+	assert.Equal(t, []TaintSpec{{Key: "dedicated", Effect: v1.TaintEffectNoSchedule}}, tt)
+
+	_, err = ParseNodeTaints([]byte("taints:\n- key: a\n  effect: Blee\n"))
+	assert.Error(t, err)
+
+	_, err = ParseNodeTaints([]byte("taints: []\n"))
+	assert.Error(t, err)
+
+	_, err = ParseNodeTaints([]byte("taints:\n- key: a\n  effect: NoSchedule\n  zorg: true\n"))
+	assert.Error(t, err)
+}
+
+func TestApplyTaints(t *testing.T) {
+	tt := []v1.Taint{
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoSchedule},
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoExecute},
+		{Key: "b", Effect: v1.TaintEffectNoSchedule},
+	}
+	ss := []TaintSpec{
+		{Key: "a", Value: "2", Effect: v1.TaintEffectNoSchedule},
+		{Key: "b", Effect: v1.TaintEffectNoSchedule, Remove: true},
+		{Key: "c", Effect: v1.TaintEffectPreferNoSchedule},
+	}
+
+	assert.Equal(t, []v1.Taint{
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoExecute},
+		{Key: "a", Value: "2", Effect: v1.TaintEffectNoSchedule},
+		{Key: "c", Effect: v1.TaintEffectPreferNoSchedule},
+	}, applyTaints(tt, ss))
+	assert.Equal(t, []v1.Taint{}, applyTaints(nil, []TaintSpec{{Key: "a", Effect: v1.TaintEffectNoSchedule, Remove: true}}))
+	assert.Len(t, tt, 3)
+}
+
+func TestNodeBulkCordonDryRun(t *testing.T) {
+	SetDryRun(true)
+	defer SetDryRun(false)
+
+	dyn := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	dyn.PrependReactor("get", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Node",
+			"metadata":   map[string]any{"name": "n1"},
+		}}, nil
+	})
+	var (
+		patch  string
+		dryRun []string
+	)
+	dyn.PrependReactor("patch", "nodes", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		p := a.(k8stesting.PatchActionImpl)
+		patch, dryRun = string(p.GetPatch()), p.GetPatchOptions().DryRun
+		return true, nil, nil
+	})
+
+	var n Node
+	n.Init(connFactory{conn: dynConn{dyn: dyn}}, client.NodeGVR)
+	rr := n.Bulk(context.Background(), []string{"n1"}, &NodeBulkOpts{Op: NodeCordon})
+	require.Len(t, rr, 1)
+	require.NoError(t, rr[0].Err)
+	assert.JSONEq(t, `{"spec":{"unschedulable":true}}`, patch)
+	assert.Equal(t, []string{metav1.DryRunAll}, dryRun)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*NodeCapacity)(nil)
+
+// pressureConditions tracks the node conditions reporting resources pressure.
+var pressureConditions = []v1.NodeConditionType{
+	v1.NodeMemoryPressure,
+	v1.NodeDiskPressure,
+	v1.NodePIDPressure,
+	v1.NodeNetworkUnavailable,
+}
+
+// NodeCapacity joins nodes, pods and metrics to report nodes allocatable vs requested vs used resources.
+type NodeCapacity struct {
+	NonResource
+}
+
+// List returns the nodes capacity.
+func (n *NodeCapacity) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	f := n.getFactory()
+	oo, err := f.List(client.NodeGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods, err := f.List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		slog.Warn("Unable to list pods for nodes capacity", slogs.Error, err)
+	}
+	var nmx client.NodesMetricsMap
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
+		nmx, _ = client.DialMetrics(f.Client()).FetchNodesMetricsMap(ctx)
+	}
+
+	return nodesCapacity(oo, pods, nmx)
+}
+
+// Helpers...
+
+func nodesCapacity(oo, pods []runtime.Object, nmx client.NodesMetricsMap) ([]runtime.Object, error) {
+	hh := make(map[string]*render.NodeCapacityRes, len(oo))
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+		}
+		var no v1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no); err != nil {
+			return nil, err
+		}
+		nc := newNodeCapacity(&no)
+		if mx, ok := nmx[no.Name]; ok {
+			nc.CPU, nc.Mem = mx.Usage.Cpu().MilliValue(), mx.Usage.Memory().Value()
+			nc.HasMX = true
+		}
+		hh[no.Name] = nc
+		res = append(res, nc)
+	}
+
+	for _, o := range pods {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		nc, ok := hh[po.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		us := render.NewPodUsage(&po.Spec, nil)
+		nc.Pods++
+		nc.ReqCPU += us.ReqCPU
+		nc.ReqMem += us.ReqMem
+		nc.LimCPU += us.LimCPU
+		nc.LimMem += us.LimMem
+	}
+
+	return res, nil
+}
+
+func newNodeCapacity(no *v1.Node) *render.NodeCapacityRes {
+	nc := render.NodeCapacityRes{
+		Name:          no.Name,
+		Unschedulable: no.Spec.Unschedulable,
+		AllocCPU:      no.Status.Allocatable.Cpu().MilliValue(),
+		AllocMem:      no.Status.Allocatable.Memory().Value(),
+		AllocPods:     no.Status.Allocatable.Pods().Value(),
+		Age:           no.CreationTimestamp,
+	}
+	for _, c := range no.Status.Conditions {
+		switch {
+		case c.Type == v1.NodeReady:
+			nc.Ready = c.Status == v1.ConditionTrue
+		case slices.Contains(pressureConditions, c.Type) && c.Status == v1.ConditionTrue:
+			nc.Pressures = append(nc.Pressures, string(c.Type))
+		}
+	}
+	for i := range no.Spec.Taints {
+		nc.Taints = append(nc.Taints, no.Spec.Taints[i].ToString())
+	}
+
+	return &nc
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNodesCapacity(t *testing.T) {
+	node := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata":   map[string]any{"name": "n1"},
+		"spec": map[string]any{
+			"taints": []any{
+				map[string]any{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"},
+			},
+		},
+		"status": map[string]any{
+			"allocatable": map[string]any{"cpu": "2", "memory": "4Gi", "pods": "110"},
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+				map[string]any{"type": "MemoryPressure", "status": "True"},
+				map[string]any{"type": "DiskPressure", "status": "False"},
+			},
+		},
+	}}
+	newPod := func(n, node, phase string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": n, "namespace": "ns1"},
+			"spec": map[string]any{
+				"nodeName": node,
+				"containers": []any{
+					map[string]any{
+						"name": "c1",
+						"resources": map[string]any{
+							"requests": map[string]any{"cpu": "500m", "memory": "1Gi"},
+							"limits":   map[string]any{"cpu": "1", "memory": "2Gi"},
+						},
+					},
+				},
+			},
+			"status": map[string]any{"phase": phase},
+		}}
+	}
+	pods := []runtime.Object{
+		newPod("p1", "n1", "Running"),
+		newPod("p2", "n1", "Running"),
+		newPod("p3", "n1", "Succeeded"),
+		newPod("p4", "n2", "Running"),
+	}
+	nmx := client.NodesMetricsMap{
+		"n1": &mv1beta1.NodeMetrics{
+			Usage: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("250m"),
+				v1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		},
+	}
+
+	oo, err := nodesCapacity([]runtime.Object{&node}, pods, nmx)
+	require.NoError(t, err)
+	require.Len(t, oo, 1)
+
+	nc, ok := oo[0].(*render.NodeCapacityRes)
+	require.True(t, ok)
+	assert.Equal(t, "n1", nc.Name)
+	assert.True(t, nc.Ready)
+	assert.Equal(t, 2, nc.Pods)
+	assert.Equal(t, int64(110), nc.AllocPods)
+	assert.Equal(t, int64(2000), nc.AllocCPU)
+	assert.Equal(t, int64(1000), nc.ReqCPU)
+	assert.Equal(t, int64(2000), nc.LimCPU)
+	assert.Equal(t, int64(2*1024*client.MegaByte), nc.ReqMem)
+	assert.True(t, nc.HasMX)
+	assert.Equal(t, int64(250), nc.CPU)
+	assert.Equal(t, int64(512*client.MegaByte), nc.Mem)
+	assert.Equal(t, []string{"MemoryPressure"}, nc.Pressures)
+	assert.Equal(t, []string{"dedicated=gpu:NoSchedule"}, nc.Taints)
+}
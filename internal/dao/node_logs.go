@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	// DefaultNodeLogQuery represents the default node logs query.
+	DefaultNodeLogQuery = "kubelet"
+
+	nodeLogPollInterval = 2 * time.Second
+	nodeLogHeadBytes    = 5000
+)
+
+var _ Loggable = (*Node)(nil)
+
+// journalLayouts tracks the journal short-precise and klog timestamp layouts.
+var journalLayouts = []string{
+	"Jan _2 15:04:05.000000",
+	"Jan _2 15:04:05",
+}
+
+const klogLayout = "0102 15:04:05.000000"
+
+// TailLogs streams a node service or log file via the kubelet node log query endpoint.
+// The query is either a service name ie kubelet or a log file relative to /var/log.
+func (n *Node) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	_, name := client.Namespaced(opts.Path)
+	auth, err := n.Client().CanI(client.ClusterScope, client.NewGVR(client.NodeGVR.String()+":proxy"), name, client.GetAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to view node logs")
+	}
+	if opts.Container == "" {
+		opts.Container = DefaultNodeLogQuery
+	}
+	opts.SingleContainer = true
+
+	dial, err := n.Client().DialLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	return []LogChan{tailNodeLogs(ctx, dial.CoreV1().RESTClient(), name, opts)}, nil
+}
+
+func tailNodeLogs(ctx context.Context, rc restclient.Interface, node string, opts *LogOptions) LogChan {
+	out := make(LogChan, logChannelBuffer)
+	go func() {
+		defer close(out)
+
+		var (
+			last  time.Time
+			fails int
+		)
+		for {
+			ts, err := fetchNodeLogs(ctx, nodeLogsRequest(rc, node, opts, last), out, opts, last)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				fails++
+				slog.Debug("Node logs query failed",
+					slogs.Container, opts.Info(),
+					slogs.Error, err,
+				)
+				if last.IsZero() || fails >= logRetryCount {
+					out <- opts.ToErrLogItem(fmt.Errorf("node logs query failed for %s: %w", opts.Info(), err))
+					return
+				}
+			default:
+				fails, last = 0, ts
+			}
+			if opts.Head || (!opts.Until.IsZero() && opts.Until.Before(time.Now())) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(nodeLogPollInterval):
+			}
+		}
+	}()
+
+	return out
+}
+
+// nodeLogsRequest builds a node log query. Subsequent queries fetch entries since the last seen one.
+func nodeLogsRequest(rc restclient.Interface, node string, opts *LogOptions, last time.Time) *restclient.Request {
+	req := rc.Get().
+		AbsPath("/api/v1/nodes/"+node+"/proxy/logs/").
+		Param("query", opts.Container)
+
+	switch {
+	case !last.IsZero():
+		req.Param("sinceTime", last.UTC().Truncate(time.Second).Format(time.RFC3339))
+	case opts.Head:
+	case !opts.From.IsZero():
+		req.Param("sinceTime", opts.From.UTC().Format(time.RFC3339))
+	case opts.SinceSeconds > 0:
+		req.Param("sinceTime", time.Now().Add(-time.Duration(opts.SinceSeconds)*time.Second).UTC().Format(time.RFC3339))
+	case opts.Lines > 0:
+		req.Param("tailLines", strconv.FormatInt(opts.Lines, 10))
+	}
+
+	if !opts.Until.IsZero() {
+		req.Param("untilTime", opts.Until.UTC().Format(time.RFC3339))
+	}
+
+	return req
+}
+
+// fetchNodeLogs emits node log lines newer than the last seen entry and returns the latest entry time.
+func fetchNodeLogs(ctx context.Context, req *restclient.Request, out chan<- *LogItem, opts *LogOptions, last time.Time) (time.Time, error) {
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return last, err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			slog.Error("Failed to close stream",
+				slogs.Container, opts.Info(),
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	var r io.Reader = stream
+	if opts.Head {
+		r = io.LimitReader(stream, nodeLogHeadBytes)
+	}
+	var (
+		s      = bufio.NewScanner(r)
+		latest = last
+		fresh  = last.IsZero()
+	)
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		line, ts, ok := nodeLogLine(s.Bytes(), latest)
+		if ok {
+			// Subsequent queries have a second granularity thus skip entries already seen.
+			if fresh = ts.After(last); fresh {
+				latest = ts
+			}
+		}
+		if !fresh {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return latest, nil
+		case out <- opts.ToLogItem(tview.EscapeBytes(line)):
+		default:
+			slog.Warn("Dropping log line due to slow consumer",
+				slogs.Container, opts.Info(),
+			)
+		}
+	}
+	if err := s.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		return latest, err
+	}
+
+	return latest, nil
+}
+
+// nodeLogLine prefixes a node log line with an RFC3339 timestamp so it renders like
+// container logs. Lines without a recognizable timestamp inherit the previous one.
+func nodeLogLine(bb []byte, prev time.Time) ([]byte, time.Time, bool) {
+	ts, ok := parseNodeLogTime(string(bb), time.Now().UTC())
+	stamp := ts
+	if !ok {
+		if stamp = prev; stamp.IsZero() {
+			stamp = time.Now().UTC()
+		}
+	}
+
+	line := make([]byte, 0, len(bb)+32)
+	line = stamp.AppendFormat(line, time.RFC3339Nano)
+	line = append(line, ' ')
+	line = append(line, bb...)
+	line = append(line, '\n')
+
+	return line, ts, ok
+}
+
+// parseNodeLogTime extracts a journal or klog entry time. Years are inferred from now.
+func parseNodeLogTime(s string, now time.Time) (time.Time, bool) {
+	var (
+		t   time.Time
+		err = errors.New("no timestamp")
+	)
+	for _, l := range journalLayouts {
+		if len(s) < len(l) {
+			continue
+		}
+		if t, err = time.Parse(l, s[:len(l)]); err == nil {
+			break
+		}
+	}
+	if err != nil && len(s) > len(klogLayout) && isKlogSeverity(s[0]) {
+		t, err = time.Parse(klogLayout, s[1:len(klogLayout)+1])
+	}
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	t = t.AddDate(now.Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+
+	return t, true
+}
+
+func isKlogSeverity(b byte) bool {
+	switch b {
+	case 'I', 'W', 'E', 'F':
+		return true
+	default:
+		return false
+	}
+}
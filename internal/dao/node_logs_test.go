@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestParseNodeLogTime(t *testing.T) {
+	now := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		line string
+		e    time.Time
+		ok   bool
+	}{
+		"journal-precise": {
+			line: "Jan 01 10:00:00.123456 n1 kubelet[1]: blee",
+			e:    time.Date(2026, time.January, 1, 10, 0, 0, 123456000, time.UTC),
+			ok:   true,
+		},
+		"journal": {
+			line: "Jan  1 10:00:00 n1 kubelet[1]: blee",
+			e:    time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC),
+			ok:   true,
+		},
+		"last-year": {
+			line: "Dec 31 23:00:00.000000 n1 kubelet[1]: blee",
+			e:    time.Date(2025, time.December, 31, 23, 0, 0, 0, time.UTC),
+			ok:   true,
+		},
+		"klog": {
+			line: "I0101 10:00:00.000001    1 kubelet.go:1] blee",
+			e:    time.Date(2026, time.January, 1, 10, 0, 0, 1000, time.UTC),
+			ok:   true,
+		},
+		"none": {
+			line: "-- No entries --",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ts, ok := parseNodeLogTime(u.line, now)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, ts)
+		})
+	}
+}
+
+func TestNodeLogLine(t *testing.T) {
+	prev := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	line, ts, ok := nodeLogLine([]byte("  at blee"), prev)
+	assert.False(t, ok)
+	assert.True(t, ts.IsZero())
+	assert.Equal(t, "2026-01-01T00:00:00Z   at blee\n", string(line))
+}
+
+func TestNodeLogsRequest(t *testing.T) {
+	rc := nodeLogsClient(t, "http://blee")
+	last := time.Date(2026, time.January, 1, 10, 0, 0, 500, time.UTC)
+	uu := map[string]struct {
+		opts LogOptions
+		last time.Time
+		e    string
+	}{
+		"tail": {
+			opts: LogOptions{Container: "kubelet", Lines: 100},
+			e:    "http://blee/api/v1/nodes/n1/proxy/logs/?query=kubelet&tailLines=100",
+		},
+		"head": {
+			opts: LogOptions{Container: "kubelet", Lines: 100, Head: true},
+			e:    "http://blee/api/v1/nodes/n1/proxy/logs/?query=kubelet",
+		},
+		"since-last": {
+			opts: LogOptions{Container: "/kubelet.log", Lines: 100},
+			last: last,
+			e:    "http://blee/api/v1/nodes/n1/proxy/logs/?query=%2Fkubelet.log&sinceTime=2026-01-01T10%3A00%3A00Z",
+		},
+		"range": {
+			opts: LogOptions{Container: "kubelet", Lines: 100, From: last, Until: last.Add(time.Hour)},
+			e:    "http://blee/api/v1/nodes/n1/proxy/logs/?query=kubelet&sinceTime=2026-01-01T10%3A00%3A00Z&untilTime=2026-01-01T11%3A00%3A00Z",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nodeLogsRequest(rc, "n1", &u.opts, u.last).URL().String())
+		})
+	}
+}
+
+func TestFetchNodeLogs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Jan 01 10:00:00.000001 n1 kubelet[1]: old\nJan 01 10:00:00.000002 n1 kubelet[1]: new\n  more\n"))
+	}))
+	defer srv.Close()
+
+	rc := nodeLogsClient(t, srv.URL)
+	opts := LogOptions{Path: "n1", Container: "kubelet", SingleContainer: true}
+	last, _ := parseNodeLogTime("Jan 01 10:00:00.000001", time.Now().UTC())
+	out := make(chan *LogItem, 10)
+	ts, err := fetchNodeLogs(context.Background(), nodeLogsRequest(rc, "n1", &opts, last), out, &opts, last)
+	require.NoError(t, err)
+	close(out)
+
+	var ll []string
+	for it := range out {
+		assert.Equal(t, "kubelet", it.Container)
+		ll = append(ll, string(it.Bytes))
+	}
+	assert.Equal(t, last.Add(time.Microsecond), ts)
+	stamp := ts.Format(time.RFC3339Nano)
+	assert.Equal(t, []string{
+		stamp + " Jan 01 10:00:00.000002 n1 kubelet[1[]: new\n",
+		stamp + "   more\n",
+	}, ll)
+}
+
+func nodeLogsClient(t *testing.T, host string) restclient.Interface {
+	c, err := kubernetes.NewForConfig(&restclient.Config{Host: host})
+	require.NoError(t, err)
+
+	return c.CoreV1().RESTClient()
+}
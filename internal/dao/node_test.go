@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDrainHelperProgress(t *testing.T) {
+	var ee []DrainEvent
+	opts := DrainOptions{OnProgress: func(evt DrainEvent) {
+		ee = append(ee, evt)
+	}}
+	h := opts.toDrainHelper(context.Background(), nil, "n1", io.Discard)
+
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"}}
+	h.OnPodDeletionOrEvictionStarted(&po, true)
+	h.OnPodDeletionOrEvictionFinished(&po, true, nil)
+	h.OnPodDeletionOrEvictionFinished(&po, true, errors.New("blee"))
+
+	assert.Equal(t, []DrainEvent{
+		{Node: "n1", Pod: "default/p1", State: DrainEvicting},
+		{Node: "n1", Pod: "default/p1", State: DrainEvicted},
+		{Node: "n1", Pod: "default/p1", State: DrainFailed, Err: errors.New("blee")},
+	}, ee)
+}
+
+func TestDrainHelperNoProgress(t *testing.T) {
+	h := DrainOptions{}.toDrainHelper(context.Background(), nil, "n1", io.Discard)
+
+	assert.Nil(t, h.OnPodDeletionOrEvictionStarted)
+	assert.Nil(t, h.OnPodDeletionOrEvictionFinished)
+}
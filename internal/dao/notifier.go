@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// notifyCmdTimeout caps a notification command duration.
+const notifyCmdTimeout = 30 * time.Second
+
+// Notification represents a triggered watched condition.
+type Notification struct {
+	Rule      string
+	Condition string
+	GVR       *client.GVR
+	FQN       string
+	Message   string
+	Actions   []string
+	Time      time.Time
+
+	command string
+	args    []string
+}
+
+// Has checks if the notification calls for a given action.
+func (n Notification) Has(action string) bool {
+	return slices.Contains(n.Actions, action)
+}
+
+// NotifyListener gets notified when a watched condition triggers.
+type NotifyListener interface {
+	Notify(Notification)
+}
+
+// notifyMatch represents a resource matching a watched condition.
+type notifyMatch struct {
+	gvr      *client.GVR
+	fqn, msg string
+}
+
+type conditionFn func(f Factory, ns string) ([]notifyMatch, error)
+
+var conditions = map[string]conditionFn{
+	config.CondCrashLoop:    crashLoops,
+	config.CondNodeNotReady: notReadyNodes,
+	config.CondPVCPending:   pendingPVCs,
+}
+
+// Notifier periodically evaluates watched conditions.
+type Notifier struct {
+	rules    []config.NotifyRule
+	interval time.Duration
+	listener NotifyListener
+	pending  map[string]time.Time
+	fired    map[string]struct{}
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+// notifier tracks the session watched conditions.
+var notifier = NewNotifier()
+
+// NewNotifier returns a new notifier.
+func NewNotifier() *Notifier {
+	return &Notifier{
+		interval: config.DefaultNotifyInterval,
+		pending:  make(map[string]time.Time),
+		fired:    make(map[string]struct{}),
+	}
+}
+
+// Notifications returns the session notifier.
+func Notifications() *Notifier {
+	return notifier
+}
+
+// Configure sets the watched conditions.
+func (n *Notifier) Configure(cfg config.Notifications) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.rules, n.interval = cfg.ValidRules(), cfg.IntervalOrDefault()
+}
+
+// SetListener registers a notifications listener.
+func (n *Notifier) SetListener(l NotifyListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.listener = l
+}
+
+// Start evaluates the watched conditions against a cluster till stopped.
+func (n *Notifier) Start(f Factory) {
+	n.Stop()
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	if len(n.rules) == 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancelFn = cancel
+	go n.run(ctx, f, n.interval)
+}
+
+// Stop stops conditions evaluations and resets triggered conditions.
+func (n *Notifier) Stop() {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.cancelFn != nil {
+		n.cancelFn()
+		n.cancelFn = nil
+	}
+	clear(n.pending)
+	clear(n.fired)
+}
+
+func (n *Notifier) run(ctx context.Context, f Factory, interval time.Duration) {
+	for {
+		for _, no := range n.Evaluate(f, time.Now()) {
+			n.dispatch(ctx, no)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Evaluate checks the watched conditions and returns the newly triggered notifications.
+// A condition notifies once till it clears.
+func (n *Notifier) Evaluate(f Factory, now time.Time) []Notification {
+	n.mx.Lock()
+	rr := slices.Clone(n.rules)
+	n.mx.Unlock()
+
+	var nn []Notification
+	seen := make(map[string]struct{})
+	for i, r := range rr {
+		mm, err := matchRule(f, r)
+		if err != nil {
+			slog.Warn("Notification rule evaluation failed",
+				slogs.Name, r.NameOrDefault(),
+				slogs.Error, err,
+			)
+			continue
+		}
+		n.mx.Lock()
+		for _, m := range mm {
+			key := fmt.Sprintf("%d|%s|%s", i, m.gvr, m.fqn)
+			seen[key] = struct{}{}
+			t, ok := n.pending[key]
+			if !ok {
+				n.pending[key], t = now, now
+			}
+			if _, ok := n.fired[key]; ok || now.Sub(t) < r.ForOrDefault() {
+				continue
+			}
+			n.fired[key] = struct{}{}
+			nn = append(nn, Notification{
+				Rule:      r.NameOrDefault(),
+				Condition: r.Condition,
+				GVR:       m.gvr,
+				FQN:       m.fqn,
+				Message:   m.msg,
+				Actions:   r.ActionsOrDefault(),
+				Time:      now,
+				command:   r.Command,
+				args:      r.Args,
+			})
+		}
+		n.mx.Unlock()
+	}
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	for k := range n.pending {
+		if _, ok := seen[k]; !ok {
+			delete(n.pending, k)
+			delete(n.fired, k)
+		}
+	}
+
+	return nn
+}
+
+func (n *Notifier) dispatch(ctx context.Context, no Notification) {
+	slog.Info("Notification triggered",
+		slogs.Name, no.Rule,
+		slogs.Condition, no.Condition,
+		slogs.FQN, no.FQN,
+	)
+	n.mx.Lock()
+	l := n.listener
+	n.mx.Unlock()
+
+	if l != nil {
+		l.Notify(no)
+	}
+	if no.Has(config.NotifyExec) {
+		go runNotifyCommand(ctx, no)
+	}
+}
+
+func runNotifyCommand(ctx context.Context, no Notification) {
+	ctx, cancel := context.WithTimeout(ctx, notifyCmdTimeout)
+	defer cancel()
+
+	ns, n := client.Namespaced(no.FQN)
+	cmd := exec.CommandContext(ctx, no.command, no.args...)
+	cmd.Env = append(os.Environ(),
+		"K9S_NOTIFY_RULE="+no.Rule,
+		"K9S_NOTIFY_CONDITION="+no.Condition,
+		"K9S_NOTIFY_RESOURCE="+no.GVR.R(),
+		"K9S_NOTIFY_NAMESPACE="+ns,
+		"K9S_NOTIFY_NAME="+n,
+		"K9S_NOTIFY_MESSAGE="+no.Message,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		slog.Error("Notification command failed",
+			slogs.Name, no.Rule,
+			slogs.Command, no.command,
+			slogs.Log, strings.TrimSpace(string(out)),
+			slogs.Error, err,
+		)
+	}
+}
+
+func matchRule(f Factory, r config.NotifyRule) ([]notifyMatch, error) {
+	fn, ok := conditions[r.Condition]
+	if !ok {
+		return nil, fmt.Errorf("unknown condition %q", r.Condition)
+	}
+	if r.Condition == config.CondNodeNotReady || len(r.Namespaces) == 0 {
+		return fn(f, client.NamespaceAll)
+	}
+
+	var mm []notifyMatch
+	for _, ns := range r.Namespaces {
+		m, err := fn(f, ns)
+		if err != nil {
+			return nil, err
+		}
+		mm = append(mm, m...)
+	}
+
+	return mm, nil
+}
+
+func crashLoops(f Factory, ns string) ([]notifyMatch, error) {
+	oo, err := f.List(client.PodGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var mm []notifyMatch
+	for _, o := range oo {
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return nil, err
+		}
+		for _, cs := range slices.Concat(po.Status.InitContainerStatuses, po.Status.ContainerStatuses) {
+			if cs.State.Waiting == nil || cs.State.Waiting.Reason != "CrashLoopBackOff" {
+				continue
+			}
+			mm = append(mm, notifyMatch{
+				gvr: client.PodGVR,
+				fqn: client.FQN(po.Namespace, po.Name),
+				msg: fmt.Sprintf("container %s is crash looping (%d restarts)", cs.Name, cs.RestartCount),
+			})
+			break
+		}
+	}
+
+	return mm, nil
+}
+
+func notReadyNodes(f Factory, _ string) ([]notifyMatch, error) {
+	oo, err := f.List(client.NodeGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var mm []notifyMatch
+	for _, o := range oo {
+		var no v1.Node
+		if err := toTyped(o, &no); err != nil {
+			return nil, err
+		}
+		status, reason := v1.ConditionUnknown, "no ready condition reported"
+		for _, c := range no.Status.Conditions {
+			if c.Type == v1.NodeReady {
+				status, reason = c.Status, c.Reason
+				break
+			}
+		}
+		if status == v1.ConditionTrue {
+			continue
+		}
+		mm = append(mm, notifyMatch{
+			gvr: client.NodeGVR,
+			fqn: no.Name,
+			msg: fmt.Sprintf("node is not ready (%s)", reason),
+		})
+	}
+
+	return mm, nil
+}
+
+func pendingPVCs(f Factory, ns string) ([]notifyMatch, error) {
+	oo, err := f.List(client.PvcGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var mm []notifyMatch
+	for _, o := range oo {
+		var pvc v1.PersistentVolumeClaim
+		if err := toTyped(o, &pvc); err != nil {
+			return nil, err
+		}
+		if pvc.Status.Phase != v1.ClaimPending {
+			continue
+		}
+		mm = append(mm, notifyMatch{
+			gvr: client.PvcGVR,
+			fqn: client.FQN(pvc.Namespace, pvc.Name),
+			msg: "claim is pending",
+		})
+	}
+
+	return mm, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNotifierEvaluate(t *testing.T) {
+	uu := map[string]struct {
+		rule config.NotifyRule
+		e    []string
+	}{
+		"crashloop": {
+			rule: config.NotifyRule{Name: "crash", Condition: config.CondCrashLoop},
+			e:    []string{"crash|ns1/fred|container c1 is crash looping (5 restarts)"},
+		},
+		"crashloop-ns": {
+			rule: config.NotifyRule{Name: "crash", Condition: config.CondCrashLoop, Namespaces: []string{"ns2"}},
+		},
+		"nodes": {
+			rule: config.NotifyRule{Condition: config.CondNodeNotReady},
+			e:    []string{"nodeNotReady|n2|node is not ready (KubeletNotReady)"},
+		},
+		"pvcs": {
+			rule: config.NotifyRule{Name: "pvc", Condition: config.CondPVCPending},
+			e:    []string{"pvc|ns1/p1|claim is pending"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := notifyFactory(true)
+			n := dao.NewNotifier()
+			n.Configure(config.Notifications{Rules: []config.NotifyRule{u.rule}})
+
+			var aa []string
+			for _, no := range n.Evaluate(&f, time.Now()) {
+				aa = append(aa, no.Rule+"|"+no.FQN+"|"+no.Message)
+			}
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
+
+func TestNotifierOnce(t *testing.T) {
+	n := dao.NewNotifier()
+	n.Configure(config.Notifications{Rules: []config.NotifyRule{
+		{Name: "crash", Condition: config.CondCrashLoop, For: "1m", Actions: []string{config.NotifyBell}},
+	}})
+
+	f, now := notifyFactory(true), time.Now()
+	assert.Empty(t, n.Evaluate(&f, now))
+	nn := n.Evaluate(&f, now.Add(time.Minute))
+	assert.Len(t, nn, 1)
+	assert.True(t, nn[0].Has(config.NotifyBell))
+	assert.False(t, nn[0].Has(config.NotifyBanner))
+	assert.Empty(t, n.Evaluate(&f, now.Add(2*time.Minute)))
+
+	f = notifyFactory(false)
+	assert.Empty(t, n.Evaluate(&f, now.Add(3*time.Minute)))
+
+	f = notifyFactory(true)
+	assert.Empty(t, n.Evaluate(&f, now.Add(4*time.Minute)))
+	assert.Len(t, n.Evaluate(&f, now.Add(5*time.Minute)), 1)
+}
+
+// Helpers...
+
+func notifyFactory(crashing bool) testFactory {
+	waiting := map[string]any{"running": map[string]any{}}
+	if crashing {
+		waiting = map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}}
+	}
+	node := func(n, status, reason string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":     "Node",
+			"metadata": map[string]any{"name": n},
+			"status": map[string]any{"conditions": []any{
+				map[string]any{"type": "Ready", "status": status, "reason": reason},
+			}},
+		}}
+	}
+	pvc := func(n, phase string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":     "PersistentVolumeClaim",
+			"metadata": map[string]any{"name": n, "namespace": "ns1"},
+			"status":   map[string]any{"phase": phase},
+		}}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		client.NamespaceAll: {
+			client.PodGVR: {
+				&unstructured.Unstructured{Object: map[string]any{
+					"kind":     "Pod",
+					"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+					"status": map[string]any{"containerStatuses": []any{map[string]any{
+						"name":         "c1",
+						"restartCount": int64(5),
+						"state":        waiting,
+					}}},
+				}},
+			},
+			client.PvcGVR: {pvc("p1", "Pending"), pvc("p2", "Bound")},
+		},
+		client.ClusterScope: {
+			client.NodeGVR: {node("n1", "True", "KubeletReady"), node("n2", "False", "KubeletNotReady")},
+		},
+	}}
+}
@@ -113,16 +113,17 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	var pmx client.PodsMetricsMap
+	var (
+		pmx    client.PodsMetricsMap
+		custom client.CustomMetrics
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
-		pmx, _ = client.DialMetrics(p.Client()).FetchPodsMetricsMap(ctx, ns)
-	}
-	sel, _ := ctx.Value(internal.KeyFields).(string)
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
-	if err != nil {
-		return nil, err
+		dial := client.DialMetrics(p.Client())
+		pmx, _ = dial.FetchPodsMetricsMap(ctx, ns)
+		if custom, err = dial.FetchPodsCustomMetrics(ctx, ns); err != nil {
+			slog.Warn("Unable to fetch pods custom metrics", slogs.Error, err)
+		}
 	}
-	nodeName := fsel["spec.nodeName"]
 
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
@@ -131,18 +132,7 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		fqn := extractFQN(o)
-		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
-			continue
-		}
-
-		spec, ok := u.Object["spec"].(map[string]any)
-		if !ok {
-			return res, fmt.Errorf("expecting interface map but got `%T", o)
-		}
-		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
-		}
+		res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn], Custom: custom[fqn]})
 	}
 
 	return res, nil
@@ -306,15 +296,17 @@ func (p *Pod) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool) (R
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&pod.Spec, n) {
+			via := configMapRefs(&pod.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: p.GVR(),
 				FQN: client.FQN(pod.Namespace, pod.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(p.Factory, &pod.Spec, pod.Namespace, n, wait)
+			via, err := secretRefs(p.Factory, &pod.Spec, pod.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Locate secret failed",
 					slogs.FQN, fqn,
@@ -322,12 +314,13 @@ func (p *Pod) Scan(_ context.Context, gvr *client.GVR, fqn string, wait bool) (R
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: p.GVR(),
 				FQN: client.FQN(pod.Namespace, pod.Name),
+				Via: via,
 			})
 		case client.PvcGVR:
 			if !hasPVC(&pod.Spec, n) {
@@ -485,6 +478,10 @@ func readLogs(ctx context.Context, stream io.ReadCloser, out chan<- *LogItem, op
 		bytes, err := r.ReadBytes('\n')
 		if err == nil {
 			item := opts.ToLogItem(tview.EscapeBytes(bytes))
+			if opts.IsPastRange(item.GetTimestamp()) {
+				slog.Debug("Log stream reached end of time range", slogs.Container, opts.Info())
+				return streamEOF
+			}
 			select {
 			case <-ctx.Done():
 				return streamCanceled
@@ -564,15 +561,20 @@ func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs)
 	if err != nil {
 		return err
 	}
+	prior := snapshot(ctx, p.Client(), p.gvr, path)
 	_, err = dial.CoreV1().Pods(ns).Patch(
 		ctx,
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, p.gvr, path, prior)
 
-	return err
+	return nil
 }
 
 func (p *Pod) isControlled(path string) (fqn string, ok bool, err error) {
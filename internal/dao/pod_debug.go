@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	debugContainerPrefix = "debugger-"
+	debugPollInterval    = time.Second
+)
+
+// Debug profiles mirror kubectl debug security profiles.
+const (
+	DebugProfileGeneral  = "general"
+	DebugProfileSysadmin = "sysadmin"
+	DebugProfileNetadmin = "netadmin"
+)
+
+// DebugOptions tracks ephemeral debug container options.
+type DebugOptions struct {
+	Image   string
+	Target  string
+	Profile string
+	Command []string
+}
+
+// Debug injects an ephemeral debug container in a pod and returns its name.
+func (p *Pod) Debug(ctx context.Context, path string, opts *DebugOptions) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, client.NewGVR(client.PodGVR.String()+":ephemeralcontainers"), n, []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to debug pod %s", path)
+	}
+
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	ec, err := debugContainer(po, opts)
+	if err != nil {
+		return "", err
+	}
+	po.Spec.EphemeralContainers = append(po.Spec.EphemeralContainers, *ec)
+	if _, err := dial.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, n, po, metav1.UpdateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", err
+	}
+
+	return ec.Name, nil
+}
+
+// WaitForDebugContainer waits for an ephemeral container to be running.
+func (p *Pod) WaitForDebugContainer(ctx context.Context, path, co string) error {
+	ns, n := client.Namespaced(path)
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	for {
+		po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, s := range po.Status.EphemeralContainerStatuses {
+			if s.Name != co {
+				continue
+			}
+			if s.State.Running != nil {
+				return nil
+			}
+			if t := s.State.Terminated; t != nil {
+				return fmt.Errorf("debug container %s terminated: %s", co, t.Reason)
+			}
+			if w := s.State.Waiting; w != nil && (w.Reason == "ErrImagePull" || w.Reason == "ImagePullBackOff") {
+				return fmt.Errorf("debug container %s failed to start: %s", co, w.Message)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(debugPollInterval):
+		}
+	}
+}
+
+// Helpers...
+
+func debugContainer(po *v1.Pod, opts *DebugOptions) (*v1.EphemeralContainer, error) {
+	if opts.Image == "" {
+		return nil, fmt.Errorf("a debug container image is required")
+	}
+	if opts.Target != "" && !slices.ContainsFunc(po.Spec.Containers, func(c v1.Container) bool {
+		return c.Name == opts.Target
+	}) {
+		return nil, fmt.Errorf("no container %q found in pod %s", opts.Target, po.Name)
+	}
+
+	ec := v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     debugContainerName(po),
+			Image:                    opts.Image,
+			Command:                  opts.Command,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+		},
+		TargetContainerName: opts.Target,
+	}
+	switch opts.Profile {
+	case "", DebugProfileGeneral:
+	case DebugProfileSysadmin:
+		priv := true
+		ec.SecurityContext = &v1.SecurityContext{Privileged: &priv}
+	case DebugProfileNetadmin:
+		ec.SecurityContext = &v1.SecurityContext{
+			Capabilities: &v1.Capabilities{Add: []v1.Capability{"NET_ADMIN", "NET_RAW"}},
+		}
+	default:
+		return nil, fmt.Errorf("invalid debug profile %q", opts.Profile)
+	}
+
+	return &ec, nil
+}
+
+// debugContainerName generates a debug container name unique to the pod.
+func debugContainerName(po *v1.Pod) string {
+	for {
+		n := debugContainerPrefix + utilrand.String(5)
+		if !slices.ContainsFunc(po.Spec.EphemeralContainers, func(c v1.EphemeralContainer) bool {
+			return c.Name == n
+		}) {
+			return n
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDebugContainer(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "c1"}},
+		},
+	}
+	uu := map[string]struct {
+		opts DebugOptions
+		priv bool
+		caps []v1.Capability
+		err  string
+	}{
+		"general": {
+			opts: DebugOptions{Image: "busybox", Target: "c1"},
+		},
+		"sysadmin": {
+			opts: DebugOptions{Image: "busybox", Profile: DebugProfileSysadmin},
+			priv: true,
+		},
+		"netadmin": {
+			opts: DebugOptions{Image: "busybox", Profile: DebugProfileNetadmin},
+			caps: []v1.Capability{"NET_ADMIN", "NET_RAW"},
+		},
+		"no-image": {
+			err: "a debug container image is required",
+		},
+		"no-target": {
+			opts: DebugOptions{Image: "busybox", Target: "c2"},
+			err:  `no container "c2" found in pod p1`,
+		},
+		"bad-profile": {
+			opts: DebugOptions{Image: "busybox", Profile: "blee"},
+			err:  `invalid debug profile "blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ec, err := debugContainer(&po, &u.opts)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(ec.Name, debugContainerPrefix))
+			assert.Equal(t, u.opts.Image, ec.Image)
+			assert.Equal(t, u.opts.Target, ec.TargetContainerName)
+			assert.True(t, ec.Stdin)
+			assert.True(t, ec.TTY)
+			if !u.priv && u.caps == nil {
+				assert.Nil(t, ec.SecurityContext)
+				return
+			}
+			if u.priv {
+				assert.True(t, *ec.SecurityContext.Privileged)
+			}
+			if u.caps != nil {
+				assert.Equal(t, u.caps, ec.SecurityContext.Capabilities.Add)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// execWorkers caps the count of concurrent container commands.
+const execWorkers = 8
+
+// ExecTarget represents a container to run a command into.
+type ExecTarget struct {
+	// Path is the pod fully qualified name.
+	Path string
+
+	// Container is the container name. Blank uses the pod default container.
+	Container string
+}
+
+// ExecResult represents a container command outcome.
+type ExecResult struct {
+	ExecTarget
+
+	// Stdout is the command standard output.
+	Stdout string
+
+	// Stderr is the command standard error.
+	Stderr string
+
+	// ExitCode is the command exit code or -1 if the command could not be run.
+	ExitCode int
+
+	// Err tracks the command failure if any.
+	Err error
+
+	// Elapsed tracks the command duration.
+	Elapsed time.Duration
+}
+
+// Exec runs a non interactive command in a pod container. Stdin is optional.
+func (p *Pod) Exec(ctx context.Context, path, co string, cmd []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, client.PodGVR.WithSubResource("exec"), "", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec into pods")
+	}
+
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	req := dial.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	cfg, err := p.Client().RestConfig()
+	if err != nil {
+		return err
+	}
+	exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	if ws, err := remotecommand.NewWebSocketExecutor(cfg, "GET", req.URL().String()); err == nil {
+		exec, err = remotecommand.NewFallbackExecutor(ws, exec, func(err error) bool {
+			return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// ExitCode returns a command exit code given its error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var e utilexec.ExitError
+	if errors.As(err, &e) {
+		return e.ExitStatus()
+	}
+
+	return -1
+}
+
+// RunCmd concurrently runs a command in the given containers.
+// Results are returned in the targets order.
+func RunCmd(ctx context.Context, f Factory, tt []ExecTarget, cmd []string) []ExecResult {
+	var p Pod
+	p.Init(f, client.PodGVR)
+
+	var (
+		rr  = make([]ExecResult, len(tt))
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, execWorkers)
+	)
+	for i, t := range tt {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			rr[i] = p.run(ctx, t, cmd)
+		}()
+	}
+	wg.Wait()
+
+	return rr
+}
+
+func (p *Pod) run(ctx context.Context, t ExecTarget, cmd []string) ExecResult {
+	r := ExecResult{ExecTarget: t, ExitCode: -1}
+	if r.Container == "" {
+		po, err := p.GetInstance(t.Path)
+		if err != nil {
+			r.Err = err
+			return r
+		}
+		r.Container = defaultContainer(&po.ObjectMeta, &po.Spec)
+	}
+
+	var stdout, stderr bytes.Buffer
+	t0 := time.Now()
+	err := p.Exec(ctx, r.Path, r.Container, cmd, nil, &stdout, &stderr)
+	r.Elapsed = time.Since(t0)
+	r.Stdout, r.Stderr, r.ExitCode = stdout.String(), stderr.String(), ExitCode(err)
+	if r.ExitCode < 0 {
+		r.Err = err
+	}
+
+	return r
+}
+
+func defaultContainer(m *metav1.ObjectMeta, spec *v1.PodSpec) string {
+	if co, ok := GetDefaultContainer(m, spec); ok {
+		return co
+	}
+	if len(spec.Containers) > 0 {
+		return spec.Containers[0].Name
+	}
+
+	return ""
+}
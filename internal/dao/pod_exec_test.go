@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestExitCode(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   int
+	}{
+		"ok": {},
+		"exit": {
+			err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 3"), Code: 3},
+			e:   3,
+		},
+		"wrapped": {
+			err: fmt.Errorf("boom: %w", utilexec.CodeExitError{Err: errors.New("blee"), Code: 127}),
+			e:   127,
+		},
+		"failed": {
+			err: errors.New("unable to upgrade connection"),
+			e:   -1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ExitCode(u.err))
+		})
+	}
+}
+
+func TestDefaultContainer(t *testing.T) {
+	uu := map[string]struct {
+		m    metav1.ObjectMeta
+		spec v1.PodSpec
+		e    string
+	}{
+		"first": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}}},
+			e:    "c1",
+		},
+		"annotated": {
+			m:    metav1.ObjectMeta{Annotations: map[string]string{DefaultContainerAnnotation: "c2"}},
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}}},
+			e:    "c2",
+		},
+		"none": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, defaultContainer(&u.m, &u.spec))
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// ErrTransferTooLarge indicates a transfer exceeded its size limit.
+var ErrTransferTooLarge = errors.New("transfer size limit exceeded")
+
+// ProgressFunc reports transferred bytes count.
+type ProgressFunc func(n int64)
+
+// FileEntry represents a container filesystem entry.
+type FileEntry struct {
+	// Name is the entry base name.
+	Name string
+
+	// Mode is the entry permissions string ie drwxr-xr-x.
+	Mode string
+
+	// Size is the entry size in bytes.
+	Size int64
+
+	// ModTime is the entry last modification time as reported by ls.
+	ModTime string
+
+	// Link is the symlink target if any.
+	Link string
+}
+
+// IsDir checks if the entry is a directory or a link to one.
+func (f FileEntry) IsDir() bool {
+	return strings.HasPrefix(f.Mode, "d")
+}
+
+// IsLink checks if the entry is a symbolic link.
+func (f FileEntry) IsLink() bool {
+	return strings.HasPrefix(f.Mode, "l")
+}
+
+// ListDir lists a container directory content.
+func (p *Pod) ListDir(ctx context.Context, fqn, co, dir string) ([]FileEntry, error) {
+	var stdout, stderr bytes.Buffer
+	if err := p.Exec(ctx, fqn, co, []string{"ls", "-lA", strings.TrimSuffix(dir, "/") + "/"}, nil, &stdout, &stderr); err != nil {
+		return nil, execError(err, &stderr)
+	}
+
+	return parseLs(&stdout), nil
+}
+
+// ReadFile reads up to max bytes of a container file. It reports whether the file was truncated.
+func (p *Pod) ReadFile(ctx context.Context, fqn, co, file string, maxSize int64) ([]byte, bool, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"head", "-c", strconv.FormatInt(maxSize+1, 10), file}
+	if err := p.Exec(ctx, fqn, co, cmd, nil, &stdout, &stderr); err != nil {
+		return nil, false, execError(err, &stderr)
+	}
+	bb := stdout.Bytes()
+	if int64(len(bb)) > maxSize {
+		return bb[:maxSize], true, nil
+	}
+
+	return bb, false, nil
+}
+
+// Download copies a container file or directory into a local directory.
+func (p *Pod) Download(ctx context.Context, fqn, co, src, dst string, maxSize int64, fn ProgressFunc) error {
+	src = path.Clean(src)
+	pr, pw := io.Pipe()
+	var stderr bytes.Buffer
+	go func() {
+		cmd := []string{"tar", "cf", "-", "-C", path.Dir(src), path.Base(src)}
+		err := p.Exec(ctx, fqn, co, cmd, nil, newProgressWriter(pw, maxSize, fn), &stderr)
+		_ = pw.CloseWithError(execError(err, &stderr))
+	}()
+	defer pr.Close()
+
+	return untar(pr, dst)
+}
+
+// Upload copies a local file or directory into a container directory.
+func (p *Pod) Upload(ctx context.Context, fqn, co, src, dir string, maxSize int64, fn ProgressFunc) error {
+	size, err := localSize(src)
+	if err != nil {
+		return err
+	}
+	if size > maxSize {
+		return fmt.Errorf("%w: %s is %d bytes (max %d)", ErrTransferTooLarge, src, size, maxSize)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(tarDir(newProgressWriter(pw, maxSize, fn), src))
+	}()
+	defer pr.Close()
+
+	var stdout, stderr bytes.Buffer
+	cmd := []string{"tar", "xmf", "-", "-C", dir}
+	if err := p.Exec(ctx, fqn, co, cmd, pr, &stdout, &stderr); err != nil {
+		return execError(err, &stderr)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func execError(err error, stderr *bytes.Buffer) error {
+	if err == nil {
+		return nil
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+
+	return err
+}
+
+// parseLs parses `ls -l` output. Total and unparsable lines are skipped.
+func parseLs(r io.Reader) []FileEntry {
+	ee := make([]FileEntry, 0, 20)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if e, ok := parseLsLine(s.Text()); ok {
+			ee = append(ee, e)
+		}
+	}
+
+	return ee
+}
+
+func parseLsLine(l string) (FileEntry, bool) {
+	ff := strings.Fields(l)
+	if len(ff) < 9 || len(ff[0]) < 10 {
+		return FileEntry{}, false
+	}
+	// Device files report major, minor numbers in place of a size.
+	if strings.HasSuffix(ff[4], ",") {
+		ff = append(ff[:4], ff[5:]...)
+		if len(ff) < 9 {
+			return FileEntry{}, false
+		}
+	}
+	size, _ := strconv.ParseInt(ff[4], 10, 64)
+	e := FileEntry{
+		Mode:    ff[0],
+		Size:    size,
+		ModTime: strings.Join(ff[5:8], " "),
+		Name:    strings.Join(ff[8:], " "),
+	}
+	if e.IsLink() {
+		if name, link, ok := strings.Cut(e.Name, " -> "); ok {
+			e.Name, e.Link = name, link
+		}
+	}
+
+	return e, true
+}
+
+type progressWriter struct {
+	w       io.Writer
+	n, max  int64
+	notifFn ProgressFunc
+}
+
+func newProgressWriter(w io.Writer, maxSize int64, fn ProgressFunc) *progressWriter {
+	return &progressWriter{w: w, max: maxSize, notifFn: fn}
+}
+
+func (p *progressWriter) Write(bb []byte) (int, error) {
+	if p.max > 0 && p.n+int64(len(bb)) > p.max {
+		return 0, fmt.Errorf("%w (max %d bytes)", ErrTransferTooLarge, p.max)
+	}
+	n, err := p.w.Write(bb)
+	p.n += int64(n)
+	if p.notifFn != nil {
+		p.notifFn(p.n)
+	}
+
+	return n, err
+}
+
+func localSize(src string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(src, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+
+	return size, err
+}
+
+// tarDir archives a local file or directory. Only regular files and directories are kept.
+func tarDir(w io.Writer, src string) error {
+	tw := tar.NewWriter(w)
+	root := filepath.Dir(filepath.Clean(src))
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			slog.Warn("Skipping non regular file", slogs.Path, p)
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		h, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		h.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(h); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// untar extracts an archive into a local directory. Links and entries escaping the
+// destination are skipped.
+func untar(r io.Reader, dst string) error {
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, filepath.FromSlash(h.Name))
+		if rel, err := filepath.Rel(dst, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			slog.Warn("Skipping archive entry outside of destination", slogs.Path, h.Name)
+			continue
+		}
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := writeFile(tr, target, h.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		default:
+			slog.Warn("Skipping non regular archive entry", slogs.Path, h.Name)
+		}
+	}
+}
+
+func writeFile(r io.Reader, target string, mode fs.FileMode) error {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLsLine(t *testing.T) {
+	uu := map[string]struct {
+		line string
+		ok   bool
+		e    FileEntry
+	}{
+		"total": {
+			line: "total 64",
+		},
+		"dir": {
+			line: "drwxr-xr-x    2 root     root          4096 Jan  1 00:00 bin",
+			ok:   true,
+			e:    FileEntry{Name: "bin", Mode: "drwxr-xr-x", Size: 4096, ModTime: "Jan 1 00:00"},
+		},
+		"file": {
+			line: "-rw-r--r-- 1 root root 1234 Mar 12  2024 my file.txt",
+			ok:   true,
+			e:    FileEntry{Name: "my file.txt", Mode: "-rw-r--r--", Size: 1234, ModTime: "Mar 12 2024"},
+		},
+		"link": {
+			line: "lrwxrwxrwx 1 root root 7 Jan 1 00:00 lib -> usr/lib",
+			ok:   true,
+			e:    FileEntry{Name: "lib", Mode: "lrwxrwxrwx", Size: 7, ModTime: "Jan 1 00:00", Link: "usr/lib"},
+		},
+		"device": {
+			line: "crw-rw-rw- 1 root root 1, 3 Jan 1 00:00 null",
+			ok:   true,
+			e:    FileEntry{Name: "null", Mode: "crw-rw-rw-", Size: 3, ModTime: "Jan 1 00:00"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, ok := parseLsLine(u.line)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "fred")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "blee"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "blee", "f1.txt"), []byte("hello"), 0o644))
+
+	var buff bytes.Buffer
+	require.NoError(t, tarDir(&buff, src))
+
+	dst := t.TempDir()
+	require.NoError(t, untar(&buff, dst))
+	bb, err := os.ReadFile(filepath.Join(dst, "fred", "blee", "f1.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(bb))
+}
+
+func TestUntarOutsideDest(t *testing.T) {
+	var buff bytes.Buffer
+	tw := tar.NewWriter(&buff)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../evil", Mode: 0o644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	root := t.TempDir()
+	dst := filepath.Join(root, "dst")
+	require.NoError(t, untar(&buff, dst))
+	_, err = os.Stat(filepath.Join(root, "evil"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestProgressWriter(t *testing.T) {
+	var (
+		buff bytes.Buffer
+		last int64
+	)
+	w := newProgressWriter(&buff, 10, func(n int64) { last = n })
+
+	_, err := w.Write([]byte(strings.Repeat("x", 6)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), last)
+
+	_, err = w.Write([]byte(strings.Repeat("x", 6)))
+	require.ErrorIs(t, err, ErrTransferTooLarge)
+	assert.Equal(t, 6, buff.Len())
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// selectorOwners tracks pod controllers that carry a pods selector.
+var selectorOwners = map[string]*client.GVR{
+	"ReplicaSet":  client.RsGVR,
+	"Deployment":  client.DpGVR,
+	"StatefulSet": client.StsGVR,
+	"DaemonSet":   client.DsGVR,
+	"Job":         client.JobGVR,
+}
+
+type selectorOwner struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Selector *metav1.LabelSelector `json:"selector"`
+	} `json:"spec"`
+}
+
+// PodSelector returns the selector of a pod top level controller so that a backing pod
+// can be re-resolved once the pod goes away. Standalone pods yield a blank selector.
+func PodSelector(f Factory, po *v1.Pod) (string, error) {
+	var sel *metav1.LabelSelector
+	for ref := metav1.GetControllerOf(po); ref != nil; {
+		gvr, ok := selectorOwners[ref.Kind]
+		if !ok {
+			break
+		}
+		o, err := f.Get(gvr, client.FQN(po.Namespace, ref.Name), true, labels.Everything())
+		if err != nil {
+			return "", err
+		}
+		var owner selectorOwner
+		if err := toTyped(o, &owner); err != nil {
+			return "", err
+		}
+		sel, ref = owner.Spec.Selector, metav1.GetControllerOf(&owner)
+	}
+	if sel == nil {
+		return "", nil
+	}
+
+	return selectorString(sel)
+}
+
+// ReadyPod returns a ready pod matching a selector, favoring a given pod name.
+// A blank selector only checks the favored pod.
+func ReadyPod(f Factory, ns, sel, prefer string) (string, error) {
+	if sel == "" {
+		o, err := f.Get(client.PodGVR, client.FQN(ns, prefer), true, labels.Everything())
+		if err != nil {
+			return "", err
+		}
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return "", err
+		}
+		if !isPodServing(&po) {
+			return "", fmt.Errorf("pod %s is not ready", client.FQN(ns, prefer))
+		}
+		return client.FQN(ns, prefer), nil
+	}
+
+	lsel, err := labels.Parse(sel)
+	if err != nil {
+		return "", err
+	}
+	oo, err := f.List(client.PodGVR, ns, true, lsel)
+	if err != nil {
+		return "", err
+	}
+	pp := make([]string, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return "", err
+		}
+		if !isPodServing(&po) {
+			continue
+		}
+		if po.Name == prefer {
+			return client.FQN(ns, po.Name), nil
+		}
+		pp = append(pp, po.Name)
+	}
+	if len(pp) == 0 {
+		return "", fmt.Errorf("no ready pods matching %q in namespace %s", sel, ns)
+	}
+	sort.Strings(pp)
+
+	return client.FQN(ns, pp[0]), nil
+}
+
+func selectorString(sel *metav1.LabelSelector) (string, error) {
+	s, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return "", err
+	}
+
+	return s.String(), nil
+}
+
+func isPodServing(po *v1.Pod) bool {
+	return po.DeletionTimestamp == nil && po.Status.Phase == v1.PodRunning && isPodReady(po)
+}
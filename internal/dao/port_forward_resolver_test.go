@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPodSelector(t *testing.T) {
+	f := resolverFactory()
+	po := v1.Pod{}
+	po.Namespace, po.Name = "ns1", "fred-1-a"
+
+	sel, err := dao.PodSelector(&f, &po)
+	require.NoError(t, err)
+	assert.Empty(t, sel)
+
+	ctrl := true
+	po.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "fred-1", Controller: &ctrl}}
+	sel, err = dao.PodSelector(&f, &po)
+	require.NoError(t, err)
+	assert.Equal(t, "app=fred", sel)
+}
+
+func TestReadyPod(t *testing.T) {
+	f := resolverFactory()
+
+	fqn, err := dao.ReadyPod(&f, "ns1", "app=fred", "fred-1-a")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fred-1-b", fqn)
+
+	fqn, err = dao.ReadyPod(&f, "ns1", "app=fred", "fred-1-c")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fred-1-c", fqn)
+
+	fqn, err = dao.ReadyPod(&f, "ns1", "", "fred-1-c")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fred-1-c", fqn)
+
+	_, err = dao.ReadyPod(&f, "ns1", "", "fred-1-a")
+	require.Error(t, err)
+}
+
+func TestServiceSelector(t *testing.T) {
+	f := resolverFactory()
+
+	var s dao.Service
+	s.Init(&f, client.SvcGVR)
+	sel, err := s.Selector("ns1/fred")
+	require.NoError(t, err)
+	assert.Equal(t, "app=fred", sel)
+
+	fqn, err := s.Pod("ns1/fred")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fred-1-b", fqn)
+}
+
+// Helpers...
+
+func resolverFactory() testFactory {
+	newPod := func(n string, ready bool) *unstructured.Unstructured {
+		status := "False"
+		if ready {
+			status = "True"
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": n, "namespace": "ns1", "labels": map[string]any{"app": "fred"}},
+			"status": map[string]any{
+				"phase":      "Running",
+				"conditions": []any{map[string]any{"type": "Ready", "status": status}},
+			},
+		}}
+	}
+	selector := map[string]any{"matchLabels": map[string]any{"app": "fred"}}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {
+			client.DpGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+				"spec":     map[string]any{"selector": selector},
+			}}},
+			client.RsGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{
+					"name":      "fred-1",
+					"namespace": "ns1",
+					"ownerReferences": []any{
+						map[string]any{"kind": "Deployment", "name": "fred", "uid": "dp", "apiVersion": "apps/v1", "controller": true},
+					},
+				},
+				"spec": map[string]any{"selector": map[string]any{"matchLabels": map[string]any{"app": "fred", "hash": "1"}}},
+			}}},
+			client.PodGVR: {newPod("fred-1-a", false), newPod("fred-1-b", true), newPod("fred-1-c", true)},
+			client.SvcGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+				"spec":     map[string]any{"selector": map[string]any{"app": "fred"}},
+			}}},
+		},
+	}}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -27,6 +28,20 @@ import (
 
 const defaultTimeout = 30 * time.Second
 
+const (
+	// PFActive tracks an established port-forward.
+	PFActive = "Active"
+
+	// PFPending tracks a port-forward being established.
+	PFPending = "Pending"
+
+	// PFReconnecting tracks a port-forward being re-established.
+	PFReconnecting = "Reconnecting"
+
+	// PFFailed tracks a port-forward that could not be re-established.
+	PFFailed = "Failed"
+)
+
 // PortForwarder tracks a port forward stream.
 type PortForwarder struct {
 	Factory
@@ -37,6 +52,11 @@ type PortForwarder struct {
 	path                string
 	tunnel              port.PortTunnel
 	age                 time.Time
+	selector            string
+	keepAlive           bool
+	status              string
+	retries             int
+	mx                  sync.RWMutex
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -68,6 +88,77 @@ func (p *PortForwarder) SetActive(b bool) {
 	p.active = b
 }
 
+// Status returns the forward connection status.
+func (p *PortForwarder) Status() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	switch {
+	case p.status == PFReconnecting:
+		return fmt.Sprintf("%s(%d)", p.status, p.retries)
+	case p.status != "":
+		return p.status
+	case p.active:
+		return PFActive
+	default:
+		return PFPending
+	}
+}
+
+// SetStatus updates the forward connection status and retries count.
+func (p *PortForwarder) SetStatus(s string, retries int) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.status, p.retries = s, retries
+}
+
+// Retries returns the number of reconnect attempts.
+func (p *PortForwarder) Retries() int {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.retries
+}
+
+// KeepAlive checks if the forward should be re-established when its pod goes away.
+func (p *PortForwarder) KeepAlive() bool {
+	return p.keepAlive
+}
+
+// SetKeepAlive sets the forward reconnect policy.
+func (p *PortForwarder) SetKeepAlive(b bool) {
+	p.keepAlive = b
+}
+
+// Selector returns the pods selector used to re-resolve the forward target.
+func (p *PortForwarder) Selector() string {
+	return p.selector
+}
+
+// SetSelector sets the pods selector used to re-resolve the forward target.
+func (p *PortForwarder) SetSelector(s string) {
+	p.selector = s
+}
+
+// Path returns the forwarded pod path.
+func (p *PortForwarder) Path() string {
+	return p.path
+}
+
+// Tunnel returns the forward tunnel.
+func (p *PortForwarder) Tunnel() port.PortTunnel {
+	return p.tunnel
+}
+
+// Stopped checks if the forward was explicitly terminated.
+func (p *PortForwarder) Stopped() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.stopChan == nil
+}
+
 // Port returns the port mapping.
 func (p *PortForwarder) Port() string {
 	return p.tunnel.PortMap()
@@ -100,6 +191,9 @@ func (p *PortForwarder) Container() string {
 
 // Stop terminates a port forward.
 func (p *PortForwarder) Stop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.active = false
 	if p.stopChan != nil {
 		close(p.stopChan)
@@ -149,25 +243,12 @@ func (p *PortForwarder) Start(path string, tt port.PortTunnel) (*portforward.Por
 		return nil, fmt.Errorf("user is not authorized to update portforward")
 	}
 
-	cfg, err := p.Client().RestConfig()
+	u, err := podPortForwardURL(p.Client(), ns, podName)
 	if err != nil {
 		return nil, err
 	}
-	cfg.GroupVersion = &schema.GroupVersion{Group: "", Version: "v1"}
-	cfg.APIPath = "/api"
-	codec, _ := codec()
-	cfg.NegotiatedSerializer = codec.WithoutConversion()
-	clt, err := rest.RESTClientFor(cfg)
-	if err != nil {
-		return nil, err
-	}
-	req := clt.Post().
-		Resource("pods").
-		Namespace(ns).
-		Name(podName).
-		SubResource("portforward")
 
-	return p.forwardPorts("POST", req.URL(), tt.Address, tt.PortMap())
+	return p.forwardPorts("POST", u, tt.Address, tt.PortMap())
 }
 
 func (p *PortForwarder) forwardPorts(method string, u *url.URL, addr, portMap string) (*portforward.PortForwarder, error) {
@@ -199,6 +280,28 @@ func (p *PortForwarder) forwardPorts(method string, u *url.URL, addr, portMap st
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func podPortForwardURL(c client.Connection, ns, po string) (*url.URL, error) {
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.GroupVersion = &schema.GroupVersion{Group: "", Version: "v1"}
+	cfg.APIPath = "/api"
+	codec, _ := codec()
+	cfg.NegotiatedSerializer = codec.WithoutConversion()
+	clt, err := rest.RESTClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	req := clt.Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(po).
+		SubResource("portforward")
+
+	return req.URL(), nil
+}
+
 // PortForwardID computes port-forward identifier.
 func PortForwardID(path, co, portMap string) string {
 	if strings.Contains(path, "|") {
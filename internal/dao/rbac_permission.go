@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Permission)(nil)
+
+// Permission aggregates a subject bound roles into effective permissions.
+type Permission struct {
+	NonResource
+}
+
+// List returns a subject effective permissions per namespace scope and resource.
+func (p *Permission) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
+	if !ok {
+		return nil, errors.New("expecting a context subject kind")
+	}
+	name, ok := ctx.Value(internal.KeySubjectName).(string)
+	if !ok {
+		return nil, errors.New("expecting a context subject name")
+	}
+
+	crs, err := fetchClusterRoles(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	crules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for i := range crs {
+		crules[crs[i].Name] = crs[i].Rules
+	}
+	ros, err := fetchRoles(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for i := range ros {
+		rules[client.FQN(ros[i].Namespace, ros[i].Name)] = ros[i].Rules
+	}
+
+	m := newPermissionMatrix()
+	crbs, err := fetchClusterRoleBindings(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	for i := range crbs {
+		crb := &crbs[i]
+		if !bindsSubject(kind, name, crb.Namespace, crb.Subjects) {
+			continue
+		}
+		m.add(render.WhoCanClusterScope, "CRB:"+crb.Name+"(CR:"+crb.RoleRef.Name+")", crules[crb.RoleRef.Name])
+	}
+
+	rbs, err := fetchRoleBindings(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rbs {
+		rb := &rbs[i]
+		if !bindsSubject(kind, name, rb.Namespace, rb.Subjects) {
+			continue
+		}
+		if rb.RoleRef.Kind == "ClusterRole" {
+			m.add(rb.Namespace, "RB:"+rb.Name+"(CR:"+rb.RoleRef.Name+")", crules[rb.RoleRef.Name])
+			continue
+		}
+		m.add(rb.Namespace, "RB:"+rb.Name+"(RO:"+rb.RoleRef.Name+")", rules[client.FQN(rb.Namespace, rb.RoleRef.Name)])
+	}
+
+	return asRuntimeObjects(m.pp), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// permissionMatrix merges policies granted by several roles keyed by scope and resource.
+type permissionMatrix struct {
+	pp   render.Policies
+	rows map[string]*render.PolicyRes
+}
+
+func newPermissionMatrix() *permissionMatrix {
+	return &permissionMatrix{rows: make(map[string]*render.PolicyRes)}
+}
+
+func (m *permissionMatrix) add(scope, source string, rules []rbacv1.PolicyRule) {
+	for _, p := range parseRules(scope, source, rules) {
+		key := scope + "|" + p.GR()
+		row, ok := m.rows[key]
+		if !ok {
+			p.Verbs = slices.Clone(p.Verbs)
+			m.rows[key] = p
+			m.pp = append(m.pp, p)
+			continue
+		}
+		for _, v := range p.Verbs {
+			if !slices.Contains(row.Verbs, v) {
+				row.Verbs = append(row.Verbs, v)
+			}
+		}
+		if !slices.Contains(strings.Split(row.Binding, ","), source) {
+			row.Binding += "," + source
+		}
+	}
+}
+
+// bindsSubject checks if a binding subjects include a given subject.
+// Service accounts also match via their implicit groups.
+func bindsSubject(kind, name, bns string, ss []rbacv1.Subject) bool {
+	ns, n := client.Namespaced(name)
+	var groups []string
+	if kind == rbacv1.ServiceAccountKind {
+		groups = []string{
+			"system:serviceaccounts",
+			"system:serviceaccounts:" + ns,
+			"system:authenticated",
+		}
+	}
+	for i := range ss {
+		if isSameSubject(kind, ns, bns, n, &ss[i]) {
+			return true
+		}
+		if ss[i].Kind == rbacv1.GroupKind && slices.Contains(groups, ss[i].Name) {
+			return true
+		}
+	}
+
+	return false
+}
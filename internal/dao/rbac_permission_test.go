@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPermissionList(t *testing.T) {
+	f := whoCanFactory()
+	f.inventory[client.ClusterScope][client.CrbGVR] = append(f.inventory[client.ClusterScope][client.CrbGVR],
+		&unstructured.Unstructured{Object: map[string]any{
+			"kind":     "ClusterRoleBinding",
+			"metadata": map[string]any{"name": "sa-viewers"},
+			"roleRef":  map[string]any{"kind": "ClusterRole", "name": "view"},
+			"subjects": []any{map[string]any{"kind": "Group", "name": "system:serviceaccounts:ns1"}},
+		}},
+	)
+	f.inventory[client.ClusterScope][client.RobGVR] = append(f.inventory[client.ClusterScope][client.RobGVR],
+		&unstructured.Unstructured{Object: map[string]any{
+			"kind":     "RoleBinding",
+			"metadata": map[string]any{"name": "fred-pods", "namespace": "ns1"},
+			"roleRef":  map[string]any{"kind": "ClusterRole", "name": "view"},
+			"subjects": []any{map[string]any{"kind": "ServiceAccount", "name": "fred", "namespace": "ns1"}},
+		}},
+		&unstructured.Unstructured{Object: map[string]any{
+			"kind":     "RoleBinding",
+			"metadata": map[string]any{"name": "fred-edit", "namespace": "ns1"},
+			"roleRef":  map[string]any{"kind": "Role", "name": "pod-editor"},
+			"subjects": []any{map[string]any{"kind": "ServiceAccount", "name": "fred"}},
+		}},
+	)
+	f.inventory[client.BlankNamespace][client.RoGVR] = append(f.inventory[client.BlankNamespace][client.RoGVR],
+		&unstructured.Unstructured{Object: map[string]any{
+			"kind":     "Role",
+			"metadata": map[string]any{"name": "pod-editor", "namespace": "ns1"},
+			"rules": []any{map[string]any{
+				"verbs":     []any{"update"},
+				"apiGroups": []any{""},
+				"resources": []any{"pods"},
+			}},
+		}},
+	)
+
+	var p dao.Permission
+	p.Init(&f, client.PermGVR)
+	ctx := context.WithValue(context.Background(), internal.KeySubjectKind, "ServiceAccount")
+	ctx = context.WithValue(ctx, internal.KeySubjectName, "ns1/fred")
+	oo, err := p.List(ctx, "")
+	require.NoError(t, err)
+
+	mm := make(map[string]*render.PolicyRes, len(oo))
+	for _, o := range oo {
+		r := o.(*render.PolicyRes)
+		mm[r.Namespace+"|"+r.GR()] = r
+	}
+	require.Len(t, mm, 4)
+
+	cluster := mm["*|core/core/pods"]
+	require.NotNil(t, cluster)
+	assert.Equal(t, []string{"get", "list"}, cluster.Verbs)
+	assert.Equal(t, "CRB:sa-viewers(CR:view)", cluster.Binding)
+
+	pods := mm["ns1|core/core/pods"]
+	require.NotNil(t, pods)
+	assert.Equal(t, []string{"get", "list", "update"}, pods.Verbs)
+	assert.Equal(t, "RB:fred-pods(CR:view),RB:fred-edit(RO:pod-editor)", pods.Binding)
+
+	assert.NotNil(t, mm["ns1|core/secrets/s1"])
+	assert.NotNil(t, mm["ns1|core/core/secrets"])
+}
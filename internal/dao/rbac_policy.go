@@ -74,7 +74,7 @@ func (p *Policy) loadClusterRoleBinding(kind, name string) (render.Policies, err
 			}
 		}
 	}
-	crs, err := p.fetchClusterRoles()
+	crs, err := fetchClusterRoles(p.Factory)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (p *Policy) loadRoleBinding(kind, name string) (render.Policies, error) {
 	if err != nil {
 		return nil, err
 	}
-	crs, err := p.fetchClusterRoles()
+	crs, err := fetchClusterRoles(p.Factory)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,7 @@ func (p *Policy) loadRoleBinding(kind, name string) (render.Policies, error) {
 		}
 	}
 
-	ros, err := p.fetchRoles()
+	ros, err := fetchRoles(p.Factory)
 	if err != nil {
 		return nil, err
 	}
@@ -201,8 +201,8 @@ func isSameSubject(kind, ns, bns, name string, subject *rbacv1.Subject) bool {
 	return true
 }
 
-func (p *Policy) fetchClusterRoles() ([]rbacv1.ClusterRole, error) {
-	oo, err := p.getFactory().List(client.CrGVR, client.ClusterScope, false, labels.Everything())
+func fetchClusterRoles(f Factory) ([]rbacv1.ClusterRole, error) {
+	oo, err := f.List(client.CrGVR, client.ClusterScope, false, labels.Everything())
 	if err != nil {
 		return nil, err
 	}
@@ -219,8 +219,8 @@ func (p *Policy) fetchClusterRoles() ([]rbacv1.ClusterRole, error) {
 	return crs, nil
 }
 
-func (p *Policy) fetchRoles() ([]rbacv1.Role, error) {
-	oo, err := p.getFactory().List(client.RoGVR, client.BlankNamespace, false, labels.Everything())
+func fetchRoles(f Factory) ([]rbacv1.Role, error) {
+	oo, err := f.List(client.RoGVR, client.BlankNamespace, false, labels.Everything())
 	if err != nil {
 		return nil, err
 	}
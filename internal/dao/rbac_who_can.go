@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const whoCanSep = "|"
+
+var _ Accessor = (*WhoCan)(nil)
+
+// WhoCanQuery represents an action to check subjects against.
+type WhoCanQuery struct {
+	Verb, Group, Resource, Subresource string
+}
+
+// NewWhoCanQuery returns a query given a verb and a resource[.group][/subresource] spec.
+// Resources starting with a slash are treated as non resource urls.
+func NewWhoCanQuery(verb, res string) WhoCanQuery {
+	q := WhoCanQuery{Verb: strings.ToLower(verb)}
+	if strings.HasPrefix(res, "/") {
+		q.Resource = res
+		return q
+	}
+	res, q.Subresource, _ = strings.Cut(res, "/")
+	q.Resource, q.Group, _ = strings.Cut(res, ".")
+
+	return q
+}
+
+// IsNonResource checks if the query targets a non resource url.
+func (q WhoCanQuery) IsNonResource() bool {
+	return strings.HasPrefix(q.Resource, "/")
+}
+
+// String returns the query as a verb resource[.group][/subresource] spec.
+func (q WhoCanQuery) String() string {
+	res := q.Resource
+	if q.Group != "" {
+		res += "." + q.Group
+	}
+	if q.Subresource != "" {
+		res += "/" + q.Subresource
+	}
+
+	return q.Verb + " " + res
+}
+
+// Allows checks if a policy rule grants the query action.
+func (q WhoCanQuery) Allows(r *rbacv1.PolicyRule) bool {
+	if !matchesAny(r.Verbs, q.Verb) {
+		return false
+	}
+	if q.IsNonResource() {
+		for _, u := range r.NonResourceURLs {
+			if u == rbacv1.NonResourceAll || u == q.Resource {
+				return true
+			}
+			if strings.HasSuffix(u, "*") && strings.HasPrefix(q.Resource, strings.TrimSuffix(u, "*")) {
+				return true
+			}
+		}
+		return false
+	}
+	if !matchesAny(r.APIGroups, q.Group) {
+		return false
+	}
+	res := q.Resource
+	if q.Subresource != "" {
+		res += "/" + q.Subresource
+		if slices.Contains(r.Resources, "*/"+q.Subresource) {
+			return true
+		}
+	}
+
+	return matchesAny(r.Resources, res)
+}
+
+// WhoCan lists subjects allowed to perform an action.
+type WhoCan struct {
+	NonResource
+}
+
+// WhoCanID returns a who-can entry id.
+func WhoCanID(gvr *client.GVR, binding, kind, subject string) string {
+	return strings.Join([]string{gvr.String(), binding, kind, subject}, whoCanSep)
+}
+
+// ParseWhoCanID returns a who-can entry granting binding resource and path.
+func ParseWhoCanID(id string) (*client.GVR, string, bool) {
+	tt := strings.Split(id, whoCanSep)
+	if len(tt) != 4 {
+		return nil, "", false
+	}
+
+	return client.NewGVR(tt[0]), tt[1], true
+}
+
+// List returns the subjects allowed to perform an action via cluster and role bindings.
+func (w *WhoCan) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyWhoCan).(WhoCanQuery)
+	if !ok {
+		return nil, errors.New("no who-can query found in context")
+	}
+
+	crs, err := fetchClusterRoles(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	crules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for i := range crs {
+		crules[crs[i].Name] = crs[i].Rules
+	}
+
+	crbs, err := fetchClusterRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	var rr []*render.WhoCanRes
+	for i := range crbs {
+		crb := &crbs[i]
+		verbs, names, ok := grants(q, crules[crb.RoleRef.Name])
+		if !ok {
+			continue
+		}
+		rr = append(rr, whoCanRows(crb.Subjects, "", whoCanGrant{
+			gvr:     client.CrbGVR,
+			fqn:     crb.Name,
+			scope:   render.WhoCanClusterScope,
+			binding: "ClusterRoleBinding/" + crb.Name,
+			role:    crb.RoleRef.Kind + "/" + crb.RoleRef.Name,
+			verbs:   verbs,
+			names:   names,
+		})...)
+	}
+	// Role bindings never grant access to non resource urls.
+	if q.IsNonResource() {
+		return asWhoCanObjects(rr), nil
+	}
+
+	ros, err := fetchRoles(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for i := range ros {
+		rules[client.FQN(ros[i].Namespace, ros[i].Name)] = ros[i].Rules
+	}
+	rbs, err := fetchRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rbs {
+		rb := &rbs[i]
+		if !client.IsAllNamespaces(ns) && rb.Namespace != ns {
+			continue
+		}
+		pp := rules[client.FQN(rb.Namespace, rb.RoleRef.Name)]
+		if rb.RoleRef.Kind == "ClusterRole" {
+			pp = crules[rb.RoleRef.Name]
+		}
+		verbs, names, ok := grants(q, pp)
+		if !ok {
+			continue
+		}
+		rr = append(rr, whoCanRows(rb.Subjects, rb.Namespace, whoCanGrant{
+			gvr:     client.RobGVR,
+			fqn:     client.FQN(rb.Namespace, rb.Name),
+			scope:   rb.Namespace,
+			binding: "RoleBinding/" + rb.Name,
+			role:    rb.RoleRef.Kind + "/" + rb.RoleRef.Name,
+			verbs:   verbs,
+			names:   names,
+		})...)
+	}
+
+	return asWhoCanObjects(rr), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type whoCanGrant struct {
+	gvr           *client.GVR
+	fqn, scope    string
+	binding, role string
+	verbs, names  []string
+}
+
+func whoCanRows(ss []rbacv1.Subject, bns string, g whoCanGrant) []*render.WhoCanRes {
+	rr := make([]*render.WhoCanRes, 0, len(ss))
+	for _, s := range ss {
+		name := s.Name
+		if s.Kind == rbacv1.ServiceAccountKind {
+			sns := s.Namespace
+			if sns == "" {
+				sns = bns
+			}
+			name = client.FQN(sns, s.Name)
+		}
+		rr = append(rr, &render.WhoCanRes{
+			ID:            WhoCanID(g.gvr, g.fqn, s.Kind, name),
+			SubjectKind:   s.Kind,
+			Subject:       name,
+			Scope:         g.scope,
+			Binding:       g.binding,
+			Role:          g.role,
+			Verbs:         g.verbs,
+			ResourceNames: g.names,
+		})
+	}
+
+	return rr
+}
+
+// grants returns the verbs and resource names granted by the rules matching a query.
+// An empty resource names list means all resources are granted.
+func grants(q WhoCanQuery, rules []rbacv1.PolicyRule) ([]string, []string, bool) {
+	var (
+		verbs, names []string
+		allNames, ok bool
+	)
+	for i := range rules {
+		if !q.Allows(&rules[i]) {
+			continue
+		}
+		ok = true
+		for _, v := range rules[i].Verbs {
+			if !slices.Contains(verbs, v) {
+				verbs = append(verbs, v)
+			}
+		}
+		if len(rules[i].ResourceNames) == 0 {
+			allNames = true
+		}
+		for _, n := range rules[i].ResourceNames {
+			if !slices.Contains(names, n) {
+				names = append(names, n)
+			}
+		}
+	}
+	if allNames {
+		names = nil
+	}
+
+	return verbs, names, ok
+}
+
+func matchesAny(ss []string, s string) bool {
+	return slices.Contains(ss, s) || slices.Contains(ss, "*")
+}
+
+func asWhoCanObjects(rr []*render.WhoCanRes) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewWhoCanQuery(t *testing.T) {
+	uu := map[string]struct {
+		verb, res string
+		e         dao.WhoCanQuery
+	}{
+		"core": {
+			verb: "GET",
+			res:  "pods",
+			e:    dao.WhoCanQuery{Verb: "get", Resource: "pods"},
+		},
+		"group": {
+			verb: "delete",
+			res:  "deployments.apps",
+			e:    dao.WhoCanQuery{Verb: "delete", Group: "apps", Resource: "deployments"},
+		},
+		"subresource": {
+			verb: "create",
+			res:  "pods/exec",
+			e:    dao.WhoCanQuery{Verb: "create", Resource: "pods", Subresource: "exec"},
+		},
+		"non-resource": {
+			verb: "get",
+			res:  "/metrics",
+			e:    dao.WhoCanQuery{Verb: "get", Resource: "/metrics"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.NewWhoCanQuery(u.verb, u.res))
+		})
+	}
+}
+
+func TestWhoCanQueryAllows(t *testing.T) {
+	uu := map[string]struct {
+		q    dao.WhoCanQuery
+		r    rbacv1.PolicyRule
+		want bool
+	}{
+		"match": {
+			q:    dao.NewWhoCanQuery("get", "pods"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+			want: true,
+		},
+		"wildcards": {
+			q:    dao.NewWhoCanQuery("delete", "deployments.apps"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			want: true,
+		},
+		"verb-mismatch": {
+			q: dao.NewWhoCanQuery("delete", "pods"),
+			r: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+		},
+		"group-mismatch": {
+			q: dao.NewWhoCanQuery("get", "deployments.apps"),
+			r: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"deployments"}},
+		},
+		"subresource": {
+			q:    dao.NewWhoCanQuery("create", "pods/exec"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"pods/exec"}},
+			want: true,
+		},
+		"subresource-wildcard": {
+			q:    dao.NewWhoCanQuery("update", "deployments.apps/scale"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"update"}, APIGroups: []string{"apps"}, Resources: []string{"*/scale"}},
+			want: true,
+		},
+		"subresource-mismatch": {
+			q: dao.NewWhoCanQuery("create", "pods/exec"),
+			r: rbacv1.PolicyRule{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+		},
+		"non-resource": {
+			q:    dao.NewWhoCanQuery("get", "/metrics"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"/metrics"}},
+			want: true,
+		},
+		"non-resource-prefix": {
+			q:    dao.NewWhoCanQuery("get", "/healthz/ready"),
+			r:    rbacv1.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz*"}},
+			want: true,
+		},
+		"non-resource-mismatch": {
+			q: dao.NewWhoCanQuery("get", "/metrics"),
+			r: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.want, u.q.Allows(&u.r))
+		})
+	}
+}
+
+func TestWhoCanList(t *testing.T) {
+	f := whoCanFactory()
+	var w dao.WhoCan
+	w.Init(&f, client.WcGVR)
+
+	ctx := context.WithValue(context.Background(), internal.KeyWhoCan, dao.NewWhoCanQuery("get", "secrets"))
+	oo, err := w.List(ctx, client.NamespaceAll)
+	require.NoError(t, err)
+	require.Len(t, oo, 3)
+
+	mm := make(map[string]*render.WhoCanRes, len(oo))
+	for _, o := range oo {
+		r := o.(*render.WhoCanRes)
+		mm[r.Subject] = r
+	}
+
+	admins := mm["admins"]
+	require.NotNil(t, admins)
+	assert.Equal(t, "Group", admins.SubjectKind)
+	assert.Equal(t, render.WhoCanClusterScope, admins.Scope)
+	assert.Equal(t, "ClusterRoleBinding/admins", admins.Binding)
+	assert.Equal(t, "ClusterRole/admin", admins.Role)
+	assert.Equal(t, []string{"*"}, admins.Verbs)
+	gvr, fqn, ok := dao.ParseWhoCanID(admins.ID)
+	require.True(t, ok)
+	assert.Equal(t, client.CrbGVR, gvr)
+	assert.Equal(t, "admins", fqn)
+
+	sa := mm["ns1/fred"]
+	require.NotNil(t, sa)
+	assert.Equal(t, "ServiceAccount", sa.SubjectKind)
+	assert.Equal(t, "ns1", sa.Scope)
+	assert.Equal(t, "Role/secret-reader", sa.Role)
+	assert.Equal(t, []string{"s1"}, sa.ResourceNames)
+	gvr, fqn, ok = dao.ParseWhoCanID(sa.ID)
+	require.True(t, ok)
+	assert.Equal(t, client.RobGVR, gvr)
+	assert.Equal(t, "ns1/fred", fqn)
+
+	blee := mm["blee"]
+	require.NotNil(t, blee)
+	assert.Equal(t, "ns2", blee.Scope)
+	assert.Equal(t, "ClusterRole/admin", blee.Role)
+	assert.Nil(t, mm["viewers"])
+
+	oo, err = w.List(ctx, "ns2")
+	require.NoError(t, err)
+	assert.Len(t, oo, 2)
+
+	ctx = context.WithValue(context.Background(), internal.KeyWhoCan, dao.NewWhoCanQuery("get", "/metrics"))
+	oo, err = w.List(ctx, client.NamespaceAll)
+	require.NoError(t, err)
+	assert.Len(t, oo, 1)
+}
+
+// Helpers...
+
+func whoCanFactory() testFactory {
+	role := func(kind, ns, n string, rules ...any) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":     kind,
+			"metadata": map[string]any{"name": n, "namespace": ns},
+			"rules":    rules,
+		}}
+	}
+	binding := func(kind, ns, n, roleKind, roleName string, subjects ...any) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":     kind,
+			"metadata": map[string]any{"name": n, "namespace": ns},
+			"roleRef":  map[string]any{"kind": roleKind, "name": roleName},
+			"subjects": subjects,
+		}}
+	}
+	subject := func(kind, ns, n string) any {
+		return map[string]any{"kind": kind, "name": n, "namespace": ns}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		client.ClusterScope: {
+			client.CrGVR: {
+				role("ClusterRole", "", "admin", map[string]any{
+					"verbs":     []any{"*"},
+					"apiGroups": []any{"*"},
+					"resources": []any{"*"},
+				}),
+				role("ClusterRole", "", "view", map[string]any{
+					"verbs":     []any{"get", "list"},
+					"apiGroups": []any{""},
+					"resources": []any{"pods"},
+				}),
+				role("ClusterRole", "", "metrics", map[string]any{
+					"verbs":           []any{"get"},
+					"nonResourceURLs": []any{"/metrics"},
+				}),
+			},
+			client.CrbGVR: {
+				binding("ClusterRoleBinding", "", "admins", "ClusterRole", "admin", subject("Group", "", "admins")),
+				binding("ClusterRoleBinding", "", "viewers", "ClusterRole", "view", subject("Group", "", "viewers")),
+				binding("ClusterRoleBinding", "", "scraper", "ClusterRole", "metrics", subject("User", "", "prom")),
+			},
+			client.RobGVR: {
+				binding("RoleBinding", "ns1", "fred", "Role", "secret-reader", subject("ServiceAccount", "", "fred")),
+				binding("RoleBinding", "ns2", "blee", "ClusterRole", "admin", subject("User", "", "blee")),
+			},
+		},
+		client.BlankNamespace: {
+			client.RoGVR: {
+				role("Role", "ns1", "secret-reader", map[string]any{
+					"verbs":         []any{"get"},
+					"apiGroups":     []any{""},
+					"resources":     []any{"secrets"},
+					"resourceNames": []any{"s1"},
+				}),
+			},
+		},
+	}}
+}
@@ -6,6 +6,7 @@ package dao
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -58,6 +59,7 @@ func (r *Reference) Scan(ctx context.Context) ([]runtime.Object, error) {
 			Namespace: ns,
 			Name:      n,
 			GVR:       ref.GVR,
+			Via:       strings.Join(ref.Via, ","),
 		})
 	}
 
@@ -83,6 +85,7 @@ func (r *Reference) ScanSA(ctx context.Context) ([]runtime.Object, error) {
 			Namespace: ns,
 			Name:      n,
 			GVR:       ref.GVR,
+			Via:       strings.Join(ref.Via, ","),
 		})
 	}
 
@@ -192,6 +192,44 @@ func loadK9s(m ResourceMetas) {
 		ShortNames:   []string{"wk"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.WkhGVR] = &metav1.APIResource{
+		Name:         "workloadhealth",
+		Kind:         "WorkloadHealth",
+		SingularName: "workloadhealth",
+		ShortNames:   []string{"wkh"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NocGVR] = &metav1.APIResource{
+		Name:         "nodecapacity",
+		Kind:         "NodeCapacity",
+		SingularName: "nodecapacity",
+		ShortNames:   []string{"noc"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.StoGVR] = &metav1.APIResource{
+		Name:         "storage",
+		Kind:         "Storage",
+		SingularName: "storage",
+		Namespaced:   true,
+		ShortNames:   []string{"sto"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.CexGVR] = &metav1.APIResource{
+		Name:         "certexpiry",
+		Kind:         "CertExpiry",
+		SingularName: "certexpiry",
+		Namespaced:   true,
+		ShortNames:   []string{"cex"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.RtGVR] = &metav1.APIResource{
+		Name:         "routing",
+		Kind:         "Routing",
+		SingularName: "routing",
+		Namespaced:   true,
+		ShortNames:   []string{"rtg"},
+		Categories:   []string{k9sCat},
+	}
 	m[client.PuGVR] = &metav1.APIResource{
 		Name:         "pulses",
 		Kind:         "Pulse",
@@ -218,6 +256,49 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.TlGVR] = &metav1.APIResource{
+		Name:         "timeline",
+		Kind:         "Timeline",
+		SingularName: "timeline",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.RevGVR] = &metav1.APIResource{
+		Name:         "revisions",
+		Kind:         "Revisions",
+		SingularName: "revision",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.JrGVR] = &metav1.APIResource{
+		Name:         "jobruns",
+		Kind:         "JobRuns",
+		SingularName: "jobrun",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.SkGVR] = &metav1.APIResource{
+		Name:         "secretkeys",
+		Kind:         "SecretKeys",
+		SingularName: "secretkey",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.SepGVR] = &metav1.APIResource{
+		Name:         "serviceendpoints",
+		Kind:         "ServiceEndpoints",
+		SingularName: "serviceendpoint",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.EsGVR] = &metav1.APIResource{
+		Name:         "eventstream",
+		Kind:         "EventStream",
+		SingularName: "eventstream",
+		ShortNames:   []string{"evs"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.AliGVR] = &metav1.APIResource{
 		Name:         "aliases",
 		Kind:         "Aliases",
@@ -241,6 +322,44 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.MutGVR] = &metav1.APIResource{
+		Name:         "mutations",
+		Kind:         "Mutations",
+		SingularName: "mutation",
+		ShortNames:   []string{"mut"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.MonGVR] = &metav1.APIResource{
+		Name:         "monitors",
+		Kind:         "Monitors",
+		SingularName: "monitor",
+		ShortNames:   []string{"mon"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.AlGVR] = &metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alerts",
+		SingularName: "alert",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.AdmGVR] = &metav1.APIResource{
+		Name:         "admission",
+		Kind:         "Admission",
+		SingularName: "admission",
+		ShortNames:   []string{"adm"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.FndGVR] = &metav1.APIResource{
+		Name:         "find",
+		Kind:         "Find",
+		SingularName: "find",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.BeGVR] = &metav1.APIResource{
 		Name:         "benchmarks",
 		Kind:         "Benchmarks",
@@ -303,6 +422,17 @@ func loadRBAC(m ResourceMetas) {
 		Namespaced: true,
 		Categories: []string{k9sCat},
 	}
+	m[client.WcGVR] = &metav1.APIResource{
+		Name:       "whocan",
+		Kind:       "WhoCan",
+		Namespaced: true,
+		Categories: []string{k9sCat},
+	}
+	m[client.PermGVR] = &metav1.APIResource{
+		Name:       "permissions",
+		Kind:       "Permissions",
+		Categories: []string{k9sCat},
+	}
 	m[client.UsrGVR] = &metav1.APIResource{
 		Name:       "users",
 		Kind:       "User",
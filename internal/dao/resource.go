@@ -6,8 +6,11 @@ package dao
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/slogs"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -30,7 +33,24 @@ func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error
 		lsel = sel
 	}
 
-	return r.getFactory().List(r.gvr, ns, false, lsel)
+	fsel, _ := ctx.Value(internal.KeyFields).(string)
+	if fsel != "" {
+		// Informers can't filter by fields, let the api server do it.
+		oo, err := r.Generic.List(ctx, ns)
+		if !apierrors.IsBadRequest(err) {
+			return oo, err
+		}
+		slog.Debug("Field selector not supported by api server. Filtering cached resources",
+			slogs.GVR, r.gvr,
+			slogs.Error, err,
+		)
+	}
+	oo, err := r.getFactory().List(r.gvr, ns, false, lsel)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterFields(oo, fsel)
 }
 
 // Get returns a resource instance if found, else an error.
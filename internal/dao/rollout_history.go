@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+)
+
+const (
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+	revisionHashLabel     = "controller-revision-hash"
+)
+
+var _ Accessor = (*RolloutHistory)(nil)
+
+// RolloutHistory represents a workload rollout revisions.
+type RolloutHistory struct {
+	NonResource
+}
+
+// List returns a workload rollout revisions.
+func (r *RolloutHistory) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(*client.GVR)
+	if !ok {
+		return nil, errors.New("no context for gvr found")
+	}
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+
+	rr, err := r.Revisions(gvr, fqn)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Revisions returns a deployment, statefulset or daemonset revisions, most recent first.
+func (r *RolloutHistory) Revisions(gvr *client.GVR, fqn string) ([]*render.RevisionRes, error) {
+	u, err := getUnstructured(r.getFactory(), gvr, fqn)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := WorkloadRevisions(r.getFactory(), gvr, u)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*render.RevisionRes, 0, len(rr))
+	for i, rev := range rr {
+		var ii []string
+		if spec, ok := rev.Template["spec"].(map[string]any); ok {
+			ii = templateImages(spec)
+		}
+		res = append(res, &render.RevisionRes{
+			Revision:    rev.Number,
+			Name:        rev.Name,
+			Current:     i == 0,
+			Ready:       rev.Ready,
+			Desired:     rev.Desired,
+			Images:      ii,
+			ChangeCause: rev.ChangeCause,
+			Created:     rev.Created,
+		})
+	}
+
+	return res, nil
+}
+
+// Rollback rolls a workload back to a given revision and returns the rollback outcome.
+func (r *RolloutHistory) Rollback(ctx context.Context, gvr *client.GVR, fqn string, rev int64) (string, error) {
+	u, err := getUnstructured(r.getFactory(), gvr, fqn)
+	if err != nil {
+		return "", err
+	}
+	dial, err := r.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	rb, err := polymorphichelpers.RollbackerFor(schema.GroupKind{Group: gvr.G(), Kind: u.GetKind()}, dial)
+	if err != nil {
+		return "", err
+	}
+	prior := snapshot(ctx, r.Client(), gvr, fqn)
+	msg, err := rb.Rollback(u, nil, rev, dryRunStrategy())
+	if err != nil {
+		return "", err
+	}
+	journalMutation(JournalRollback, gvr, fqn, prior)
+
+	return msg, nil
+}
+
+// Helpers...
+
+func templateImages(spec map[string]any) []string {
+	var ii []string
+	for _, k := range []string{"initContainers", "containers"} {
+		cc, _ := spec[k].([]any)
+		for _, c := range cc {
+			if m, ok := c.(map[string]any); ok {
+				ii = append(ii, fmt.Sprint(m["image"]))
+			}
+		}
+	}
+
+	return ii
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRolloutHistoryRevisions(t *testing.T) {
+	f := timelineFactory()
+
+	var h dao.RolloutHistory
+	h.Init(&f, client.RevGVR)
+	rr, err := h.Revisions(client.DpGVR, "ns1/fred")
+	require.NoError(t, err)
+	require.Len(t, rr, 2)
+	assert.Equal(t, int64(2), rr[0].Revision)
+	assert.True(t, rr[0].Current)
+	assert.Equal(t, []string{"nginx:2.0"}, rr[0].Images)
+	assert.Equal(t, "fred-1", rr[1].Name)
+	assert.False(t, rr[1].Current)
+}
+
+func TestRolloutHistoryControllerRevisions(t *testing.T) {
+	owner := []any{map[string]any{"kind": "StatefulSet", "name": "fred", "uid": "sts", "apiVersion": "apps/v1", "controller": true}}
+	crev := func(n string, rev int64, image, cause string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind": "ControllerRevision",
+			"metadata": map[string]any{
+				"name":            n,
+				"namespace":       "ns1",
+				"annotations":     map[string]any{"kubernetes.io/change-cause": cause},
+				"ownerReferences": owner,
+			},
+			"revision": rev,
+			"data": map[string]any{"spec": map[string]any{"template": map[string]any{
+				"$patch": "replace",
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "c1", "image": image}},
+				},
+			}}},
+		}}
+	}
+	pod := func(n, hash string, ready bool) runtime.Object {
+		status := "False"
+		if ready {
+			status = "True"
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind": "Pod",
+			"metadata": map[string]any{
+				"name":            n,
+				"namespace":       "ns1",
+				"labels":          map[string]any{"controller-revision-hash": hash},
+				"ownerReferences": owner,
+			},
+			"status": map[string]any{"conditions": []any{map[string]any{"type": "Ready", "status": status}}},
+		}}
+	}
+	f := testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {
+			client.StsGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "StatefulSet",
+				"metadata": map[string]any{"name": "fred", "namespace": "ns1", "uid": "sts"},
+			}}},
+			client.NewGVR("apps/v1/controllerrevisions"): {
+				crev("fred-a", 1, "nginx:1.0", ""),
+				crev("fred-b", 2, "nginx:2.0", "bump nginx"),
+			},
+			client.PodGVR: {
+				pod("fred-0", "fred-b", true),
+				pod("fred-1", "fred-b", false),
+				pod("fred-2", "fred-a", true),
+			},
+		},
+	}}
+
+	var h dao.RolloutHistory
+	h.Init(&f, client.RevGVR)
+	rr, err := h.Revisions(client.StsGVR, "ns1/fred")
+	require.NoError(t, err)
+	require.Len(t, rr, 2)
+	assert.Equal(t, "bump nginx", rr[0].ChangeCause)
+	assert.Equal(t, []int64{1, 2}, []int64{rr[0].Ready, rr[0].Desired})
+	assert.Equal(t, []int64{1, 1}, []int64{rr[1].Ready, rr[1].Desired})
+
+	d, err := dao.DiffRevision(&f, client.StsGVR, "ns1/fred", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), d.Revision)
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+const progressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+// RolloutStatus tracks a workload rollout progress.
+type RolloutStatus struct {
+	GVR *client.GVR
+	FQN string
+
+	// Desired, Updated, Ready and Available track the replicas progress.
+	Desired, Updated, Ready, Available int64
+
+	// Done indicates all replicas run the latest revision and are available.
+	Done bool
+
+	// Stuck indicates the rollout is not making progress.
+	Stuck bool
+
+	// Reason and Message explain a stuck rollout.
+	Reason, Message string
+
+	// Suggestions lists possible next actions for a stuck rollout.
+	Suggestions []string
+}
+
+// RolloutProgress returns a deployment, statefulset or daemonset rollout progress.
+func RolloutProgress(f Factory, gvr *client.GVR, fqn string) (*RolloutStatus, error) {
+	u, err := getUnstructured(f, gvr, fqn)
+	if err != nil {
+		return nil, err
+	}
+	st := RolloutStatus{GVR: gvr, FQN: fqn}
+
+	var sel *metav1.LabelSelector
+	switch gvr {
+	case client.DpGVR:
+		var dp appsv1.Deployment
+		if err := toTyped(u, &dp); err != nil {
+			return nil, err
+		}
+		sel = dp.Spec.Selector
+		st.Desired = int64(ptr.Deref(dp.Spec.Replicas, 1))
+		st.Updated, st.Ready, st.Available = int64(dp.Status.UpdatedReplicas), int64(dp.Status.ReadyReplicas), int64(dp.Status.AvailableReplicas)
+		st.Done = dp.Status.ObservedGeneration >= dp.Generation &&
+			st.Updated == st.Desired && st.Available == st.Desired && dp.Status.Replicas == dp.Status.UpdatedReplicas
+		for _, c := range dp.Status.Conditions {
+			if c.Type == appsv1.DeploymentProgressing && c.Reason == progressDeadlineExceeded {
+				st.Stuck, st.Reason, st.Message = true, c.Reason, c.Message
+			}
+		}
+	case client.StsGVR:
+		var sts appsv1.StatefulSet
+		if err := toTyped(u, &sts); err != nil {
+			return nil, err
+		}
+		sel = sts.Spec.Selector
+		st.Desired = int64(ptr.Deref(sts.Spec.Replicas, 1))
+		st.Updated, st.Ready, st.Available = int64(sts.Status.UpdatedReplicas), int64(sts.Status.ReadyReplicas), int64(sts.Status.AvailableReplicas)
+		st.Done = sts.Status.ObservedGeneration >= sts.Generation &&
+			sts.Status.CurrentRevision == sts.Status.UpdateRevision && st.Ready == st.Desired
+	case client.DsGVR:
+		var ds appsv1.DaemonSet
+		if err := toTyped(u, &ds); err != nil {
+			return nil, err
+		}
+		sel = ds.Spec.Selector
+		st.Desired = int64(ds.Status.DesiredNumberScheduled)
+		st.Updated, st.Ready, st.Available = int64(ds.Status.UpdatedNumberScheduled), int64(ds.Status.NumberReady), int64(ds.Status.NumberAvailable)
+		st.Done = ds.Status.ObservedGeneration >= ds.Generation &&
+			st.Updated == st.Desired && st.Available == st.Desired
+	default:
+		return nil, fmt.Errorf("no rollout status for %s", gvr)
+	}
+	if st.Done {
+		return &st, nil
+	}
+	if err := st.checkPods(f, u.GetNamespace(), sel); err != nil {
+		return nil, err
+	}
+	st.suggest()
+
+	return &st, nil
+}
+
+// Helpers...
+
+// checkPods flags a rollout as stuck when some of its pods can't start.
+func (s *RolloutStatus) checkPods(f Factory, ns string, sel *metav1.LabelSelector) error {
+	if sel == nil {
+		return nil
+	}
+	ls, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return err
+	}
+	oo, err := f.List(client.PodGVR, ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !ls.Matches(labels.Set(u.GetLabels())) {
+			continue
+		}
+		var po v1.Pod
+		if err := toTyped(u, &po); err != nil {
+			return err
+		}
+		if reason, msg, ok := podBlocked(&po); ok && !s.Stuck {
+			s.Stuck, s.Reason, s.Message = true, reason, fmt.Sprintf("pod %s: %s", po.Name, msg)
+		}
+	}
+
+	return nil
+}
+
+func (s *RolloutStatus) suggest() {
+	if !s.Stuck {
+		return
+	}
+	switch s.Reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+		s.Suggestions = append(s.Suggestions, "Check the image name, tag and pull secrets")
+	case "CrashLoopBackOff":
+		s.Suggestions = append(s.Suggestions, "Check the crashing container previous logs")
+	case v1.PodReasonUnschedulable:
+		s.Suggestions = append(s.Suggestions, "Check nodes capacity, taints and the pods resource requests")
+	case "CreateContainerConfigError":
+		s.Suggestions = append(s.Suggestions, "Check the configmaps and secrets referenced by the containers")
+	case progressDeadlineExceeded:
+		s.Suggestions = append(s.Suggestions, "Check the new pods events and logs")
+	}
+	s.Suggestions = append(s.Suggestions, "Rollback to a previous revision from the rollout history")
+}
+
+var blockedReasons = []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CrashLoopBackOff", "CreateContainerConfigError"}
+
+// podBlocked checks if a pod is unschedulable or has containers that can't start.
+func podBlocked(po *v1.Pod) (string, string, bool) {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodScheduled && c.Status == v1.ConditionFalse && c.Reason == v1.PodReasonUnschedulable {
+			return c.Reason, c.Message, true
+		}
+	}
+	for _, cs := range slices.Concat(po.Status.InitContainerStatuses, po.Status.ContainerStatuses) {
+		if w := cs.State.Waiting; w != nil && slices.Contains(blockedReasons, w.Reason) {
+			msg := w.Message
+			if msg == "" {
+				msg = fmt.Sprintf("container %s %s", cs.Name, w.Reason)
+			}
+			return w.Reason, msg, true
+		}
+	}
+
+	return "", "", false
+}
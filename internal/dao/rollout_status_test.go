@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRolloutProgress(t *testing.T) {
+	dp := func(updated, available int64, cc ...any) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind": "Deployment",
+			"metadata": map[string]any{
+				"name":       "fred",
+				"namespace":  "ns1",
+				"generation": int64(2),
+			},
+			"spec": map[string]any{
+				"replicas": int64(2),
+				"selector": map[string]any{"matchLabels": map[string]any{"app": "fred"}},
+			},
+			"status": map[string]any{
+				"observedGeneration": int64(2),
+				"replicas":           int64(2),
+				"updatedReplicas":    updated,
+				"readyReplicas":      available,
+				"availableReplicas":  available,
+				"conditions":         cc,
+			},
+		}}
+	}
+	pod := func(app, reason string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind": "Pod",
+			"metadata": map[string]any{
+				"name":      "fred-" + app,
+				"namespace": "ns1",
+				"labels":    map[string]any{"app": app},
+			},
+			"status": map[string]any{"containerStatuses": []any{map[string]any{
+				"name":  "c1",
+				"state": map[string]any{"waiting": map[string]any{"reason": reason}},
+			}}},
+		}}
+	}
+
+	uu := map[string]struct {
+		oo          []runtime.Object
+		done, stuck bool
+		reason      string
+		suggestions int
+	}{
+		"done": {
+			oo:   []runtime.Object{dp(2, 2)},
+			done: true,
+		},
+		"in-progress": {
+			oo: []runtime.Object{dp(1, 1), pod("fred", "ContainerCreating")},
+		},
+		"image-pull": {
+			oo:          []runtime.Object{dp(1, 1), pod("fred", "ImagePullBackOff")},
+			stuck:       true,
+			reason:      "ImagePullBackOff",
+			suggestions: 2,
+		},
+		"other-pods": {
+			oo: []runtime.Object{dp(1, 1), pod("blee", "ImagePullBackOff")},
+		},
+		"deadline": {
+			oo: []runtime.Object{dp(1, 1, map[string]any{
+				"type":    "Progressing",
+				"status":  "False",
+				"reason":  "ProgressDeadlineExceeded",
+				"message": "fred has timed out progressing",
+			})},
+			stuck:       true,
+			reason:      "ProgressDeadlineExceeded",
+			suggestions: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			inv := map[*client.GVR][]runtime.Object{client.DpGVR: u.oo[:1]}
+			if len(u.oo) > 1 {
+				inv[client.PodGVR] = u.oo[1:]
+			}
+			f := testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{"ns1": inv}}
+
+			st, err := dao.RolloutProgress(&f, client.DpGVR, "ns1/fred")
+			require.NoError(t, err)
+			assert.Equal(t, u.done, st.Done)
+			assert.Equal(t, u.stuck, st.Stuck)
+			assert.Equal(t, u.reason, st.Reason)
+			assert.Len(t, st.Suggestions, u.suggestions)
+		})
+	}
+}
+
+func TestRolloutProgressFails(t *testing.T) {
+	f := timelineFactory()
+
+	_, err := dao.RolloutProgress(&f, client.PodGVR, "ns1/fred")
+	require.Error(t, err)
+}
@@ -0,0 +1,482 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ingressClassAnnotation tracks the legacy ingress class annotation.
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+	// defaultBackendPath tracks an ingress default backend path.
+	defaultBackendPath = "<default>"
+)
+
+var _ Accessor = (*Routing)(nil)
+
+// Routing resolves ingresses and gateway routes backends to their endpoints and pods.
+type Routing struct {
+	NonResource
+}
+
+// routingInventory tracks the resources a routing diagnostic joins.
+type routingInventory struct {
+	ingresses, httpRoutes, services, slices []runtime.Object
+}
+
+// gatewayHTTPRoute represents the gateway.networking.k8s.io HTTPRoute fields k9s resolves.
+type gatewayHTTPRoute struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Hostnames  []string                  `json:"hostnames"`
+		ParentRefs []render.GatewayParentRef `json:"parentRefs"`
+		Rules      []struct {
+			Matches []struct {
+				Path *struct {
+					Value string `json:"value"`
+				} `json:"path"`
+			} `json:"matches"`
+			BackendRefs []render.GatewayBackendRef `json:"backendRefs"`
+		} `json:"rules"`
+	} `json:"spec"`
+	Status struct {
+		Parents []struct {
+			Conditions []metav1.Condition `json:"conditions"`
+		} `json:"parents"`
+	} `json:"status"`
+}
+
+// routeBackend tracks a route backend and the hosts and paths routed to it.
+type routeBackend struct {
+	kind, ns, name, port string
+	hosts, paths         []string
+}
+
+func (b *routeBackend) ref() string {
+	return b.kind + ":" + client.FQN(b.ns, b.name) + ":" + b.port
+}
+
+func (b *routeBackend) display(ns string) string {
+	s := b.name
+	if b.kind != "Service" {
+		s = b.kind + "/" + s
+	}
+	if b.ns != ns {
+		s = b.ns + "/" + s
+	}
+	if b.port != "" {
+		s += ":" + b.port
+	}
+
+	return s
+}
+
+// List returns the routes backends diagnostics.
+func (r *Routing) List(_ context.Context, ns string) ([]runtime.Object, error) {
+	f := r.getFactory()
+	var (
+		inv routingInventory
+		err error
+	)
+	if inv.ingresses, err = f.List(client.IngGVR, ns, true, labels.Everything()); err != nil {
+		return nil, err
+	}
+	if _, err := MetaAccess.MetaFor(client.HrGVR); err == nil {
+		if inv.httpRoutes, err = f.List(client.HrGVR, ns, true, labels.Everything()); err != nil {
+			slog.Warn("Unable to list http routes for routing",
+				slogs.GVR, client.HrGVR,
+				slogs.Error, err,
+			)
+		}
+	}
+	if inv.services, err = f.List(client.SvcGVR, ns, true, labels.Everything()); err != nil {
+		slog.Warn("Unable to list services for routing", slogs.Error, err)
+	}
+	if inv.slices, err = f.List(client.EpsGVR, ns, true, labels.Everything()); err != nil {
+		slog.Warn("Unable to list endpoint slices for routing", slogs.Error, err)
+	}
+	for _, fns := range foreignNamespaces(inv.httpRoutes, ns) {
+		ss, err := f.List(client.SvcGVR, fns, true, labels.Everything())
+		if err != nil {
+			slog.Warn("Unable to list services for routing", slogs.Namespace, fns, slogs.Error, err)
+			continue
+		}
+		inv.services = append(inv.services, ss...)
+		if ee, err := f.List(client.EpsGVR, fns, true, labels.Everything()); err == nil {
+			inv.slices = append(inv.slices, ee...)
+		}
+	}
+
+	rr, err := joinRoutes(&inv)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// ParseRoutePath returns the route gvr and fqn and its backend service fqn if any.
+func ParseRoutePath(path string) (gvr *client.GVR, fqn, svc string, err error) {
+	tt := strings.Split(path, "|")
+	if len(tt) != 4 {
+		return client.NoGVR, "", "", fmt.Errorf("invalid route path: %q", path)
+	}
+	if kind, rest, ok := strings.Cut(tt[3], ":"); ok && kind == "Service" {
+		svc, _, _ = strings.Cut(rest, ":")
+	}
+
+	return client.NewGVR(tt[0]), client.FQN(tt[1], tt[2]), svc, nil
+}
+
+// Helpers...
+
+// joinRoutes returns a diagnostic per route backend.
+func joinRoutes(inv *routingInventory) ([]*render.RouteRes, error) {
+	svcs := make(map[string]*v1.Service, len(inv.services))
+	for _, o := range inv.services {
+		var svc v1.Service
+		if err := toTyped(o, &svc); err != nil {
+			return nil, err
+		}
+		svcs[client.FQN(svc.Namespace, svc.Name)] = &svc
+	}
+	eps := make(map[string][]*discoveryv1.EndpointSlice, len(inv.slices))
+	for _, o := range inv.slices {
+		var es discoveryv1.EndpointSlice
+		if err := toTyped(o, &es); err != nil {
+			return nil, err
+		}
+		if svc, ok := es.Labels[discoveryv1.LabelServiceName]; ok {
+			fqn := client.FQN(es.Namespace, svc)
+			eps[fqn] = append(eps[fqn], &es)
+		}
+	}
+
+	rr := make([]*render.RouteRes, 0, len(inv.ingresses)+len(inv.httpRoutes))
+	for _, o := range inv.ingresses {
+		var ing netv1.Ingress
+		if err := toTyped(o, &ing); err != nil {
+			return nil, err
+		}
+		for _, b := range ingressBackends(&ing) {
+			res := render.RouteRes{
+				GVR:       client.IngGVR.String(),
+				Kind:      "Ingress",
+				Namespace: ing.Namespace,
+				Name:      ing.Name,
+				Parent:    ingressParent(&ing),
+				Age:       ing.CreationTimestamp,
+			}
+			resolveBackend(&res, b, svcs, eps)
+			rr = append(rr, &res)
+		}
+	}
+	for _, o := range inv.httpRoutes {
+		var hr gatewayHTTPRoute
+		if err := toTyped(o, &hr); err != nil {
+			return nil, err
+		}
+		pp := make([]string, 0, len(hr.Spec.ParentRefs))
+		for _, p := range hr.Spec.ParentRefs {
+			pp = append(pp, p.String(hr.Namespace))
+		}
+		for _, b := range httpRouteBackends(&hr) {
+			res := render.RouteRes{
+				GVR:       client.HrGVR.String(),
+				Kind:      "HTTPRoute",
+				Namespace: hr.Namespace,
+				Name:      hr.Name,
+				Parent:    strings.Join(pp, ","),
+				Age:       hr.CreationTimestamp,
+			}
+			if c := rejectedParent(&hr, "Accepted"); c != nil {
+				res.Hosts, res.Paths, res.Backend, res.BackendRef = b.hosts, b.paths, b.display(hr.Namespace), b.ref()
+				res.Status, res.Reason = render.RouteBroken, conditionReason(c)
+				rr = append(rr, &res)
+				continue
+			}
+			resolveBackend(&res, b, svcs, eps)
+			if c := rejectedParent(&hr, "ResolvedRefs"); c != nil && res.Status == render.RouteOK {
+				res.Status, res.Reason = render.RouteBroken, conditionReason(c)
+			}
+			rr = append(rr, &res)
+		}
+	}
+
+	return rr, nil
+}
+
+// resolveBackend resolves a route backend service to its endpoints and pods.
+func resolveBackend(res *render.RouteRes, b *routeBackend, svcs map[string]*v1.Service, eps map[string][]*discoveryv1.EndpointSlice) {
+	res.Hosts, res.Paths = b.hosts, b.paths
+	res.Backend, res.BackendRef = b.display(res.Namespace), b.ref()
+	res.Status = render.RouteOK
+	if b.kind != "Service" {
+		res.Reason = "backend kind " + b.kind + " not resolved"
+		return
+	}
+
+	fqn := client.FQN(b.ns, b.name)
+	svc, ok := svcs[fqn]
+	if !ok {
+		res.Status, res.Reason = render.RouteBroken, "service "+fqn+" not found"
+		return
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		res.Reason = "external name " + svc.Spec.ExternalName
+		return
+	}
+	port, ok := servicePort(svc, b.port)
+	if !ok {
+		res.Status, res.Reason = render.RouteBroken, "service port "+b.port+" not found"
+		return
+	}
+
+	res.HasEndpoints = true
+	pods := make(map[string]struct{})
+	for _, es := range eps[fqn] {
+		if !slicePort(es, port) {
+			continue
+		}
+		for _, e := range es.Endpoints {
+			res.Total++
+			if e.Conditions.Ready == nil || *e.Conditions.Ready {
+				res.Ready++
+			}
+			if e.TargetRef != nil && e.TargetRef.Kind == "Pod" {
+				pods[e.TargetRef.Name] = struct{}{}
+			}
+		}
+	}
+	for p := range pods {
+		res.Pods = append(res.Pods, p)
+	}
+	slices.Sort(res.Pods)
+
+	switch {
+	case res.Ready == 0:
+		res.Status, res.Reason = render.RouteNoEndpoints, "no ready endpoints"
+	case res.Ready < res.Total:
+		res.Status = render.RouteDegraded
+		res.Reason = strconv.Itoa(res.Total-res.Ready) + " endpoint(s) not ready"
+	}
+}
+
+// servicePort returns the service port matching a route port name or number.
+// A blank port matches a single port service.
+func servicePort(svc *v1.Service, port string) (*v1.ServicePort, bool) {
+	if port == "" {
+		if len(svc.Spec.Ports) == 1 {
+			return &svc.Spec.Ports[0], true
+		}
+		return nil, false
+	}
+	for i, p := range svc.Spec.Ports {
+		if p.Name == port || strconv.Itoa(int(p.Port)) == port {
+			return &svc.Spec.Ports[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// slicePort returns true if an endpoint slice serves the given service port.
+func slicePort(es *discoveryv1.EndpointSlice, port *v1.ServicePort) bool {
+	if len(es.Ports) == 0 {
+		return true
+	}
+	for _, p := range es.Ports {
+		if p.Name != nil && *p.Name == port.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ingressParent(ing *netv1.Ingress) string {
+	if ing.Spec.IngressClassName != nil {
+		return *ing.Spec.IngressClassName
+	}
+
+	return ing.Annotations[ingressClassAnnotation]
+}
+
+// ingressBackends returns an ingress backends in rule order.
+func ingressBackends(ing *netv1.Ingress) []*routeBackend {
+	var bb []*routeBackend
+	idx := make(map[string]*routeBackend)
+	add := func(b *netv1.IngressBackend, host, path string) {
+		rb := ingressBackend(ing.Namespace, b)
+		if prev, ok := idx[rb.ref()]; ok {
+			rb = prev
+		} else {
+			idx[rb.ref()] = rb
+			bb = append(bb, rb)
+		}
+		if !slices.Contains(rb.hosts, host) {
+			rb.hosts = append(rb.hosts, host)
+		}
+		if !slices.Contains(rb.paths, path) {
+			rb.paths = append(rb.paths, path)
+		}
+	}
+	if ing.Spec.DefaultBackend != nil {
+		add(ing.Spec.DefaultBackend, "*", defaultBackendPath)
+	}
+	for _, r := range ing.Spec.Rules {
+		host := r.Host
+		if host == "" {
+			host = "*"
+		}
+		if r.HTTP == nil {
+			continue
+		}
+		for i := range r.HTTP.Paths {
+			p := &r.HTTP.Paths[i]
+			path := p.Path
+			if path == "" {
+				path = "/"
+			}
+			add(&p.Backend, host, path)
+		}
+	}
+
+	return bb
+}
+
+func ingressBackend(ns string, b *netv1.IngressBackend) *routeBackend {
+	if b.Resource != nil {
+		return &routeBackend{kind: b.Resource.Kind, ns: ns, name: b.Resource.Name}
+	}
+	rb := routeBackend{kind: "Service", ns: ns}
+	if b.Service != nil {
+		rb.name = b.Service.Name
+		rb.port = b.Service.Port.Name
+		if rb.port == "" && b.Service.Port.Number != 0 {
+			rb.port = strconv.Itoa(int(b.Service.Port.Number))
+		}
+	}
+
+	return &rb
+}
+
+// httpRouteBackends returns an http route backends in rule order.
+func httpRouteBackends(hr *gatewayHTTPRoute) []*routeBackend {
+	hosts := hr.Spec.Hostnames
+	if len(hosts) == 0 {
+		hosts = []string{"*"}
+	}
+	var bb []*routeBackend
+	idx := make(map[string]*routeBackend)
+	for _, r := range hr.Spec.Rules {
+		paths := make([]string, 0, len(r.Matches))
+		for _, m := range r.Matches {
+			if m.Path != nil && m.Path.Value != "" {
+				paths = append(paths, m.Path.Value)
+			}
+		}
+		if len(paths) == 0 {
+			paths = append(paths, "/")
+		}
+		for _, b := range r.BackendRefs {
+			rb := httpRouteBackend(hr.Namespace, b)
+			if prev, ok := idx[rb.ref()]; ok {
+				rb = prev
+			} else {
+				idx[rb.ref()] = rb
+				rb.hosts = hosts
+				bb = append(bb, rb)
+			}
+			for _, p := range paths {
+				if !slices.Contains(rb.paths, p) {
+					rb.paths = append(rb.paths, p)
+				}
+			}
+		}
+	}
+
+	return bb
+}
+
+func httpRouteBackend(ns string, b render.GatewayBackendRef) *routeBackend {
+	rb := routeBackend{kind: b.Kind, ns: b.Namespace, name: b.Name}
+	if b.IsService() {
+		rb.kind = "Service"
+	}
+	if rb.ns == "" {
+		rb.ns = ns
+	}
+	if b.Port != nil {
+		rb.port = strconv.Itoa(int(*b.Port))
+	}
+
+	return &rb
+}
+
+// rejectedParent returns the first parent condition of the given type that is not true.
+func rejectedParent(hr *gatewayHTTPRoute, kind string) *metav1.Condition {
+	for _, p := range hr.Status.Parents {
+		for i, c := range p.Conditions {
+			if c.Type == kind && c.Status != metav1.ConditionTrue {
+				return &p.Conditions[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func conditionReason(c *metav1.Condition) string {
+	if c.Message != "" {
+		return c.Message
+	}
+
+	return c.Type + " " + c.Reason
+}
+
+// foreignNamespaces returns the namespaces other than ns http routes backends live in.
+func foreignNamespaces(oo []runtime.Object, ns string) []string {
+	if client.IsAllNamespaces(ns) {
+		return nil
+	}
+	var nss []string
+	for _, o := range oo {
+		var hr gatewayHTTPRoute
+		if err := toTyped(o, &hr); err != nil {
+			continue
+		}
+		for _, r := range hr.Spec.Rules {
+			for _, b := range r.BackendRefs {
+				if b.Namespace != "" && b.Namespace != ns && !slices.Contains(nss, b.Namespace) {
+					nss = append(nss, b.Namespace)
+				}
+			}
+		}
+	}
+
+	return nss
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestJoinRoutes(t *testing.T) {
+	svc := func(n string, ports ...any) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": n, "namespace": "ns1"},
+			"spec":       map[string]any{"ports": ports},
+		}}
+	}
+	slice := func(svc string, ready ...bool) runtime.Object {
+		ee := make([]any, 0, len(ready))
+		for i, r := range ready {
+			ee = append(ee, map[string]any{
+				"addresses":  []any{"10.0.0.1"},
+				"conditions": map[string]any{"ready": r},
+				"targetRef":  map[string]any{"kind": "Pod", "name": svc + "-" + string(rune('a'+i))},
+			})
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion":  "discovery.k8s.io/v1",
+			"kind":        "EndpointSlice",
+			"addressType": "IPv4",
+			"metadata": map[string]any{
+				"name":      svc + "-x1",
+				"namespace": "ns1",
+				"labels":    map[string]any{"kubernetes.io/service-name": svc},
+			},
+			"ports":     []any{map[string]any{"name": "http", "port": int64(8080)}},
+			"endpoints": ee,
+		}}
+	}
+	http := map[string]any{"name": "http", "port": int64(80)}
+	inv := routingInventory{
+		ingresses: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "Ingress",
+				"metadata": map[string]any{
+					"name":        "web",
+					"namespace":   "ns1",
+					"annotations": map[string]any{"kubernetes.io/ingress.class": "nginx"},
+				},
+				"spec": map[string]any{
+					"defaultBackend": map[string]any{"service": map[string]any{"name": "fe", "port": map[string]any{"name": "http"}}},
+					"rules": []any{
+						map[string]any{
+							"host": "fred.io",
+							"http": map[string]any{"paths": []any{
+								map[string]any{"path": "/", "pathType": "Prefix", "backend": map[string]any{"service": map[string]any{"name": "fe", "port": map[string]any{"name": "http"}}}},
+								map[string]any{"path": "/api", "pathType": "Prefix", "backend": map[string]any{"service": map[string]any{"name": "api", "port": map[string]any{"number": int64(80)}}}},
+								map[string]any{"path": "/old", "pathType": "Prefix", "backend": map[string]any{"service": map[string]any{"name": "bozo", "port": map[string]any{"number": int64(80)}}}},
+							}},
+						},
+					},
+				},
+			}},
+		},
+		httpRoutes: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "gateway.networking.k8s.io/v1",
+				"kind":       "HTTPRoute",
+				"metadata":   map[string]any{"name": "blee", "namespace": "ns1"},
+				"spec": map[string]any{
+					"hostnames":  []any{"blee.io"},
+					"parentRefs": []any{map[string]any{"name": "gw", "namespace": "infra", "sectionName": "https"}},
+					"rules": []any{
+						map[string]any{
+							"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/v1"}}},
+							"backendRefs": []any{map[string]any{"name": "api", "port": int64(80)}},
+						},
+						map[string]any{
+							"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/v2"}}},
+							"backendRefs": []any{map[string]any{"name": "api", "port": int64(80)}, map[string]any{"name": "idle", "port": int64(80)}},
+						},
+					},
+				},
+				"status": map[string]any{"parents": []any{
+					map[string]any{"conditions": []any{
+						map[string]any{"type": "Accepted", "status": "True", "reason": "Accepted"},
+					}},
+				}},
+			}},
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "gateway.networking.k8s.io/v1",
+				"kind":       "HTTPRoute",
+				"metadata":   map[string]any{"name": "zorg", "namespace": "ns1"},
+				"spec": map[string]any{
+					"parentRefs": []any{map[string]any{"name": "gw"}},
+					"rules": []any{
+						map[string]any{"backendRefs": []any{map[string]any{"name": "fe", "port": int64(9090)}}},
+					},
+				},
+				"status": map[string]any{"parents": []any{
+					map[string]any{"conditions": []any{
+						map[string]any{"type": "Accepted", "status": "False", "reason": "NotAllowedByListeners", "message": "no matching listener"},
+					}},
+				}},
+			}},
+		},
+		services: []runtime.Object{svc("fe", http), svc("api", http), svc("idle", http)},
+		slices:   []runtime.Object{slice("fe", true, true), slice("api", true, false)},
+	}
+
+	rr, err := joinRoutes(&inv)
+	require.NoError(t, err)
+	require.Len(t, rr, 6)
+
+	assert.Equal(t, "nginx", rr[0].Parent)
+	assert.Equal(t, "fe:http", rr[0].Backend)
+	assert.Equal(t, []string{"*", "fred.io"}, rr[0].Hosts)
+	assert.Equal(t, []string{"<default>", "/"}, rr[0].Paths)
+	assert.Equal(t, render.RouteOK, rr[0].Status)
+	assert.Equal(t, []string{"fe-a", "fe-b"}, rr[0].Pods)
+	assert.Equal(t, 2, rr[0].Ready)
+
+	assert.Equal(t, render.RouteDegraded, rr[1].Status)
+	assert.Equal(t, "1 endpoint(s) not ready", rr[1].Reason)
+	assert.Equal(t, render.RouteBroken, rr[2].Status)
+	assert.Equal(t, "service ns1/bozo not found", rr[2].Reason)
+
+	assert.Equal(t, "infra/gw:https", rr[3].Parent)
+	assert.Equal(t, "api:80", rr[3].Backend)
+	assert.Equal(t, []string{"/v1", "/v2"}, rr[3].Paths)
+	assert.Equal(t, []string{"blee.io"}, rr[3].Hosts)
+	assert.Equal(t, render.RouteNoEndpoints, rr[4].Status)
+	assert.Equal(t, render.RouteBroken, rr[5].Status)
+	assert.Equal(t, "no matching listener", rr[5].Reason)
+}
+
+func TestParseRoutePath(t *testing.T) {
+	gvr, fqn, svc, err := ParseRoutePath("networking.k8s.io/v1/ingresses|ns1|web|Service:ns1/fe:80")
+	require.NoError(t, err)
+	assert.Equal(t, "networking.k8s.io/v1/ingresses", gvr.String())
+	assert.Equal(t, "ns1/web", fqn)
+	assert.Equal(t, "ns1/fe", svc)
+
+	_, _, svc, err = ParseRoutePath("networking.k8s.io/v1/ingresses|ns1|web|StorageBucket:ns1/assets:")
+	require.NoError(t, err)
+	assert.Empty(t, svc)
+
+	_, _, _, err = ParseRoutePath("ns1/web")
+	require.Error(t, err)
+}
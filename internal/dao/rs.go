@@ -17,7 +17,6 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
@@ -121,7 +120,7 @@ func (r *ReplicaSet) Rollback(fqn string) error {
 		return err
 	}
 
-	_, err = rb.Rollback(dp, map[string]string{}, version, cmdutil.DryRunNone)
+	_, err = rb.Rollback(dp, map[string]string{}, version, dryRunStrategy())
 	if err != nil {
 		return err
 	}
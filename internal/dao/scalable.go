@@ -56,10 +56,12 @@ func (s *Scaler) Scale(ctx context.Context, path string, replicas int32) error {
 	}
 
 	currentScale.Spec.Replicas = replicas
-	updatedScale, err := scaleClient.Scales(ns).Update(ctx, *s.gvr.GR(), currentScale, metav1.UpdateOptions{})
+	prior := snapshot(ctx, s.Client(), s.gvr, path)
+	updatedScale, err := scaleClient.Scales(ns).Update(ctx, *s.gvr.GR(), currentScale, metav1.UpdateOptions{DryRun: dryRunOpts()})
 	if err != nil {
 		return err
 	}
+	journalMutation(JournalScale, s.gvr, path, prior)
 
 	slog.Debug("Scaled resource",
 		slogs.FQN, path,
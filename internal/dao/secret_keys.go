@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*SecretKeys)(nil)
+
+// SecretKeys represents a secret data keys.
+type SecretKeys struct {
+	NonResource
+}
+
+// List returns a secret keys. Values are only carried for revealed keys.
+func (s *SecretKeys) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+	sec, err := getSecret(s.getFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	revealed, _ := ctx.Value(internal.KeyRevealed).([]string)
+	decode, _ := ctx.Value(internal.KeyDecode).(bool)
+
+	return secretKeys(sec, revealed, decode), nil
+}
+
+// SecretValue returns a secret key decoded value.
+func SecretValue(f Factory, fqn, key string) (string, error) {
+	sec, err := getSecret(f, fqn)
+	if err != nil {
+		return "", err
+	}
+	v, ok := sec.Data[key]
+	if !ok {
+		return "", fmt.Errorf("no key %q found in secret %s", key, fqn)
+	}
+
+	return string(v), nil
+}
+
+// Helpers...
+
+func getSecret(f Factory, fqn string) (*v1.Secret, error) {
+	o, err := f.Get(client.SecGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var sec v1.Secret
+	if err := toTyped(o, &sec); err != nil {
+		return nil, err
+	}
+
+	return &sec, nil
+}
+
+func secretKeys(sec *v1.Secret, revealed []string, decode bool) []runtime.Object {
+	kk := make([]string, 0, len(sec.Data))
+	for k := range sec.Data {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		raw := sec.Data[k]
+		res := render.SecretKeyRes{Key: k, Size: len(raw)}
+		if slices.Contains(revealed, k) {
+			res.Revealed = true
+			if decode && utf8.Valid(raw) {
+				res.Value = string(raw)
+			} else {
+				res.Value, res.Encoded = base64.StdEncoding.EncodeToString(raw), true
+			}
+		}
+		oo = append(oo, &res)
+	}
+
+	return oo
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSecretKeys(t *testing.T) {
+	sec := v1.Secret{Data: map[string][]byte{
+		"password": []byte("blee"),
+		"cert":     {0xff, 0xfe},
+		"user":     []byte("fred"),
+	}}
+
+	uu := map[string]struct {
+		revealed []string
+		decode   bool
+		e        []runtime.Object
+	}{
+		"masked": {
+			decode: true,
+			e: []runtime.Object{
+				&render.SecretKeyRes{Key: "cert", Size: 2},
+				&render.SecretKeyRes{Key: "password", Size: 4},
+				&render.SecretKeyRes{Key: "user", Size: 4},
+			},
+		},
+		"decoded": {
+			revealed: []string{"cert", "password"},
+			decode:   true,
+			e: []runtime.Object{
+				&render.SecretKeyRes{Key: "cert", Value: "//4=", Size: 2, Revealed: true, Encoded: true},
+				&render.SecretKeyRes{Key: "password", Value: "blee", Size: 4, Revealed: true},
+				&render.SecretKeyRes{Key: "user", Size: 4},
+			},
+		},
+		"encoded": {
+			revealed: []string{"user"},
+			e: []runtime.Object{
+				&render.SecretKeyRes{Key: "cert", Size: 2},
+				&render.SecretKeyRes{Key: "password", Size: 4},
+				&render.SecretKeyRes{Key: "user", Value: "ZnJlZA==", Size: 4, Revealed: true, Encoded: true},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, secretKeys(&sec, u.revealed, u.decode))
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// volumeStatsTTL tracks how long a node volumes stats are reused.
+	volumeStatsTTL = 30 * time.Second
+
+	// volumeStatsTimeout bounds a node stats summary call.
+	volumeStatsTimeout = 2 * time.Second
+
+	// volumeStatsWorkers bounds the nodes stats summary calls in flight.
+	volumeStatsWorkers = 5
+)
+
+var (
+	_ Accessor = (*Storage)(nil)
+
+	volumeStatsCache   = map[string]volumeStatsEntry{}
+	volumeStatsCacheMx sync.Mutex
+)
+
+type volumeStatsEntry struct {
+	usage   map[string]volumeUsage
+	expires time.Time
+}
+
+// Storage joins claims, volumes, storage classes, usage and pods to diagnose storage.
+type Storage struct {
+	NonResource
+}
+
+// storageInventory tracks the resources a storage diagnostic joins.
+type storageInventory struct {
+	pvcs, pvs, scs, pods, events []runtime.Object
+}
+
+// volumeUsage tracks a claim volume usage as reported by the kubelet.
+type volumeUsage struct {
+	used, capacity int64
+}
+
+// volumeStatsSummary represents a kubelet stats summary volumes section.
+type volumeStatsSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			UsedBytes     *int64 `json:"usedBytes"`
+			CapacityBytes *int64 `json:"capacityBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// List returns the claims storage diagnostics.
+func (s *Storage) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	f := s.getFactory()
+	var (
+		inv storageInventory
+		err error
+	)
+	if inv.pvcs, err = f.List(client.PvcGVR, ns, true, labels.Everything()); err != nil {
+		return nil, err
+	}
+	if inv.pvs, err = f.List(client.PvGVR, client.ClusterScope, true, labels.Everything()); err != nil {
+		slog.Warn("Unable to list volumes for storage", slogs.Error, err)
+	}
+	if inv.scs, err = f.List(client.ScGVR, client.ClusterScope, true, labels.Everything()); err != nil {
+		slog.Warn("Unable to list storage classes for storage", slogs.Error, err)
+	}
+	if inv.pods, err = f.List(client.PodGVR, ns, false, labels.Everything()); err != nil {
+		slog.Warn("Unable to list pods for storage", slogs.Error, err)
+	}
+	if inv.events, err = f.List(client.EvGVR, ns, false, labels.Everything()); err != nil {
+		slog.Warn("Unable to list events for storage", slogs.Error, err)
+	}
+
+	rr, nodes, err := joinStorage(&inv)
+	if err != nil {
+		return nil, err
+	}
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
+		applyUsage(rr, s.volumesUsage(ctx, nodes))
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// volumesUsage collects claims usage from the given nodes kubelet stats.
+func (s *Storage) volumesUsage(ctx context.Context, nodes []string) map[string]volumeUsage {
+	uu := make(map[string]volumeUsage)
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return uu
+	}
+
+	var (
+		mx  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, volumeStatsWorkers)
+	)
+	for _, n := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			nu := s.nodeVolumesUsage(ctx, dial, n)
+			mx.Lock()
+			maps.Copy(uu, nu)
+			mx.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return uu
+}
+
+// nodeVolumesUsage returns a node claims usage. Stats are cached for a while,
+// failures included, so slow kubelets do not stall each refresh.
+func (s *Storage) nodeVolumesUsage(ctx context.Context, dial kubernetes.Interface, node string) map[string]volumeUsage {
+	key := s.Client().ActiveContext() + "/" + node
+	volumeStatsCacheMx.Lock()
+	e, ok := volumeStatsCache[key]
+	volumeStatsCacheMx.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.usage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, volumeStatsTimeout)
+	defer cancel()
+	uu := make(map[string]volumeUsage)
+	raw, err := dial.CoreV1().RESTClient().Get().
+		AbsPath("/api/v1/nodes", node, "proxy", "stats", "summary").
+		DoRaw(ctx)
+	if err != nil {
+		slog.Debug("Unable to fetch node volumes stats", slogs.ResName, node, slogs.Error, err)
+	} else if err := parseVolumesUsage(raw, uu); err != nil {
+		slog.Debug("Unable to parse node volumes stats", slogs.ResName, node, slogs.Error, err)
+	}
+	volumeStatsCacheMx.Lock()
+	volumeStatsCache[key] = volumeStatsEntry{usage: uu, expires: time.Now().Add(volumeStatsTTL)}
+	volumeStatsCacheMx.Unlock()
+
+	return uu
+}
+
+// Helpers...
+
+// joinStorage returns the claims diagnostics and the nodes their consumers run on.
+func joinStorage(inv *storageInventory) ([]*render.StorageRes, []string, error) {
+	pvs := make(map[string]*v1.PersistentVolume, len(inv.pvs))
+	for _, o := range inv.pvs {
+		var pv v1.PersistentVolume
+		if err := toTyped(o, &pv); err != nil {
+			return nil, nil, err
+		}
+		pvs[pv.Name] = &pv
+	}
+	scs := make(map[string]*storagev1.StorageClass, len(inv.scs))
+	for _, o := range inv.scs {
+		var sc storagev1.StorageClass
+		if err := toTyped(o, &sc); err != nil {
+			return nil, nil, err
+		}
+		scs[sc.Name] = &sc
+	}
+	consumers, nodes := claimConsumers(inv.pods)
+	events := claimEvents(inv.events)
+
+	rr := make([]*render.StorageRes, 0, len(inv.pvcs))
+	for _, o := range inv.pvcs {
+		var pvc v1.PersistentVolumeClaim
+		if err := toTyped(o, &pvc); err != nil {
+			return nil, nil, err
+		}
+		fqn := client.FQN(pvc.Namespace, pvc.Name)
+		res := render.StorageRes{
+			Namespace:    pvc.Namespace,
+			Name:         pvc.Name,
+			Phase:        pvc.Status.Phase,
+			Terminating:  pvc.DeletionTimestamp != nil,
+			Volume:       pvc.Spec.VolumeName,
+			StorageClass: claimClass(&pvc),
+			Pods:         consumers[fqn],
+			Age:          pvc.CreationTimestamp,
+		}
+		if pvc.Spec.VolumeName != "" {
+			q := pvc.Status.Capacity[v1.ResourceStorage]
+			res.Capacity, res.AccessModes = q.String(), pvc.Status.AccessModes
+		}
+		pv, pvOK := pvs[pvc.Spec.VolumeName]
+		if pvOK {
+			res.ReclaimPolicy = string(pv.Spec.PersistentVolumeReclaimPolicy)
+			if res.StorageClass == "" {
+				res.StorageClass = pv.Spec.StorageClassName
+			}
+		}
+		sc, scOK := scs[res.StorageClass]
+		if scOK {
+			res.Provisioner = sc.Provisioner
+			if sc.VolumeBindingMode != nil {
+				res.BindingMode = string(*sc.VolumeBindingMode)
+			}
+		}
+		switch {
+		case res.Terminating && len(res.Pods) > 0:
+			res.Reason = "deletion blocked, claim still mounted"
+		case pvc.Status.Phase == v1.ClaimPending:
+			res.Reason = pendingReason(&res, scOK, events[fqn])
+		case pvc.Status.Phase == v1.ClaimLost:
+			res.Reason = fmt.Sprintf("volume %s lost", pvc.Spec.VolumeName)
+		case pvOK && pv.Status.Phase != v1.VolumeBound:
+			res.Reason = fmt.Sprintf("volume %s is %s", pv.Name, pv.Status.Phase)
+		}
+		rr = append(rr, &res)
+	}
+
+	return rr, nodes, nil
+}
+
+// pendingReason diagnoses why a claim is not bound yet.
+func pendingReason(res *render.StorageRes, scFound bool, ev *eventsv1.Event) string {
+	switch {
+	case ev != nil:
+		return ev.Reason + ": " + strings.TrimSpace(ev.Note)
+	case res.StorageClass != "" && !scFound:
+		return fmt.Sprintf("storage class %q not found", res.StorageClass)
+	case res.BindingMode == string(storagev1.VolumeBindingWaitForFirstConsumer) && len(res.Pods) == 0:
+		return "waiting for first consumer"
+	default:
+		return ""
+	}
+}
+
+// claimConsumers returns the pods mounting each claim and the nodes these pods run on.
+func claimConsumers(oo []runtime.Object) (map[string][]string, []string) {
+	mm, nodes := make(map[string][]string), make([]string, 0, 10)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			continue
+		}
+		var mounts bool
+		for _, vol := range po.Spec.Volumes {
+			var claim string
+			switch {
+			case vol.PersistentVolumeClaim != nil:
+				claim = vol.PersistentVolumeClaim.ClaimName
+			case vol.Ephemeral != nil:
+				claim = po.Name + "-" + vol.Name
+			default:
+				continue
+			}
+			fqn := client.FQN(po.Namespace, claim)
+			mm[fqn], mounts = append(mm[fqn], po.Name), true
+		}
+		if mounts && po.Spec.NodeName != "" && !slices.Contains(nodes, po.Spec.NodeName) {
+			nodes = append(nodes, po.Spec.NodeName)
+		}
+	}
+
+	return mm, nodes
+}
+
+// claimEvents returns the most recent event for each claim.
+func claimEvents(oo []runtime.Object) map[string]*eventsv1.Event {
+	mm := make(map[string]*eventsv1.Event)
+	for _, o := range oo {
+		var ev eventsv1.Event
+		if err := toTyped(o, &ev); err != nil {
+			continue
+		}
+		if ev.Regarding.Kind != "PersistentVolumeClaim" {
+			continue
+		}
+		fqn := client.FQN(ev.Regarding.Namespace, ev.Regarding.Name)
+		if prev, ok := mm[fqn]; ok && !eventTime(&ev).After(eventTime(prev)) {
+			continue
+		}
+		mm[fqn] = &ev
+	}
+
+	return mm
+}
+
+func claimClass(pvc *v1.PersistentVolumeClaim) string {
+	if class, ok := pvc.Annotations[v1.BetaStorageClassAnnotation]; ok {
+		return class
+	}
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return ""
+}
+
+func parseVolumesUsage(raw []byte, uu map[string]volumeUsage) error {
+	var summary volumeStatsSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return err
+	}
+	for _, p := range summary.Pods {
+		for _, v := range p.Volumes {
+			if v.PVCRef == nil || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			uu[client.FQN(v.PVCRef.Namespace, v.PVCRef.Name)] = volumeUsage{used: *v.UsedBytes, capacity: *v.CapacityBytes}
+		}
+	}
+
+	return nil
+}
+
+func applyUsage(rr []*render.StorageRes, uu map[string]volumeUsage) {
+	for _, r := range rr {
+		if u, ok := uu[client.FQN(r.Namespace, r.Name)]; ok {
+			r.Used, r.UsageCapacity, r.HasUsage = u.used, u.capacity, true
+		}
+	}
+}
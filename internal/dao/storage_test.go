@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestJoinStorage(t *testing.T) {
+	pvc := func(n, class, volume, phase string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolumeClaim",
+			"metadata":   map[string]any{"name": n, "namespace": "ns1"},
+			"spec":       map[string]any{"storageClassName": class, "volumeName": volume},
+			"status": map[string]any{
+				"phase":       phase,
+				"capacity":    map[string]any{"storage": "10Gi"},
+				"accessModes": []any{"ReadWriteOnce"},
+			},
+		}}
+	}
+	inv := storageInventory{
+		pvcs: []runtime.Object{
+			pvc("data", "fast", "pv1", "Bound"),
+			pvc("logs", "slow", "", "Pending"),
+			pvc("cache", "bozo", "", "Pending"),
+			pvc("scratch", "fast", "", "Pending"),
+		},
+		pvs: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolume",
+				"metadata":   map[string]any{"name": "pv1"},
+				"spec":       map[string]any{"persistentVolumeReclaimPolicy": "Retain", "storageClassName": "fast"},
+				"status":     map[string]any{"phase": "Bound"},
+			}},
+		},
+		scs: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion":        "storage.k8s.io/v1",
+				"kind":              "StorageClass",
+				"metadata":          map[string]any{"name": "fast"},
+				"provisioner":       "ebs.csi.aws.com",
+				"volumeBindingMode": "WaitForFirstConsumer",
+			}},
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion":        "storage.k8s.io/v1",
+				"kind":              "StorageClass",
+				"metadata":          map[string]any{"name": "slow"},
+				"provisioner":       "nfs",
+				"volumeBindingMode": "Immediate",
+			}},
+		},
+		pods: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]any{"name": "p1", "namespace": "ns1"},
+				"spec": map[string]any{
+					"nodeName": "n1",
+					"volumes": []any{
+						map[string]any{"name": "v1", "persistentVolumeClaim": map[string]any{"claimName": "data"}},
+						map[string]any{"name": "tmp", "emptyDir": map[string]any{}},
+					},
+				},
+			}},
+		},
+		events: []runtime.Object{
+			&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion":              "events.k8s.io/v1",
+				"kind":                    "Event",
+				"metadata":                map[string]any{"name": "e1", "namespace": "ns1"},
+				"regarding":               map[string]any{"kind": "PersistentVolumeClaim", "name": "logs", "namespace": "ns1"},
+				"reason":                  "ProvisioningFailed",
+				"note":                    "nfs server unreachable ",
+				"type":                    "Warning",
+				"deprecatedLastTimestamp": "2026-10-14T10:00:00Z",
+			}},
+		},
+	}
+
+	rr, nodes, err := joinStorage(&inv)
+	require.NoError(t, err)
+	require.Len(t, rr, 4)
+	assert.Equal(t, []string{"n1"}, nodes)
+
+	assert.Equal(t, "Bound", rr[0].Status())
+	assert.Equal(t, "10Gi", rr[0].Capacity)
+	assert.Equal(t, "Retain", rr[0].ReclaimPolicy)
+	assert.Equal(t, "ebs.csi.aws.com", rr[0].Provisioner)
+	assert.Equal(t, []string{"p1"}, rr[0].Pods)
+	assert.Empty(t, rr[0].Reason)
+
+	assert.Equal(t, "ProvisioningFailed: nfs server unreachable", rr[1].Reason)
+	assert.Empty(t, rr[1].Capacity)
+	assert.Equal(t, `storage class "bozo" not found`, rr[2].Reason)
+	assert.Equal(t, "waiting for first consumer", rr[3].Reason)
+}
+
+func TestParseVolumesUsage(t *testing.T) {
+	raw := []byte(`{"pods":[{"volume":[
+		{"name":"v1","usedBytes":1048576,"capacityBytes":4194304,"pvcRef":{"name":"data","namespace":"ns1"}},
+		{"name":"tmp","usedBytes":10}
+	]}]}`)
+
+	uu := make(map[string]volumeUsage)
+	require.NoError(t, parseVolumesUsage(raw, uu))
+	assert.Equal(t, map[string]volumeUsage{"ns1/data": {used: 1048576, capacity: 4194304}}, uu)
+	require.Error(t, parseVolumesUsage([]byte("bozo"), uu))
+}
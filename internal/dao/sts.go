@@ -97,6 +97,16 @@ func (s *StatefulSet) Pod(fqn string) (string, error) {
 	return podFromSelector(s.Factory, sts.Namespace, sts.Spec.Selector.MatchLabels)
 }
 
+// Selector returns the statefulset pods selector.
+func (s *StatefulSet) Selector(fqn string) (string, error) {
+	sts, err := s.getStatefulSet(fqn)
+	if err != nil {
+		return "", err
+	}
+
+	return selectorString(sts.Spec.Selector)
+}
+
 func (s *StatefulSet) getStatefulSet(fqn string) (*appsv1.StatefulSet, error) {
 	o, err := s.getFactory().Get(s.gvr, fqn, true, labels.Everything())
 	if err != nil {
@@ -155,15 +165,17 @@ func (s *StatefulSet) Scan(_ context.Context, gvr *client.GVR, fqn string, wait
 		}
 		switch gvr {
 		case client.CmGVR:
-			if !hasConfigMap(&sts.Spec.Template.Spec, n) {
+			via := configMapRefs(&sts.Spec.Template.Spec, n)
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: s.GVR(),
 				FQN: client.FQN(sts.Namespace, sts.Name),
+				Via: via,
 			})
 		case client.SecGVR:
-			found, err := hasSecret(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
+			via, err := secretRefs(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
 			if err != nil {
 				slog.Warn("Locate secret failed",
 					slogs.FQN, fqn,
@@ -171,12 +183,13 @@ func (s *StatefulSet) Scan(_ context.Context, gvr *client.GVR, fqn string, wait
 				)
 				continue
 			}
-			if !found {
+			if len(via) == 0 {
 				continue
 			}
 			refs = append(refs, Ref{
 				GVR: s.GVR(),
 				FQN: client.FQN(sts.Namespace, sts.Name),
+				Via: via,
 			})
 		case client.PvcGVR:
 			for i := range sts.Spec.VolumeClaimTemplates {
@@ -237,12 +250,18 @@ func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs Ima
 	if err != nil {
 		return err
 	}
+	prior := snapshot(ctx, s.Client(), s.gvr, path)
 	_, err = dial.AppsV1().StatefulSets(ns).Patch(
 		ctx,
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalPatch, s.gvr, path, prior)
+
+	return nil
 }
@@ -49,6 +49,19 @@ func (s *Service) Pod(fqn string) (string, error) {
 	return podFromSelector(s.Factory, svc.Namespace, svc.Spec.Selector)
 }
 
+// Selector returns the service pods selector.
+func (s *Service) Selector(fqn string) (string, error) {
+	svc, err := s.GetInstance(fqn)
+	if err != nil {
+		return "", err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return "", fmt.Errorf("service %s has no pods selector", fqn)
+	}
+
+	return labels.Set(svc.Spec.Selector).AsSelector().String(), nil
+}
+
 // GetInstance returns a service instance.
 func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 	o, err := s.getFactory().Get(s.gvr, fqn, true, labels.Everything())
@@ -78,11 +91,21 @@ func podFromSelector(f Factory, ns string, sel map[string]string) (string, error
 		return "", fmt.Errorf("no matching pods for %v", sel)
 	}
 
-	var pod v1.Pod
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(oo[0].(*unstructured.Unstructured).Object, &pod)
-	if err != nil {
-		return "", err
+	// Favor a ready pod so forwards and shells land on a serving instance.
+	var first string
+	for _, o := range oo {
+		var pod v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
+		if err != nil {
+			return "", err
+		}
+		if isPodServing(&pod) {
+			return client.FQN(pod.Namespace, pod.Name), nil
+		}
+		if first == "" {
+			first = client.FQN(pod.Namespace, pod.Name)
+		}
 	}
 
-	return client.FQN(pod.Namespace, pod.Name), nil
+	return first, nil
 }
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var _ Accessor = (*ServiceEndpoints)(nil)
+
+// ServiceEndpoints represents a service endpoint slices addresses.
+type ServiceEndpoints struct {
+	NonResource
+}
+
+// List returns a service endpoints mapped back to their pods and nodes.
+func (s *ServiceEndpoints) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+	f := s.getFactory()
+	o, err := f.Get(client.SvcGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var svc v1.Service
+	if err := toTyped(o, &svc); err != nil {
+		return nil, err
+	}
+	sel := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: svc.Name})
+	ee, err := f.List(client.EpsGVR, svc.Namespace, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := f.List(client.PodGVR, svc.Namespace, true, labels.Everything())
+	if err != nil {
+		slog.Warn("Unable to list pods for service endpoints", slogs.Error, err)
+	}
+
+	rr, err := serviceEndpoints(&svc, ee, pp)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// ParseEndpointPath returns an endpoint pod fqn and node name if any.
+func ParseEndpointPath(path string) (pod, node string, err error) {
+	tt := strings.Split(path, "|")
+	if len(tt) != 5 {
+		return "", "", fmt.Errorf("invalid endpoint path: %q", path)
+	}
+	if tt[3] != "" {
+		pod = client.FQN(tt[0], tt[3])
+	}
+
+	return pod, tt[4], nil
+}
+
+// Helpers...
+
+// serviceEndpoints returns an endpoint per slice address. When a service has no
+// endpoints a single row explains why.
+func serviceEndpoints(svc *v1.Service, ee, pp []runtime.Object) ([]*render.ServiceEndpointRes, error) {
+	pods := make(map[string]*v1.Pod, len(pp))
+	for _, o := range pp {
+		var pod v1.Pod
+		if err := toTyped(o, &pod); err != nil {
+			return nil, err
+		}
+		pods[pod.Name] = &pod
+	}
+
+	var rr []*render.ServiceEndpointRes
+	for _, o := range ee {
+		var es discoveryv1.EndpointSlice
+		if err := toTyped(o, &es); err != nil {
+			return nil, err
+		}
+		ports := slicePorts(es.Ports)
+		for _, e := range es.Endpoints {
+			res := render.ServiceEndpointRes{
+				Namespace:   es.Namespace,
+				Slice:       es.Name,
+				Address:     strings.Join(e.Addresses, ","),
+				Ports:       ports,
+				Ready:       ptr.Deref(e.Conditions.Ready, true),
+				Terminating: ptr.Deref(e.Conditions.Terminating, false),
+				Node:        ptr.Deref(e.NodeName, ""),
+				Zone:        ptr.Deref(e.Zone, ""),
+			}
+			res.Serving = ptr.Deref(e.Conditions.Serving, res.Ready)
+			if e.TargetRef != nil && e.TargetRef.Kind == "Pod" {
+				res.Pod = e.TargetRef.Name
+			}
+			if !res.Ready {
+				res.Reason = notReadyReason(&res, pods[res.Pod])
+			}
+			rr = append(rr, &res)
+		}
+	}
+	if len(rr) == 0 {
+		rr = append(rr, &render.ServiceEndpointRes{
+			Namespace: svc.Namespace,
+			Reason:    noEndpointsReason(svc, pods, len(ee)),
+		})
+	}
+	slices.SortFunc(rr, func(a, b *render.ServiceEndpointRes) int {
+		return strings.Compare(a.Slice+a.Address, b.Slice+b.Address)
+	})
+
+	return rr, nil
+}
+
+func slicePorts(pp []discoveryv1.EndpointPort) []string {
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		var s string
+		if p.Name != nil && *p.Name != "" {
+			s = *p.Name + ":"
+		}
+		if p.Port != nil {
+			s += strconv.Itoa(int(*p.Port))
+		}
+		if p.Protocol != nil {
+			s += "/" + string(*p.Protocol)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+// notReadyReason returns why an endpoint pod is not ready.
+func notReadyReason(res *render.ServiceEndpointRes, pod *v1.Pod) string {
+	switch {
+	case res.Terminating:
+		return "pod terminating"
+	case res.Pod == "":
+		return "endpoint not ready"
+	case pod == nil:
+		return "pod " + res.Pod + " not found"
+	case pod.DeletionTimestamp != nil:
+		return "pod terminating"
+	case pod.Status.Phase != v1.PodRunning:
+		return "pod " + strings.ToLower(string(pod.Status.Phase))
+	}
+	var cc []string
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Ready {
+			continue
+		}
+		switch {
+		case cs.State.Waiting != nil && cs.State.Waiting.Reason != "":
+			cc = append(cc, cs.Name+" "+cs.State.Waiting.Reason)
+		case cs.State.Terminated != nil && cs.State.Terminated.Reason != "":
+			cc = append(cc, cs.Name+" "+cs.State.Terminated.Reason)
+		default:
+			cc = append(cc, cs.Name+" not ready")
+		}
+	}
+	if len(cc) > 0 {
+		return "container " + strings.Join(cc, ",")
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Status != v1.ConditionTrue && c.Message != "" {
+			return c.Message
+		}
+	}
+
+	return "pod not ready"
+}
+
+// noEndpointsReason returns why a service has no endpoints.
+func noEndpointsReason(svc *v1.Service, pods map[string]*v1.Pod, sliceCount int) string {
+	switch {
+	case svc.Spec.Type == v1.ServiceTypeExternalName:
+		return "external name service " + svc.Spec.ExternalName
+	case len(svc.Spec.Selector) == 0 && sliceCount == 0:
+		return "service has no selector and no endpoint slices"
+	case len(svc.Spec.Selector) == 0:
+		return "service has no selector and its endpoint slices are empty"
+	}
+	sel := labels.SelectorFromSet(svc.Spec.Selector)
+	var matched int
+	for _, p := range pods {
+		if sel.Matches(labels.Set(p.Labels)) {
+			matched++
+		}
+	}
+	if matched == 0 {
+		return "no pods match selector " + sel.String()
+	}
+
+	return strconv.Itoa(matched) + " pod(s) match selector " + sel.String() + " but none are endpoints"
+}
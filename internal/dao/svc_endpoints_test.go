@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestServiceEndpoints(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "fe", Namespace: "ns1"},
+		Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "fe"}},
+	}
+	pod := func(n, phase string, ready bool) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": n, "namespace": "ns1", "labels": map[string]any{"app": "fe"}},
+			"status": map[string]any{
+				"phase": phase,
+				"containerStatuses": []any{map[string]any{
+					"name":  "c1",
+					"ready": ready,
+					"state": map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+				}},
+			},
+		}}
+	}
+	ep := func(ip, pod string, ready bool) any {
+		return map[string]any{
+			"addresses":  []any{ip},
+			"conditions": map[string]any{"ready": ready, "serving": ready},
+			"nodeName":   "n1",
+			"zone":       "z1",
+			"targetRef":  map[string]any{"kind": "Pod", "name": pod, "namespace": "ns1"},
+		}
+	}
+	slice := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion":  "discovery.k8s.io/v1",
+		"kind":        "EndpointSlice",
+		"addressType": "IPv4",
+		"metadata":    map[string]any{"name": "fe-x1", "namespace": "ns1"},
+		"ports":       []any{map[string]any{"name": "http", "port": int64(8080), "protocol": "TCP"}},
+		"endpoints":   []any{ep("10.0.0.2", "fe-b", false), ep("10.0.0.1", "fe-a", true)},
+	}}
+
+	rr, err := serviceEndpoints(&svc, []runtime.Object{slice}, []runtime.Object{pod("fe-a", "Running", true), pod("fe-b", "Running", false)})
+	require.NoError(t, err)
+	assert.Equal(t, []*render.ServiceEndpointRes{
+		{Namespace: "ns1", Slice: "fe-x1", Address: "10.0.0.1", Ports: []string{"http:8080/TCP"}, Ready: true, Serving: true, Pod: "fe-a", Node: "n1", Zone: "z1"},
+		{Namespace: "ns1", Slice: "fe-x1", Address: "10.0.0.2", Ports: []string{"http:8080/TCP"}, Pod: "fe-b", Node: "n1", Zone: "z1", Reason: "container c1 CrashLoopBackOff"},
+	}, rr)
+}
+
+func TestServiceNoEndpoints(t *testing.T) {
+	uu := map[string]struct {
+		svc  v1.ServiceSpec
+		pods []runtime.Object
+		e    string
+	}{
+		"no-selector": {
+			e: "service has no selector and no endpoint slices",
+		},
+		"no-match": {
+			svc: v1.ServiceSpec{Selector: map[string]string{"app": "fe"}},
+			e:   "no pods match selector app=fe",
+		},
+		"external": {
+			svc: v1.ServiceSpec{Type: v1.ServiceTypeExternalName, ExternalName: "fred.io"},
+			e:   "external name service fred.io",
+		},
+		"not-endpoints": {
+			svc: v1.ServiceSpec{Selector: map[string]string{"app": "fe"}},
+			pods: []runtime.Object{&unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]any{"name": "fe-a", "namespace": "ns1", "labels": map[string]any{"app": "fe"}},
+			}}},
+			e: "1 pod(s) match selector app=fe but none are endpoints",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc := v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "fe", Namespace: "ns1"}, Spec: u.svc}
+			rr, err := serviceEndpoints(&svc, nil, u.pods)
+			require.NoError(t, err)
+			require.Len(t, rr, 1)
+			assert.Equal(t, u.e, rr[0].Reason)
+			assert.Equal(t, "ns1||||", rr[0].Path())
+		})
+	}
+}
+
+func TestParseEndpointPath(t *testing.T) {
+	pod, node, err := ParseEndpointPath("ns1|fe-x1|10.0.0.1|fe-a|n1")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/fe-a", pod)
+	assert.Equal(t, "n1", node)
+
+	pod, node, err = ParseEndpointPath("ns1||||")
+	require.NoError(t, err)
+	assert.Empty(t, pod)
+	assert.Empty(t, node)
+
+	_, _, err = ParseEndpointPath("ns1/fe")
+	require.Error(t, err)
+}
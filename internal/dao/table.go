@@ -5,10 +5,15 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -26,6 +31,9 @@ const (
 	header      = "application/json;as=Table;v=v1;g=meta.k8s.io,application/json;as=Table;v=v1beta1;g=meta.k8s.io,application/json"
 )
 
+// maxListRestarts bounds the paginated list restarts once a continue token expired.
+const maxListRestarts = 1
+
 var genScheme = runtime.NewScheme()
 
 // Table retrieves K8s resources as tabular data.
@@ -55,6 +63,9 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return req.Do(ctx).Get()
 }
 
+// TablePageFunc is called with the rows fetched so far while a paginated list is in progress.
+type TablePageFunc func(runtime.Object)
+
 // List all Resources in a given namespace.
 func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	sel := labels.Everything()
@@ -62,6 +73,14 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		sel = labelSel
 	}
 	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
+	pageSize, _ := ctx.Value(internal.KeyPageSize).(int64)
+	extraCols, _ := ctx.Value(internal.KeyExtraColumns).([]config.ExtraColumn)
+
+	// Only report partial pages for the resource being viewed.
+	var pageFn TablePageFunc
+	if gvr, ok := ctx.Value(internal.KeyGVR).(*client.GVR); ok && gvr == t.gvr {
+		pageFn, _ = ctx.Value(internal.KeyTablePage).(TablePageFunc)
+	}
 
 	includeObject := includeMeta
 	if t.includeObj {
@@ -73,35 +92,91 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	o, err := c.Get().
-		SetHeader("Accept", header).
-		Param("includeObject", includeObject).
-		Namespace(ns).
-		Resource(t.gvr.R()).
-		VersionedParams(&metav1.ListOptions{
-			LabelSelector: sel.String(),
-			FieldSelector: fieldSel,
-		}, metav1.ParameterCodec).
-		Do(ctx).Get()
-	if err != nil {
-		return nil, err
-	}
 
 	namespaced := true
 	if res, e := MetaAccess.MetaFor(t.gvr); e == nil && !res.Namespaced {
 		namespaced = false
 	}
-	ta, err := decodeTable(ctx, o.(*metav1.Table), namespaced)
-	if err != nil {
-		return nil, err
+
+	var (
+		table    *metav1.Table
+		cont     string
+		restarts int
+	)
+	for {
+		o, err := c.Get().
+			SetHeader("Accept", header).
+			Param("includeObject", includeObject).
+			Namespace(ns).
+			Resource(t.gvr.R()).
+			VersionedParams(&metav1.ListOptions{
+				LabelSelector: sel.String(),
+				FieldSelector: fieldSel,
+				Limit:         pageSize,
+				Continue:      cont,
+			}, metav1.ParameterCodec).
+			Do(ctx).Get()
+		if cont != "" && apierrors.IsResourceExpired(err) {
+			// Resume from the inconsistent continue token if any or start over.
+			if next := inconsistentContinue(err); next != "" {
+				slog.Debug("List continue token expired, resuming inconsistent list", slogs.GVR, t.gvr)
+				cont = next
+				continue
+			}
+			if restarts < maxListRestarts {
+				slog.Debug("List continue token expired, restarting list", slogs.GVR, t.gvr)
+				restarts++
+				table, cont = nil, ""
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		page, ok := o.(*metav1.Table)
+		if !ok {
+			return nil, fmt.Errorf("expected metav1.Table but got %T", o)
+		}
+		if _, err := decodeTable(ctx, page, namespaced); err != nil {
+			return nil, err
+		}
+		extendTable(page, extraCols)
+		table = mergeTablePage(table, page)
+		if cont = page.Continue; cont == "" || pageSize <= 0 {
+			break
+		}
+		if pageFn != nil {
+			pageFn(table)
+		}
+	}
+	table.Continue = ""
+
+	return []runtime.Object{table}, nil
+}
+
+// mergeTablePage appends a page rows to a table.
+func mergeTablePage(table, page *metav1.Table) *metav1.Table {
+	if table == nil {
+		return page
 	}
+	table.Rows = append(table.Rows, page.Rows...)
 
-	return []runtime.Object{ta}, nil
+	return table
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// inconsistentContinue returns the continue token an expired list error carries if any.
+func inconsistentContinue(err error) string {
+	var s apierrors.APIStatus
+	if !errors.As(err, &s) {
+		return ""
+	}
+
+	return s.Status().Continue
+}
+
 func decodeTable(ctx context.Context, table *metav1.Table, namespaced bool) (runtime.Object, error) {
 	if namespaced {
 		table.ColumnDefinitions = append([]metav1.TableColumnDefinition{{Name: "Namespace", Type: "string"}}, table.ColumnDefinitions...)
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestTableListPaginated(t *testing.T) {
+	const total = 5
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		start, _ := strconv.Atoi(r.URL.Query().Get("continue"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit == 0 {
+			limit = total
+		}
+		end := min(start+limit, total)
+		table := metav1.Table{
+			TypeMeta:          metav1.TypeMeta{Kind: "Table", APIVersion: "meta.k8s.io/v1"},
+			ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+		}
+		for i := start; i < end; i++ {
+			n := fmt.Sprintf("p%d", i)
+			raw, _ := json.Marshal(metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: "meta.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1"},
+			})
+			table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{n}, Object: runtime.RawExtension{Raw: raw}})
+		}
+		if end < total {
+			table.Continue = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(table)
+	}))
+	defer srv.Close()
+
+	uu := map[string]struct {
+		pageSize     int64
+		calls, pages int
+	}{
+		"paged":    {pageSize: 2, calls: 3, pages: 2},
+		"single":   {pageSize: 10, calls: 1},
+		"disabled": {calls: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			calls = 0
+			var pages []int
+			ctx := context.WithValue(context.Background(), internal.KeyPageSize, u.pageSize)
+			ctx = context.WithValue(ctx, internal.KeyGVR, client.PodGVR)
+			ctx = context.WithValue(ctx, internal.KeyTablePage, dao.TablePageFunc(func(o runtime.Object) {
+				pages = append(pages, len(o.(*metav1.Table).Rows))
+			}))
+
+			var ta dao.Table
+			ta.Init(&restFactory{host: srv.URL}, client.PodGVR)
+			oo, err := ta.List(ctx, "ns1")
+			require.NoError(t, err)
+			require.Len(t, oo, 1)
+
+			table := oo[0].(*metav1.Table)
+			assert.Len(t, table.Rows, total)
+			assert.Empty(t, table.Continue)
+			assert.Equal(t, "Namespace", table.ColumnDefinitions[0].Name)
+			assert.Len(t, table.ColumnDefinitions, 2)
+			assert.Equal(t, []any{"ns1", "p4"}, table.Rows[total-1].Cells)
+			assert.Equal(t, u.calls, calls)
+			assert.Len(t, pages, u.pages)
+		})
+	}
+}
+
+func TestTableListExpiredContinue(t *testing.T) {
+	const total = 5
+	uu := map[string]struct {
+		resume string
+		calls  int
+	}{
+		"resume":  {resume: "2i", calls: 4},
+		"restart": {calls: 5},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var calls int
+			expired := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				cont := r.URL.Query().Get("continue")
+				if cont == "2" && !expired {
+					expired = true
+					w.WriteHeader(http.StatusGone)
+					_ = json.NewEncoder(w).Encode(metav1.Status{
+						TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+						ListMeta: metav1.ListMeta{Continue: u.resume},
+						Status:   metav1.StatusFailure,
+						Reason:   metav1.StatusReasonExpired,
+						Code:     http.StatusGone,
+					})
+					return
+				}
+				start, _ := strconv.Atoi(strings.TrimSuffix(cont, "i"))
+				end := min(start+2, total)
+				table := metav1.Table{
+					TypeMeta:          metav1.TypeMeta{Kind: "Table", APIVersion: "meta.k8s.io/v1"},
+					ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+				}
+				for i := start; i < end; i++ {
+					n := fmt.Sprintf("p%d", i)
+					raw, _ := json.Marshal(metav1.PartialObjectMetadata{
+						TypeMeta:   metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: "meta.k8s.io/v1"},
+						ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1"},
+					})
+					table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{n}, Object: runtime.RawExtension{Raw: raw}})
+				}
+				if end < total {
+					table.Continue = strconv.Itoa(end)
+				}
+				_ = json.NewEncoder(w).Encode(table)
+			}))
+			defer srv.Close()
+
+			ctx := context.WithValue(context.Background(), internal.KeyPageSize, int64(2))
+			var ta dao.Table
+			ta.Init(&restFactory{host: srv.URL}, client.PodGVR)
+			oo, err := ta.List(ctx, "ns1")
+			require.NoError(t, err)
+			require.Len(t, oo, 1)
+
+			table := oo[0].(*metav1.Table)
+			assert.Len(t, table.Rows, total)
+			assert.Equal(t, []any{"ns1", "p4"}, table.Rows[total-1].Cells)
+			assert.Equal(t, u.calls, calls)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type restConn struct {
+	*conn
+	host string
+}
+
+func (c restConn) RestConfig() (*restclient.Config, error) {
+	return &restclient.Config{Host: c.host}, nil
+}
+
+type restFactory struct {
+	testFactory
+	host string
+}
+
+func (f *restFactory) Client() client.Connection {
+	return restConn{conn: makeConn(), host: f.host}
+}
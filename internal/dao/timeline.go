@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+// crevGVR represents controller revisions.
+var crevGVR = client.NewGVR("apps/v1/controllerrevisions")
+
+var _ Accessor = (*Timeline)(nil)
+
+// Timeline merges a resource events, rollouts and container restarts.
+type Timeline struct {
+	NonResource
+}
+
+// timelineRefs tracks a resource and its dependents.
+type timelineRefs struct {
+	uids    map[types.UID]struct{}
+	objects map[string]struct{}
+}
+
+func newTimelineRefs() *timelineRefs {
+	return &timelineRefs{
+		uids:    make(map[types.UID]struct{}),
+		objects: make(map[string]struct{}),
+	}
+}
+
+func (t *timelineRefs) add(kind, name string, uid types.UID) {
+	t.uids[uid] = struct{}{}
+	t.objects[kind+"/"+name] = struct{}{}
+}
+
+func (t *timelineRefs) owns(oo []metav1.OwnerReference) bool {
+	for _, o := range oo {
+		if _, ok := t.uids[o.UID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *timelineRefs) has(kind, name string) bool {
+	_, ok := t.objects[kind+"/"+name]
+
+	return ok
+}
+
+// List returns a resource timeline in chronological order.
+func (t *Timeline) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(*client.GVR)
+	if !ok {
+		return nil, errors.New("no context for gvr found")
+	}
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+
+	ee, err := t.Entries(gvr, fqn)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+// Entries collects a resource timeline entries ordered by time.
+func (t *Timeline) Entries(gvr *client.GVR, fqn string) ([]*render.TimelineRes, error) {
+	o, err := t.getFactory().Get(gvr, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || u == nil {
+		return nil, fmt.Errorf("unable to locate %s %s", gvr, fqn)
+	}
+	ns := u.GetNamespace()
+	refs := newTimelineRefs()
+	refs.add(u.GetKind(), u.GetName(), u.GetUID())
+
+	var ee []*render.TimelineRes
+	if gvr == client.DpGVR {
+		rr, err := t.replicaSets(ns, refs)
+		if err != nil {
+			return nil, err
+		}
+		ee = append(ee, rr...)
+	}
+	if gvr == client.StsGVR || gvr == client.DsGVR {
+		rr, err := t.controllerRevisions(ns, u.GetKind(), u.GetName(), refs)
+		if err != nil {
+			return nil, err
+		}
+		ee = append(ee, rr...)
+	}
+	rr, err := t.restarts(ns, gvr, u, refs)
+	if err != nil {
+		return nil, err
+	}
+	ee = append(ee, rr...)
+	rr, err = t.events(ns, refs)
+	if err != nil {
+		return nil, err
+	}
+	ee = append(ee, rr...)
+
+	slices.SortStableFunc(ee, func(a, b *render.TimelineRes) int {
+		return a.Time.Compare(b.Time)
+	})
+	for i, e := range ee {
+		e.ID = fmt.Sprintf("%05d", i)
+	}
+
+	return ee, nil
+}
+
+func (t *Timeline) replicaSets(ns string, refs *timelineRefs) ([]*render.TimelineRes, error) {
+	oo, err := t.getFactory().List(client.RsGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]*render.TimelineRes, 0, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		if err := toTyped(o, &rs); err != nil {
+			return nil, err
+		}
+		if !refs.owns(rs.OwnerReferences) {
+			continue
+		}
+		refs.add("ReplicaSet", rs.Name, rs.UID)
+		ee = append(ee, &render.TimelineRes{
+			Time:    rs.CreationTimestamp.Time,
+			Source:  render.TimelineRollout,
+			Type:    v1.EventTypeNormal,
+			Object:  "ReplicaSet/" + rs.Name,
+			Reason:  "Revision " + revisionOf(rs.Annotations),
+			Message: "images " + strings.Join(images(rs.Spec.Template.Spec.Containers), ", "),
+		})
+	}
+
+	return ee, nil
+}
+
+func (t *Timeline) controllerRevisions(ns, kind, name string, refs *timelineRefs) ([]*render.TimelineRes, error) {
+	oo, err := t.getFactory().List(crevGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]*render.TimelineRes, 0, len(oo))
+	for _, o := range oo {
+		var cr appsv1.ControllerRevision
+		if err := toTyped(o, &cr); err != nil {
+			return nil, err
+		}
+		if !refs.owns(cr.OwnerReferences) {
+			continue
+		}
+		ee = append(ee, &render.TimelineRes{
+			Time:    cr.CreationTimestamp.Time,
+			Source:  render.TimelineRollout,
+			Type:    v1.EventTypeNormal,
+			Object:  "ControllerRevision/" + cr.Name,
+			Reason:  fmt.Sprintf("Revision %d", cr.Revision),
+			Message: fmt.Sprintf("%s %s revision created", kind, name),
+		})
+	}
+
+	return ee, nil
+}
+
+func (t *Timeline) restarts(ns string, gvr *client.GVR, u *unstructured.Unstructured, refs *timelineRefs) ([]*render.TimelineRes, error) {
+	var pods []runtime.Object
+	if gvr == client.PodGVR {
+		pods = []runtime.Object{u}
+	} else {
+		oo, err := t.getFactory().List(client.PodGVR, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		pods = oo
+	}
+
+	var ee []*render.TimelineRes
+	for _, o := range pods {
+		var po v1.Pod
+		if err := toTyped(o, &po); err != nil {
+			return nil, err
+		}
+		if gvr != client.PodGVR {
+			if !refs.owns(po.OwnerReferences) {
+				continue
+			}
+			refs.add("Pod", po.Name, po.UID)
+		}
+		cc := slices.Concat(po.Status.InitContainerStatuses, po.Status.ContainerStatuses)
+		for _, cs := range cc {
+			term := cs.LastTerminationState.Terminated
+			if cs.RestartCount == 0 || term == nil {
+				continue
+			}
+			ee = append(ee, &render.TimelineRes{
+				Time:    term.FinishedAt.Time,
+				Source:  render.TimelineRestart,
+				Type:    v1.EventTypeWarning,
+				Object:  "Pod/" + po.Name,
+				Reason:  term.Reason,
+				Message: fmt.Sprintf("container %s restarted (exit code %d)", cs.Name, term.ExitCode),
+				Count:   int(cs.RestartCount),
+			})
+		}
+	}
+
+	return ee, nil
+}
+
+func (t *Timeline) events(ns string, refs *timelineRefs) ([]*render.TimelineRes, error) {
+	oo, err := t.getFactory().List(client.EvGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]*render.TimelineRes, 0, len(oo))
+	for _, o := range oo {
+		var ev eventsv1.Event
+		if err := toTyped(o, &ev); err != nil {
+			return nil, err
+		}
+		if !refs.has(ev.Regarding.Kind, ev.Regarding.Name) {
+			continue
+		}
+		count := int(ev.DeprecatedCount)
+		if ev.Series != nil {
+			count = int(ev.Series.Count)
+		}
+		ee = append(ee, &render.TimelineRes{
+			Time:    eventTime(&ev),
+			Source:  render.TimelineEvent,
+			Type:    ev.Type,
+			Object:  ev.Regarding.Kind + "/" + ev.Regarding.Name,
+			Reason:  ev.Reason,
+			Message: strings.TrimSpace(ev.Note),
+			Count:   max(count, 1),
+		})
+	}
+
+	return ee, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func eventTime(ev *eventsv1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.DeprecatedLastTimestamp.IsZero():
+		return ev.DeprecatedLastTimestamp.Time
+	case !ev.DeprecatedFirstTimestamp.IsZero():
+		return ev.DeprecatedFirstTimestamp.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+func revisionOf(annotations map[string]string) string {
+	if r, ok := annotations[revisionAnnotation]; ok {
+		return r
+	}
+
+	return render.NAValue
+}
+
+func images(cc []v1.Container) []string {
+	ii := make([]string, 0, len(cc))
+	for _, c := range cc {
+		ii = append(ii, c.Image)
+	}
+
+	return ii
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTimelineEntries(t *testing.T) {
+	f := timelineFactory()
+
+	var tl dao.Timeline
+	tl.Init(&f, client.TlGVR)
+
+	uu := map[string]struct {
+		gvr *client.GVR
+		fqn string
+		e   []string
+	}{
+		"deployment": {
+			gvr: client.DpGVR,
+			fqn: "ns1/fred",
+			e: []string{
+				"Rollout|ReplicaSet/fred-1|Revision 1",
+				"Event|Deployment/fred|ScalingReplicaSet",
+				"Rollout|ReplicaSet/fred-2|Revision 2",
+				"Event|Pod/fred-2-a|BackOff",
+				"Restart|Pod/fred-2-a|OOMKilled",
+			},
+		},
+		"pod": {
+			gvr: client.PodGVR,
+			fqn: "ns1/fred-2-a",
+			e: []string{
+				"Event|Pod/fred-2-a|BackOff",
+				"Restart|Pod/fred-2-a|OOMKilled",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := tl.Entries(u.gvr, u.fqn)
+			require.NoError(t, err)
+			aa := make([]string, 0, len(ee))
+			for _, e := range ee {
+				aa = append(aa, e.Source+"|"+e.Object+"|"+e.Reason)
+			}
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
+
+func TestTimelineRestartCount(t *testing.T) {
+	f := timelineFactory()
+
+	var tl dao.Timeline
+	tl.Init(&f, client.TlGVR)
+	ee, err := tl.Entries(client.PodGVR, "ns1/fred-2-a")
+	require.NoError(t, err)
+	require.Len(t, ee, 2)
+	assert.Equal(t, render.TimelineRestart, ee[1].Source)
+	assert.Equal(t, 3, ee[1].Count)
+	assert.Equal(t, "container c1 restarted (exit code 137)", ee[1].Message)
+}
+
+// Helpers...
+
+func timelineFactory() testFactory {
+	owner := func(kind, name, uid string) []any {
+		return []any{map[string]any{"kind": kind, "name": name, "uid": uid, "apiVersion": "apps/v1", "controller": true}}
+	}
+	rs := func(n, uid, rev, ts, image string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind": "ReplicaSet",
+			"metadata": map[string]any{
+				"name":              n,
+				"namespace":         "ns1",
+				"uid":               uid,
+				"creationTimestamp": ts,
+				"annotations":       map[string]any{"deployment.kubernetes.io/revision": rev},
+				"ownerReferences":   owner("Deployment", "fred", "dp"),
+			},
+			"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+				"containers": []any{map[string]any{"name": "c1", "image": image}},
+			}}},
+		}}
+	}
+	ev := func(n, kind, obj, reason, ts string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"kind":                    "Event",
+			"metadata":                map[string]any{"name": n, "namespace": "ns1"},
+			"regarding":               map[string]any{"kind": kind, "name": obj, "namespace": "ns1"},
+			"reason":                  reason,
+			"type":                    "Normal",
+			"note":                    reason + " happened",
+			"deprecatedLastTimestamp": ts,
+		}}
+	}
+
+	return testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {
+			client.DpGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "fred", "namespace": "ns1", "uid": "dp"},
+			}}},
+			client.RsGVR: {
+				rs("fred-1", "rs1", "1", "2026-10-14T10:00:00Z", "nginx:1.0"),
+				rs("fred-2", "rs2", "2", "2026-10-14T11:00:00Z", "nginx:2.0"),
+				&unstructured.Unstructured{Object: map[string]any{
+					"kind":     "ReplicaSet",
+					"metadata": map[string]any{"name": "blee-1", "namespace": "ns1", "uid": "rs3", "creationTimestamp": "2026-10-14T09:00:00Z"},
+				}},
+			},
+			client.PodGVR: {
+				&unstructured.Unstructured{Object: map[string]any{
+					"kind": "Pod",
+					"metadata": map[string]any{
+						"name":            "fred-2-a",
+						"namespace":       "ns1",
+						"uid":             "po1",
+						"ownerReferences": owner("ReplicaSet", "fred-2", "rs2"),
+					},
+					"status": map[string]any{"containerStatuses": []any{map[string]any{
+						"name":         "c1",
+						"restartCount": int64(3),
+						"lastState": map[string]any{"terminated": map[string]any{
+							"reason":     "OOMKilled",
+							"exitCode":   int64(137),
+							"finishedAt": "2026-10-14T11:30:00Z",
+						}},
+					}}},
+				}},
+				&unstructured.Unstructured{Object: map[string]any{
+					"kind":     "Pod",
+					"metadata": map[string]any{"name": "blee-1-a", "namespace": "ns1", "uid": "po2"},
+				}},
+			},
+			client.EvGVR: {
+				ev("e1", "Deployment", "fred", "ScalingReplicaSet", "2026-10-14T10:00:01Z"),
+				ev("e2", "Pod", "fred-2-a", "BackOff", "2026-10-14T11:20:00Z"),
+				ev("e3", "Pod", "blee-1-a", "Pulled", "2026-10-14T11:25:00Z"),
+			},
+		},
+	}}
+}
@@ -45,6 +45,12 @@ type Factory interface {
 	Forwarders() watch.Forwarders
 }
 
+// Cacher represents a factory able to enumerate its watched resources.
+type Cacher interface {
+	// CachedGVRs returns the watched resources per namespace.
+	CachedGVRs() map[string][]*client.GVR
+}
+
 // ImageLister tracks resources with container images.
 type ImageLister interface {
 	// ListImages lists container images.
@@ -86,6 +92,33 @@ type DrainOptions struct {
 	DeleteEmptyDirData  bool
 	Force               bool
 	DisableEviction     bool
+
+	// OnProgress when set gets notified as pods are drained off a node.
+	OnProgress func(DrainEvent)
+}
+
+// DrainState represents a pod drain state.
+type DrainState string
+
+const (
+	// DrainPending indicates a pod is waiting to be drained.
+	DrainPending DrainState = "pending"
+
+	// DrainEvicting indicates a pod is being evicted or deleted.
+	DrainEvicting DrainState = "evicting"
+
+	// DrainEvicted indicates a pod was successfully drained.
+	DrainEvicted DrainState = "evicted"
+
+	// DrainFailed indicates a pod could not be drained.
+	DrainFailed DrainState = "failed"
+)
+
+// DrainEvent tracks a pod drain progress.
+type DrainEvent struct {
+	Node, Pod string
+	State     DrainState
+	Err       error
 }
 
 // NodeMaintainer performs node maintenance operations.
@@ -94,7 +127,7 @@ type NodeMaintainer interface {
 	ToggleCordon(path string, cordon bool) error
 
 	// Drain drains the given node.
-	Drain(path string, opts DrainOptions, w io.Writer) error
+	Drain(ctx context.Context, path string, opts DrainOptions, w io.Writer) error
 }
 
 // Loggable represents resources with logs.
@@ -130,6 +163,12 @@ type Controller interface {
 	Pod(path string) (string, error)
 }
 
+// Selectable represents a resource selecting its backing pods.
+type Selectable interface {
+	// Selector returns the resource pods label selector.
+	Selector(path string) (string, error)
+}
+
 // Nuker represents a resource deleter.
 type Nuker interface {
 	// Delete removes a resource from the api server.
@@ -148,6 +187,12 @@ type Restartable interface {
 	Restart(context.Context, string, *metav1.PatchOptions) error
 }
 
+// Pausable represents a resource which rollouts can be paused.
+type Pausable interface {
+	// Pause pauses or resumes a resource rollout.
+	Pause(ctx context.Context, path string, paused bool) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
@@ -180,3 +225,9 @@ type Valuer interface {
 	// GetValues returns values for a resource.
 	GetValues(path string, allValues bool) ([]byte, error)
 }
+
+// Disruptor represents a resource whose removal may violate pod disruption budgets.
+type Disruptor interface {
+	// Disruptions returns the disruption budgets violated by removing the given resources.
+	Disruptions(paths []string) ([]DisruptionViolation, error)
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// defaultSnapshotClassAnnotation flags the default volume snapshot class.
+const defaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+
+var _ Accessor = (*VolumeSnapshot)(nil)
+
+// VolumeSnapshot represents a CSI volume snapshot.
+type VolumeSnapshot struct {
+	Resource
+}
+
+// Snapshot snapshots a claim and returns the snapshot path.
+func (v *VolumeSnapshot) Snapshot(ctx context.Context, pvcPath, name, class string) (string, error) {
+	ns, n := client.Namespaced(pvcPath)
+	auth, err := v.Client().CanI(ns, client.VsGVR, "", []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create volume snapshots")
+	}
+
+	dial, err := v.Client().DynDial()
+	if err != nil {
+		return "", err
+	}
+	snap := newVolumeSnapshot(ns, n, name, class)
+	if _, err := dial.Resource(client.VsGVR.GVR()).Namespace(ns).Create(ctx, snap, metav1.CreateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", fmt.Errorf("unable to snapshot claim %s: %w", n, err)
+	}
+
+	return client.FQN(ns, name), nil
+}
+
+// Restore creates a claim from a snapshot and returns the claim path.
+// A blank class restores into the source claim storage class.
+func (v *VolumeSnapshot) Restore(ctx context.Context, path, claim, class string) (string, error) {
+	ns, _ := client.Namespaced(path)
+	auth, err := v.Client().CanI(ns, client.PvcGVR, "", []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create persistent volume claims")
+	}
+
+	snap, err := getUnstructured(v.getFactory(), client.VsGVR, path)
+	if err != nil {
+		return "", err
+	}
+	var src *v1.PersistentVolumeClaim
+	if n, _, _ := unstructured.NestedString(snap.Object, "spec", "source", "persistentVolumeClaimName"); n != "" {
+		o, err := v.getFactory().Get(client.PvcGVR, client.FQN(ns, n), true, labels.Everything())
+		if err == nil {
+			var pvc v1.PersistentVolumeClaim
+			if toTyped(o, &pvc) == nil {
+				src = &pvc
+			}
+		}
+	}
+	pvc, err := restoreClaim(snap, src, claim, class)
+	if err != nil {
+		return "", err
+	}
+
+	dial, err := v.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	if _, err := dial.CoreV1().PersistentVolumeClaims(ns).Create(ctx, pvc, metav1.CreateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", fmt.Errorf("unable to restore snapshot %s: %w", snap.GetName(), err)
+	}
+
+	return client.FQN(ns, claim), nil
+}
+
+// SnapshotClasses returns the volume snapshot classes names, default class first.
+func SnapshotClasses(f Factory) ([]string, error) {
+	oo, err := f.List(client.VsclGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	cc := make([]string, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+		}
+		if u.GetAnnotations()[defaultSnapshotClassAnnotation] == "true" {
+			cc = slices.Insert(cc, 0, u.GetName())
+			continue
+		}
+		cc = append(cc, u.GetName())
+	}
+
+	return cc, nil
+}
+
+// Helpers...
+
+func newVolumeSnapshot(ns, pvc, name, class string) *unstructured.Unstructured {
+	spec := map[string]any{
+		"source": map[string]any{"persistentVolumeClaimName": pvc},
+	}
+	if class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": client.VsGVR.GV().String(),
+		"kind":       "VolumeSnapshot",
+		"metadata":   map[string]any{"name": name, "namespace": ns},
+		"spec":       spec,
+	}}
+}
+
+// restoreClaim returns a claim restoring a snapshot, sized and shaped after the snapshot source claim if known.
+func restoreClaim(snap *unstructured.Unstructured, src *v1.PersistentVolumeClaim, claim, class string) (*v1.PersistentVolumeClaim, error) {
+	if ready, _, _ := unstructured.NestedBool(snap.Object, "status", "readyToUse"); !ready {
+		return nil, fmt.Errorf("snapshot %s is not ready to use", snap.GetName())
+	}
+
+	group := client.VsGVR.G()
+	pvc := v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claim,
+			Namespace: snap.GetNamespace(),
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			DataSource: &v1.TypedLocalObjectReference{
+				APIGroup: &group,
+				Kind:     "VolumeSnapshot",
+				Name:     snap.GetName(),
+			},
+		},
+	}
+	var size resource.Quantity
+	if src != nil {
+		pvc.Spec.AccessModes, pvc.Spec.VolumeMode = src.Spec.AccessModes, src.Spec.VolumeMode
+		pvc.Spec.StorageClassName = src.Spec.StorageClassName
+		size = src.Spec.Resources.Requests[v1.ResourceStorage]
+	}
+	if s, _, _ := unstructured.NestedString(snap.Object, "status", "restoreSize"); s != "" {
+		q, err := resource.ParseQuantity(s)
+		if err != nil {
+			return nil, err
+		}
+		if q.Cmp(size) > 0 {
+			size = q
+		}
+	}
+	if size.IsZero() {
+		return nil, errors.New("unable to size the restored claim")
+	}
+	pvc.Spec.Resources.Requests = v1.ResourceList{v1.ResourceStorage: size}
+	if class != "" {
+		pvc.Spec.StorageClassName = &class
+	}
+
+	return &pvc, nil
+}
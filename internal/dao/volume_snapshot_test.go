@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNewVolumeSnapshot(t *testing.T) {
+	vs := newVolumeSnapshot("ns1", "data", "data-snap", "csi-snap")
+
+	assert.Equal(t, "snapshot.storage.k8s.io/v1", vs.GetAPIVersion())
+	assert.Equal(t, "VolumeSnapshot", vs.GetKind())
+	assert.Equal(t, "ns1", vs.GetNamespace())
+	n, _, _ := unstructured.NestedString(vs.Object, "spec", "source", "persistentVolumeClaimName")
+	assert.Equal(t, "data", n)
+	c, _, _ := unstructured.NestedString(vs.Object, "spec", "volumeSnapshotClassName")
+	assert.Equal(t, "csi-snap", c)
+
+	_, ok, _ := unstructured.NestedString(newVolumeSnapshot("ns1", "data", "s1", "").Object, "spec", "volumeSnapshotClassName")
+	assert.False(t, ok)
+}
+
+func TestRestoreClaim(t *testing.T) {
+	snap := func(ready bool, size string) *unstructured.Unstructured {
+		status := map[string]any{"readyToUse": ready}
+		if size != "" {
+			status["restoreSize"] = size
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "data-snap", "namespace": "ns1"},
+			"spec":     map[string]any{"source": map[string]any{"persistentVolumeClaimName": "data"}},
+			"status":   status,
+		}}
+	}
+	fast, block := "fast", v1.PersistentVolumeBlock
+	src := v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "ns1"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			StorageClassName: &fast,
+			VolumeMode:       &block,
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("5Gi")},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		snap        *unstructured.Unstructured
+		src         *v1.PersistentVolumeClaim
+		class, size string
+		modes       []v1.PersistentVolumeAccessMode
+		err         string
+	}{
+		"source": {
+			snap:  snap(true, "2Gi"),
+			src:   &src,
+			class: "fast",
+			size:  "5Gi",
+			modes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+		},
+		"no-source": {
+			snap:  snap(true, "2Gi"),
+			size:  "2Gi",
+			modes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		},
+		"not-ready": {
+			snap: snap(false, "2Gi"),
+			err:  "snapshot data-snap is not ready to use",
+		},
+		"no-size": {
+			snap: snap(true, ""),
+			err:  "unable to size the restored claim",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pvc, err := restoreClaim(u.snap, u.src, "data-restore", "")
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "data-restore", pvc.Name)
+			assert.Equal(t, "ns1", pvc.Namespace)
+			assert.Equal(t, "VolumeSnapshot", pvc.Spec.DataSource.Kind)
+			assert.Equal(t, "data-snap", pvc.Spec.DataSource.Name)
+			assert.Equal(t, "snapshot.storage.k8s.io", *pvc.Spec.DataSource.APIGroup)
+			assert.Equal(t, u.modes, pvc.Spec.AccessModes)
+			q := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+			assert.Equal(t, u.size, q.String())
+			if u.class != "" {
+				assert.Equal(t, u.class, *pvc.Spec.StorageClassName)
+			}
+		})
+	}
+
+	pvc, err := restoreClaim(snap(true, "2Gi"), &src, "data-restore", "slow")
+	require.NoError(t, err)
+	assert.Equal(t, "slow", *pvc.Spec.StorageClassName)
+	assert.Equal(t, "fast", *src.Spec.StorageClassName)
+}
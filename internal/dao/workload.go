@@ -9,16 +9,23 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
@@ -33,6 +40,16 @@ var resList = []*client.GVR{
 	client.StsGVR,
 	client.DpGVR,
 	client.RsGVR,
+	client.JobGVR,
+	client.CjGVR,
+}
+
+// wkTables caches workload tables across refreshes.
+var wkTables = newWorkloadTables()
+
+// ClearWorkloadTables drops cached workload tables ie on context switch.
+func ClearWorkloadTables() {
+	wkTables.clear()
 }
 
 // Workload tracks a select set of resources in a given namespace.
@@ -58,6 +75,7 @@ func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.
 	opts := metav1.DeleteOptions{
 		PropagationPolicy:  propagation,
 		GracePeriodSeconds: gracePeriod,
+		DryRun:             dryRunOpts(),
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, w.Client().Config().CallTimeout())
@@ -68,122 +86,360 @@ func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.
 		return err
 	}
 	dial := d.Resource(gvr.GVR())
+	prior := snapshot(ctx, w.Client(), gvr, path)
 	if client.IsClusterScoped(ns) {
-		return dial.Delete(ctx, n, opts)
+		err = dial.Delete(ctx, n, opts)
+	} else {
+		err = dial.Namespace(ns).Delete(ctx, n, opts)
 	}
+	if err != nil {
+		return err
+	}
+	journalMutation(JournalDelete, gvr, path, prior)
 
-	return dial.Namespace(ns).Delete(ctx, n, opts)
+	return nil
 }
 
-func (a *Workload) fetch(ctx context.Context, gvr *client.GVR, ns string) (*metav1.Table, error) {
-	a.gvr = gvr
-	oo, err := a.Table.List(ctx, ns)
-	if err != nil {
-		return nil, err
+func (a *Workload) fetch(ctx context.Context, gvr *client.GVR, ns string, includeObj bool) (*metav1.Table, error) {
+	var sel string
+	if labelSel, ok := ctx.Value(internal.KeyLabels).(labels.Selector); ok {
+		sel = labelSel.String()
 	}
-	if len(oo) == 0 {
-		return nil, fmt.Errorf("no table found for gvr: %s", gvr)
-	}
-	tt, ok := oo[0].(*metav1.Table)
-	if !ok {
-		return nil, errors.New("not a metav1.Table")
+	if fieldSel, ok := ctx.Value(internal.KeyFields).(string); ok {
+		sel += "|" + fieldSel
 	}
 
-	return tt, nil
+	return wkTables.fetch(a.getFactory(), gvr, ns, sel, func() (*metav1.Table, error) {
+		var t Table
+		t.Init(a.getFactory(), gvr)
+		t.SetIncludeObject(includeObj)
+		oo, err := t.List(ctx, ns)
+		if err != nil {
+			return nil, err
+		}
+		if len(oo) == 0 {
+			return nil, fmt.Errorf("no table found for gvr: %s", gvr)
+		}
+		tt, ok := oo[0].(*metav1.Table)
+		if !ok {
+			return nil, errors.New("not a metav1.Table")
+		}
+
+		return tt, nil
+	})
 }
 
 // List fetch workloads.
 func (a *Workload) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	oo := make([]runtime.Object, 0, 100)
-	for _, gvr := range resList {
-		table, err := a.fetch(ctx, gvr, ns)
-		if err != nil {
-			return nil, err
+	specs, nss := workloadGVRs(ctx), workloadNamespaces(ctx, ns)
+	custom, _ := ctx.Value(internal.KeyWorkloadCEL).([]config.CustomWorkload)
+	results := make([][]runtime.Object, (len(specs)+len(custom))*len(nss))
+	var (
+		queued, errs int
+		mx           sync.Mutex
+	)
+	fail := func(idx int, gvr *client.GVR, ns string, err error) error {
+		mx.Lock()
+		errs++
+		mx.Unlock()
+		results[idx] = []runtime.Object{errorRow(gvr, ns, err)}
+		return err
+	}
+	pool := internal.NewWorkerPool(ctx, internal.DefaultPoolSize)
+	for i, spec := range specs {
+		gvr := client.NewGVR(spec.Name)
+		if _, err := MetaAccess.MetaFor(gvr); err != nil {
+			slog.Warn("Skipping unknown workload resource", slogs.GVR, gvr)
+			continue
 		}
-		var (
-			ns string
-			ts metav1.Time
-		)
-		for _, r := range table.Rows {
-			if obj := r.Object.Object; obj != nil {
-				if m, err := meta.Accessor(obj); err == nil {
-					ns, ts = m.GetNamespace(), m.GetCreationTimestamp()
+		for j, ns := range nss {
+			idx := i*len(nss) + j
+			queued++
+			pool.Add(func(ctx context.Context) error {
+				degradedOnly, _ := ctx.Value(internal.KeyDegradedOnly).(bool)
+				if fn, ok := objectWorkloads[gvr]; ok && !spec.IsMapped() {
+					oo, err := a.getFactory().List(gvr, ns, true, labels.Everything())
+					if err != nil {
+						return fail(idx, gvr, ns, err)
+					}
+					results[idx] = objectRows(gvr, oo, degradedOnly, fn)
+					return nil
 				}
-			} else {
-				var m metav1.PartialObjectMetadata
-				if err := json.Unmarshal(r.Object.Raw, &m); err == nil {
-					ns, ts = m.GetNamespace(), m.CreationTimestamp
+				table, err := a.fetch(ctx, gvr, ns, includeObject(gvr))
+				if err != nil {
+					return fail(idx, gvr, ns, err)
 				}
+				results[idx] = workloadRows(gvr, spec, table, degradedOnly)
+				return nil
+			})
+		}
+	}
+	for i, spec := range custom {
+		gvr := client.NewGVR(spec.Name)
+		if _, err := MetaAccess.MetaFor(gvr); err != nil {
+			slog.Warn("Skipping unknown custom workload resource", slogs.GVR, gvr)
+			continue
+		}
+		w, err := newCELWorkload(spec)
+		for j, ns := range nss {
+			idx := (len(specs)+i)*len(nss) + j
+			if err != nil {
+				results[idx] = []runtime.Object{errorRow(gvr, ns, err)}
+				continue
 			}
-			stat := status(gvr, &r, table.ColumnDefinitions)
-			oo = append(oo, &render.WorkloadRes{Row: metav1.TableRow{Cells: []any{
-				gvr.String(),
-				ns,
-				r.Cells[indexOf("Name", table.ColumnDefinitions)],
-				stat,
-				readiness(gvr, &r, table.ColumnDefinitions),
-				validity(stat),
-				ts,
-			}}})
+			queued++
+			pool.Add(func(ctx context.Context) error {
+				oo, err := a.getFactory().List(w.gvr, ns, true, labels.Everything())
+				if err != nil {
+					return fail(idx, w.gvr, ns, err)
+				}
+				degradedOnly, _ := ctx.Value(internal.KeyDegradedOnly).(bool)
+				results[idx] = w.rows(oo, degradedOnly)
+				return nil
+			})
 		}
 	}
+	if ee := pool.Drain(); len(ee) > 0 && errs == queued {
+		return nil, ee[0]
+	}
+
+	oo := make([]runtime.Object, 0, 100)
+	for _, rr := range results {
+		oo = append(oo, rr...)
+	}
+	if f := a.getFactory(); f != nil {
+		pods := make([]runtime.Object, 0, len(oo))
+		for _, ns := range nss {
+			pp, err := f.List(client.PodGVR, ns, false, labels.Everything())
+			if err != nil {
+				slog.Warn("Unable to list pods for workload restarts", slogs.Error, err)
+			}
+			pods = append(pods, pp...)
+		}
+		addRestarts(oo, pods)
+		addUsages(oo, pods, podsMetrics(ctx, a.Client(), nss))
+		addHPAs(oo, hpaLookup(f, nss))
+	}
+	owned := resolveOwners(oo)
+	if collapse, _ := ctx.Value(internal.KeyCollapseOwned).(bool); collapse {
+		oo = collapseOwned(oo, owned)
+	}
+	if label, _ := ctx.Value(internal.KeyWorkloadGroup).(string); label != "" {
+		collapsed, _ := ctx.Value(internal.KeyCollapsed).([]string)
+		oo = groupWorkloads(oo, label, sets.New(collapsed...))
+	}
+	wkHistory.track(oo, time.Now())
 
 	return oo, nil
 }
 
+func workloadRows(gvr *client.GVR, spec config.WorkloadGVR, table *metav1.Table, degradedOnly bool) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(table.Rows))
+	var schemaErr string
+	if _, ok := workloadSchemas[gvr]; ok && !spec.IsMapped() {
+		if err := validateSchema(gvr, table.ColumnDefinitions); err != nil {
+			schemaErr = err.Error()
+		}
+	}
+	for _, r := range table.Rows {
+		stat, ready := status(gvr, &r, table.ColumnDefinitions), readiness(gvr, &r, table.ColumnDefinitions)
+		if spec.IsMapped() {
+			stat, ready = mappedStatus(spec, &r, table.ColumnDefinitions), mappedReadiness(spec, &r, table.ColumnDefinitions)
+		}
+		var reason string
+		if u, ok := r.Object.Object.(*unstructured.Unstructured); ok {
+			if cc := failedConditions(objectConditions(u)); len(cc) > 0 {
+				if stat == StatusOK {
+					stat = DegradedStatus
+				}
+				reason = conditionsReason(cc)
+			}
+			if paused, _, _ := unstructured.NestedBool(u.Object, "spec", "paused"); paused && gvr == client.DpGVR {
+				stat, reason = pausedStatus(stat, reason)
+			}
+		}
+		if degradedOnly && stat != DegradedStatus {
+			continue
+		}
+		var m metav1.Object
+		if obj := r.Object.Object; obj != nil {
+			m, _ = meta.Accessor(obj)
+		} else {
+			var pm metav1.PartialObjectMetadata
+			if err := json.Unmarshal(r.Object.Raw, &pm); err == nil {
+				m = &pm
+			}
+		}
+		res := newWorkloadRes(gvr.String(), "", newTableRow(&r, table.ColumnDefinitions).str("Name"), stat, ready, validity(stat), metav1.Time{}, schemaErr)
+		res.Row.Cells[render.WKReasonCell] = reason
+		if m != nil {
+			res.Row.Cells[render.WKNamespaceCell], res.Row.Cells[render.WKCreatedCell] = m.GetNamespace(), m.GetCreationTimestamp()
+			res.UID, res.Owners, res.Labels = m.GetUID(), m.GetOwnerReferences(), m.GetLabels()
+		}
+		oo = append(oo, res)
+	}
+
+	return oo
+}
+
+// newWorkloadRes returns a workload row. Decorations such as restarts, autoscalers,
+// readiness history, owner and conditions reason are filled in later on.
+func newWorkloadRes(kind, ns, n, stat, ready, valid string, created metav1.Time, msg string) *render.WorkloadRes {
+	return &render.WorkloadRes{Row: metav1.TableRow{Cells: []any{
+		kind,
+		ns,
+		n,
+		stat,
+		ready,
+		valid,
+		created,
+		msg,
+		"",
+		metav1.Time{},
+		"",
+		[]float64(nil),
+		"",
+		"",
+	}}}
+}
+
+// errorRow reports a resource that could not be fetched.
+func errorRow(gvr *client.GVR, ns string, err error) *render.WorkloadRes {
+	return newWorkloadRes(gvr.String(), client.CleanseNamespace(ns), "", render.MissingValue, "", "", metav1.Time{}, err.Error())
+}
+
 // Helpers...
 
+// defaultWorkloadGVR tracks column mappings for resources without custom status logic.
+var defaultWorkloadGVR = config.WorkloadGVR{Ready: "Ready"}
+
+// workloadGVRs returns the user specified workload resources if any or the default ones.
+func workloadGVRs(ctx context.Context) []config.WorkloadGVR {
+	if gg, ok := ctx.Value(internal.KeyWorkloadGVRs).([]config.WorkloadGVR); ok && len(gg) > 0 {
+		return gg
+	}
+	gg := make([]config.WorkloadGVR, 0, len(resList)+len(optionalWorkloads))
+	for _, gvr := range resList {
+		gg = append(gg, config.WorkloadGVR{Name: gvr.String()})
+	}
+	for _, gvr := range optionalWorkloads {
+		if _, err := MetaAccess.MetaFor(gvr); err == nil {
+			gg = append(gg, config.WorkloadGVR{Name: gvr.String()})
+		}
+	}
+
+	return gg
+}
+
+// workloadNamespaces returns the selected namespaces if any or the given one.
+func workloadNamespaces(ctx context.Context, ns string) []string {
+	nss, ok := ctx.Value(internal.KeyNamespaces).([]string)
+	if !ok || len(nss) == 0 {
+		return []string{ns}
+	}
+
+	return nss
+}
+
+func mappedReadiness(spec config.WorkloadGVR, r *metav1.TableRow, h []metav1.TableColumnDefinition) string {
+	if spec.Ready == "" {
+		return ""
+	}
+
+	return newTableRow(r, h).ratio(spec.Ready, spec.Desired)
+}
+
+func mappedStatus(spec config.WorkloadGVR, r *metav1.TableRow, h []metav1.TableColumnDefinition) string {
+	if spec.Status != "" {
+		s := newTableRow(r, h).str(spec.Status)
+		if s == "" {
+			return render.MissingValue
+		}
+		if len(spec.HealthyStatus) > 0 && !slices.Contains(spec.HealthyStatus, s) {
+			return DegradedStatus
+		}
+	}
+	if ready := mappedReadiness(spec, r, h); strings.Contains(ready, "/") && !isReady(ready) {
+		return DegradedStatus
+	}
+
+	return StatusOK
+}
+
 func readiness(gvr *client.GVR, r *metav1.TableRow, h []metav1.TableColumnDefinition) string {
+	row := newTableRow(r, h)
 	switch gvr {
 	case client.PodGVR, client.DpGVR, client.StsGVR:
-		return r.Cells[indexOf("Ready", h)].(string)
+		return row.ratio("Ready", "")
 	case client.RsGVR, client.DsGVR:
-		c := r.Cells[indexOf("Ready", h)].(int64)
-		d := r.Cells[indexOf("Desired", h)].(int64)
-		return fmt.Sprintf("%d/%d", c, d)
+		return row.ratio("Ready", "Desired")
+	case client.JobGVR:
+		return row.str("Completions")
+	case client.CjGVR:
+		return row.str("Last Schedule")
 	case client.SvcGVR:
 		return ""
 	}
+	if row.has(defaultWorkloadGVR.Ready) {
+		return mappedReadiness(defaultWorkloadGVR, r, h)
+	}
 
 	return render.NAValue
 }
 
 func status(gvr *client.GVR, r *metav1.TableRow, h []metav1.TableColumnDefinition) string {
+	if _, ok := workloadSchemas[gvr]; ok && validateSchema(gvr, h) != nil {
+		return render.MissingValue
+	}
+	row := newTableRow(r, h)
 	switch gvr {
 	case client.PodGVR:
-		if status := r.Cells[indexOf("Status", h)]; status == render.PhaseCompleted {
+		if status := row.str("Status"); status == render.PhaseCompleted {
 			return StatusOK
-		} else if !isReady(r.Cells[indexOf("Ready", h)].(string)) || status != render.PhaseRunning {
+		} else if !isReady(row.ratio("Ready", "")) || status != render.PhaseRunning {
 			return DegradedStatus
 		}
 	case client.DpGVR, client.StsGVR:
-		if !isReady(r.Cells[indexOf("Ready", h)].(string)) {
+		if !isReady(row.ratio("Ready", "")) {
 			return DegradedStatus
 		}
 	case client.RsGVR, client.DsGVR:
-		rd, ok1 := r.Cells[indexOf("Ready", h)].(int64)
-		de, ok2 := r.Cells[indexOf("Desired", h)].(int64)
-		if ok1 && ok2 {
-			if !isReady(fmt.Sprintf("%d/%d", rd, de)) {
+		if ready := row.ratio("Ready", "Desired"); ready != "" && !isReady(ready) {
+			return DegradedStatus
+		}
+	case client.JobGVR:
+		if status := row.str("Status"); status != "" {
+			if status == "Failed" || status == "FailureTarget" {
 				return DegradedStatus
 			}
 			break
 		}
-		rds, oks1 := r.Cells[indexOf("Ready", h)].(string)
-		des, oks2 := r.Cells[indexOf("Desired", h)].(string)
-		if oks1 && oks2 {
-			if !isReady(fmt.Sprintf("%s/%s", rds, des)) {
-				return DegradedStatus
-			}
+		if !isReady(row.str("Completions")) {
+			return DegradedStatus
 		}
-	case client.SvcGVR:
+	case client.CjGVR, client.SvcGVR:
 	default:
-		return render.MissingValue
+		if !row.has(defaultWorkloadGVR.Ready) {
+			return render.MissingValue
+		}
+		return mappedStatus(defaultWorkloadGVR, r, h)
 	}
 
 	return StatusOK
 }
 
+// pausedStatus flags a paused rollout, keeping degraded rollouts status.
+func pausedStatus(stat, reason string) (string, string) {
+	if stat == StatusOK {
+		return render.PausedStatus, reason
+	}
+	if reason == "" {
+		return stat, "rollout paused"
+	}
+
+	return stat, "rollout paused, " + reason
+}
+
 func validity(status string) string {
 	if status != "DEGRADED" {
 		return ""
@@ -219,13 +475,3 @@ func isReady(s string) bool {
 	}
 	return r == c
 }
-
-func indexOf(n string, defs []metav1.TableColumnDefinition) int {
-	for i, d := range defs {
-		if d.Name == n {
-			return i
-		}
-	}
-
-	return -1
-}
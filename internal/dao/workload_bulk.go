@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// WorkloadOp represents a workload bulk operation.
+type WorkloadOp string
+
+const (
+	// WorkloadDelete deletes workloads.
+	WorkloadDelete WorkloadOp = "Delete"
+
+	// WorkloadRestart performs a rollout restart.
+	WorkloadRestart WorkloadOp = "Restart"
+
+	// WorkloadScale scales workloads.
+	WorkloadScale WorkloadOp = "Scale"
+)
+
+// WorkloadBulkOpts tracks bulk operation options.
+type WorkloadBulkOpts struct {
+	Op           WorkloadOp
+	Replicas     int32
+	Propagation  *metav1.DeletionPropagation
+	Grace        Grace
+	FieldManager string
+}
+
+// WorkloadResult tracks the outcome of an operation on a given workload.
+type WorkloadResult struct {
+	Path string
+	Err  error
+}
+
+// ParseWorkloadPath splits a workload path ie gvr|ns|name into a gvr and fqn.
+func ParseWorkloadPath(path string) (*client.GVR, string, error) {
+	tt := strings.Split(path, "|")
+	if len(tt) != 3 {
+		return client.NoGVR, "", fmt.Errorf("invalid workload path: %q", path)
+	}
+
+	return client.NewGVR(tt[0]), client.FQN(tt[1], tt[2]), nil
+}
+
+// Bulk applies an operation to a collection of workloads, possibly of different kinds.
+func (a *Workload) Bulk(ctx context.Context, paths []string, opts *WorkloadBulkOpts) []WorkloadResult {
+	rr := make([]WorkloadResult, 0, len(paths))
+	for _, path := range paths {
+		rr = append(rr, WorkloadResult{Path: path, Err: a.apply(ctx, path, opts)})
+	}
+
+	return rr
+}
+
+func (a *Workload) apply(ctx context.Context, path string, opts *WorkloadBulkOpts) error {
+	gvr, fqn, err := ParseWorkloadPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Op {
+	case WorkloadDelete:
+		return a.Delete(context.WithValue(ctx, internal.KeyGVR, gvr), fqn, opts.Propagation, opts.Grace)
+	case WorkloadRestart:
+		return a.Restart(ctx, gvr, fqn, &metav1.PatchOptions{FieldManager: opts.FieldManager})
+	case WorkloadScale:
+		return a.Scale(ctx, gvr, fqn, opts.Replicas)
+	default:
+		return fmt.Errorf("unsupported workload operation: %q", opts.Op)
+	}
+}
+
+// restartableRes tracks workload resources supporting rollout restarts.
+var restartableRes = sets.New(client.DpGVR, client.StsGVR, client.DsGVR)
+
+// Restart performs a rollout restart on a given workload.
+func (a *Workload) Restart(ctx context.Context, gvr *client.GVR, fqn string, opts *metav1.PatchOptions) error {
+	if !restartableRes.Has(gvr) {
+		return fmt.Errorf("%s is not restartable", gvr)
+	}
+	ns, n := client.Namespaced(fqn)
+	auth, err := a.Client().CanI(ns, gvr, n, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to restart %s", fqn)
+	}
+
+	acc, err := AccessorFor(a.getFactory(), gvr)
+	if err != nil {
+		return err
+	}
+	r, ok := acc.(Restartable)
+	if !ok {
+		return fmt.Errorf("%s is not restartable", gvr)
+	}
+
+	return r.Restart(ctx, fqn, opts)
+}
+
+// scalableWorkloads tracks workload resources supporting scaling.
+var scalableWorkloads = sets.New(client.DpGVR, client.StsGVR, client.RsGVR, RolloutGVR)
+
+// ScalePreview tracks a workload replicas change.
+type ScalePreview struct {
+	Current, Desired int32
+}
+
+// Scale scales a given workload via its scale subresource.
+func (a *Workload) Scale(ctx context.Context, gvr *client.GVR, fqn string, replicas int32) error {
+	_, err := a.scale(ctx, gvr, fqn, replicas, false)
+
+	return err
+}
+
+// PreviewScale performs a server side dry run of a scale change.
+func (a *Workload) PreviewScale(ctx context.Context, gvr *client.GVR, fqn string, replicas int32) (*ScalePreview, error) {
+	return a.scale(ctx, gvr, fqn, replicas, true)
+}
+
+func (a *Workload) scale(ctx context.Context, gvr *client.GVR, fqn string, replicas int32, dryRun bool) (*ScalePreview, error) {
+	if !scalableWorkloads.Has(gvr) {
+		return nil, fmt.Errorf("%s is not scalable", gvr)
+	}
+	ns, n := client.Namespaced(fqn)
+	auth, err := a.Client().CanI(ns, client.NewGVR(gvr.String()+":scale"), n, []string{client.GetVerb, client.PatchVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to scale %s", fqn)
+	}
+
+	d, err := a.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+	dial := d.Resource(gvr.GVR()).Namespace(ns)
+	current, err := dial.Get(ctx, n, metav1.GetOptions{}, "scale")
+	if err != nil {
+		return nil, err
+	}
+	from, _, _ := unstructured.NestedInt64(current.Object, "spec", "replicas")
+
+	opts := metav1.PatchOptions{DryRun: dryRunOpts()}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	var prior *unstructured.Unstructured
+	if !dryRun {
+		prior = snapshot(ctx, a.Client(), gvr, fqn)
+	}
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	updated, err := dial.Patch(ctx, n, types.MergePatchType, []byte(patch), opts, "scale")
+	if err != nil {
+		return nil, err
+	}
+	journalMutation(JournalScale, gvr, fqn, prior)
+	to, _, _ := unstructured.NestedInt64(updated.Object, "spec", "replicas")
+
+	return &ScalePreview{Current: int32(from), Desired: int32(to)}, nil
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestParseWorkloadPath(t *testing.T) {
+	gvr, fqn, err := ParseWorkloadPath("apps/v1/deployments|ns1|dp1")
+	require.NoError(t, err)
+	assert.Equal(t, client.DpGVR, gvr)
+	assert.Equal(t, "ns1/dp1", fqn)
+
+	_, _, err = ParseWorkloadPath("ns1/dp1")
+	require.Error(t, err)
+}
+
+func TestWorkloadBulk(t *testing.T) {
+	var w Workload
+
+	uu := map[string]struct {
+		opts *WorkloadBulkOpts
+		path string
+		err  string
+	}{
+		"bad-path": {
+			opts: &WorkloadBulkOpts{Op: WorkloadRestart},
+			path: "blee",
+			err:  `invalid workload path: "blee"`,
+		},
+		"bad-op": {
+			opts: &WorkloadBulkOpts{Op: "Blee"},
+			path: "v1/services|ns1|svc1",
+			err:  `unsupported workload operation: "Blee"`,
+		},
+		"restart-svc": {
+			opts: &WorkloadBulkOpts{Op: WorkloadRestart},
+			path: "v1/services|ns1|svc1",
+			err:  "v1/services is not restartable",
+		},
+		"scale-pod": {
+			opts: &WorkloadBulkOpts{Op: WorkloadScale, Replicas: 2},
+			path: "v1/pods|ns1|p1",
+			err:  "v1/pods is not scalable",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := w.Bulk(context.Background(), []string{u.path}, u.opts)
+			require.Len(t, rr, 1)
+			assert.Equal(t, u.path, rr[0].Path)
+			assert.Equal(t, u.err, rr[0].Err.Error())
+		})
+	}
+}
+
+func TestWorkloadRestartUnauthorized(t *testing.T) {
+	var w Workload
+	w.Init(connFactory{conn: denyConn{}}, client.WkGVR)
+
+	err := w.Restart(context.Background(), client.DpGVR, "ns1/dp1", nil)
+	require.Error(t, err)
+	assert.Equal(t, "user is not authorized to restart ns1/dp1", err.Error())
+}
+
+// Helpers...
+
+type denyConn struct {
+	client.Connection
+}
+
+func (denyConn) CanI(string, *client.GVR, string, []string) (bool, error) {
+	return false, nil
+}
+
+type connFactory struct {
+	Factory
+	conn client.Connection
+}
+
+func (f connFactory) Client() client.Connection {
+	return f.conn
+}
+
+func TestWorkloadPreviewScale(t *testing.T) {
+	dyn := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	var dryRun []string
+	dyn.PrependReactor("get", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		if a.GetSubresource() != "scale" {
+			return true, newDeployment(3), nil
+		}
+		return true, newScale(3), nil
+	})
+	dyn.PrependReactor("patch", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		p := a.(k8stesting.PatchActionImpl)
+		assert.Equal(t, "scale", p.GetSubresource())
+		assert.JSONEq(t, `{"spec":{"replicas":5}}`, string(p.GetPatch()))
+		dryRun = p.GetPatchOptions().DryRun
+		return true, newScale(5), nil
+	})
+
+	var w Workload
+	w.Init(connFactory{conn: dynConn{dyn: dyn}}, client.WkGVR)
+
+	p, err := w.PreviewScale(context.Background(), client.DpGVR, "ns1/dp1", 5)
+	require.NoError(t, err)
+	assert.Equal(t, &ScalePreview{Current: 3, Desired: 5}, p)
+	assert.Equal(t, []string{metav1.DryRunAll}, dryRun)
+
+	require.NoError(t, w.Scale(context.Background(), client.DpGVR, "ns1/dp1", 5))
+	assert.Empty(t, dryRun)
+}
+
+func newScale(replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "Scale",
+		"metadata":   map[string]any{"name": "dp1", "namespace": "ns1"},
+		"spec":       map[string]any{"replicas": replicas},
+	}}
+}
+
+func newDeployment(replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "dp1", "namespace": "ns1"},
+		"spec":       map[string]any{"replicas": replicas},
+	}}
+}
+
+type dynConn struct {
+	client.Connection
+	dyn dynamic.Interface
+}
+
+func (dynConn) CanI(string, *client.GVR, string, []string) (bool, error) {
+	return true, nil
+}
+
+func (c dynConn) DynDial() (dynamic.Interface, error) {
+	return c.dyn, nil
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// tableFetchFunc fetches a resource table from the api server.
+type tableFetchFunc func() (*metav1.Table, error)
+
+// wkTable tracks a cached resource table and its backing informer.
+type wkTable struct {
+	informer cache.SharedIndexInformer
+	handler  cache.ResourceEventHandlerRegistration
+	table    *metav1.Table
+	sel      string
+	gen      uint64
+	fetched  uint64
+	mx       sync.Mutex
+}
+
+func (t *wkTable) markDirty() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.gen++
+}
+
+// release stops tracking the informer changes.
+func (t *wkTable) release() {
+	if t.handler == nil {
+		return
+	}
+	if err := t.informer.RemoveEventHandler(t.handler); err != nil {
+		slog.Warn("Unable to release workload resource watch", slogs.Error, err)
+	}
+}
+
+// workloadTables caches workload tables and only refetches a table
+// once its shared informer reports a change.
+type workloadTables struct {
+	entries map[string]*wkTable
+	mx      sync.Mutex
+}
+
+// newWorkloadTables returns a new table cache.
+func newWorkloadTables() *workloadTables {
+	return &workloadTables{
+		entries: make(map[string]*wkTable),
+	}
+}
+
+// clear drops all cached tables.
+func (w *workloadTables) clear() {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	for k, e := range w.entries {
+		e.release()
+		delete(w.entries, k)
+	}
+}
+
+// fetch returns a cached table if no changes occurred since the last fetch.
+// Resources that can't be watched are always fetched. The entry is not locked
+// while fetching so changes reported meanwhile keep the entry stale.
+func (w *workloadTables) fetch(f Factory, gvr *client.GVR, ns, sel string, fetch tableFetchFunc) (*metav1.Table, error) {
+	e, ok := w.entryFor(f, gvr, ns)
+	if !ok {
+		return fetch()
+	}
+
+	e.mx.Lock()
+	if e.table != nil && e.sel == sel && e.fetched == e.gen {
+		t := e.table
+		e.mx.Unlock()
+		return t, nil
+	}
+	gen := e.gen
+	e.mx.Unlock()
+
+	t, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	// Don't clobber a fresher table fetched concurrently.
+	if e.table == nil || gen >= e.fetched {
+		e.table, e.sel, e.fetched = t, sel, gen
+	}
+
+	return t, nil
+}
+
+func (w *workloadTables) entryFor(f Factory, gvr *client.GVR, ns string) (*wkTable, bool) {
+	if f == nil {
+		return nil, false
+	}
+	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+	if err != nil || inf == nil {
+		return nil, false
+	}
+
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	key := client.FQN(ns, gvr.String())
+	e, ok := w.entries[key]
+	if ok && e.informer == inf.Informer() {
+		return e, true
+	}
+	// Informers are recreated on context or namespace switch so stale entries must be replaced.
+	if ok {
+		e.release()
+		delete(w.entries, key)
+	}
+
+	e = &wkTable{informer: inf.Informer(), gen: 1}
+	e.handler, err = e.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { e.markDirty() },
+		UpdateFunc: func(any, any) { e.markDirty() },
+		DeleteFunc: func(any) { e.markDirty() },
+	})
+	if err != nil {
+		slog.Warn("Unable to watch workload resource",
+			slogs.GVR, gvr,
+			slogs.Error, err,
+		)
+		return nil, false
+	}
+	w.entries[key] = e
+
+	return e, true
+}
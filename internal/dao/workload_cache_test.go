@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type informerFactory struct {
+	Factory
+	inf informers.GenericInformer
+}
+
+func (f *informerFactory) CanForResource(string, *client.GVR, []string) (informers.GenericInformer, error) {
+	return f.inf, nil
+}
+
+func newInformerFactory(t *testing.T) *informerFactory {
+	inf, err := informers.NewSharedInformerFactory(fake.NewClientset(), 0).ForResource(client.PodGVR.GVR())
+	require.NoError(t, err)
+
+	return &informerFactory{inf: inf}
+}
+
+func TestWorkloadTablesFetch(t *testing.T) {
+	f, w := newInformerFactory(t), newWorkloadTables()
+	var calls int
+	fetch := func() (*metav1.Table, error) {
+		calls++
+		return &metav1.Table{}, nil
+	}
+
+	t1, err := w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	t2, err := w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	assert.Same(t, t1, t2)
+	assert.Equal(t, 1, calls)
+
+	_, err = w.fetch(f, client.PodGVR, "ns1", "app=fred", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	w.entries[client.FQN("ns1", client.PodGVR.String())].markDirty()
+	_, err = w.fetch(f, client.PodGVR, "ns1", "app=fred", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWorkloadTablesFetchUnlocked(t *testing.T) {
+	f, w := newInformerFactory(t), newWorkloadTables()
+	var calls int
+	var fetch tableFetchFunc
+	fetch = func() (*metav1.Table, error) {
+		calls++
+		if calls == 1 {
+			// Changes reported while fetching must not be lost.
+			w.entries[client.FQN("ns1", client.PodGVR.String())].markDirty()
+			if _, err := w.fetch(f, client.PodGVR, "ns1", "", fetch); err != nil {
+				return nil, err
+			}
+		}
+		return &metav1.Table{}, nil
+	}
+
+	_, err := w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	_, err = w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	w.entries[client.FQN("ns1", client.PodGVR.String())].markDirty()
+	_, err = w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWorkloadTablesClear(t *testing.T) {
+	f, w := newInformerFactory(t), newWorkloadTables()
+	fetch := func() (*metav1.Table, error) {
+		return &metav1.Table{}, nil
+	}
+
+	_, err := w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	require.Len(t, w.entries, 1)
+
+	w.clear()
+	assert.Empty(t, w.entries)
+
+	f2 := newInformerFactory(t)
+	_, err = w.fetch(f2, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	_, err = w.fetch(f, client.PodGVR, "ns1", "", fetch)
+	require.NoError(t, err)
+	assert.Len(t, w.entries, 1)
+	assert.Equal(t, f.inf.Informer(), w.entries[client.FQN("ns1", client.PodGVR.String())].informer)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// InvalidStatus flags a custom workload deemed invalid.
+const InvalidStatus = "INVALID"
+
+var (
+	celEnv     *cel.Env
+	celEnvErr  error
+	celEnvOnce sync.Once
+
+	// celPrograms caches compiled expressions.
+	celPrograms sync.Map
+)
+
+// celWorkload computes a custom workload health from CEL expressions.
+type celWorkload struct {
+	gvr                  *client.GVR
+	status, ready, valid cel.Program
+}
+
+func newCELWorkload(spec config.CustomWorkload) (*celWorkload, error) {
+	w := celWorkload{gvr: client.NewGVR(spec.Name)}
+	if spec.Status == "" {
+		return nil, fmt.Errorf("no status expression defined for %s", spec.Name)
+	}
+	var err error
+	if w.status, err = celProgram(spec.Status); err != nil {
+		return nil, err
+	}
+	if spec.Ready != "" {
+		if w.ready, err = celProgram(spec.Ready); err != nil {
+			return nil, err
+		}
+	}
+	if spec.Valid != "" {
+		if w.valid, err = celProgram(spec.Valid); err != nil {
+			return nil, err
+		}
+	}
+
+	return &w, nil
+}
+
+func celProgram(expr string) (cel.Program, error) {
+	if p, ok := celPrograms.Load(expr); ok {
+		return p.(cel.Program), nil
+	}
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(cel.Variable("object", cel.DynType))
+	})
+	if celEnvErr != nil {
+		return nil, celEnvErr
+	}
+	ast, iss := celEnv.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expr, iss.Err())
+	}
+	p, err := celEnv.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	celPrograms.Store(expr, p)
+
+	return p, nil
+}
+
+// rows computes workload rows for the given resources.
+func (w *celWorkload) rows(oo []runtime.Object, degradedOnly bool) []runtime.Object {
+	rr := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		stat, ready, valid, err := w.eval(u)
+		if degradedOnly && stat != DegradedStatus {
+			continue
+		}
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		rr = append(rr, objectRow(w.gvr, u, stat, ready, valid, msg))
+	}
+
+	return rr
+}
+
+func (w *celWorkload) eval(u *unstructured.Unstructured) (stat, ready, valid string, err error) {
+	vars := map[string]any{"object": u.Object}
+	v, err := evalProgram(w.status, vars)
+	if err != nil {
+		return render.MissingValue, "", "", err
+	}
+	switch v.Type() {
+	case types.BoolType:
+		stat = StatusOK
+		if !v.Value().(bool) {
+			stat = DegradedStatus
+		}
+	default:
+		stat = fmt.Sprintf("%v", v.Value())
+	}
+	if w.ready != nil {
+		v, err := evalProgram(w.ready, vars)
+		if err != nil {
+			return stat, "", "", err
+		}
+		ready = fmt.Sprintf("%v", v.Value())
+	}
+	if w.valid == nil {
+		return stat, ready, validity(stat), nil
+	}
+	v, err = evalProgram(w.valid, vars)
+	if err != nil {
+		return stat, ready, "", err
+	}
+	if b, ok := v.Value().(bool); ok {
+		if !b {
+			valid = InvalidStatus
+		}
+		return stat, ready, valid, nil
+	}
+
+	return stat, ready, fmt.Sprintf("%v", v.Value()), nil
+}
+
+func evalProgram(p cel.Program, vars map[string]any) (ref.Val, error) {
+	v, _, err := p.Eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
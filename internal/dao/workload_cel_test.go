@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCELWorkloadRows(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Blee",
+		"metadata":   map[string]any{"name": "b1", "namespace": "ns1", "uid": "b1-uid"},
+		"spec":       map[string]any{"replicas": int64(3)},
+		"status": map[string]any{
+			"phase":         "Healthy",
+			"readyReplicas": int64(2),
+		},
+	}}
+
+	uu := map[string]struct {
+		spec                      config.CustomWorkload
+		status, ready, valid, err string
+	}{
+		"bool": {
+			spec: config.CustomWorkload{
+				Name:   "fred.io/v1/blees",
+				Status: "object.status.readyReplicas == object.spec.replicas",
+				Ready:  "string(object.status.readyReplicas) + '/' + string(object.spec.replicas)",
+			},
+			status: DegradedStatus,
+			ready:  "2/3",
+			valid:  DegradedStatus,
+		},
+		"string": {
+			spec: config.CustomWorkload{
+				Name:   "fred.io/v1/blees",
+				Status: "object.status.phase",
+				Valid:  "has(object.spec.selector)",
+			},
+			status: "Healthy",
+			valid:  InvalidStatus,
+		},
+		"valid-msg": {
+			spec: config.CustomWorkload{
+				Name:   "fred.io/v1/blees",
+				Status: "true",
+				Valid:  "'needs love'",
+			},
+			status: StatusOK,
+			valid:  "needs love",
+		},
+		"eval-error": {
+			spec: config.CustomWorkload{
+				Name:   "fred.io/v1/blees",
+				Status: "object.status.blee",
+			},
+			status: render.MissingValue,
+			err:    "no such key: blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w, err := newCELWorkload(u.spec)
+			require.NoError(t, err)
+			oo := w.rows([]runtime.Object{&o}, false)
+			require.Len(t, oo, 1)
+			res := oo[0].(*render.WorkloadRes)
+			assert.Equal(t, []any{"fred.io/v1/blees", "ns1", "b1", u.status, u.ready, u.valid}, res.Row.Cells[:6])
+			assert.Equal(t, u.err, res.Row.Cells[7])
+			assert.Equal(t, "b1-uid", string(res.UID))
+		})
+	}
+}
+
+func TestCELWorkloadInvalid(t *testing.T) {
+	_, err := newCELWorkload(config.CustomWorkload{Name: "fred.io/v1/blees"})
+	require.Error(t, err)
+
+	_, err = newCELWorkload(config.CustomWorkload{Name: "fred.io/v1/blees", Status: "object.("})
+	require.Error(t, err)
+}
+
+func TestCELWorkloadDegradedOnly(t *testing.T) {
+	w, err := newCELWorkload(config.CustomWorkload{Name: "fred.io/v1/blees", Status: "object.spec.ok"})
+	require.NoError(t, err)
+
+	oo := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "b1"}, "spec": map[string]any{"ok": true}}},
+		&unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "b2"}, "spec": map[string]any{"ok": false}}},
+	}
+	rr := w.rows(oo, true)
+	require.Len(t, rr, 1)
+	assert.Equal(t, "b2", rr[0].(*render.WorkloadRes).Row.Cells[2])
+}
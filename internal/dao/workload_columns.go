@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workloadSchemas tracks the table columns required to compute a resource health.
+var workloadSchemas = map[*client.GVR][]string{
+	client.PodGVR: {"Name", "Ready", "Status"},
+	client.DpGVR:  {"Name", "Ready"},
+	client.StsGVR: {"Name", "Ready"},
+	client.RsGVR:  {"Name", "Ready", "Desired"},
+	client.DsGVR:  {"Name", "Ready", "Desired"},
+	client.JobGVR: {"Name"},
+	client.CjGVR:  {"Name"},
+	client.SvcGVR: {"Name"},
+}
+
+// schemaWarnings tracks resources whose schema mismatches were already reported.
+var schemaWarnings sync.Map
+
+// validateSchema checks a resource table carries the columns needed to compute its health.
+func validateSchema(gvr *client.GVR, defs []metav1.TableColumnDefinition) error {
+	var missing []string
+	for _, c := range workloadSchemas[gvr] {
+		if indexOf(c, defs) < 0 {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	err := fmt.Errorf("%s table is missing columns: %s", gvr, strings.Join(missing, ","))
+	if _, ok := schemaWarnings.LoadOrStore(gvr, struct{}{}); !ok {
+		slog.Warn("Unexpected workload table schema",
+			slogs.GVR, gvr,
+			slogs.Error, err,
+		)
+	}
+
+	return err
+}
+
+// tableRow provides safe access to table row cells by column name.
+type tableRow struct {
+	row  *metav1.TableRow
+	defs []metav1.TableColumnDefinition
+}
+
+func newTableRow(r *metav1.TableRow, defs []metav1.TableColumnDefinition) tableRow {
+	return tableRow{row: r, defs: defs}
+}
+
+// has checks if a column is present.
+func (r tableRow) has(n string) bool {
+	return indexOf(n, r.defs) >= 0
+}
+
+// cell returns a cell value or false if the column or value is missing.
+func (r tableRow) cell(n string) (any, bool) {
+	idx := indexOf(n, r.defs)
+	if idx < 0 || idx >= len(r.row.Cells) || r.row.Cells[idx] == nil {
+		return nil, false
+	}
+
+	return r.row.Cells[idx], true
+}
+
+// str returns a cell value as a string or blank if missing.
+func (r tableRow) str(n string) string {
+	c, ok := r.cell(n)
+	if !ok {
+		return ""
+	}
+	if s, ok := c.(string); ok {
+		return s
+	}
+
+	return fmt.Sprintf("%v", c)
+}
+
+// count returns a cell value as a count regardless of its wire type.
+func (r tableRow) count(n string) (int64, bool) {
+	c, ok := r.cell(n)
+	if !ok {
+		return 0, false
+	}
+	switch v := c.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return i, err == nil
+	}
+
+	return 0, false
+}
+
+// ratio returns a ready/desired ratio. The ready column may either hold
+// a ratio ie 1/2 or a plain count paired with a desired column.
+func (r tableRow) ratio(ready, desired string) string {
+	if s := r.str(ready); strings.Contains(s, "/") {
+		return s
+	}
+	rd, ok1 := r.count(ready)
+	if desired == "" {
+		if ok1 {
+			return strconv.FormatInt(rd, 10)
+		}
+		return r.str(ready)
+	}
+	de, ok2 := r.count(desired)
+	if !ok1 || !ok2 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d", rd, de)
+}
+
+func indexOf(n string, defs []metav1.TableColumnDefinition) int {
+	for i, d := range defs {
+		if d.Name == n {
+			return i
+		}
+	}
+
+	return -1
+}
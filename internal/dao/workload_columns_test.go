@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateSchema(t *testing.T) {
+	uu := map[string]struct {
+		gvr  *client.GVR
+		defs []metav1.TableColumnDefinition
+		err  string
+	}{
+		"valid": {
+			gvr:  client.DpGVR,
+			defs: []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+		},
+		"missing": {
+			gvr:  client.RsGVR,
+			defs: []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Current"}},
+			err:  "apps/v1/replicasets table is missing columns: Ready,Desired",
+		},
+		"unknown": {
+			gvr:  client.NewGVR("fred/v1/blees"),
+			defs: []metav1.TableColumnDefinition{{Name: "Name"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := validateSchema(u.gvr, u.defs)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestTableRowCount(t *testing.T) {
+	defs := []metav1.TableColumnDefinition{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}, {Name: "E"}}
+	r := newTableRow(&metav1.TableRow{Cells: []any{int64(1), float64(2), "3", "x", nil}}, defs)
+
+	uu := map[string]struct {
+		col string
+		e   int64
+		ok  bool
+	}{
+		"int":     {col: "A", e: 1, ok: true},
+		"float":   {col: "B", e: 2, ok: true},
+		"string":  {col: "C", e: 3, ok: true},
+		"invalid": {col: "D"},
+		"nil":     {col: "E"},
+		"missing": {col: "F"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := r.count(u.col)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestWorkloadVersionSkew(t *testing.T) {
+	uu := map[string]struct {
+		gvr           *client.GVR
+		defs          []metav1.TableColumnDefinition
+		cells         []any
+		status, ready string
+	}{
+		"rs-string-counts": {
+			gvr:    client.RsGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Desired"}, {Name: "Ready"}},
+			cells:  []any{"rs1", "3", "1"},
+			status: DegradedStatus,
+			ready:  "1/3",
+		},
+		"ds-float-counts": {
+			gvr:    client.DsGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Desired"}, {Name: "Ready"}},
+			cells:  []any{"ds1", float64(2), float64(2)},
+			status: StatusOK,
+			ready:  "2/2",
+		},
+		"dp-missing-ready": {
+			gvr:    client.DpGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Available"}},
+			cells:  []any{"dp1", int64(1)},
+			status: render.MissingValue,
+		},
+		"dp-short-row": {
+			gvr:    client.DpGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+			cells:  []any{"dp1"},
+			status: DegradedStatus,
+		},
+		"pod-missing-status": {
+			gvr:    client.PodGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+			cells:  []any{"p1", "1/1"},
+			status: render.MissingValue,
+			ready:  "1/1",
+		},
+		"sts-ready-count": {
+			gvr:    client.StsGVR,
+			defs:   []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+			cells:  []any{"sts1", int64(2)},
+			status: DegradedStatus,
+			ready:  "2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := metav1.TableRow{Cells: u.cells}
+			assert.NotPanics(t, func() {
+				assert.Equal(t, u.status, status(u.gvr, &r, u.defs))
+				assert.Equal(t, u.ready, readiness(u.gvr, &r, u.defs))
+			})
+		})
+	}
+}
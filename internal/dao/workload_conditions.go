@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+// conditionWorkloads tracks builtin workload resources whose rows need the full
+// object to surface failed conditions or a paused rollout. Other builtin resources
+// health is computed from their table cells only.
+var conditionWorkloads = sets.New(client.DpGVR, client.RsGVR)
+
+// includeObject checks if a workload table must carry full objects.
+// Custom resources health relies on their status conditions.
+func includeObject(gvr *client.GVR) bool {
+	return conditionWorkloads.Has(gvr) || !slices.Contains(resList, gvr)
+}
+
+// failingConditions tracks condition types indicating a failure when in the given status.
+var failingConditions = map[string]string{
+	"Available":      "False",
+	"Progressing":    "False",
+	"ReplicaFailure": conditionTrue,
+	readyCondition:   "False",
+}
+
+// failedConditions returns the conditions flagging a resource as degraded.
+func failedConditions(cc []objectCondition) []objectCondition {
+	ff := make([]objectCondition, 0, len(cc))
+	for _, c := range cc {
+		if s, ok := failingConditions[c.kind]; ok && s == c.status {
+			ff = append(ff, c)
+		}
+	}
+
+	return ff
+}
+
+// conditionsReason summarizes conditions reasons and messages.
+func conditionsReason(cc []objectCondition) string {
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		s := c.kind + "=" + c.status
+		if c.reason != "" {
+			s += " " + c.reason
+		}
+		if c.message != "" {
+			s += ": " + strings.Join(strings.Fields(c.message), " ")
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, "; ")
+}
+
+// Conditions returns a given workload status conditions as yaml.
+func (a *Workload) Conditions(ctx context.Context, gvr *client.GVR, fqn string) (string, error) {
+	ns, n := client.Namespaced(fqn)
+	auth, err := a.Client().CanI(ns, gvr, n, client.GetAccess)
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to view %s", fqn)
+	}
+
+	d, err := a.Client().DynDial()
+	if err != nil {
+		return "", err
+	}
+	o, err := d.Resource(gvr.GVR()).Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	cc, _, _ := unstructured.NestedSlice(o.Object, "status", "conditions")
+	if len(cc) == 0 {
+		return "", fmt.Errorf("no conditions found for %s", fqn)
+	}
+	raw, err := yaml.Marshal(map[string]any{"conditions": cc})
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
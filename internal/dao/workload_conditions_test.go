@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestWorkloadRowsConditions(t *testing.T) {
+	newDp := func(n string, cc ...any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": n, "namespace": "ns1"},
+			"status":   map[string]any{"conditions": cc},
+		}}
+	}
+	table := metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+		Rows: []metav1.TableRow{
+			{
+				Cells: []any{"dp1", "1/1"},
+				Object: runtime.RawExtension{Object: newDp("dp1",
+					map[string]any{"type": "Available", "status": "True"},
+					map[string]any{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"},
+				)},
+			},
+			{
+				Cells: []any{"dp2", "1/1"},
+				Object: runtime.RawExtension{Object: newDp("dp2",
+					map[string]any{"type": "Available", "status": "True"},
+					map[string]any{
+						"type":    "Progressing",
+						"status":  "False",
+						"reason":  "ProgressDeadlineExceeded",
+						"message": "ReplicaSet \"dp2-1\" has timed out\n progressing.",
+					},
+				)},
+			},
+			{
+				Cells: []any{"dp3", "1/1"},
+				Object: runtime.RawExtension{Object: newDp("dp3",
+					map[string]any{"type": "ReplicaFailure", "status": "True", "reason": "FailedCreate"},
+				)},
+			},
+		},
+	}
+
+	oo := workloadRows(client.DpGVR, config.WorkloadGVR{Name: client.DpGVR.String()}, &table, false)
+	require.Len(t, oo, 3)
+	ee := []struct{ stat, reason string }{
+		{stat: StatusOK},
+		{stat: DegradedStatus, reason: `Progressing=False ProgressDeadlineExceeded: ReplicaSet "dp2-1" has timed out progressing.`},
+		{stat: DegradedStatus, reason: "ReplicaFailure=True FailedCreate"},
+	}
+	for i, e := range ee {
+		res := oo[i].(*render.WorkloadRes)
+		assert.Equal(t, e.stat, res.Row.Cells[3])
+		assert.Equal(t, e.reason, res.Row.Cells[13])
+	}
+
+	oo = workloadRows(client.DpGVR, config.WorkloadGVR{Name: client.DpGVR.String()}, &table, true)
+	assert.Len(t, oo, 2)
+}
+
+func TestWorkloadRowsPaused(t *testing.T) {
+	newDp := func(n string, cc ...any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": n, "namespace": "ns1"},
+			"spec":     map[string]any{"paused": true},
+			"status":   map[string]any{"conditions": cc},
+		}}
+	}
+	table := metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name"}, {Name: "Ready"}},
+		Rows: []metav1.TableRow{
+			{
+				Cells:  []any{"dp1", "1/1"},
+				Object: runtime.RawExtension{Object: newDp("dp1")},
+			},
+			{
+				Cells:  []any{"dp2", "0/1"},
+				Object: runtime.RawExtension{Object: newDp("dp2")},
+			},
+			{
+				Cells: []any{"dp3", "1/1"},
+				Object: runtime.RawExtension{Object: newDp("dp3",
+					map[string]any{"type": "ReplicaFailure", "status": "True", "reason": "FailedCreate"},
+				)},
+			},
+		},
+	}
+
+	oo := workloadRows(client.DpGVR, config.WorkloadGVR{Name: client.DpGVR.String()}, &table, false)
+	require.Len(t, oo, 3)
+	ee := []struct{ stat, reason string }{
+		{stat: render.PausedStatus},
+		{stat: DegradedStatus, reason: "rollout paused"},
+		{stat: DegradedStatus, reason: "rollout paused, ReplicaFailure=True FailedCreate"},
+	}
+	for i, e := range ee {
+		res := oo[i].(*render.WorkloadRes)
+		assert.Equal(t, e.stat, res.Row.Cells[3])
+		assert.Equal(t, e.reason, res.Row.Cells[13])
+	}
+}
+
+func TestIncludeObject(t *testing.T) {
+	uu := map[*client.GVR]bool{
+		client.DpGVR:                   true,
+		client.RsGVR:                   true,
+		client.PodGVR:                  false,
+		client.StsGVR:                  false,
+		client.CjGVR:                   false,
+		client.NewGVR("fred.io/v1/bs"): true,
+	}
+	for gvr, e := range uu {
+		assert.Equal(t, e, includeObject(gvr), gvr.String())
+	}
+}
+
+func TestWorkloadConditions(t *testing.T) {
+	dp := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "dp1", "namespace": "ns1"},
+		"status": map[string]any{"conditions": []any{
+			map[string]any{"type": "Available", "status": "True"},
+		}},
+	}}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), nil, &dp)
+
+	var w Workload
+	w.Init(connFactory{conn: dynConn{dyn: dyn}}, client.WkGVR)
+	cc, err := w.Conditions(context.Background(), client.DpGVR, "ns1/dp1")
+	require.NoError(t, err)
+	assert.Equal(t, "conditions:\n- status: \"True\"\n  type: Available\n", cc)
+
+	_, err = w.Conditions(context.Background(), client.DpGVR, "ns1/dp2")
+	require.Error(t, err)
+
+	w.Init(connFactory{conn: denyConn{}}, client.WkGVR)
+	_, err = w.Conditions(context.Background(), client.DpGVR, "ns1/dp1")
+	assert.EqualError(t, err, "user is not authorized to view ns1/dp1")
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// dependentKinds tracks the resources owned by a given workload kind.
+var dependentKinds = map[*client.GVR][]*client.GVR{
+	client.DpGVR:  {client.RsGVR},
+	client.RsGVR:  {client.PodGVR},
+	client.StsGVR: {client.PodGVR},
+	client.DsGVR:  {client.PodGVR},
+	client.JobGVR: {client.PodGVR},
+	client.CjGVR:  {client.JobGVR},
+	RolloutGVR:    {client.RsGVR},
+}
+
+// WorkloadDependent represents a resource affected by a workload deletion.
+type WorkloadDependent struct {
+	GVR    *client.GVR
+	FQN    string
+	Detail string
+}
+
+// Dependents returns the resources affected by deleting a given workload.
+func (a *Workload) Dependents(gvr *client.GVR, fqn string) ([]WorkloadDependent, error) {
+	f := a.getFactory()
+	if gvr == client.SvcGVR {
+		return serviceDependents(f, fqn)
+	}
+
+	o, err := f.Get(gvr, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var dd []WorkloadDependent
+	ns, _ := client.Namespaced(fqn)
+	owners := map[types.UID]*client.GVR{m.GetUID(): gvr}
+	for len(owners) > 0 {
+		next := make(map[types.UID]*client.GVR)
+		for _, g := range dependentGVRs(owners) {
+			oo, err := f.List(g, ns, true, labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range oo {
+				m, err := meta.Accessor(o)
+				if err != nil || !ownedBy(m.GetOwnerReferences(), owners) {
+					continue
+				}
+				dd = append(dd, WorkloadDependent{GVR: g, FQN: client.FQN(m.GetNamespace(), m.GetName())})
+				next[m.GetUID()] = g
+			}
+		}
+		owners = next
+	}
+
+	return dd, nil
+}
+
+// DeleteImpact describes the effect of a deletion propagation policy on workload dependents.
+func DeleteImpact(gvr *client.GVR, dd []WorkloadDependent, propagation string) string {
+	if len(dd) == 0 {
+		return "No dependents found."
+	}
+	var b strings.Builder
+	b.WriteString("Dependents:\n")
+	for _, d := range dd {
+		fmt.Fprintf(&b, "  %s %s", d.GVR, d.FQN)
+		if d.Detail != "" {
+			fmt.Fprintf(&b, " (%s)", d.Detail)
+		}
+		b.WriteString("\n")
+	}
+	if gvr == client.SvcGVR {
+		b.WriteString("Endpoints are removed along with the service.")
+		return b.String()
+	}
+	switch metav1.DeletionPropagation(propagation) {
+	case metav1.DeletePropagationOrphan:
+		fmt.Fprintf(&b, "%d dependent(s) will be orphaned and keep running.", len(dd))
+	case metav1.DeletePropagationForeground:
+		fmt.Fprintf(&b, "%d dependent(s) will be deleted before the %s is removed.", len(dd), gvr.R())
+	default:
+		fmt.Fprintf(&b, "%d dependent(s) will be garbage collected in the background.", len(dd))
+	}
+
+	return b.String()
+}
+
+func serviceDependents(f Factory, fqn string) ([]WorkloadDependent, error) {
+	o, err := f.Get(client.EpGVR, fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil, nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expected unstructured but got %T", o)
+	}
+	var count int
+	ss, _, _ := unstructured.NestedSlice(u.Object, "subsets")
+	for _, s := range ss {
+		if m, ok := s.(map[string]any); ok {
+			aa, _, _ := unstructured.NestedSlice(m, "addresses")
+			count += len(aa)
+		}
+	}
+
+	return []WorkloadDependent{{GVR: client.EpGVR, FQN: fqn, Detail: fmt.Sprintf("%d address(es)", count)}}, nil
+}
+
+func dependentGVRs(owners map[types.UID]*client.GVR) []*client.GVR {
+	gg := make([]*client.GVR, 0, len(owners))
+	for _, gvr := range owners {
+		for _, g := range dependentKinds[gvr] {
+			if !slices.Contains(gg, g) {
+				gg = append(gg, g)
+			}
+		}
+	}
+
+	return gg
+}
+
+func ownedBy(rr []metav1.OwnerReference, owners map[types.UID]*client.GVR) bool {
+	for _, r := range rr {
+		if _, ok := owners[r.UID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWorkloadDependents(t *testing.T) {
+	newObj := func(n, uid, owner string) *unstructured.Unstructured {
+		m := map[string]any{"name": n, "namespace": "ns1", "uid": uid}
+		if owner != "" {
+			m["ownerReferences"] = []any{map[string]any{"uid": owner, "name": "x", "kind": "x", "apiVersion": "v1"}}
+		}
+		return &unstructured.Unstructured{Object: map[string]any{"metadata": m}}
+	}
+	f := testFactory{inventory: map[string]map[*client.GVR][]runtime.Object{
+		"ns1": {
+			client.DpGVR: {newObj("fred", "dp", "")},
+			client.RsGVR: {newObj("fred-1", "rs1", "dp"), newObj("blee-1", "rs2", "dp2")},
+			client.PodGVR: {
+				newObj("fred-1-a", "po1", "rs1"),
+				newObj("fred-1-b", "po2", "rs1"),
+				newObj("blee-1-a", "po3", "rs2"),
+			},
+			client.SvcGVR: {newObj("fred", "svc", "")},
+			client.EpGVR: {&unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "fred", "namespace": "ns1"},
+				"subsets": []any{
+					map[string]any{"addresses": []any{map[string]any{"ip": "10.0.0.1"}, map[string]any{"ip": "10.0.0.2"}}},
+				},
+			}}},
+		},
+	}}
+
+	var w dao.Workload
+	w.Init(&f, client.WkGVR)
+
+	dd, err := w.Dependents(client.DpGVR, "ns1/fred")
+	require.NoError(t, err)
+	assert.Equal(t, []dao.WorkloadDependent{
+		{GVR: client.RsGVR, FQN: "ns1/fred-1"},
+		{GVR: client.PodGVR, FQN: "ns1/fred-1-a"},
+		{GVR: client.PodGVR, FQN: "ns1/fred-1-b"},
+	}, dd)
+	assert.Equal(t, "Dependents:\n  apps/v1/replicasets ns1/fred-1\n  v1/pods ns1/fred-1-a\n  v1/pods ns1/fred-1-b\n3 dependent(s) will be orphaned and keep running.", dao.DeleteImpact(client.DpGVR, dd, "Orphan"))
+	assert.Contains(t, dao.DeleteImpact(client.DpGVR, dd, "Foreground"), "3 dependent(s) will be deleted before the deployments is removed.")
+	assert.Contains(t, dao.DeleteImpact(client.DpGVR, dd, ""), "3 dependent(s) will be garbage collected in the background.")
+
+	dd, err = w.Dependents(client.SvcGVR, "ns1/fred")
+	require.NoError(t, err)
+	assert.Equal(t, []dao.WorkloadDependent{{GVR: client.EpGVR, FQN: "ns1/fred", Detail: "2 address(es)"}}, dd)
+	assert.Equal(t, "Dependents:\n  v1/endpoints ns1/fred (2 address(es))\nEndpoints are removed along with the service.", dao.DeleteImpact(client.SvcGVR, dd, "Orphan"))
+
+	assert.Equal(t, "No dependents found.", dao.DeleteImpact(client.PodGVR, nil, ""))
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ExportFormat represents a workload snapshot file format.
+type ExportFormat string
+
+const (
+	// ExportJSON dumps a snapshot as json.
+	ExportJSON ExportFormat = "json"
+
+	// ExportCSV dumps a snapshot as csv.
+	ExportCSV ExportFormat = "csv"
+)
+
+// WorkloadRecord represents a workload snapshot entry.
+type WorkloadRecord struct {
+	Kind        string     `json:"kind"`
+	Namespace   string     `json:"namespace,omitempty"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Ready       string     `json:"ready,omitempty"`
+	Valid       string     `json:"valid,omitempty"`
+	Restarts    string     `json:"restarts,omitempty"`
+	LastRestart *time.Time `json:"lastRestart,omitempty"`
+	HPA         string     `json:"hpa,omitempty"`
+	Owner       string     `json:"owner,omitempty"`
+	Created     *time.Time `json:"created,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+}
+
+var workloadRecordHeader = []string{
+	"KIND",
+	"NAMESPACE",
+	"NAME",
+	"STATUS",
+	"READY",
+	"VALID",
+	"RESTARTS",
+	"LAST RESTART",
+	"HPA",
+	"OWNER",
+	"CREATED",
+	"ERROR",
+	"REASON",
+}
+
+func (r WorkloadRecord) fields() []string {
+	return []string{
+		r.Kind,
+		r.Namespace,
+		r.Name,
+		r.Status,
+		r.Ready,
+		r.Valid,
+		r.Restarts,
+		formatTime(r.LastRestart),
+		r.HPA,
+		r.Owner,
+		formatTime(r.Created),
+		r.Error,
+		r.Reason,
+	}
+}
+
+// WorkloadRecords converts workload rows to snapshot records.
+func WorkloadRecords(oo []runtime.Object) ([]WorkloadRecord, error) {
+	rr := make([]WorkloadRecord, 0, len(oo))
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			return nil, fmt.Errorf("expected WorkloadRes but got %T", o)
+		}
+		cc := wk.Row.Cells
+		if len(cc) < render.WKCellCount {
+			return nil, fmt.Errorf("invalid workload row for %v", cc)
+		}
+		r := WorkloadRecord{
+			Kind:        fmt.Sprintf("%v", cc[render.WKKindCell]),
+			Namespace:   fmt.Sprintf("%v", cc[render.WKNamespaceCell]),
+			Name:        fmt.Sprintf("%v", cc[render.WKNameCell]),
+			Status:      fmt.Sprintf("%v", cc[render.WKStatusCell]),
+			Ready:       fmt.Sprintf("%v", cc[render.WKReadyCell]),
+			Valid:       fmt.Sprintf("%v", cc[render.WKValidCell]),
+			Error:       fmt.Sprintf("%v", cc[render.WKErrorCell]),
+			Restarts:    fmt.Sprintf("%v", cc[render.WKRestartsCell]),
+			Created:     timeOf(cc[render.WKCreatedCell]),
+			LastRestart: timeOf(cc[render.WKLastRestartCell]),
+			HPA:         fmt.Sprintf("%v", cc[render.WKHPACell]),
+			Owner:       fmt.Sprintf("%v", cc[render.WKOwnerCell]),
+			Reason:      fmt.Sprintf("%v", cc[render.WKReasonCell]),
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+// ExportWorkloads dumps a workload snapshot to the given directory.
+func ExportWorkloads(dir, ns string, format ExportFormat, oo []runtime.Object) (string, error) {
+	if format != ExportJSON && format != ExportCSV {
+		return "", fmt.Errorf("unsupported export format: %q", format)
+	}
+	rr, err := WorkloadRecords(oo)
+	if err != nil {
+		return "", err
+	}
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		return "", err
+	}
+	if client.IsClusterWide(ns) {
+		ns = client.NamespaceAll
+	}
+	fPath := filepath.Join(dir, strings.ToLower(fmt.Sprintf("workloads-%s-%d.%s", data.SanitizeFileName(ns), time.Now().UnixNano(), format)))
+	slog.Debug("Saving workload snapshot to disk", slogs.FileName, fPath)
+
+	out, err := os.OpenFile(fPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Error("Closing file failed",
+				slogs.Path, fPath,
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	switch format {
+	case ExportJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(rr)
+	case ExportCSV:
+		w := csv.NewWriter(out)
+		_ = w.Write(workloadRecordHeader)
+		for _, r := range rr {
+			_ = w.Write(r.fields())
+		}
+		w.Flush()
+		err = w.Error()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return fPath, nil
+}
+
+// Helpers...
+
+func timeOf(a any) *time.Time {
+	t, ok := a.(metav1.Time)
+	if !ok || t.IsZero() {
+		return nil
+	}
+	tt := t.UTC()
+
+	return &tt
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExportWorkloads(t *testing.T) {
+	created := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	cc := make([]any, render.WKCellCount)
+	cc[render.WKKindCell], cc[render.WKNamespaceCell], cc[render.WKNameCell] = "apps/v1/deployments", "ns1", "dp1"
+	cc[render.WKStatusCell], cc[render.WKReadyCell], cc[render.WKValidCell] = DegradedStatus, "0/1", DegradedStatus
+	cc[render.WKCreatedCell], cc[render.WKErrorCell], cc[render.WKRestartsCell] = created, "", "2"
+	cc[render.WKLastRestartCell], cc[render.WKHPACell], cc[render.WKTrendCell] = metav1.Time{}, "cpu:10%/80% (1-3)", []float64(nil)
+	cc[render.WKOwnerCell], cc[render.WKReasonCell] = "Rollout/fred", ""
+	oo := []runtime.Object{
+		&render.WorkloadRes{Row: metav1.TableRow{Cells: cc}},
+		errorRow(client.JobGVR, "ns1", os.ErrPermission),
+	}
+
+	uu := map[string]struct {
+		format ExportFormat
+		err    string
+	}{
+		"json": {format: ExportJSON},
+		"csv":  {format: ExportCSV},
+		"unsupported": {
+			format: "blee",
+			err:    `unsupported export format: "blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir := t.TempDir()
+			path, err := ExportWorkloads(dir, client.BlankNamespace, u.format, oo)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dir, filepath.Dir(path))
+			assert.True(t, strings.HasPrefix(filepath.Base(path), "workloads-all-"))
+
+			bb, err := os.ReadFile(path)
+			require.NoError(t, err)
+			switch u.format {
+			case ExportJSON:
+				var rr []WorkloadRecord
+				require.NoError(t, json.Unmarshal(bb, &rr))
+				assert.Len(t, rr, 2)
+				assert.Equal(t, "dp1", rr[0].Name)
+				assert.Equal(t, created.Time, *rr[0].Created)
+				assert.Nil(t, rr[0].LastRestart)
+				assert.Equal(t, os.ErrPermission.Error(), rr[1].Error)
+			case ExportCSV:
+				rr, err := csv.NewReader(strings.NewReader(string(bb))).ReadAll()
+				require.NoError(t, err)
+				assert.Len(t, rr, 3)
+				assert.Equal(t, workloadRecordHeader, rr[0])
+				assert.Equal(t, []string{"apps/v1/deployments", "ns1", "dp1", DegradedStatus, "0/1", DegradedStatus, "2", "", "cpu:10%/80% (1-3)", "Rollout/fred", "2024-01-02T03:04:05Z", "", ""}, rr[1])
+			}
+		})
+	}
+}
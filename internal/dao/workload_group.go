@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// AppGroupKind represents the kind of application group rows.
+const AppGroupKind = "app"
+
+const (
+	expandedGroup  = "▾ "
+	collapsedGroup = "▸ "
+	groupBranch    = "├─ "
+	groupLeaf      = "└─ "
+)
+
+// groupRanks orders resources within an application group.
+var groupRanks = []*client.GVR{
+	client.DpGVR,
+	client.StsGVR,
+	client.DsGVR,
+	client.CjGVR,
+	client.JobGVR,
+	client.RsGVR,
+	client.PodGVR,
+	client.SvcGVR,
+}
+
+// appGroup tracks workloads sharing the same application label.
+type appGroup struct {
+	ns, name string
+	rows     []*render.WorkloadRes
+}
+
+// IsAppGroup checks if a workload path refers to an application group row.
+func IsAppGroup(path string) bool {
+	gvr, _, err := ParseWorkloadPath(path)
+
+	return err == nil && gvr.String() == AppGroupKind
+}
+
+// groupWorkloads arranges workloads as application trees based on the given label.
+// Children of collapsed groups, keyed by their fully qualified name, are omitted.
+func groupWorkloads(oo []runtime.Object, label string, collapsed sets.Set[string]) []runtime.Object {
+	groups := make(map[string]*appGroup)
+	ungrouped := make([]*render.WorkloadRes, 0, len(oo))
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			continue
+		}
+		app := wk.Labels[label]
+		if app == "" {
+			ungrouped = append(ungrouped, wk)
+			continue
+		}
+		ns := wk.Str(render.WKNamespaceCell)
+		key := client.FQN(ns, app)
+		g, ok := groups[key]
+		if !ok {
+			g = &appGroup{ns: ns, name: app}
+			groups[key] = g
+		}
+		g.rows = append(g.rows, wk)
+	}
+
+	rr := make([]runtime.Object, 0, len(oo)+len(groups))
+	var seq int
+	add := func(wk *render.WorkloadRes, prefix string) {
+		seq++
+		wk.Group, wk.Prefix = fmt.Sprintf("%06d", seq), prefix
+		rr = append(rr, wk)
+	}
+	for _, key := range slices.Sorted(maps.Keys(groups)) {
+		g := groups[key]
+		slices.SortStableFunc(g.rows, compareWorkloads)
+		if collapsed.Has(key) {
+			add(g.header(), collapsedGroup)
+			continue
+		}
+		add(g.header(), expandedGroup)
+		for i, wk := range g.rows {
+			prefix := groupBranch
+			if i == len(g.rows)-1 {
+				prefix = groupLeaf
+			}
+			add(wk, prefix)
+		}
+	}
+	slices.SortStableFunc(ungrouped, compareWorkloads)
+	for _, wk := range ungrouped {
+		add(wk, "")
+	}
+
+	return rr
+}
+
+// header returns a row summarizing the group health.
+func (g *appGroup) header() *render.WorkloadRes {
+	var (
+		ok      int
+		stat    = StatusOK
+		created metav1.Time
+	)
+	for _, wk := range g.rows {
+		switch wk.Str(render.WKStatusCell) {
+		case StatusOK:
+			ok++
+		case DegradedStatus:
+			stat = DegradedStatus
+		}
+		if t := wk.Time(render.WKCreatedCell); !t.IsZero() && (created.IsZero() || t.Before(&created)) {
+			created = t
+		}
+	}
+
+	return newWorkloadRes(AppGroupKind, g.ns, g.name, stat, fmt.Sprintf("%d/%d", ok, len(g.rows)), validity(stat), created, "")
+}
+
+func compareWorkloads(a, b *render.WorkloadRes) int {
+	ka := a.Str(render.WKKindCell)
+	kb := b.Str(render.WKKindCell)
+	if c := cmp.Compare(groupRank(ka), groupRank(kb)); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(ka, kb); c != 0 {
+		return c
+	}
+	na := a.Str(render.WKNameCell)
+	nb := b.Str(render.WKNameCell)
+
+	return cmp.Compare(na, nb)
+}
+
+func groupRank(kind string) int {
+	if idx := slices.Index(groupRanks, client.NewGVR(kind)); idx >= 0 {
+		return idx
+	}
+
+	return len(groupRanks)
+}
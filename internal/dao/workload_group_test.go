@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestGroupWorkloads(t *testing.T) {
+	const label = "app.kubernetes.io/name"
+	newRow := func(kind, n, stat, app string) *render.WorkloadRes {
+		wk := newWorkloadRes(kind, "ns1", n, stat, "", "", metav1.Time{}, "")
+		if app != "" {
+			wk.Labels = map[string]string{label: app}
+		}
+		return wk
+	}
+	oo := func() []runtime.Object {
+		return []runtime.Object{
+			newRow("v1/pods", "fred-1", DegradedStatus, "fred"),
+			newRow("v1/services", "fred", StatusOK, "fred"),
+			newRow("v1/pods", "blee", StatusOK, ""),
+			newRow("apps/v1/deployments", "fred", StatusOK, "fred"),
+			newRow("apps/v1/replicasets", "fred-1", StatusOK, "fred"),
+		}
+	}
+
+	uu := map[string]struct {
+		collapsed sets.Set[string]
+		e         [][3]string
+	}{
+		"expanded": {
+			collapsed: sets.New[string](),
+			e: [][3]string{
+				{expandedGroup, AppGroupKind, "fred"},
+				{groupBranch, "apps/v1/deployments", "fred"},
+				{groupBranch, "apps/v1/replicasets", "fred-1"},
+				{groupBranch, "v1/pods", "fred-1"},
+				{groupLeaf, "v1/services", "fred"},
+				{"", "v1/pods", "blee"},
+			},
+		},
+		"collapsed": {
+			collapsed: sets.New("ns1/fred"),
+			e: [][3]string{
+				{collapsedGroup, AppGroupKind, "fred"},
+				{"", "v1/pods", "blee"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := groupWorkloads(oo(), label, u.collapsed)
+			assert.Len(t, rr, len(u.e))
+			var last string
+			for i, e := range u.e {
+				wk := rr[i].(*render.WorkloadRes)
+				assert.Equal(t, e, [3]string{wk.Prefix, wk.Row.Cells[0].(string), wk.Row.Cells[2].(string)})
+				assert.Greater(t, wk.Group, last)
+				last = wk.Group
+			}
+			head := rr[0].(*render.WorkloadRes)
+			assert.Equal(t, DegradedStatus, head.Row.Cells[3])
+			assert.Equal(t, "3/4", head.Row.Cells[4])
+		})
+	}
+}
+
+func TestIsAppGroup(t *testing.T) {
+	assert.True(t, IsAppGroup("app|ns1|fred"))
+	assert.False(t, IsAppGroup("apps/v1/deployments|ns1|fred"))
+	assert.False(t, IsAppGroup("app"))
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// templatePaths tracks where workloads keep their pod template.
+var templatePaths = map[*client.GVR][]string{
+	client.DpGVR:  {"spec", "template"},
+	client.RsGVR:  {"spec", "template"},
+	client.StsGVR: {"spec", "template"},
+	client.JobGVR: {"spec", "template"},
+	client.CjGVR:  {"spec", "jobTemplate", "spec", "template"},
+	RolloutGVR:    {"spec", "template"},
+}
+
+// Headroom tracks how many more replicas of a workload fit in the cluster.
+type Headroom struct {
+	Workload string         `json:"workload"`
+	CPU      string         `json:"cpuRequest"`
+	MEM      string         `json:"memRequest"`
+	Fit      int            `json:"additionalReplicas"`
+	Nodes    []NodeHeadroom `json:"nodes"`
+}
+
+// NodeHeadroom tracks a node free resources and how many replicas fit on it.
+type NodeHeadroom struct {
+	Name     string `json:"name"`
+	FreeCPU  string `json:"freeCPU"`
+	FreeMEM  string `json:"freeMEM"`
+	FreePods int64  `json:"freePods"`
+	Fit      int    `json:"fit"`
+	Reason   string `json:"limitedBy,omitempty"`
+}
+
+// Headroom estimates how many more replicas of a given workload fit in the cluster by
+// simulating scheduling its pod template against nodes allocatable and existing requests.
+func (a *Workload) Headroom(ctx context.Context, gvr *client.GVR, fqn string) (string, error) {
+	path, ok := templatePaths[gvr]
+	if !ok {
+		return "", fmt.Errorf("headroom is not available for %s", gvr)
+	}
+	ns, n := client.Namespaced(fqn)
+	d, err := a.Client().DynDial()
+	if err != nil {
+		return "", err
+	}
+	o, err := d.Resource(gvr.GVR()).Namespace(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	tpl, ok, err := unstructured.NestedMap(o.Object, path...)
+	if err != nil || !ok {
+		return "", fmt.Errorf("no pod template found for %s", fqn)
+	}
+	var pt v1.PodTemplateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(tpl, &pt); err != nil {
+		return "", err
+	}
+
+	f := a.getFactory()
+	nn, err := f.List(client.NodeGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	pp, err := f.List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	nodes, pods := make([]*v1.Node, 0, len(nn)), make([]*v1.Pod, 0, len(pp))
+	for _, o := range nn {
+		var no v1.Node
+		if u, ok := o.(*unstructured.Unstructured); ok && runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no) == nil {
+			nodes = append(nodes, &no)
+		}
+	}
+	for _, o := range pp {
+		var po v1.Pod
+		if u, ok := o.(*unstructured.Unstructured); ok && runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po) == nil {
+			pods = append(pods, &po)
+		}
+	}
+
+	h := computeHeadroom(&v1.Pod{ObjectMeta: pt.ObjectMeta, Spec: pt.Spec}, nodes, pods)
+	h.Workload = gvr.R() + "/" + fqn
+	raw, err := yaml.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// Helpers...
+
+func computeHeadroom(po *v1.Pod, nodes []*v1.Node, pods []*v1.Pod) Headroom {
+	us := render.NewPodUsage(&po.Spec, nil)
+	h := Headroom{
+		CPU: fmt.Sprintf("%dm", us.ReqCPU),
+		MEM: fmt.Sprintf("%dMi", client.ToMB(us.ReqMem)),
+	}
+
+	used := make(map[string]*render.WorkloadUsage, len(nodes))
+	count := make(map[string]int64, len(nodes))
+	for _, p := range pods {
+		if p.Spec.NodeName == "" || p.Status.Phase == v1.PodSucceeded || p.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if _, ok := used[p.Spec.NodeName]; !ok {
+			used[p.Spec.NodeName] = new(render.WorkloadUsage)
+		}
+		used[p.Spec.NodeName].Add(render.NewPodUsage(&p.Spec, nil))
+		count[p.Spec.NodeName]++
+	}
+
+	affinity := nodeaffinity.GetRequiredNodeAffinity(po)
+	for _, no := range nodes {
+		u := used[no.Name]
+		if u == nil {
+			u = new(render.WorkloadUsage)
+		}
+		nh := NodeHeadroom{
+			Name:     no.Name,
+			FreeCPU:  fmt.Sprintf("%dm", no.Status.Allocatable.Cpu().MilliValue()-u.ReqCPU),
+			FreeMEM:  fmt.Sprintf("%dMi", client.ToMB(no.Status.Allocatable.Memory().Value()-u.ReqMem)),
+			FreePods: max(no.Status.Allocatable.Pods().Value()-count[no.Name], 0),
+		}
+		if reason := unschedulable(po, no, affinity); reason != "" {
+			nh.Reason = reason
+			h.Nodes = append(h.Nodes, nh)
+			continue
+		}
+		nh.Fit, nh.Reason = fits(
+			[]int64{us.ReqCPU, us.ReqMem, 1},
+			[]int64{no.Status.Allocatable.Cpu().MilliValue() - u.ReqCPU, no.Status.Allocatable.Memory().Value() - u.ReqMem, nh.FreePods},
+			[]string{"cpu", "memory", "pods"},
+		)
+		h.Fit += nh.Fit
+		h.Nodes = append(h.Nodes, nh)
+	}
+	slices.SortStableFunc(h.Nodes, func(a, b NodeHeadroom) int {
+		return b.Fit - a.Fit
+	})
+
+	return h
+}
+
+// fits returns how many requests fit in the free resources along with the limiting resource.
+func fits(reqs, free []int64, names []string) (int, string) {
+	fit, reason := math.MaxInt, ""
+	for i, r := range reqs {
+		if r <= 0 {
+			continue
+		}
+		n := int(max(free[i], 0) / r)
+		if n < fit {
+			fit, reason = n, names[i]
+		}
+	}
+
+	return fit, reason
+}
+
+// unschedulable returns why a pod cannot be scheduled on a given node if any.
+func unschedulable(po *v1.Pod, no *v1.Node, affinity nodeaffinity.RequiredNodeAffinity) string {
+	if no.Spec.Unschedulable {
+		return "cordoned"
+	}
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady && c.Status != v1.ConditionTrue {
+			return "not ready"
+		}
+	}
+	if ok, _ := affinity.Match(no); !ok {
+		return "node affinity"
+	}
+	for i := range no.Spec.Taints {
+		t := &no.Spec.Taints[i]
+		if t.Effect != v1.TaintEffectNoSchedule && t.Effect != v1.TaintEffectNoExecute {
+			continue
+		}
+		if !slices.ContainsFunc(po.Spec.Tolerations, func(tl v1.Toleration) bool {
+			return tl.ToleratesTaint(klog.Background(), t, false)
+		}) {
+			return "taint " + t.ToString()
+		}
+	}
+
+	return ""
+}
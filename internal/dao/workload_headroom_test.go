@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeHeadroom(t *testing.T) {
+	newNode := func(n, cpu, mem, pods string, taints ...v1.Taint) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Labels: map[string]string{"zone": n}},
+			Spec:       v1.NodeSpec{Taints: taints},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse(cpu),
+					v1.ResourceMemory: resource.MustParse(mem),
+					v1.ResourcePods:   resource.MustParse(pods),
+				},
+				Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			},
+		}
+	}
+	newSpec := func(cpu, mem string) v1.PodSpec {
+		return v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "c1",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpu),
+						v1.ResourceMemory: resource.MustParse(mem),
+					},
+				},
+			}},
+		}
+	}
+
+	cordoned := newNode("n4", "4", "8Gi", "110")
+	cordoned.Spec.Unschedulable = true
+	nodes := []*v1.Node{
+		newNode("n1", "2", "8Gi", "110"),
+		newNode("n2", "4", "1Gi", "110"),
+		newNode("n3", "4", "8Gi", "110", v1.Taint{Key: "gpu", Effect: v1.TaintEffectNoSchedule}),
+		cordoned,
+		newNode("n5", "4", "8Gi", "2"),
+	}
+	used := &v1.Pod{Spec: newSpec("1", "1Gi"), Status: v1.PodStatus{Phase: v1.PodRunning}}
+	used.Spec.NodeName = "n1"
+	done := &v1.Pod{Spec: newSpec("1", "1Gi"), Status: v1.PodStatus{Phase: v1.PodSucceeded}}
+	done.Spec.NodeName = "n1"
+
+	po := &v1.Pod{Spec: newSpec("500m", "512Mi")}
+	h := computeHeadroom(po, nodes, []*v1.Pod{used, done})
+
+	assert.Equal(t, "500m", h.CPU)
+	assert.Equal(t, "512Mi", h.MEM)
+	assert.Equal(t, 2+2+2, h.Fit)
+	require.Len(t, h.Nodes, 5)
+	assert.Equal(t, NodeHeadroom{Name: "n1", FreeCPU: "1000m", FreeMEM: "7168Mi", FreePods: 109, Fit: 2, Reason: "cpu"}, byNameOf(h, "n1"))
+	assert.Equal(t, 2, byNameOf(h, "n2").Fit)
+	assert.Equal(t, "memory", byNameOf(h, "n2").Reason)
+	assert.Equal(t, "taint gpu:NoSchedule", byNameOf(h, "n3").Reason)
+	assert.Equal(t, "cordoned", byNameOf(h, "n4").Reason)
+	assert.Equal(t, "pods", byNameOf(h, "n5").Reason)
+
+	po.Spec.NodeSelector = map[string]string{"zone": "n5"}
+	h = computeHeadroom(po, nodes, nil)
+	assert.Equal(t, 2, h.Fit)
+	assert.Equal(t, "node affinity", byNameOf(h, "n1").Reason)
+
+	po.Spec.NodeSelector = nil
+	po.Spec.Tolerations = []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists}}
+	h = computeHeadroom(po, nodes, nil)
+	assert.Equal(t, 8, byNameOf(h, "n3").Fit)
+}
+
+func byNameOf(h Headroom, n string) NodeHeadroom {
+	for _, nh := range h.Nodes {
+		if nh.Name == n {
+			return nh
+		}
+	}
+
+	return NodeHeadroom{}
+}
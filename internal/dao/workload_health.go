@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkloadHealth rolls up workloads health per namespace.
+type WorkloadHealth struct {
+	Workload
+}
+
+// List returns workloads health grouped by namespace.
+func (h *WorkloadHealth) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	oo, err := h.Workload.List(ctx, client.BlankNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	hh := make(map[string]*render.WorkloadHealthRes)
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			return nil, fmt.Errorf("expected WorkloadRes but got %T", o)
+		}
+		ns := wk.Str(render.WKNamespaceCell)
+		if ns == "" {
+			continue
+		}
+		res, ok := hh[ns]
+		if !ok {
+			res = &render.WorkloadHealthRes{Namespace: ns}
+			hh[ns] = res
+		}
+		res.Total++
+		if stat := wk.Str(render.WKStatusCell); stat == DegradedStatus {
+			res.Degraded++
+		} else {
+			res.OK++
+		}
+		ready := wk.Str(render.WKReadyCell)
+		if r, d, ok := readyCounts(ready); ok {
+			res.Ready += r
+			res.Desired += d
+		}
+	}
+
+	ll := make([]runtime.Object, 0, len(hh))
+	for _, ns := range slices.Sorted(maps.Keys(hh)) {
+		ll = append(ll, hh[ns])
+	}
+
+	return ll, nil
+}
+
+// Helpers...
+
+func readyCounts(s string) (ready, desired int, ok bool) {
+	tt := strings.Split(s, "/")
+	if len(tt) != 2 {
+		return 0, 0, false
+	}
+	r, err := strconv.Atoi(tt[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	d, err := strconv.Atoi(tt[1])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return r, d, true
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// readinessSamples tracks the number of readiness samples kept per workload.
+	readinessSamples = 30
+
+	// readinessTTL evicts workloads that have not been seen for a while.
+	readinessTTL = 10 * time.Minute
+)
+
+// wkHistory tracks workloads readiness across refreshes.
+var wkHistory = newReadinessHistory()
+
+type readinessSeries struct {
+	samples  []float64
+	lastSeen time.Time
+}
+
+// readinessHistory tracks workloads readiness ratios over time.
+type readinessHistory struct {
+	series map[string]*readinessSeries
+	mx     sync.Mutex
+}
+
+func newReadinessHistory() *readinessHistory {
+	return &readinessHistory{
+		series: make(map[string]*readinessSeries),
+	}
+}
+
+// track records the current readiness of each workload and decorates rows with their history.
+func (h *readinessHistory) track(oo []runtime.Object, now time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			continue
+		}
+		ready := wk.Str(render.WKReadyCell)
+		r, d, ok := readyCounts(ready)
+		if !ok {
+			continue
+		}
+		ratio := 1.0
+		if d > 0 {
+			ratio = min(float64(r)/float64(d), 1)
+		}
+		key := wk.Str(render.WKKindCell) + "|" + wk.Str(render.WKNamespaceCell) + "|" + wk.Str(render.WKNameCell)
+		s, ok := h.series[key]
+		if !ok {
+			s = &readinessSeries{samples: make([]float64, 0, readinessSamples)}
+			h.series[key] = s
+		}
+		if len(s.samples) == readinessSamples {
+			s.samples = append(s.samples[:0], s.samples[1:]...)
+		}
+		s.samples, s.lastSeen = append(s.samples, ratio), now
+		wk.Row.Cells[render.WKTrendCell] = append([]float64(nil), s.samples...)
+	}
+	for k, s := range h.series {
+		if now.Sub(s.lastSeen) > readinessTTL {
+			delete(h.series, k)
+		}
+	}
+}
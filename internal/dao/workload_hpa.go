@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var hpaV2GVR = client.NewGVR("autoscaling/v2/horizontalpodautoscalers")
+
+// hpaKinds tracks workload resources that can be autoscaled.
+var hpaKinds = map[*client.GVR]string{
+	client.DpGVR:  "Deployment",
+	client.StsGVR: "StatefulSet",
+	RolloutGVR:    "Rollout",
+}
+
+// hpaTarget identifies an autoscaled resource.
+type hpaTarget struct {
+	ns, kind, name string
+}
+
+// hpaLookup returns autoscalers summaries keyed by their scale target.
+func hpaLookup(f Factory, nss []string) map[hpaTarget]string {
+	gvr := hpaV2GVR
+	if _, err := MetaAccess.MetaFor(gvr); err != nil {
+		gvr = client.HpaGVR
+	}
+
+	hh := make(map[hpaTarget]string)
+	for _, ns := range nss {
+		oo, err := f.List(gvr, ns, false, labels.Everything())
+		if err != nil {
+			slog.Warn("Unable to list autoscalers for workloads", slogs.Error, err)
+			continue
+		}
+		for _, o := range oo {
+			t, s, err := hpaSummary(gvr, o)
+			if err != nil {
+				slog.Warn("Unable to decode autoscaler", slogs.Error, err)
+				continue
+			}
+			hh[t] = s
+		}
+	}
+
+	return hh
+}
+
+// addHPAs decorates autoscaled workloads with their autoscaler summary.
+func addHPAs(oo []runtime.Object, hh map[hpaTarget]string) {
+	if len(hh) == 0 {
+		return
+	}
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			continue
+		}
+		kind, ok := hpaKinds[client.NewGVR(wk.Str(render.WKKindCell))]
+		if !ok {
+			continue
+		}
+		ns := wk.Str(render.WKNamespaceCell)
+		n := wk.Str(render.WKNameCell)
+		if s, ok := hh[hpaTarget{ns: ns, kind: kind, name: n}]; ok {
+			wk.Row.Cells[render.WKHPACell] = s
+		}
+	}
+}
+
+func hpaSummary(gvr *client.GVR, o runtime.Object) (hpaTarget, string, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return hpaTarget{}, "", fmt.Errorf("expected unstructured but got %T", o)
+	}
+	if gvr == hpaV2GVR {
+		var hpa autoscalingv2.HorizontalPodAutoscaler
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &hpa); err != nil {
+			return hpaTarget{}, "", err
+		}
+		ref := hpa.Spec.ScaleTargetRef
+		return hpaTarget{ns: hpa.Namespace, kind: ref.Kind, name: ref.Name}, hpaV2Summary(&hpa), nil
+	}
+
+	var hpa autoscalingv1.HorizontalPodAutoscaler
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &hpa); err != nil {
+		return hpaTarget{}, "", err
+	}
+	ref := hpa.Spec.ScaleTargetRef
+
+	return hpaTarget{ns: hpa.Namespace, kind: ref.Kind, name: ref.Name}, hpaV1Summary(&hpa), nil
+}
+
+func hpaV1Summary(hpa *autoscalingv1.HorizontalPodAutoscaler) string {
+	var mm []string
+	if t := hpa.Spec.TargetCPUUtilizationPercentage; t != nil {
+		current := render.UnknownValue
+		if c := hpa.Status.CurrentCPUUtilizationPercentage; c != nil {
+			current = fmt.Sprintf("%d%%", *c)
+		}
+		mm = append(mm, fmt.Sprintf("cpu:%s/%d%%", current, *t))
+	}
+
+	return hpaReplicas(mm, hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+}
+
+func hpaV2Summary(hpa *autoscalingv2.HorizontalPodAutoscaler) string {
+	mm := make([]string, 0, len(hpa.Spec.Metrics))
+	for _, spec := range hpa.Spec.Metrics {
+		n, target := hpaMetricTarget(spec)
+		current := render.UnknownValue
+		for _, st := range hpa.Status.CurrentMetrics {
+			if cn, c, ok := hpaMetricCurrent(st); ok && st.Type == spec.Type && cn == n {
+				current = c
+				break
+			}
+		}
+		mm = append(mm, fmt.Sprintf("%s:%s/%s", n, current, target))
+	}
+
+	return hpaReplicas(mm, hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+}
+
+func hpaReplicas(mm []string, minReplicas *int32, maxReplicas int32) string {
+	lo := int32(1)
+	if minReplicas != nil {
+		lo = *minReplicas
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s (%d-%d)", strings.Join(mm, ","), lo, maxReplicas))
+}
+
+func hpaMetricTarget(spec autoscalingv2.MetricSpec) (string, string) {
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			return string(spec.Resource.Name), metricTarget(spec.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			return string(spec.ContainerResource.Name), metricTarget(spec.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			return spec.Pods.Metric.Name, metricTarget(spec.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			return spec.Object.Metric.Name, metricTarget(spec.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			return spec.External.Metric.Name, metricTarget(spec.External.Target)
+		}
+	}
+
+	return strings.ToLower(string(spec.Type)), render.UnknownValue
+}
+
+func hpaMetricCurrent(st autoscalingv2.MetricStatus) (string, string, bool) {
+	switch st.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if st.Resource != nil {
+			return string(st.Resource.Name), metricValue(st.Resource.Current), true
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if st.ContainerResource != nil {
+			return string(st.ContainerResource.Name), metricValue(st.ContainerResource.Current), true
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if st.Pods != nil {
+			return st.Pods.Metric.Name, metricValue(st.Pods.Current), true
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if st.Object != nil {
+			return st.Object.Metric.Name, metricValue(st.Object.Current), true
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if st.External != nil {
+			return st.External.Metric.Name, metricValue(st.External.Current), true
+		}
+	}
+
+	return "", "", false
+}
+
+func metricTarget(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String()
+	case t.Value != nil:
+		return t.Value.String()
+	}
+
+	return render.UnknownValue
+}
+
+func metricValue(v autoscalingv2.MetricValueStatus) string {
+	switch {
+	case v.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *v.AverageUtilization)
+	case v.AverageValue != nil:
+		return v.AverageValue.String()
+	case v.Value != nil:
+		return v.Value.String()
+	}
+
+	return render.UnknownValue
+}
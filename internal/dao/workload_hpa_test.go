@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHPASummary(t *testing.T) {
+	uu := map[string]struct {
+		gvr *client.GVR
+		o   map[string]any
+		t   hpaTarget
+		e   string
+	}{
+		"v2": {
+			gvr: hpaV2GVR,
+			o: map[string]any{
+				"apiVersion": "autoscaling/v2",
+				"kind":       "HorizontalPodAutoscaler",
+				"metadata":   map[string]any{"name": "h1", "namespace": "ns1"},
+				"spec": map[string]any{
+					"scaleTargetRef": map[string]any{"kind": "Deployment", "name": "dp1"},
+					"minReplicas":    int64(2),
+					"maxReplicas":    int64(10),
+					"metrics": []any{
+						map[string]any{
+							"type": "Resource",
+							"resource": map[string]any{
+								"name":   "cpu",
+								"target": map[string]any{"type": "Utilization", "averageUtilization": int64(80)},
+							},
+						},
+						map[string]any{
+							"type": "Pods",
+							"pods": map[string]any{
+								"metric": map[string]any{"name": "rps"},
+								"target": map[string]any{"type": "AverageValue", "averageValue": "100"},
+							},
+						},
+					},
+				},
+				"status": map[string]any{
+					"currentMetrics": []any{
+						map[string]any{
+							"type": "Resource",
+							"resource": map[string]any{
+								"name":    "cpu",
+								"current": map[string]any{"averageUtilization": int64(45)},
+							},
+						},
+					},
+				},
+			},
+			t: hpaTarget{ns: "ns1", kind: "Deployment", name: "dp1"},
+			e: "cpu:45%/80%,rps:<unknown>/100 (2-10)",
+		},
+		"v1": {
+			gvr: client.HpaGVR,
+			o: map[string]any{
+				"apiVersion": "autoscaling/v1",
+				"kind":       "HorizontalPodAutoscaler",
+				"metadata":   map[string]any{"name": "h1", "namespace": "ns1"},
+				"spec": map[string]any{
+					"scaleTargetRef":                 map[string]any{"kind": "StatefulSet", "name": "sts1"},
+					"maxReplicas":                    int64(3),
+					"targetCPUUtilizationPercentage": int64(70),
+				},
+				"status": map[string]any{"currentCPUUtilizationPercentage": int64(20)},
+			},
+			t: hpaTarget{ns: "ns1", kind: "StatefulSet", name: "sts1"},
+			e: "cpu:20%/70% (1-3)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ht, s, err := hpaSummary(u.gvr, &unstructured.Unstructured{Object: u.o})
+			require.NoError(t, err)
+			assert.Equal(t, u.t, ht)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestWorkloadAddHPAs(t *testing.T) {
+	newRes := func(gvr *client.GVR, n string) *render.WorkloadRes {
+		return &render.WorkloadRes{
+			Row: newWorkloadRes(gvr.String(), "ns1", n, StatusOK, "", "", metav1.Time{}, "").Row,
+		}
+	}
+	dp, rs := newRes(client.DpGVR, "fred"), newRes(client.RsGVR, "fred")
+
+	addHPAs([]runtime.Object{dp, rs}, map[hpaTarget]string{
+		{ns: "ns1", kind: "Deployment", name: "fred"}: "cpu:1%/2% (1-2)",
+		{ns: "ns1", kind: "ReplicaSet", name: "fred"}: "cpu:1%/2% (1-2)",
+	})
+
+	assert.Equal(t, "cpu:1%/2% (1-2)", dp.Row.Cells[10])
+	assert.Empty(t, rs.Row.Cells[10])
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	// KnativeSvcGVR represents a Knative service resource.
+	KnativeSvcGVR = client.NewGVR("serving.knative.dev/v1/services")
+
+	// KnativeRevGVR represents a Knative revision resource.
+	KnativeRevGVR = client.NewGVR("serving.knative.dev/v1/revisions")
+)
+
+const (
+	readyCondition = "Ready"
+	conditionTrue  = "True"
+)
+
+// knativeSvcHealth computes a Knative service health from its conditions.
+func knativeSvcHealth(u *unstructured.Unstructured) (string, string) {
+	cc := objectConditions(u)
+	var ok int
+	for _, c := range cc {
+		if c.status == conditionTrue {
+			ok++
+		}
+	}
+
+	return conditionsHealth(cc), fmt.Sprintf("%d/%d", ok, len(cc))
+}
+
+// knativeRevHealth computes a Knative revision health from its conditions and replicas.
+func knativeRevHealth(u *unstructured.Unstructured) (string, string) {
+	stat, ready := knativeSvcHealth(u)
+	desired, ok, _ := unstructured.NestedInt64(u.Object, "status", "desiredReplicas")
+	if !ok {
+		return stat, ready
+	}
+	actual, _, _ := unstructured.NestedInt64(u.Object, "status", "actualReplicas")
+
+	return stat, fmt.Sprintf("%d/%d", actual, desired)
+}
+
+// conditionsHealth flags a resource as degraded unless its Ready condition holds.
+func conditionsHealth(cc []objectCondition) string {
+	for _, c := range cc {
+		if c.kind == readyCondition && c.status == conditionTrue {
+			return StatusOK
+		}
+	}
+
+	return DegradedStatus
+}
+
+// objectCondition represents a resource status condition.
+type objectCondition struct {
+	kind, status, reason, message string
+}
+
+// objectConditions returns a resource status conditions.
+func objectConditions(u *unstructured.Unstructured) []objectCondition {
+	ss, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	cc := make([]objectCondition, 0, len(ss))
+	for _, s := range ss {
+		m, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		var c objectCondition
+		c.kind, _, _ = unstructured.NestedString(m, "type")
+		c.status, _, _ = unstructured.NestedString(m, "status")
+		c.reason, _, _ = unstructured.NestedString(m, "reason")
+		c.message, _, _ = unstructured.NestedString(m, "message")
+		cc = append(cc, c)
+	}
+
+	return cc
+}
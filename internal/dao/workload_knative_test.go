@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestKnativeHealth(t *testing.T) {
+	cond := func(kind, status string) map[string]any {
+		return map[string]any{"type": kind, "status": status}
+	}
+	uu := map[string]struct {
+		gvr         string
+		status      map[string]any
+		stat, ready string
+	}{
+		"svc-ready": {
+			gvr: KnativeSvcGVR.String(),
+			status: map[string]any{"conditions": []any{
+				cond("ConfigurationsReady", "True"),
+				cond("Ready", "True"),
+				cond("RoutesReady", "True"),
+			}},
+			stat:  StatusOK,
+			ready: "3/3",
+		},
+		"svc-not-ready": {
+			gvr: KnativeSvcGVR.String(),
+			status: map[string]any{"conditions": []any{
+				cond("ConfigurationsReady", "True"),
+				cond("Ready", "Unknown"),
+				cond("RoutesReady", "False"),
+			}},
+			stat:  DegradedStatus,
+			ready: "1/3",
+		},
+		"svc-no-conditions": {
+			gvr:   KnativeSvcGVR.String(),
+			stat:  DegradedStatus,
+			ready: "0/0",
+		},
+		"rev-scaled": {
+			gvr: KnativeRevGVR.String(),
+			status: map[string]any{
+				"conditions":      []any{cond("Ready", "True")},
+				"actualReplicas":  int64(1),
+				"desiredReplicas": int64(2),
+			},
+			stat:  StatusOK,
+			ready: "1/2",
+		},
+		"rev-no-replicas": {
+			gvr: KnativeRevGVR.String(),
+			status: map[string]any{
+				"conditions": []any{cond("Ready", "False")},
+			},
+			stat:  DegradedStatus,
+			ready: "0/1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "k1", "namespace": "ns1"},
+			}}
+			if u.status != nil {
+				o.Object["status"] = u.status
+			}
+			fn := knativeSvcHealth
+			if u.gvr == KnativeRevGVR.String() {
+				fn = knativeRevHealth
+			}
+			stat, ready := fn(&o)
+			assert.Equal(t, u.stat, stat)
+			assert.Equal(t, u.ready, ready)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// objectHealthFn computes a workload status and readiness from the resource itself.
+type objectHealthFn func(*unstructured.Unstructured) (stat, ready string)
+
+// objectWorkloads tracks resources whose health is not available from their table.
+var objectWorkloads = map[*client.GVR]objectHealthFn{
+	RolloutGVR:    rolloutHealth,
+	KnativeSvcGVR: knativeSvcHealth,
+	KnativeRevGVR: knativeRevHealth,
+}
+
+// optionalWorkloads tracks resources aggregated by default when installed on the cluster.
+var optionalWorkloads = []*client.GVR{
+	RolloutGVR,
+	KnativeSvcGVR,
+	KnativeRevGVR,
+}
+
+// objectRows computes workload rows for the given resources.
+func objectRows(gvr *client.GVR, oo []runtime.Object, degradedOnly bool, fn objectHealthFn) []runtime.Object {
+	rr := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		stat, ready := fn(u)
+		if degradedOnly && stat != DegradedStatus {
+			continue
+		}
+		rr = append(rr, objectRow(gvr, u, stat, ready, validity(stat), ""))
+	}
+
+	return rr
+}
+
+// objectRow returns a workload row for a given resource.
+func objectRow(gvr *client.GVR, u *unstructured.Unstructured, stat, ready, valid, msg string) *render.WorkloadRes {
+	res := newWorkloadRes(gvr.String(), u.GetNamespace(), u.GetName(), stat, ready, valid, u.GetCreationTimestamp(), msg)
+	res.Row.Cells[render.WKReasonCell] = conditionsReason(failedConditions(objectConditions(u)))
+	res.UID, res.Owners, res.Labels = u.GetUID(), u.GetOwnerReferences(), u.GetLabels()
+
+	return res
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// resolveOwners decorates workloads with their top level owner by walking
+// their owner references chain ie pod -> replicaset -> deployment.
+// Returns the workloads owned by another listed workload.
+func resolveOwners(oo []runtime.Object) sets.Set[types.UID] {
+	uids := make(map[types.UID]*render.WorkloadRes, len(oo))
+	for _, o := range oo {
+		if wk, ok := o.(*render.WorkloadRes); ok && wk.UID != "" {
+			uids[wk.UID] = wk
+		}
+	}
+
+	owned := sets.New[types.UID]()
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok {
+			continue
+		}
+		ref, listed, ok := topOwner(wk, uids)
+		if !ok {
+			continue
+		}
+		wk.Row.Cells[render.WKOwnerCell] = ref.Kind + "/" + ref.Name
+		if listed {
+			owned.Insert(wk.UID)
+		}
+	}
+
+	return owned
+}
+
+// topOwner returns the top level owner reference and whether any of the
+// owners in the chain is a listed workload.
+func topOwner(wk *render.WorkloadRes, uids map[types.UID]*render.WorkloadRes) (metav1.OwnerReference, bool, bool) {
+	ref, ok := controllerRef(wk.Owners)
+	if !ok {
+		return ref, false, false
+	}
+	var listed bool
+	seen := sets.New(wk.UID)
+	for !seen.Has(ref.UID) {
+		o, ok := uids[ref.UID]
+		if !ok {
+			break
+		}
+		listed = true
+		seen.Insert(ref.UID)
+		next, ok := controllerRef(o.Owners)
+		if !ok {
+			break
+		}
+		ref = next
+	}
+
+	return ref, listed, true
+}
+
+// controllerRef returns the managing owner reference if any or the first one.
+func controllerRef(rr []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	if len(rr) == 0 {
+		return metav1.OwnerReference{}, false
+	}
+	for _, r := range rr {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+
+	return rr[0], true
+}
+
+// collapseOwned drops workloads owned by another listed workload.
+func collapseOwned(oo []runtime.Object, owned sets.Set[types.UID]) []runtime.Object {
+	if owned.Len() == 0 {
+		return oo
+	}
+	rr := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if wk, ok := o.(*render.WorkloadRes); ok && owned.Has(wk.UID) {
+			continue
+		}
+		rr = append(rr, o)
+	}
+
+	return rr
+}
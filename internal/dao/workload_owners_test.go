@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestResolveOwners(t *testing.T) {
+	newRow := func(gvr *client.GVR, n string, uid types.UID, owners ...metav1.OwnerReference) *render.WorkloadRes {
+		return &render.WorkloadRes{
+			Row:    newWorkloadRes(gvr.String(), "ns1", n, StatusOK, "", "", metav1.Time{}, "").Row,
+			UID:    uid,
+			Owners: owners,
+		}
+	}
+	ref := func(kind, n string, uid types.UID) metav1.OwnerReference {
+		yes := true
+		return metav1.OwnerReference{Kind: kind, Name: n, UID: uid, Controller: &yes}
+	}
+	oo := []runtime.Object{
+		newRow(client.DpGVR, "fred", "dp"),
+		newRow(client.RsGVR, "fred-1", "rs", ref("Deployment", "fred", "dp")),
+		newRow(client.PodGVR, "fred-1-a", "po1", ref("ReplicaSet", "fred-1", "rs")),
+		newRow(client.PodGVR, "blee-a", "po2", ref("ReplicaSet", "blee", "rs2")),
+		newRow(client.PodGVR, "zorg", "po3"),
+	}
+
+	owned := resolveOwners(oo)
+	ee := []string{"", "Deployment/fred", "Deployment/fred", "ReplicaSet/blee", ""}
+	for i, e := range ee {
+		assert.Equal(t, e, oo[i].(*render.WorkloadRes).Row.Cells[12])
+	}
+	assert.ElementsMatch(t, []types.UID{"rs", "po1"}, owned.UnsortedList())
+
+	rr := collapseOwned(oo, owned)
+	assert.Len(t, rr, 3)
+	for _, r := range rr {
+		assert.NotContains(t, []types.UID{"rs", "po1"}, r.(*render.WorkloadRes).UID)
+	}
+}
+
+func TestTopOwnerCycle(t *testing.T) {
+	a := &render.WorkloadRes{UID: "a", Owners: []metav1.OwnerReference{{Kind: "B", Name: "b", UID: "b"}}}
+	b := &render.WorkloadRes{UID: "b", Owners: []metav1.OwnerReference{{Kind: "A", Name: "a", UID: "a"}}}
+
+	ref, listed, ok := topOwner(a, map[types.UID]*render.WorkloadRes{"a": a, "b": b})
+	assert.True(t, ok)
+	assert.True(t, listed)
+	assert.Equal(t, "a", ref.Name)
+}
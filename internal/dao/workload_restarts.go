@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartStats tracks container restarts.
+type restartStats struct {
+	count int32
+	last  metav1.Time
+}
+
+func (r *restartStats) merge(s restartStats) {
+	r.count += s.count
+	if r.last.Before(&s.last) {
+		r.last = s.last
+	}
+}
+
+// podRestarts computes container restarts per pod and per pod controller.
+func podRestarts(oo []runtime.Object) (byPod, byOwner map[types.UID]restartStats) {
+	byPod, byOwner = make(map[types.UID]restartStats, len(oo)), make(map[types.UID]restartStats)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		var s restartStats
+		for _, cs := range append(po.Status.InitContainerStatuses, po.Status.ContainerStatuses...) {
+			s.merge(restartStats{count: cs.RestartCount, last: lastRestart(cs)})
+		}
+		byPod[po.UID] = s
+		for _, ref := range po.OwnerReferences {
+			if ref.Controller == nil || !*ref.Controller {
+				continue
+			}
+			st := byOwner[ref.UID]
+			st.merge(s)
+			byOwner[ref.UID] = st
+		}
+	}
+
+	return
+}
+
+func lastRestart(cs v1.ContainerStatus) metav1.Time {
+	if t := cs.LastTerminationState.Terminated; t != nil && cs.RestartCount > 0 {
+		return t.FinishedAt
+	}
+
+	return metav1.Time{}
+}
+
+// addRestarts decorates pod backed workloads with their container restarts.
+func addRestarts(oo []runtime.Object, pods []runtime.Object) {
+	byPod, byOwner := podRestarts(pods)
+	// Deployments do not own pods directly so roll up their replicasets stats.
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok || wk.Str(render.WKKindCell) != client.RsGVR.String() {
+			continue
+		}
+		s, ok := byOwner[wk.UID]
+		if !ok {
+			continue
+		}
+		for _, ref := range wk.Owners {
+			if ref.Controller != nil && *ref.Controller {
+				st := byOwner[ref.UID]
+				st.merge(s)
+				byOwner[ref.UID] = st
+			}
+		}
+	}
+
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok || wk.UID == "" {
+			continue
+		}
+		var (
+			s     restartStats
+			found bool
+		)
+		switch wk.Str(render.WKKindCell) {
+		case client.PodGVR.String():
+			s, found = byPod[wk.UID]
+		case client.DpGVR.String(), client.RsGVR.String(), client.StsGVR.String(), client.DsGVR.String(), client.JobGVR.String():
+			s, found = byOwner[wk.UID]
+		}
+		if !found {
+			continue
+		}
+		wk.Row.Cells[render.WKRestartsCell], wk.Row.Cells[render.WKLastRestartCell] = render.IntToStr(int(s.count)), s.last
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// RolloutGVR represents an Argo rollout resource.
+var RolloutGVR = client.NewGVR("argoproj.io/v1alpha1/rollouts")
+
+// rolloutDegraded represents a failed rollout phase.
+const rolloutDegraded = "Degraded"
+
+// rolloutHealth computes an Argo rollout health from its phase and replica counters.
+func rolloutHealth(u *unstructured.Unstructured) (string, string) {
+	desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !ok {
+		desired = 1
+	}
+	available, _, _ := unstructured.NestedInt64(u.Object, "status", "availableReplicas")
+	ready := fmt.Sprintf("%d/%d", available, desired)
+
+	if aborted, _, _ := unstructured.NestedBool(u.Object, "status", "abort"); aborted {
+		return DegradedStatus, ready
+	}
+	if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase == rolloutDegraded || !isReady(ready) {
+		return DegradedStatus, ready
+	}
+
+	return StatusOK, ready
+}
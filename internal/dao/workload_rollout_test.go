@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRolloutHealth(t *testing.T) {
+	uu := map[string]struct {
+		spec, status map[string]any
+		stat, ready  string
+	}{
+		"healthy": {
+			spec:   map[string]any{"replicas": int64(3)},
+			status: map[string]any{"phase": "Healthy", "availableReplicas": int64(3)},
+			stat:   StatusOK,
+			ready:  "3/3",
+		},
+		"default-replicas": {
+			status: map[string]any{"phase": "Healthy", "availableReplicas": int64(1)},
+			stat:   StatusOK,
+			ready:  "1/1",
+		},
+		"paused": {
+			spec:   map[string]any{"replicas": int64(2)},
+			status: map[string]any{"phase": "Paused", "availableReplicas": int64(2)},
+			stat:   StatusOK,
+			ready:  "2/2",
+		},
+		"progressing": {
+			spec:   map[string]any{"replicas": int64(3)},
+			status: map[string]any{"phase": "Progressing", "availableReplicas": int64(1)},
+			stat:   DegradedStatus,
+			ready:  "1/3",
+		},
+		"degraded": {
+			spec:   map[string]any{"replicas": int64(1)},
+			status: map[string]any{"phase": "Degraded", "availableReplicas": int64(1)},
+			stat:   DegradedStatus,
+			ready:  "1/1",
+		},
+		"aborted": {
+			spec:   map[string]any{"replicas": int64(1)},
+			status: map[string]any{"phase": "Healthy", "abort": true, "availableReplicas": int64(1)},
+			stat:   DegradedStatus,
+			ready:  "1/1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "r1", "namespace": "ns1"},
+			}}
+			if u.spec != nil {
+				o.Object["spec"] = u.spec
+			}
+			if u.status != nil {
+				o.Object["status"] = u.status
+			}
+			stat, ready := rolloutHealth(&o)
+			assert.Equal(t, u.stat, stat)
+			assert.Equal(t, u.ready, ready)
+		})
+	}
+}
+
+func TestObjectRowsDegradedOnly(t *testing.T) {
+	oo := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "r1", "namespace": "ns1"},
+			"status":   map[string]any{"phase": "Healthy", "availableReplicas": int64(1)},
+		}},
+		&unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "r2", "namespace": "ns1"},
+			"status":   map[string]any{"phase": "Degraded"},
+		}},
+	}
+
+	rr := objectRows(RolloutGVR, oo, true, rolloutHealth)
+	require.Len(t, rr, 1)
+	res := rr[0].(*render.WorkloadRes)
+	assert.Equal(t, []any{RolloutGVR.String(), "ns1", "r2", DegradedStatus, "0/1", DegradedStatus}, res.Row.Cells[:6])
+}
@@ -0,0 +1,351 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestWorkloadBatchStatus(t *testing.T) {
+	jobDefs := []metav1.TableColumnDefinition{
+		{Name: "Name"},
+		{Name: "Status"},
+		{Name: "Completions"},
+	}
+	legacyJobDefs := []metav1.TableColumnDefinition{
+		{Name: "Name"},
+		{Name: "Completions"},
+	}
+	cjDefs := []metav1.TableColumnDefinition{
+		{Name: "Name"},
+		{Name: "Schedule"},
+		{Name: "Suspend"},
+		{Name: "Active"},
+		{Name: "Last Schedule"},
+	}
+
+	uu := map[string]struct {
+		gvr           *client.GVR
+		defs          []metav1.TableColumnDefinition
+		cells         []any
+		status, ready string
+	}{
+		"job-complete": {
+			gvr:    client.JobGVR,
+			defs:   jobDefs,
+			cells:  []any{"j1", "Complete", "1/1"},
+			status: StatusOK,
+			ready:  "1/1",
+		},
+		"job-running": {
+			gvr:    client.JobGVR,
+			defs:   jobDefs,
+			cells:  []any{"j1", "Running", "0/1"},
+			status: StatusOK,
+			ready:  "0/1",
+		},
+		"job-failed": {
+			gvr:    client.JobGVR,
+			defs:   jobDefs,
+			cells:  []any{"j1", "Failed", "0/1"},
+			status: DegradedStatus,
+			ready:  "0/1",
+		},
+		"job-legacy-incomplete": {
+			gvr:    client.JobGVR,
+			defs:   legacyJobDefs,
+			cells:  []any{"j1", "0/3"},
+			status: DegradedStatus,
+			ready:  "0/3",
+		},
+		"cronjob": {
+			gvr:    client.CjGVR,
+			defs:   cjDefs,
+			cells:  []any{"cj1", "*/1 * * * *", "False", int64(0), "5m"},
+			status: StatusOK,
+			ready:  "5m",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := metav1.TableRow{Cells: u.cells}
+			assert.Equal(t, u.status, status(u.gvr, &r, u.defs))
+			assert.Equal(t, u.ready, readiness(u.gvr, &r, u.defs))
+		})
+	}
+}
+
+func TestWorkloadMappedStatus(t *testing.T) {
+	defs := []metav1.TableColumnDefinition{
+		{Name: "Name"},
+		{Name: "Phase"},
+		{Name: "Available"},
+		{Name: "Desired"},
+	}
+
+	uu := map[string]struct {
+		spec          config.WorkloadGVR
+		cells         []any
+		status, ready string
+	}{
+		"healthy": {
+			spec: config.WorkloadGVR{
+				Status:        "Phase",
+				HealthyStatus: []string{"Healthy"},
+				Ready:         "Available",
+				Desired:       "Desired",
+			},
+			cells:  []any{"r1", "Healthy", int64(3), int64(3)},
+			status: StatusOK,
+			ready:  "3/3",
+		},
+		"bad-phase": {
+			spec: config.WorkloadGVR{
+				Status:        "Phase",
+				HealthyStatus: []string{"Healthy"},
+			},
+			cells:  []any{"r1", "Degraded", int64(3), int64(3)},
+			status: DegradedStatus,
+		},
+		"not-ready": {
+			spec: config.WorkloadGVR{
+				Ready:   "Available",
+				Desired: "Desired",
+			},
+			cells:  []any{"r1", "Healthy", int64(1), int64(3)},
+			status: DegradedStatus,
+			ready:  "1/3",
+		},
+		"missing-col": {
+			spec: config.WorkloadGVR{
+				Status: "Blee",
+			},
+			cells:  []any{"r1", "Healthy", int64(1), int64(3)},
+			status: render.MissingValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := metav1.TableRow{Cells: u.cells}
+			assert.Equal(t, u.status, mappedStatus(u.spec, &r, defs))
+			assert.Equal(t, u.ready, mappedReadiness(u.spec, &r, defs))
+		})
+	}
+}
+
+func TestReadyCounts(t *testing.T) {
+	uu := map[string]struct {
+		s    string
+		r, d int
+		ok   bool
+	}{
+		"plain":   {s: "1/3", r: 1, d: 3, ok: true},
+		"blank":   {s: ""},
+		"count":   {s: "3"},
+		"invalid": {s: "a/3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, d, ok := readyCounts(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.r, r)
+			assert.Equal(t, u.d, d)
+		})
+	}
+}
+
+func TestWorkloadRowsDegradedOnly(t *testing.T) {
+	table := metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Ready"},
+		},
+		Rows: []metav1.TableRow{
+			{Cells: []any{"dp1", "1/1"}},
+			{Cells: []any{"dp2", "0/1"}},
+		},
+	}
+
+	assert.Len(t, workloadRows(client.DpGVR, config.WorkloadGVR{}, &table, false), 2)
+	oo := workloadRows(client.DpGVR, config.WorkloadGVR{}, &table, true)
+	assert.Len(t, oo, 1)
+	assert.Equal(t, "dp2", oo[0].(*render.WorkloadRes).Row.Cells[2])
+}
+
+func TestWorkloadAddRestarts(t *testing.T) {
+	ctrl := true
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	pod := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      "p1",
+			"namespace": "ns1",
+			"uid":       "p1-uid",
+			"ownerReferences": []any{
+				map[string]any{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "rs1", "uid": "rs1-uid", "controller": true},
+			},
+		},
+		"status": map[string]any{
+			"containerStatuses": []any{
+				map[string]any{
+					"name":         "c1",
+					"restartCount": int64(3),
+					"lastState": map[string]any{
+						"terminated": map[string]any{"finishedAt": finishedAt.UTC().Format(time.RFC3339)},
+					},
+				},
+			},
+		},
+	}}
+	newRes := func(gvr *client.GVR, uid types.UID, owners ...metav1.OwnerReference) *render.WorkloadRes {
+		return &render.WorkloadRes{
+			Row:    newWorkloadRes(gvr.String(), "ns1", "n", StatusOK, "", "", metav1.Time{}, "").Row,
+			UID:    uid,
+			Owners: owners,
+		}
+	}
+	po, rs := newRes(client.PodGVR, "p1-uid"), newRes(client.RsGVR, "rs1-uid", metav1.OwnerReference{UID: "dp1-uid", Controller: &ctrl})
+	dp, svc := newRes(client.DpGVR, "dp1-uid"), newRes(client.SvcGVR, "svc1-uid")
+
+	addRestarts([]runtime.Object{po, rs, dp, svc}, []runtime.Object{&pod})
+
+	for _, o := range []*render.WorkloadRes{po, rs, dp} {
+		assert.Equal(t, "3", o.Row.Cells[8])
+		assert.Equal(t, finishedAt.Unix(), o.Row.Cells[9].(metav1.Time).Unix())
+	}
+	assert.Empty(t, svc.Row.Cells[8])
+}
+
+func TestAddUsages(t *testing.T) {
+	ctrl := true
+	pod := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      "p1",
+			"namespace": "ns1",
+			"uid":       "p1-uid",
+			"ownerReferences": []any{
+				map[string]any{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": "rs1", "uid": "rs1-uid", "controller": true},
+			},
+		},
+		"spec": map[string]any{
+			"containers": []any{
+				map[string]any{
+					"name": "c1",
+					"resources": map[string]any{
+						"requests": map[string]any{"cpu": "200m", "memory": "128Mi"},
+						"limits":   map[string]any{"memory": "256Mi"},
+					},
+				},
+			},
+		},
+	}}
+	pmx := client.PodsMetricsMap{
+		"ns1/p1": &mv1beta1.PodMetrics{
+			Containers: []mv1beta1.ContainerMetrics{
+				{
+					Name: "c1",
+					Usage: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("100m"),
+						v1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			},
+		},
+	}
+	newRes := func(gvr *client.GVR, uid types.UID, owners ...metav1.OwnerReference) *render.WorkloadRes {
+		return &render.WorkloadRes{
+			Row:    newWorkloadRes(gvr.String(), "ns1", "n", StatusOK, "", "", metav1.Time{}, "").Row,
+			UID:    uid,
+			Owners: owners,
+		}
+	}
+	po, rs := newRes(client.PodGVR, "p1-uid"), newRes(client.RsGVR, "rs1-uid", metav1.OwnerReference{UID: "dp1-uid", Controller: &ctrl})
+	dp, svc := newRes(client.DpGVR, "dp1-uid"), newRes(client.SvcGVR, "svc1-uid")
+
+	addUsages([]runtime.Object{po, rs, dp, svc}, []runtime.Object{&pod}, pmx)
+
+	e := render.WorkloadUsage{CPU: 100, ReqCPU: 200, Mem: 64 * client.MegaByte, ReqMem: 128 * client.MegaByte, LimMem: 256 * client.MegaByte}
+	for _, o := range []*render.WorkloadRes{po, rs, dp} {
+		assert.Equal(t, &e, o.Usage)
+	}
+	assert.Nil(t, svc.Usage)
+
+	po.Usage = nil
+	addUsages([]runtime.Object{po}, []runtime.Object{&pod}, nil)
+	assert.Nil(t, po.Usage)
+}
+
+func TestWorkloadNamespaces(t *testing.T) {
+	uu := map[string]struct {
+		nss []string
+		ns  string
+		e   []string
+	}{
+		"none":  {ns: "ns1", e: []string{"ns1"}},
+		"empty": {nss: []string{}, ns: client.BlankNamespace, e: []string{client.BlankNamespace}},
+		"set":   {nss: []string{"ns1", "ns2"}, ns: "ns3", e: []string{"ns1", "ns2"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.Background()
+			if u.nss != nil {
+				ctx = context.WithValue(ctx, internal.KeyNamespaces, u.nss)
+			}
+			assert.Equal(t, u.e, workloadNamespaces(ctx, u.ns))
+		})
+	}
+}
+
+func TestReadinessHistory(t *testing.T) {
+	h := newReadinessHistory()
+	newRes := func(ready string) *render.WorkloadRes {
+		return &render.WorkloadRes{
+			Row: newWorkloadRes(client.DpGVR.String(), "ns1", "dp1", StatusOK, ready, "", metav1.Time{}, "").Row,
+		}
+	}
+
+	now := time.Now()
+	for i, ready := range []string{"0/2", "1/2", "2/2"} {
+		h.track([]runtime.Object{newRes(ready)}, now.Add(time.Duration(i)*time.Second))
+	}
+	o := newRes("2/2")
+	h.track([]runtime.Object{o}, now.Add(3*time.Second))
+	assert.Equal(t, []float64{0, 0.5, 1, 1}, o.Row.Cells[11])
+
+	for range readinessSamples {
+		h.track([]runtime.Object{o}, now.Add(4*time.Second))
+	}
+	assert.Len(t, o.Row.Cells[11], readinessSamples)
+
+	svc := newRes("")
+	h.track([]runtime.Object{svc}, now.Add(readinessTTL+time.Hour))
+	assert.Nil(t, svc.Row.Cells[11])
+	assert.Empty(t, h.series)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// podsMetrics fetches the pods metrics for the given namespaces when metrics are available.
+func podsMetrics(ctx context.Context, c client.Connection, nss []string) client.PodsMetricsMap {
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); !ok || !withMx || c == nil {
+		return nil
+	}
+	mx := client.DialMetrics(c)
+	pmx := make(client.PodsMetricsMap)
+	for _, ns := range nss {
+		mm, err := mx.FetchPodsMetricsMap(ctx, ns)
+		if err != nil {
+			slog.Warn("Unable to fetch pods metrics for workloads", slogs.Namespace, ns, slogs.Error, err)
+			continue
+		}
+		for k, v := range mm {
+			pmx[k] = v
+		}
+	}
+
+	return pmx
+}
+
+// podUsages computes resources usage per pod and per pod controller.
+func podUsages(oo []runtime.Object, pmx client.PodsMetricsMap) (byPod, byOwner map[types.UID]*render.WorkloadUsage) {
+	byPod, byOwner = make(map[types.UID]*render.WorkloadUsage, len(oo)), make(map[types.UID]*render.WorkloadUsage)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		us := render.NewPodUsage(&po.Spec, pmx[client.FQN(po.Namespace, po.Name)])
+		byPod[po.UID] = us
+		for _, ref := range po.OwnerReferences {
+			if ref.Controller == nil || !*ref.Controller {
+				continue
+			}
+			if _, ok := byOwner[ref.UID]; !ok {
+				byOwner[ref.UID] = new(render.WorkloadUsage)
+			}
+			byOwner[ref.UID].Add(us)
+		}
+	}
+
+	return
+}
+
+// addUsages decorates pod backed workloads with their current resources usage.
+func addUsages(oo []runtime.Object, pods []runtime.Object, pmx client.PodsMetricsMap) {
+	if pmx == nil {
+		return
+	}
+	byPod, byOwner := podUsages(pods, pmx)
+	// Deployments do not own pods directly so roll up their replicasets usage.
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok || wk.Str(render.WKKindCell) != client.RsGVR.String() {
+			continue
+		}
+		us, ok := byOwner[wk.UID]
+		if !ok {
+			continue
+		}
+		for _, ref := range wk.Owners {
+			if ref.Controller != nil && *ref.Controller {
+				if _, ok := byOwner[ref.UID]; !ok {
+					byOwner[ref.UID] = new(render.WorkloadUsage)
+				}
+				byOwner[ref.UID].Add(us)
+			}
+		}
+	}
+
+	for _, o := range oo {
+		wk, ok := o.(*render.WorkloadRes)
+		if !ok || wk.UID == "" {
+			continue
+		}
+		switch wk.Str(render.WKKindCell) {
+		case client.PodGVR.String():
+			wk.Usage = byPod[wk.UID]
+		case client.DpGVR.String(), client.RsGVR.String(), client.StsGVR.String(), client.DsGVR.String(), client.JobGVR.String():
+			wk.Usage = byOwner[wk.UID]
+		}
+	}
+}
@@ -22,6 +22,7 @@ func TestIsLabelSelector(t *testing.T) {
 		"wrong-flag":  {s: "-f app=fred,env=blee"},
 		"missing-key": {s: "=fred"},
 		"missing-val": {s: "fred="},
+		"field":       {s: "-Fstatus.phase=Pending"},
 	}
 
 	for k := range uu {
@@ -31,3 +32,23 @@ func TestIsLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		ok bool
+	}{
+		"empty":    {s: ""},
+		"cool":     {s: "-F status.phase=Pending", ok: true},
+		"no-space": {s: "-Fspec.nodeName=node-1", ok: true},
+		"fuzzy":    {s: "-f status.phase=Pending"},
+		"labels":   {s: "-l app=fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, internal.IsFieldSelector(u.s))
+		})
+	}
+}
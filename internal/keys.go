@@ -36,4 +36,21 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyWorkloadGVRs  ContextKey = "workloadGVRs"
+	KeyWorkloadCEL   ContextKey = "workloadCEL"
+	KeyDegradedOnly  ContextKey = "degradedOnly"
+	KeyNamespaces    ContextKey = "namespaces"
+	KeyPageSize      ContextKey = "pageSize"
+	KeyTablePage     ContextKey = "tablePage"
+	KeyWorkloadGroup ContextKey = "workloadGroup"
+	KeyCollapsed     ContextKey = "collapsed"
+	KeyCollapseOwned ContextKey = "collapseOwned"
+	KeyFind          ContextKey = "find"
+	KeyExtraColumns  ContextKey = "extraColumns"
+	KeyStreamFilter  ContextKey = "streamFilter"
+	KeyAlertmanager  ContextKey = "alertmanager"
+	KeyWhoCan        ContextKey = "whoCan"
+	KeyFleet         ContextKey = "fleet"
+	KeyRevealed      ContextKey = "revealed"
+	KeyDecode        ContextKey = "decode"
 )
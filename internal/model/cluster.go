@@ -92,6 +92,20 @@ func (c *Cluster) UserName() string {
 	return n
 }
 
+// Credential returns the active user credential status if known.
+func (c *Cluster) Credential() *client.Credential {
+	cfg := c.factory.Client().Config()
+	if cfg == nil {
+		return nil
+	}
+	cred, err := cfg.Credential()
+	if err != nil {
+		return nil
+	}
+
+	return cred
+}
+
 // Metrics gathers node level metrics and compute utilization percentages.
 func (c *Cluster) Metrics(ctx context.Context, mx *client.ClusterMetrics) error {
 	var (
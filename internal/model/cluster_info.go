@@ -43,6 +43,8 @@ type ClusterMeta struct {
 	K9sVer, K9sLatest   string
 	K8sVer              string
 	Cpu, Mem, Ephemeral int
+	CredKind, CredError string
+	CredExpiry          time.Time
 }
 
 // NewClusterMeta returns a new instance.
@@ -70,7 +72,10 @@ func (c *ClusterMeta) Deltas(n *ClusterMeta) bool {
 		c.User != n.User ||
 		c.K8sVer != n.K8sVer ||
 		c.K9sVer != n.K9sVer ||
-		c.K9sLatest != n.K9sLatest
+		c.K9sLatest != n.K9sLatest ||
+		c.CredKind != n.CredKind ||
+		c.CredError != n.CredError ||
+		!c.CredExpiry.Equal(n.CredExpiry)
 }
 
 // ClusterInfo models cluster metadata.
@@ -131,6 +136,13 @@ func (c *ClusterInfo) Reset(f dao.Factory) {
 // Refresh fetches the latest cluster meta.
 func (c *ClusterInfo) Refresh() {
 	data := NewClusterMeta()
+	// Credentials are checked regardless of connectivity to explain auth failures.
+	if cred := c.cluster.Credential(); cred != nil {
+		data.CredKind, data.CredExpiry = cred.Kind, cred.Expiry
+		if cred.Err != nil {
+			data.CredError = cred.Err.Error()
+		}
+	}
 	if c.factory.Client().ConnectionOK() {
 		data.Context = c.cluster.ContextName()
 		data.Cluster = c.cluster.ClusterName()
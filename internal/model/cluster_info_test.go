@@ -6,6 +6,7 @@ package model_test
 import (
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,15 @@ func TestClusterMetaDelta(t *testing.T) {
 			n: makeClusterMeta("freddie"),
 			e: true,
 		},
+		"cred": {
+			o: makeClusterMeta("fred"),
+			n: func() *model.ClusterMeta {
+				m := makeClusterMeta("fred")
+				m.CredKind, m.CredExpiry = "exec", time.Unix(10, 0)
+				return m
+			}(),
+			e: true,
+		},
 	}
 
 	for k := range uu {
@@ -212,3 +212,8 @@ func (d *Describe) RemoveListener(l ResourceViewerListener) {
 func (d *Describe) Toggle() {
 	d.decode = !d.decode
 }
+
+// Decoded returns true if secret values are shown decoded.
+func (d *Describe) Decoded() bool {
+	return d.decode
+}
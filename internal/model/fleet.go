@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// FleetContextCol tracks the fleet context column name.
+	FleetContextCol = "CONTEXT"
+
+	// fleetSep separates a fleet row context from its resource path.
+	// Context names commonly carry @ hence a pipe is used instead.
+	fleetSep = "|"
+)
+
+// FleetMember represents a fleet context and its connection.
+type FleetMember struct {
+	Context string
+	Factory dao.Factory
+}
+
+// Fleet represents a set of contexts to list resources across.
+type Fleet []FleetMember
+
+// FleetID returns a fleet row id.
+func FleetID(ctx, id string) string {
+	return ctx + fleetSep + id
+}
+
+// ParseFleetID returns a fleet row context and resource path.
+func ParseFleetID(id string) (ctx, path string, ok bool) {
+	return strings.Cut(id, fleetSep)
+}
+
+type fleetResult struct {
+	member FleetMember
+	oo     []runtime.Object
+	err    error
+}
+
+// reconcileFleet lists a resource across the fleet concurrently and merges the results
+// with a leading context column. Members are rendered sequentially since renderers
+// track per call table state.
+func (t *Table) reconcileFleet(ctx context.Context, fleet Fleet, meta ResourceMeta) error {
+	rr := make([]fleetResult, len(fleet))
+	var wg sync.WaitGroup
+	for i, m := range fleet {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rr[i] = t.listMember(ctx, m, meta)
+		}()
+	}
+	wg.Wait()
+
+	var (
+		rows   model1.Rows
+		header model1.Header
+		errs   error
+	)
+	for _, r := range rr {
+		if r.err != nil {
+			slog.Warn("Fleet member list failed",
+				slogs.Context, r.member.Context,
+				slogs.GVR, t.gvr,
+				slogs.Error, r.err,
+			)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", r.member.Context, r.err))
+			continue
+		}
+		if len(r.oo) == 0 {
+			continue
+		}
+		data := model1.NewTableDataFull(t.gvr, t.data.GetNamespace(), model1.Header{}, model1.NewRowEvents(len(r.oo)))
+		if err := data.Render(memberContext(ctx, r.member), meta.Renderer, r.oo); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", r.member.Context, err))
+			continue
+		}
+		if header == nil && data.HeaderCount() > 0 {
+			header = append(model1.Header{{Name: FleetContextCol}}, data.Header()...)
+		}
+		data.RowsRange(func(_ int, re model1.RowEvent) bool {
+			rows = append(rows, model1.Row{
+				ID:     FleetID(r.member.Context, re.Row.ID),
+				Fields: append(model1.Fields{r.member.Context}, re.Row.Fields...),
+			})
+			return true
+		})
+	}
+	if header == nil {
+		if errs != nil {
+			return errs
+		}
+		header = append(model1.Header{{Name: FleetContextCol}}, meta.Renderer.Header(t.data.GetNamespace())...)
+	}
+	t.data.Update(rows)
+	t.data.SetHeader(t.data.GetNamespace(), header)
+
+	return nil
+}
+
+func (t *Table) listMember(ctx context.Context, m FleetMember, meta ResourceMeta) fleetResult {
+	r := fleetResult{member: m}
+	// DAOs are stateful, hence each member gets its own.
+	a, ok := reflect.New(reflect.TypeOf(meta.DAO).Elem()).Interface().(dao.Accessor)
+	if !ok {
+		r.err = fmt.Errorf("invalid accessor for %s", t.gvr)
+		return r
+	}
+	if t.vs != nil {
+		a.SetIncludeObject(true)
+	}
+	r.oo, r.err = t.list(memberContext(ctx, m), a)
+
+	return r
+}
+
+func memberContext(ctx context.Context, m FleetMember) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyFactory, m.Factory)
+
+	return context.WithValue(ctx, internal.KeyWithMetrics, m.Factory.Client().HasMetrics())
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestParseFleetID(t *testing.T) {
+	uu := map[string]struct {
+		id       string
+		ctx, fqn string
+		ok       bool
+	}{
+		"plain": {
+			id: "fred/p1",
+		},
+		"fleet": {
+			id:  model.FleetID("prod", "fred/p1"),
+			ctx: "prod",
+			fqn: "fred/p1",
+			ok:  true,
+		},
+		"at-context": {
+			id:  model.FleetID("kubernetes-admin@kubernetes", "apps/v1/deployments|fred|dp1"),
+			ctx: "kubernetes-admin@kubernetes",
+			fqn: "apps/v1/deployments|fred|dp1",
+			ok:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, fqn, ok := model.ParseFleetID(u.id)
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.ctx, ctx)
+				assert.Equal(t, u.fqn, fqn)
+			}
+		})
+	}
+}
+
+func TestTableFleetRefresh(t *testing.T) {
+	ta := model.NewTable(client.PodGVR)
+	ta.SetNamespace(client.NamespaceAll)
+
+	l := tableListener{}
+	ta.AddListener(&l)
+	f1, f2 := makeTableFactory(), makeTableFactory()
+	f1.rows, f2.rows = []runtime.Object{mustLoad("p1")}, []runtime.Object{mustLoad("p1")}
+	fleet := model.Fleet{
+		{Context: "prod", Factory: f1},
+		{Context: "staging", Factory: f2},
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyFleet, fleet)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	require.NoError(t, ta.Refresh(ctx))
+
+	data := ta.Peek()
+	assert.Equal(t, 27, data.HeaderCount())
+	assert.Equal(t, model.FleetContextCol, data.Header()[0].Name)
+	assert.Equal(t, 2, data.RowCount())
+	for _, ct := range []string{"prod", "staging"} {
+		re, ok := data.FindRow(model.FleetID(ct, "default/nginx-7fb78fb6d8-2w75j"))
+		require.True(t, ok, ct)
+		assert.Equal(t, ct, re.Row.Fields[0])
+	}
+	assert.Equal(t, 1, l.count)
+	assert.Equal(t, 0, l.errs)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
@@ -37,6 +38,9 @@ type LogsListener interface {
 
 	// LogCanceled indicates no more logs will come.
 	LogCanceled()
+
+	// LogAlerted indicates a log line matched an alerting highlight rule.
+	LogAlerted(*dao.LogItem, config.LogHighlight)
 }
 
 // Log represents a resource logger.
@@ -51,6 +55,13 @@ type Log struct {
 	filter       string
 	lastSent     int
 	flushTimeout time.Duration
+	json         config.LogJSON
+	highlighter  *dao.LogHighlighter
+	capture      *dao.LogCapture
+	sinks        map[string]dao.LogSink
+	backend      dao.LogBackend
+	backendLimit int
+	backfilled   time.Time
 }
 
 // NewLog returns a new model.
@@ -93,9 +104,130 @@ func (l *Log) ToggleShowTimestamp(b bool) {
 	l.Refresh()
 }
 
+// JSON returns the structured logs settings.
+func (l *Log) JSON() config.LogJSON {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.json
+}
+
+// SetJSON sets the structured logs settings.
+func (l *Log) SetJSON(cfg config.LogJSON) {
+	l.mx.Lock()
+	l.json = cfg
+	if cfg.Enabled {
+		l.lines.SetJSON(&cfg)
+	} else {
+		l.lines.SetJSON(nil)
+	}
+	l.mx.Unlock()
+	l.Refresh()
+}
+
+// SetCapture mirrors incoming log lines to disk. Nil disables the capture.
+func (l *Log) SetCapture(c *dao.LogCapture) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.capture = c
+}
+
+// SetBackend sets a historical logs backend. Nil disables it.
+func (l *Log) SetBackend(b dao.LogBackend, limit int) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.backend, l.backendLimit = b, limit
+}
+
+// BackendName returns the historical logs backend name if any.
+func (l *Log) BackendName() (string, bool) {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	if l.backend == nil {
+		return "", false
+	}
+
+	return l.backend.Name(), true
+}
+
+// HasSink checks if lines are mirrored to a named sink.
+func (l *Log) HasSink(name string) bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	_, ok := l.sinks[name]
+
+	return ok
+}
+
+// AddSink mirrors incoming log lines to a named sink.
+func (l *Log) AddSink(name string, s dao.LogSink) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.sinks == nil {
+		l.sinks = make(map[string]dao.LogSink)
+	}
+	l.sinks[name] = s
+}
+
+// RemoveSink stops mirroring lines to a named sink and closes it.
+func (l *Log) RemoveSink(name string) error {
+	l.mx.Lock()
+	s, ok := l.sinks[name]
+	delete(l.sinks, name)
+	l.mx.Unlock()
+	if !ok {
+		return nil
+	}
+
+	return s.Close()
+}
+
+// CloseSinks closes all log sinks.
+func (l *Log) CloseSinks() {
+	l.mx.Lock()
+	ss := l.sinks
+	l.sinks = nil
+	l.mx.Unlock()
+
+	for name, s := range ss {
+		if err := s.Close(); err != nil {
+			slog.Warn("Log sink close failed", slogs.Name, name, slogs.Error, err)
+		}
+	}
+}
+
+// SendTo sends the current log buffer to a sink and closes it.
+func (l *Log) SendTo(s dao.LogSink) error {
+	l.mx.RLock()
+	ll := slices.Clone(l.lines.Items())
+	l.mx.RUnlock()
+
+	for _, item := range ll {
+		if err := s.Write(item); err != nil {
+			_ = s.Close()
+			return err
+		}
+	}
+
+	return s.Close()
+}
+
+// ToggleJSON toggles structured logs rendering.
+func (l *Log) ToggleJSON(b bool) {
+	cfg := l.JSON()
+	cfg.Enabled = b
+	l.SetJSON(cfg)
+}
+
 func (l *Log) Head(ctx context.Context) {
 	l.mx.Lock()
 	l.logOptions.Head = true
+	l.logOptions.ClearTimeRange()
 	l.mx.Unlock()
 	l.Restart(ctx)
 }
@@ -103,13 +235,37 @@ func (l *Log) Head(ctx context.Context) {
 // SetSinceSeconds sets the logs retrieval time.
 func (l *Log) SetSinceSeconds(ctx context.Context, i int64) {
 	l.logOptions.SinceSeconds, l.logOptions.Head = i, false
+	l.logOptions.ClearTimeRange()
+	l.Restart(ctx)
+}
+
+// SetTimeRange sets the logs retrieval time range.
+func (l *Log) SetTimeRange(ctx context.Context, from, until time.Time) error {
+	l.mx.Lock()
+	err := l.logOptions.SetTimeRange(from, until)
+	l.mx.Unlock()
+	if err != nil {
+		return err
+	}
 	l.Restart(ctx)
+
+	return nil
+}
+
+// TimeRange returns the logs time range if any.
+func (l *Log) TimeRange() (string, bool) {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.logOptions.TimeRange(), l.logOptions.HasTimeRange()
 }
 
 // Configure sets logger configuration.
 func (l *Log) Configure(opts config.Logger) {
 	l.logOptions.Lines = opts.TailCount
 	l.logOptions.SinceSeconds = opts.SinceSeconds
+	l.highlighter = dao.NewLogHighlighter(opts.Highlights)
+	l.lines.SetHighlighter(l.highlighter)
 }
 
 // GetPath returns resource path.
@@ -169,6 +325,11 @@ func (l *Log) Start(ctx context.Context) {
 // Stop terminates logging.
 func (l *Log) Stop() {
 	l.cancel()
+	if l.capture != nil {
+		if err := l.capture.Close(); err != nil {
+			slog.Warn("Log capture close failed", slogs.Error, err)
+		}
+	}
 }
 
 // Set sets the log lines (for testing only!)
@@ -228,26 +389,118 @@ func (l *Log) load(ctx context.Context) error {
 	ctx = context.WithValue(ctx, internal.KeyFactory, l.factory)
 	ctx, l.cancelFn = context.WithCancel(ctx)
 
-	cc, err := loggable.TailLogs(ctx, l.logOptions)
+	if b := l.historicalBackend(); b != nil {
+		go l.backfill(ctx, loggable, b)
+		return nil
+	}
+	l.tail(ctx, loggable, l.logOptions)
+
+	return nil
+}
+
+func (l *Log) tail(ctx context.Context, loggable dao.Loggable, opts *dao.LogOptions) {
+	cc, err := loggable.TailLogs(ctx, opts)
 	if err != nil {
 		slog.Error("Tail logs failed", slogs.Error, err)
 		l.cancel()
 		l.fireLogError(err)
 	}
+	if len(cc) > 1 {
+		cc = []dao.LogChan{dao.NewLogMux(dao.LogMuxWindow).Mux(ctx, cc)}
+	}
 	for _, c := range cc {
 		go l.updateLogs(ctx, c)
 	}
+}
 
-	return nil
+// historicalBackend returns the logs backend when a pod logs time range is requested.
+func (l *Log) historicalBackend() dao.LogBackend {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.backfilled = time.Time{}
+	if l.backend == nil || l.logOptions.From.IsZero() || (l.gvr != client.PodGVR && l.gvr != client.CoGVR) {
+		return nil
+	}
+
+	return l.backend
+}
+
+// backfill loads logs from the historical backend then resumes kubelet streaming
+// past the last historical line.
+func (l *Log) backfill(ctx context.Context, loggable dao.Loggable, b dao.LogBackend) {
+	l.mx.RLock()
+	opts, limit := l.logOptions.Clone(), l.backendLimit
+	l.mx.RUnlock()
+
+	until := opts.Until
+	if until.IsZero() || until.After(time.Now()) {
+		until = time.Now()
+	}
+	ns, po := client.Namespaced(opts.Path)
+	items, err := b.Query(ctx, dao.LogQuery{
+		Namespace: ns,
+		Pod:       po,
+		Container: opts.Container,
+		From:      opts.From,
+		Until:     until,
+		Limit:     limit,
+	})
+	if err != nil {
+		slog.Warn("Historical logs query failed", slogs.Error, err)
+		l.fireLogError(fmt.Errorf("%s historical logs query failed: %w", b.Name(), err))
+	}
+	for _, item := range items {
+		l.Append(item)
+	}
+	if n := len(items); n > 0 {
+		if ts, err := time.Parse(time.RFC3339Nano, items[n-1].GetTimestamp()); err == nil {
+			l.mx.Lock()
+			l.backfilled = ts
+			l.mx.Unlock()
+			opts.From = ts
+		}
+	}
+	l.Notify()
+	if ctx.Err() != nil {
+		return
+	}
+	l.tail(ctx, loggable, opts)
+}
+
+// isBackfilled checks if a kubelet line was already fetched from the historical backend.
+func (l *Log) isBackfilled(line *dao.LogItem) bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	if l.backfilled.IsZero() {
+		return false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, line.GetTimestamp())
+
+	return err == nil && !ts.After(l.backfilled)
 }
 
 // Append adds a log line.
 func (l *Log) Append(line *dao.LogItem) {
-	if line == nil || line.IsEmpty() {
+	if line == nil || line.IsEmpty() || l.isBackfilled(line) {
 		return
 	}
+	if rule, ok := l.highlighter.Match(line); ok && rule.IsAlert() {
+		defer l.fireLogAlerted(line, rule)
+	}
 	l.mx.Lock()
 	defer l.mx.Unlock()
+	if l.capture != nil {
+		if err := l.capture.Write(l.logOptions.Path, line); err != nil {
+			slog.Warn("Log capture failed", slogs.Dir, l.capture.Dir(), slogs.Error, err)
+		}
+	}
+	for name, s := range l.sinks {
+		if err := s.Write(line); err != nil {
+			slog.Warn("Log sink failed", slogs.Name, name, slogs.Error, err)
+		}
+	}
 	l.logOptions.SinceTime = line.GetTimestamp()
 	if l.lines.Len() < int(l.logOptions.Lines) {
 		l.lines.Add(line)
@@ -403,6 +656,16 @@ func (l *Log) fireLogChanged(lines [][]byte) {
 	}
 }
 
+func (l *Log) fireLogAlerted(item *dao.LogItem, rule config.LogHighlight) {
+	var ll []LogsListener
+	l.mx.RLock()
+	ll = l.listeners
+	l.mx.RUnlock()
+	for _, lis := range ll {
+		lis.LogAlerted(item, rule)
+	}
+}
+
 func (l *Log) fireLogCleared() {
 	var ll []LogsListener
 	l.mx.RLock()
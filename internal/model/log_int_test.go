@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
 )
@@ -77,8 +78,9 @@ func newMockLogView() *mockLogView {
 func (t *mockLogView) LogChanged(ll [][]byte) {
 	t.count += len(ll)
 }
-func (*mockLogView) LogStop()        {}
-func (*mockLogView) LogCanceled()    {}
-func (*mockLogView) LogResume()      {}
-func (*mockLogView) LogCleared()     {}
-func (*mockLogView) LogFailed(error) {}
+func (*mockLogView) LogStop()                                     {}
+func (*mockLogView) LogCanceled()                                 {}
+func (*mockLogView) LogResume()                                   {}
+func (*mockLogView) LogCleared()                                  {}
+func (*mockLogView) LogFailed(error)                              {}
+func (*mockLogView) LogAlerted(*dao.LogItem, config.LogHighlight) {}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/watch"
@@ -45,6 +46,30 @@ func TestLogFullBuffer(t *testing.T) {
 	assert.Equal(t, 0, v.errCalled)
 }
 
+func TestLogHighlights(t *testing.T) {
+	m := model.NewLog(client.NewGVR("fred"), makeLogOpts(10), 10*time.Millisecond)
+	m.Configure(config.Logger{
+		TailCount: 10,
+		Highlights: []config.LogHighlight{
+			{Pattern: "panic:", Color: "red", Beep: true},
+			{Pattern: "warn", Color: "orange"},
+		},
+	})
+	m.Init(makeFactory())
+
+	v := newTestView()
+	m.AddListener(v)
+	for _, l := range []string{"ok", "panic: boom", "a warning"} {
+		m.Append(dao.NewLogItemFromString("2024-01-01T00:00:00Z " + l))
+	}
+	m.Notify()
+
+	assert.Equal(t, []string{"panic:@2024-01-01T00:00:00Z panic: boom"}, v.alerts)
+	assert.Equal(t, "ok", string(v.data[0]))
+	assert.Equal(t, "[:red:]panic: boom[:-:]", string(v.data[1]))
+	assert.Equal(t, "[:orange:]a warning[:-:]", string(v.data[2]))
+}
+
 func TestLogFilter(t *testing.T) {
 	uu := map[string]struct {
 		q string
@@ -257,6 +282,7 @@ type testView struct {
 	dataCalled  int
 	clearCalled int
 	errCalled   int
+	alerts      []string
 }
 
 func newTestView() *testView {
@@ -281,6 +307,10 @@ func (t *testView) LogFailed(error) {
 	t.errCalled++
 }
 
+func (t *testView) LogAlerted(item *dao.LogItem, rule config.LogHighlight) {
+	t.alerts = append(t.alerts, rule.Pattern+"@"+string(item.Bytes))
+}
+
 // ----------------------------------------------------------------------------
 
 type testFactory struct{}
@@ -19,10 +19,54 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.Workload),
 		Renderer: new(render.Workload),
 	},
+	client.WkhGVR: {
+		DAO:      new(dao.WorkloadHealth),
+		Renderer: new(render.WorkloadHealth),
+	},
+	client.NocGVR: {
+		DAO:      new(dao.NodeCapacity),
+		Renderer: new(render.NodeCapacity),
+	},
+	client.StoGVR: {
+		DAO:      new(dao.Storage),
+		Renderer: new(render.Storage),
+	},
+	client.CexGVR: {
+		DAO:      new(dao.CertExpiry),
+		Renderer: new(render.CertExpiry),
+	},
+	client.RtGVR: {
+		DAO:      new(dao.Routing),
+		Renderer: new(render.Routing),
+	},
 	client.RefGVR: {
 		DAO:      new(dao.Reference),
 		Renderer: new(render.Reference),
 	},
+	client.TlGVR: {
+		DAO:      new(dao.Timeline),
+		Renderer: new(render.Timeline),
+	},
+	client.RevGVR: {
+		DAO:      new(dao.RolloutHistory),
+		Renderer: new(render.RolloutHistory),
+	},
+	client.JrGVR: {
+		DAO:      new(dao.CronJobRuns),
+		Renderer: new(render.JobRun),
+	},
+	client.SkGVR: {
+		DAO:      new(dao.SecretKeys),
+		Renderer: new(render.SecretKey),
+	},
+	client.SepGVR: {
+		DAO:      new(dao.ServiceEndpoints),
+		Renderer: new(render.ServiceEndpoint),
+	},
+	client.EsGVR: {
+		DAO:      new(dao.EventStream),
+		Renderer: new(render.EventStream),
+	},
 	client.DirGVR: {
 		DAO:      new(dao.Dir),
 		Renderer: new(render.Dir),
@@ -55,6 +99,26 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.ScreenDump),
 		Renderer: new(render.ScreenDump),
 	},
+	client.MutGVR: {
+		DAO:      new(dao.Mutations),
+		Renderer: new(render.Mutation),
+	},
+	client.MonGVR: {
+		DAO:      new(dao.Monitors),
+		Renderer: new(render.Monitor),
+	},
+	client.AlGVR: {
+		DAO:      new(dao.Alerts),
+		Renderer: new(render.Alert),
+	},
+	client.AdmGVR: {
+		DAO:      new(dao.Admission),
+		Renderer: new(render.Admission),
+	},
+	client.FndGVR: {
+		DAO:      new(dao.Finder),
+		Renderer: new(render.Find),
+	},
 	client.RbacGVR: {
 		DAO:      new(dao.Rbac),
 		Renderer: new(render.Rbac),
@@ -63,6 +127,14 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.Policy),
 		Renderer: new(render.Policy),
 	},
+	client.WcGVR: {
+		DAO:      new(dao.WhoCan),
+		Renderer: new(render.WhoCan),
+	},
+	client.PermGVR: {
+		DAO:      new(dao.Permission),
+		Renderer: new(render.Permission),
+	},
 	client.UsrGVR: {
 		DAO:      new(dao.Subject),
 		Renderer: new(render.Subject),
@@ -179,6 +251,35 @@ var Registry = map[*client.GVR]ResourceMeta{
 	client.ScGVR: {
 		Renderer: &render.StorageClass{},
 	},
+	client.VsGVR: {
+		DAO:      new(dao.VolumeSnapshot),
+		Renderer: new(render.VolumeSnapshot),
+	},
+	client.VscGVR: {
+		Renderer: new(render.VolumeSnapshotContent),
+	},
+
+	// Secrets operators...
+	client.SsGVR: {
+		Renderer: new(render.SealedSecret),
+	},
+	client.ExsGVR: {
+		DAO:      new(dao.ExternalSecret),
+		Renderer: new(render.ExternalSecret),
+	},
+	// Older external-secrets releases only serve v1beta1.
+	client.ExsBetaGVR: {
+		DAO:      new(dao.ExternalSecret),
+		Renderer: new(render.ExternalSecret),
+	},
+
+	// Gateway API...
+	client.GwGVR: {
+		Renderer: new(render.Gateway),
+	},
+	client.HrGVR: {
+		Renderer: new(render.HTTPRoute),
+	},
 
 	// Policy...
 	client.PdbGVR: {
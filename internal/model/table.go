@@ -273,9 +273,17 @@ func (t *Table) reconcile(ctx context.Context) error {
 	meta := resourceMeta(t.gvr)
 	if t.vs != nil {
 		meta.DAO.SetIncludeObject(true)
+		ctx = context.WithValue(ctx, internal.KeyExtraColumns, t.vs.ExtraColumns)
 	}
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelSelector)
+	if fleet, ok := ctx.Value(internal.KeyFleet).(Fleet); ok {
+		meta.Renderer.SetViewSetting(t.vs)
+		return t.reconcileFleet(ctx, fleet, meta)
+	}
 	if t.instance == "" {
+		if t.data.RowCount() == 0 {
+			ctx = context.WithValue(ctx, internal.KeyTablePage, t.pageRenderer(ctx, meta.Renderer))
+		}
 		oo, err = t.list(ctx, meta.DAO)
 	} else {
 		o, e := t.Get(ctx, t.instance)
@@ -290,6 +298,21 @@ func (t *Table) reconcile(ctx context.Context) error {
 	return t.data.Render(ctx, meta.Renderer, oo)
 }
 
+// pageRenderer renders partial results so large lists load progressively.
+func (t *Table) pageRenderer(ctx context.Context, r model1.Renderer) dao.TablePageFunc {
+	return func(o runtime.Object) {
+		r.SetViewSetting(t.vs)
+		if err := t.data.Render(ctx, r, []runtime.Object{o}); err != nil {
+			slog.Warn("Partial table render failed",
+				slogs.GVR, t.gvr,
+				slogs.Error, err,
+			)
+			return
+		}
+		t.fireTableChanged(t.Peek())
+	}
+}
+
 func (t *Table) fireTableChanged(data *model1.TableData) {
 	var ll []TableListener
 	t.mx.RLock()
@@ -51,6 +51,7 @@ type ResourceViewer interface {
 type EncDecResourceViewer interface {
 	ResourceViewer
 	Toggle()
+	Decoded() bool
 }
 
 // Igniter represents a runnable view.
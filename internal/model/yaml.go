@@ -23,6 +23,9 @@ import (
 // ManagedFieldsOpts tracks managed fields.
 const ManagedFieldsOpts = "ManagedFields"
 
+// CleanOpts tracks manifest cleaning of server populated and defaulted fields.
+const CleanOpts = "Clean"
+
 // YAML tracks yaml resource representations.
 type YAML struct {
 	gvr       *client.GVR
@@ -165,6 +168,11 @@ func (y *YAML) reconcile(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if y.options[CleanOpts] {
+		if s, err = dao.CleanYAML(s); err != nil {
+			return err
+		}
+	}
 	lines := strings.Split(s, "\n")
 	if reflect.DeepEqual(lines, y.lines) {
 		return nil
@@ -217,3 +225,8 @@ func (y *YAML) ToYAML(ctx context.Context, gvr *client.GVR, path string, showMan
 func (y *YAML) Toggle() {
 	y.decode = !y.decode
 }
+
+// Decoded returns true if secret values are shown decoded.
+func (y *YAML) Decoded() bool {
+	return y.decode
+}
@@ -26,6 +26,7 @@ type Attrs struct {
 	Capacity  bool
 	VS        bool
 	Hide      bool
+	Width     int
 }
 
 func (a Attrs) Merge(b Attrs) Attrs {
@@ -52,6 +53,9 @@ func (a Attrs) Merge(b Attrs) Attrs {
 	if !a.Capacity {
 		a.Capacity = b.Capacity
 	}
+	if a.Width == 0 {
+		a.Width = b.Width
+	}
 
 	return a
 }
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"sort"
+
+	"github.com/fvbommel/sortorder"
 )
 
 type ReRangeFn func(int, RowEvent) bool
@@ -273,6 +275,15 @@ func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity,
 	r.reindex()
 }
 
+// SortBy sorts rows given a collection of sort keys, subsequent keys break ties.
+func (r *RowEvents) SortBy(kk []SortKey) {
+	if r == nil || len(kk) == 0 {
+		return
+	}
+	sort.Sort(multiSorter{events: r, keys: kk})
+	r.reindex()
+}
+
 // For debugging...
 func (re RowEvents) Dump(msg string) {
 	slog.Debug("[DEBUG] RowEvents" + msg)
@@ -312,3 +323,43 @@ func (r RowEventSorter) Less(i, j int) bool {
 
 	return !less
 }
+
+// SortKey represents a column sort criteria.
+type SortKey struct {
+	Index      int
+	IsNumber   bool
+	IsDuration bool
+	IsCapacity bool
+	Asc        bool
+}
+
+// multiSorter sorts row events by multiple columns.
+type multiSorter struct {
+	events *RowEvents
+	keys   []SortKey
+}
+
+func (m multiSorter) Len() int {
+	return len(m.events.events)
+}
+
+func (m multiSorter) Swap(i, j int) {
+	m.events.events[i], m.events.events[j] = m.events.events[j], m.events.events[i]
+}
+
+func (m multiSorter) Less(i, j int) bool {
+	f1, f2 := m.events.events[i].Row.Fields, m.events.events[j].Row.Fields
+	id1, id2 := m.events.events[i].Row.ID, m.events.events[j].Row.ID
+	for _, k := range m.keys {
+		if f1[k.Index] == f2[k.Index] {
+			continue
+		}
+		less := Less(k.IsNumber, k.IsDuration, k.IsCapacity, id1, id2, f1[k.Index], f2[k.Index])
+		if k.Asc {
+			return less
+		}
+		return !less
+	}
+
+	return sortorder.NaturalLess(id1, id2)
+}
@@ -36,6 +36,42 @@ func (s SortColumn) IsSet() bool {
 	return s.Name != ""
 }
 
+// String returns the column sort spec.
+func (s SortColumn) String() string {
+	if s.ASC {
+		return s.Name + ":asc"
+	}
+
+	return s.Name + ":desc"
+}
+
+// ParseSortSpec parses a multi columns sort spec ie `STATUS:asc,AGE:desc`.
+func ParseSortSpec(spec string) ([]SortColumn, error) {
+	ss := strings.Split(spec, ",")
+	cc := make([]SortColumn, 0, len(ss))
+	for _, s := range ss {
+		name, order, ok := strings.Cut(strings.TrimSpace(s), ":")
+		if !ok || name == "" || (order != "asc" && order != "desc") {
+			return nil, fmt.Errorf("invalid sort column spec: %q. must be col-name:asc|desc", s)
+		}
+		cc = append(cc, SortColumn{Name: name, ASC: order == "asc"})
+	}
+
+	return cc, nil
+}
+
+// SortSpec returns the sort spec for the given columns.
+func SortSpec(cc ...SortColumn) string {
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		if c.IsSet() {
+			ss = append(ss, c.String())
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
+
 const spacer = " "
 
 type FilterOpts struct {
@@ -104,11 +140,22 @@ func (t *TableData) RowsRange(f ReRangeFn) {
 	t.rowEvents.Range(f)
 }
 
-func (t *TableData) Sort(sc SortColumn) {
+// Sort sorts the table rows given a sort column. Additional columns break ties in order.
+func (t *TableData) Sort(sc SortColumn, then ...SortColumn) {
 	col, idx := t.HeadCol(sc.Name, false)
 	if idx < 0 {
 		return
 	}
+	if len(then) > 0 {
+		kk := []SortKey{{Index: idx, IsDuration: col.Time, IsNumber: col.MX, IsCapacity: col.Capacity, Asc: sc.ASC}}
+		for _, c := range then {
+			if col, idx := t.HeadCol(c.Name, false); idx >= 0 {
+				kk = append(kk, SortKey{Index: idx, IsDuration: col.Time, IsNumber: col.MX, IsCapacity: col.Capacity, Asc: c.ASC})
+			}
+		}
+		t.rowEvents.SortBy(kk)
+		return
+	}
 	t.rowEvents.Sort(
 		t.GetNamespace(),
 		idx,
@@ -146,7 +193,7 @@ func (t *TableData) Filter(f FilterOpts) *TableData {
 	if f.Toast {
 		td.rowEvents = t.filterToast()
 	}
-	if f.Filter == "" || internal.IsLabelSelector(f.Filter) {
+	if f.Filter == "" || internal.IsLabelSelector(f.Filter) || internal.IsFieldSelector(f.Filter) {
 		return td
 	}
 	if f, ok := internal.IsFuzzySelector(f.Filter); ok {
@@ -347,6 +394,19 @@ func (t *TableData) ComputeSortCol(vs *config.ViewSetting, sc SortColumn, manual
 	return sc
 }
 
+// ComputeThenCols returns the tie breaker sort columns specified by the view settings if any.
+func (*TableData) ComputeThenCols(vs *config.ViewSetting, then []SortColumn, manual bool) []SortColumn {
+	if manual || vs.IsBlank() {
+		return then
+	}
+	cc, err := ParseSortSpec(vs.SortColumn)
+	if err != nil || len(cc) < 2 {
+		return nil
+	}
+
+	return cc[1:]
+}
+
 func (t *TableData) sortCol(vs *config.ViewSetting) (SortColumn, error) {
 	var psc SortColumn
 
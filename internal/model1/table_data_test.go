@@ -5,6 +5,7 @@ package model1
 
 import (
 	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -89,6 +90,64 @@ func TestTableDataComputeSortCol(t *testing.T) {
 	}
 }
 
+func TestParseSortSpec(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    []SortColumn
+		err  bool
+	}{
+		"single": {spec: "AGE:asc", e: []SortColumn{{Name: "AGE", ASC: true}}},
+		"multi": {
+			spec: "STATUS:asc, AGE:desc",
+			e:    []SortColumn{{Name: "STATUS", ASC: true}, {Name: "AGE"}},
+		},
+		"no-order": {spec: "STATUS", err: true},
+		"toast":    {spec: "STATUS:up", err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			cc, err := ParseSortSpec(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, cc)
+			assert.Equal(t, strings.ReplaceAll(u.spec, " ", ""), SortSpec(cc...))
+		})
+	}
+}
+
+func TestTableDataSortMulti(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "RESTARTS", Attrs: Attrs{MX: true}},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "Running", "1"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "Pending", "2"}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c", "Running", "10"}}},
+			RowEvent{Row: Row{ID: "d", Fields: Fields{"d", "Pending", "0"}}},
+		),
+	)
+
+	td.Sort(SortColumn{Name: "STATUS", ASC: true}, SortColumn{Name: "RESTARTS"})
+	ids := make([]string, 0, td.RowCount())
+	td.RowsRange(func(_ int, re RowEvent) bool {
+		ids = append(ids, re.Row.ID)
+		return true
+	})
+	assert.Equal(t, []string{"b", "d", "c", "a"}, ids)
+
+	vs := config.ViewSetting{Columns: []string{"NAME"}, SortColumn: "STATUS:asc,RESTARTS:desc"}
+	assert.Equal(t, []SortColumn{{Name: "RESTARTS"}}, td.ComputeThenCols(&vs, nil, false))
+	assert.Equal(t, []SortColumn{{Name: "NAME", ASC: true}}, td.ComputeThenCols(&vs, []SortColumn{{Name: "NAME", ASC: true}}, true))
+}
+
 func TestTableDataDiff(t *testing.T) {
 	uu := map[string]struct {
 		t1, t2 *TableData
@@ -30,18 +30,25 @@ const (
 	k9sUA        = "k9s/"
 )
 
+// worker runs a benchmark load and writes out its report.
+type worker interface {
+	Run(ctx context.Context, w io.Writer) error
+}
+
 // Benchmark puts a workload under load.
 type Benchmark struct {
 	canceled bool
+	base     string
 	config   *config.BenchConfig
-	worker   *requester.Work
+	worker   worker
+	ctx      context.Context
 	cancelFn context.CancelFunc
 	mx       sync.RWMutex
 }
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base, version string, cfg *config.BenchConfig) (*Benchmark, error) {
-	b := Benchmark{config: cfg}
+	b := Benchmark{base: base, config: cfg}
 	if err := b.init(base, version); err != nil {
 		return nil, err
 	}
@@ -49,9 +56,31 @@ func NewBenchmark(base, version string, cfg *config.BenchConfig) (*Benchmark, er
 }
 
 func (b *Benchmark) init(base, version string) error {
-	var ctx context.Context
-	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
-	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, http.NoBody)
+	b.ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
+	switch p := b.config.ProtocolOrDefault(); p {
+	case config.BenchHTTP:
+		return b.initHTTP(base, version)
+	case config.BenchGRPC:
+		w, err := newGRPCWorker(base, b.config)
+		if err != nil {
+			return err
+		}
+		b.worker = w
+	case config.BenchTCP:
+		w, err := newTCPWorker(base, b.config)
+		if err != nil {
+			return err
+		}
+		b.worker = w
+	default:
+		return fmt.Errorf("unsupported benchmark protocol %q", p)
+	}
+
+	return nil
+}
+
+func (b *Benchmark) initHTTP(base, version string) error {
+	req, err := http.NewRequestWithContext(b.ctx, b.config.HTTP.Method, base, http.NoBody)
 	if err != nil {
 		return err
 	}
@@ -74,13 +103,13 @@ func (b *Benchmark) init(base, version string) error {
 	req.Header.Set("User-Agent", ua)
 
 	slog.Debug(fmt.Sprintf("Using bench config N:%d--C:%d", b.config.N, b.config.C))
-	b.worker = &requester.Work{
+	b.worker = &httpWorker{Work: &requester.Work{
 		Request:     req,
 		RequestBody: []byte(b.config.HTTP.Body),
 		N:           b.config.N,
 		C:           b.config.C,
 		H2:          b.config.HTTP.HTTP2,
-	}
+	}}
 
 	return nil
 }
@@ -111,12 +140,22 @@ func (b *Benchmark) Run(cluster, ct string, done func()) {
 		slogs.Cluster, cluster,
 		slogs.Context, ct,
 	)
-	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
+	buff, started := new(bytes.Buffer), time.Now()
+	writeHeader(buff,
+		RunID(b.config.Name, b.base, started),
+		b.base,
+		b.config.ProtocolOrDefault(),
+		b.config.C,
+		b.config.N,
+		started,
+	)
+	hdr := buff.Len()
 	// this call will block until the benchmark is complete or times out.
-	b.worker.Run()
-	b.worker.Stop()
-	if buff.Len() > 0 {
+	if err := b.worker.Run(b.ctx, buff); err != nil {
+		slog.Error("Benchmark run failed", slogs.Error, err)
+		fmt.Fprintf(buff, "\nError distribution:\n  [1]\t%s\n", err)
+	}
+	if buff.Len() > hdr {
 		if err := b.save(cluster, ct, buff); err != nil {
 			slog.Error("Saving Benchmark", slogs.Error, err)
 		}
@@ -124,6 +163,19 @@ func (b *Benchmark) Run(cluster, ct string, done func()) {
 	done()
 }
 
+// httpWorker runs HTTP loads via hey.
+type httpWorker struct {
+	*requester.Work
+}
+
+func (h *httpWorker) Run(_ context.Context, w io.Writer) error {
+	h.Writer = w
+	h.Work.Run()
+	h.Stop()
+
+	return nil
+}
+
 func (b *Benchmark) save(cluster, ct string, r io.Reader) error {
 	ns, n := client.Namespaced(b.config.Name)
 	n = strings.ReplaceAll(n, "|", "_")
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// grpcWorker benchmarks a gRPC unary method.
+type grpcWorker struct {
+	addr            string
+	service, method string
+	cfg             *config.BenchConfig
+}
+
+func newGRPCWorker(base string, cfg *config.BenchConfig) (*grpcWorker, error) {
+	addr, err := hostPort(base)
+	if err != nil {
+		return nil, err
+	}
+	svc, m, err := splitMethod(cfg.GRPC.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcWorker{addr: addr, service: svc, method: m, cfg: cfg}, nil
+}
+
+// Run invokes the method n times and reports calls latencies and status codes.
+func (g *grpcWorker) Run(ctx context.Context, w io.Writer) error {
+	creds := insecure.NewCredentials()
+	if g.cfg.GRPC.TLS {
+		creds = credentials.NewTLS(&tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: g.cfg.GRPC.Insecure, //nolint:gosec // opt-in for self-signed endpoints.
+		})
+	}
+	conn, err := grpc.NewClient(g.addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	md, err := g.methodDescriptor(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return fmt.Errorf("streaming method %s is not supported", g.cfg.GRPC.Method)
+	}
+	req := dynamicpb.NewMessage(md.Input())
+	if g.cfg.GRPC.Data != "" {
+		if err := protojson.Unmarshal([]byte(g.cfg.GRPC.Data), req); err != nil {
+			return fmt.Errorf("invalid request data for %s: %w", md.Input().FullName(), err)
+		}
+	}
+	if len(g.cfg.GRPC.Metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(g.cfg.GRPC.Metadata))
+	}
+
+	fqm := "/" + g.service + "/" + g.method
+	stats := runLoad(ctx, g.cfg.N, g.cfg.C, func(ctx context.Context) (string, error) {
+		err := conn.Invoke(ctx, fqm, req, dynamicpb.NewMessage(md.Output()))
+		s := status.Convert(err)
+
+		return s.Code().String(), err
+	})
+
+	return stats.write(w)
+}
+
+func (g *grpcWorker) methodDescriptor(ctx context.Context, conn *grpc.ClientConn) (protoreflect.MethodDescriptor, error) {
+	var (
+		fds *descriptorpb.FileDescriptorSet
+		err error
+	)
+	if g.cfg.GRPC.Protoset != "" {
+		fds, err = loadProtoset(g.cfg.GRPC.Protoset)
+	} else {
+		fds, err = reflectFiles(ctx, conn, g.service)
+	}
+	if err != nil {
+		return nil, err
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, err
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(g.service))
+	if err != nil {
+		return nil, fmt.Errorf("unable to locate service %s: %w", g.service, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", g.service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(g.method))
+	if md == nil {
+		return nil, fmt.Errorf("unable to locate method %s on service %s", g.method, g.service)
+	}
+
+	return md, nil
+}
+
+func loadProtoset(path string) (*descriptorpb.FileDescriptorSet, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(bb, &fds); err != nil {
+		return nil, fmt.Errorf("invalid protoset %s: %w", path, err)
+	}
+
+	return &fds, nil
+}
+
+// reflectFiles fetches a service file descriptors and their dependencies via server reflection.
+func reflectFiles(ctx context.Context, conn *grpc.ClientConn, svc string) (*descriptorpb.FileDescriptorSet, error) {
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.CloseSend() }()
+
+	files, asked := make(map[string]*descriptorpb.FileDescriptorProto), make(map[string]bool)
+	var fds descriptorpb.FileDescriptorSet
+	req := &rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: svc},
+	}
+	for req != nil {
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if e := resp.GetErrorResponse(); e != nil {
+			return nil, errors.New(e.GetErrorMessage())
+		}
+		for _, bb := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			var fd descriptorpb.FileDescriptorProto
+			if err := proto.Unmarshal(bb, &fd); err != nil {
+				return nil, err
+			}
+			if _, ok := files[fd.GetName()]; !ok {
+				files[fd.GetName()] = &fd
+				fds.File = append(fds.File, &fd)
+			}
+		}
+		req = nil
+		for _, fd := range fds.File {
+			if dep, ok := missingDep(files, fd); ok {
+				if asked[dep] {
+					return nil, fmt.Errorf("server reflection did not return file %s", dep)
+				}
+				asked[dep] = true
+				req = &rpb.ServerReflectionRequest{
+					MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep},
+				}
+				break
+			}
+		}
+	}
+
+	return &fds, nil
+}
+
+func missingDep(files map[string]*descriptorpb.FileDescriptorProto, fd *descriptorpb.FileDescriptorProto) (string, bool) {
+	for _, dep := range fd.GetDependency() {
+		if _, ok := files[dep]; !ok {
+			return dep, true
+		}
+	}
+
+	return "", false
+}
+
+// splitMethod splits pkg.Service/Method or pkg.Service.Method into service and method names.
+func splitMethod(s string) (string, string, error) {
+	s = strings.TrimPrefix(s, "/")
+	if svc, m, ok := strings.Cut(s, "/"); ok && svc != "" && m != "" {
+		return svc, m, nil
+	}
+	if i := strings.LastIndex(s, "."); i > 0 && i < len(s)-1 {
+		return s[:i], s[i+1:], nil
+	}
+
+	return "", "", fmt.Errorf("invalid grpc method %q. Expecting pkg.Service/Method", s)
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StatusOK tracks a successful call status.
+const StatusOK = "OK"
+
+var latencyPercentiles = []int{10, 25, 50, 75, 90, 95, 99}
+
+// callFunc issues one benchmark call. It returns the call status and an error if the call failed.
+type callFunc func(ctx context.Context) (string, error)
+
+// loadStats tracks a load run outcome.
+type loadStats struct {
+	total    time.Duration
+	lats     []time.Duration
+	statuses map[string]int
+	errors   map[string]int
+	mx       sync.Mutex
+}
+
+func newLoadStats() *loadStats {
+	return &loadStats{
+		statuses: make(map[string]int),
+		errors:   make(map[string]int),
+	}
+}
+
+func (s *loadStats) record(d time.Duration, status string, err error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.lats = append(s.lats, d)
+	if status != "" {
+		s.statuses[status]++
+	}
+	if err != nil {
+		s.errors[err.Error()]++
+	}
+}
+
+// runLoad issues n calls using c concurrent workers till done or canceled.
+func runLoad(ctx context.Context, n, c int, fn callFunc) *loadStats {
+	n, c = max(n, 1), max(c, 1)
+	stats := newLoadStats()
+	jobs := make(chan struct{}, n)
+	for range n {
+		jobs <- struct{}{}
+	}
+	close(jobs)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range min(c, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				if ctx.Err() != nil {
+					return
+				}
+				t := time.Now()
+				status, err := fn(ctx)
+				stats.record(time.Since(t), status, err)
+			}
+		}()
+	}
+	wg.Wait()
+	stats.total = time.Since(start)
+
+	return stats
+}
+
+// write dumps a hey compatible report so runs render in the benchmarks view.
+func (s *loadStats) write(w io.Writer) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if len(s.lats) == 0 {
+		_, err := fmt.Fprint(w, "\nError distribution:\n  [1]\tno calls completed\n")
+		return err
+	}
+	lats := slices.Clone(s.lats)
+	slices.Sort(lats)
+	var sum time.Duration
+	for _, l := range lats {
+		sum += l
+	}
+	total := max(s.total.Seconds(), 1e-9)
+
+	fmt.Fprint(w, "\nSummary:\n")
+	fmt.Fprintf(w, "  Total:\t%4.4f secs\n", s.total.Seconds())
+	fmt.Fprintf(w, "  Slowest:\t%4.4f secs\n", lats[len(lats)-1].Seconds())
+	fmt.Fprintf(w, "  Fastest:\t%4.4f secs\n", lats[0].Seconds())
+	fmt.Fprintf(w, "  Average:\t%4.4f secs\n", (sum / time.Duration(len(lats))).Seconds())
+	fmt.Fprintf(w, "  Requests/sec:\t%4.4f\n\n", float64(len(lats))/total)
+
+	fmt.Fprintf(w, "Latency distribution:\n")
+	for _, p := range latencyPercentiles {
+		idx := min(len(lats)*p/100, len(lats)-1)
+		fmt.Fprintf(w, "  %d%% in %4.4f secs\n", p, lats[idx].Seconds())
+	}
+
+	if len(s.statuses) > 0 {
+		fmt.Fprintf(w, "\nStatus code distribution:\n")
+		for _, k := range sortedKeys(s.statuses) {
+			fmt.Fprintf(w, "  [%s]\t%d responses\n", k, s.statuses[k])
+		}
+	}
+	if len(s.errors) > 0 {
+		fmt.Fprintf(w, "\nError distribution:\n")
+		for _, k := range sortedKeys(s.errors) {
+			fmt.Fprintf(w, "  [%d]\t%s\n", s.errors[k], k)
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]int) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+// hostPort extracts a dial address from a benchmark base url.
+func hostPort(base string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("no host found in benchmark url %q", base)
+	}
+	if u.Port() == "" {
+		return "", fmt.Errorf("no port found in benchmark url %q", base)
+	}
+
+	return u.Host, nil
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func TestRunLoad(t *testing.T) {
+	var calls atomic.Int32
+	stats := runLoad(context.Background(), 10, 3, func(context.Context) (string, error) {
+		if calls.Add(1)%5 == 0 {
+			return "Unavailable", errors.New("boom")
+		}
+		return StatusOK, nil
+	})
+
+	var buff bytes.Buffer
+	require.NoError(t, stats.write(&buff))
+	out := buff.String()
+	assert.Contains(t, out, "Requests/sec:")
+	assert.Contains(t, out, "[OK]\t8 responses")
+	assert.Contains(t, out, "[Unavailable]\t2 responses")
+	assert.Contains(t, out, "Error distribution:\n  [2]\tboom")
+}
+
+func TestRunLoadCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats := runLoad(ctx, 10, 2, func(context.Context) (string, error) {
+		return StatusOK, nil
+	})
+
+	var buff bytes.Buffer
+	require.NoError(t, stats.write(&buff))
+	assert.Contains(t, buff.String(), "no calls completed")
+}
+
+func TestSplitMethod(t *testing.T) {
+	uu := map[string]struct {
+		m, svc, method string
+		err            bool
+	}{
+		"slash":  {m: "grpc.health.v1.Health/Check", svc: "grpc.health.v1.Health", method: "Check"},
+		"full":   {m: "/grpc.health.v1.Health/Check", svc: "grpc.health.v1.Health", method: "Check"},
+		"dotted": {m: "grpc.health.v1.Health.Check", svc: "grpc.health.v1.Health", method: "Check"},
+		"toast":  {m: "Check", err: true},
+		"blank":  {err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc, m, err := splitMethod(u.m)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.svc, svc)
+			assert.Equal(t, u.method, m)
+		})
+	}
+}
+
+func TestTCPWorker(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			_ = c.Close()
+		}
+	}()
+
+	w, err := newTCPWorker("http://"+l.Addr().String()+"/", &config.BenchConfig{N: 5, C: 2})
+	require.NoError(t, err)
+	var buff bytes.Buffer
+	require.NoError(t, w.Run(context.Background(), &buff))
+	assert.Contains(t, buff.String(), "[OK]\t5 responses")
+	assert.NotContains(t, buff.String(), "Error distribution")
+}
+
+func TestGRPCWorker(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	reflection.Register(s)
+	go func() { _ = s.Serve(l) }()
+	defer s.Stop()
+
+	cfg := config.BenchConfig{
+		N:        6,
+		C:        2,
+		Protocol: config.BenchGRPC,
+		GRPC:     config.GRPC{Method: "grpc.health.v1.Health/Check", Data: `{"service": ""}`},
+	}
+	w, err := newGRPCWorker("http://"+l.Addr().String(), &cfg)
+	require.NoError(t, err)
+	var buff bytes.Buffer
+	require.NoError(t, w.Run(context.Background(), &buff))
+	assert.Contains(t, buff.String(), "[OK]\t6 responses")
+
+	cfg.GRPC.Data = `{"service": "fred"}`
+	buff.Reset()
+	require.NoError(t, w.Run(context.Background(), &buff))
+	assert.Contains(t, buff.String(), "[NotFound]\t6 responses")
+
+	cfg.GRPC.Method = "grpc.health.v1.Health/Zorg"
+	w, err = newGRPCWorker("http://"+l.Addr().String(), &cfg)
+	require.NoError(t, err)
+	require.Error(t, w.Run(context.Background(), &buff))
+}
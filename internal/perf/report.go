@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // only used to derive short run ids.
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+var (
+	runRx      = regexp.MustCompile(`(?m)^Run:\s+(\S+)`)
+	targetRx   = regexp.MustCompile(`(?m)^Target:\s+(\S+)`)
+	protoRx    = regexp.MustCompile(`(?m)^Protocol:\s+(\S+)`)
+	concurRx   = regexp.MustCompile(`(?m)^Concurrency:\s+(\d+)`)
+	reqsRx     = regexp.MustCompile(`(?m)^Requests:\s+(\d+)`)
+	startedRx  = regexp.MustCompile(`(?m)^Started:\s+(\S+)`)
+	totalRx    = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
+	rpsRx      = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	avgRx      = regexp.MustCompile(`Average:\s+([0-9.]+)\ssecs`)
+	pctRx      = regexp.MustCompile(`(\d+)%\s+in\s+([0-9.]+)\ssecs`)
+	statusRx   = regexp.MustCompile(`\[([0-9A-Za-z]+)\]\s+(\d+)\s+responses`)
+	errDistRx  = regexp.MustCompile(`(?s)Error distribution:\n(.*)`)
+	errCountRx = regexp.MustCompile(`(?m)^\s+\[(\d+)\]\s+`)
+
+	comparedPercentiles = []int{50, 90, 95, 99}
+)
+
+// Report represents a parsed benchmark run report.
+type Report struct {
+	ID          string
+	Target      string
+	Protocol    string
+	Concurrency int
+	Requests    int
+	Started     time.Time
+	Total       float64
+	RPS         float64
+	Average     float64
+	Percentiles map[int]float64
+	OK, Failed  int
+	Errors      int
+}
+
+// RunID returns a short run id for a given benchmark target and start time.
+func RunID(name, target string, t time.Time) string {
+	h := sha1.Sum([]byte(name + "|" + target + "|" + strconv.FormatInt(t.UnixNano(), 10))) //nolint:gosec
+
+	return hex.EncodeToString(h[:])[:7]
+}
+
+// writeHeader dumps run metadata ahead of the load report.
+func writeHeader(w io.Writer, id, target, protocol string, c, n int, t time.Time) {
+	fmt.Fprintf(w, "Run:\t%s\n", id)
+	fmt.Fprintf(w, "Target:\t%s\n", target)
+	fmt.Fprintf(w, "Protocol:\t%s\n", protocol)
+	fmt.Fprintf(w, "Concurrency:\t%d\n", c)
+	fmt.Fprintf(w, "Requests:\t%d\n", n)
+	fmt.Fprintf(w, "Started:\t%s\n", t.Format(time.RFC3339))
+}
+
+// ParseReport extracts run metadata and stats from a benchmark report.
+func ParseReport(s string) Report {
+	r := Report{
+		ID:          firstMatch(runRx, s),
+		Target:      firstMatch(targetRx, s),
+		Protocol:    firstMatch(protoRx, s),
+		Percentiles: make(map[int]float64),
+	}
+	if r.Protocol == "" {
+		r.Protocol = "http"
+	}
+	r.Concurrency, _ = strconv.Atoi(firstMatch(concurRx, s))
+	r.Requests, _ = strconv.Atoi(firstMatch(reqsRx, s))
+	if t, err := time.Parse(time.RFC3339, firstMatch(startedRx, s)); err == nil {
+		r.Started = t
+	}
+	r.Total, _ = strconv.ParseFloat(firstMatch(totalRx, s), 64)
+	r.RPS, _ = strconv.ParseFloat(firstMatch(rpsRx, s), 64)
+	r.Average, _ = strconv.ParseFloat(firstMatch(avgRx, s), 64)
+	for _, m := range pctRx.FindAllStringSubmatch(s, -1) {
+		p, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		if v, err := strconv.ParseFloat(m[2], 64); err == nil {
+			r.Percentiles[p] = v
+		}
+	}
+	for _, m := range statusRx.FindAllStringSubmatch(s, -1) {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+		if isOKStatus(m[1]) {
+			r.OK += n
+		} else {
+			r.Failed += n
+		}
+	}
+	if m := errDistRx.FindStringSubmatch(s); len(m) > 1 {
+		r.Errors = 1
+		if mm := errCountRx.FindAllStringSubmatch(m[1], -1); len(mm) > 0 {
+			r.Errors = 0
+			for _, e := range mm {
+				n, _ := strconv.Atoi(e[1])
+				r.Errors += n
+			}
+		}
+	}
+
+	return r
+}
+
+// Calls returns the total count of calls issued.
+func (r Report) Calls() int {
+	return max(r.OK+r.Failed, r.OK+r.Errors)
+}
+
+// ErrorRate returns the percentage of failed calls.
+func (r Report) ErrorRate() float64 {
+	calls := r.Calls()
+	if calls == 0 {
+		if r.Errors > 0 {
+			return 100
+		}
+		return 0
+	}
+
+	return float64(max(r.Failed, r.Errors)) * 100 / float64(calls)
+}
+
+// Compare renders a side by side comparison of a baseline and a subsequent run.
+func Compare(base, run Report) string {
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "METRIC\t%s\t%s\tDELTA\n", runLabel(base, "base"), runLabel(run, "run"))
+	fmt.Fprintf(w, "Target\t%s\t%s\t\n", orNA(base.Target), orNA(run.Target))
+	fmt.Fprintf(w, "Protocol\t%s\t%s\t\n", base.Protocol, run.Protocol)
+	fmt.Fprintf(w, "Started\t%s\t%s\t\n", startedOf(base), startedOf(run))
+	fmt.Fprintf(w, "Concurrency\t%d\t%d\t\n", base.Concurrency, run.Concurrency)
+	fmt.Fprintf(w, "Requests/sec\t%.4f\t%.4f\t%s\n", base.RPS, run.RPS, pctDelta(base.RPS, run.RPS))
+	fmt.Fprintf(w, "Average (ms)\t%.2f\t%.2f\t%s\n", base.Average*1e3, run.Average*1e3, pctDelta(base.Average, run.Average))
+	for _, p := range comparedPercentiles {
+		b, ok1 := base.Percentiles[p]
+		r, ok2 := run.Percentiles[p]
+		if !ok1 && !ok2 {
+			continue
+		}
+		fmt.Fprintf(w, "p%d (ms)\t%.2f\t%.2f\t%s\n", p, b*1e3, r*1e3, pctDelta(b, r))
+	}
+	be, re := base.ErrorRate(), run.ErrorRate()
+	fmt.Fprintf(w, "Error rate\t%.2f%%\t%.2f%%\t%+.2fpp\n", be, re, re-be)
+	_ = w.Flush()
+
+	return buff.String()
+}
+
+// Regressed checks if a run is slower or fails more than its baseline past a given tolerance percentage.
+func Regressed(base, run Report, tolerance float64) bool {
+	if run.ErrorRate()-base.ErrorRate() > tolerance {
+		return true
+	}
+	for _, p := range comparedPercentiles {
+		b, r := base.Percentiles[p], run.Percentiles[p]
+		if b > 0 && (r-b)*100/b > tolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func firstMatch(rx *regexp.Regexp, s string) string {
+	if m := rx.FindStringSubmatch(s); len(m) > 1 {
+		return m[1]
+	}
+
+	return ""
+}
+
+func isOKStatus(s string) bool {
+	return s == StatusOK || (len(s) == 3 && s[0] == '2')
+}
+
+func pctDelta(b, r float64) string {
+	if b == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%+.2f%%", (r-b)*100/b)
+}
+
+func runLabel(r Report, fallback string) string {
+	if r.ID == "" {
+		return strings.ToUpper(fallback)
+	}
+
+	return strings.ToUpper(fallback) + "(" + r.ID + ")"
+}
+
+func startedOf(r Report) string {
+	if r.Started.IsZero() {
+		return "n/a"
+	}
+
+	return r.Started.Format(time.RFC3339)
+}
+
+func orNA(s string) string {
+	if s == "" {
+		return "n/a"
+	}
+
+	return s
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const baseReport = `Run:	3f2a9c1
+Target:	http://localhost:8080
+Protocol:	http
+Concurrency:	2
+Requests:	100
+Started:	2026-10-14T10:00:00Z
+
+Summary:
+  Total:	1.0000 secs
+  Slowest:	0.0500 secs
+  Fastest:	0.0010 secs
+  Average:	0.0100 secs
+  Requests/sec:	100.0000
+
+Latency distribution:
+  10% in 0.0020 secs
+  50% in 0.0100 secs
+  90% in 0.0200 secs
+  95% in 0.0300 secs
+  99% in 0.0400 secs
+
+Status code distribution:
+  [200]	98 responses
+  [503]	2 responses
+`
+
+const runReport = `Run:	8c01d2e
+Target:	http://localhost:8080
+Protocol:	http
+Concurrency:	2
+Requests:	100
+Started:	2026-10-14T11:00:00Z
+
+Summary:
+  Total:	2.0000 secs
+  Average:	0.0200 secs
+  Requests/sec:	50.0000
+
+Latency distribution:
+  50% in 0.0200 secs
+  90% in 0.0300 secs
+  95% in 0.0300 secs
+  99% in 0.0800 secs
+
+Status code distribution:
+  [200]	90 responses
+
+Error distribution:
+  [10]	Get "http://localhost:8080": dial tcp: connection refused
+`
+
+func TestParseReport(t *testing.T) {
+	r := ParseReport(baseReport)
+
+	assert.Equal(t, "3f2a9c1", r.ID)
+	assert.Equal(t, "http://localhost:8080", r.Target)
+	assert.Equal(t, "http", r.Protocol)
+	assert.Equal(t, 2, r.Concurrency)
+	assert.Equal(t, 100, r.Requests)
+	assert.Equal(t, time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC), r.Started.UTC())
+	assert.InDelta(t, 100.0, r.RPS, 0.001)
+	assert.InDelta(t, 0.01, r.Average, 0.0001)
+	assert.InDelta(t, 0.04, r.Percentiles[99], 0.0001)
+	assert.Equal(t, 98, r.OK)
+	assert.Equal(t, 2, r.Failed)
+	assert.InDelta(t, 2.0, r.ErrorRate(), 0.001)
+
+	r = ParseReport(runReport)
+	assert.Equal(t, 10, r.Errors)
+	assert.Equal(t, 100, r.Calls())
+	assert.InDelta(t, 10.0, r.ErrorRate(), 0.001)
+}
+
+func TestParseReportLegacy(t *testing.T) {
+	r := ParseReport("\nSummary:\n  Requests/sec:\t29.8116\n\nError distribution:\n  boom\n")
+
+	assert.Empty(t, r.ID)
+	assert.Equal(t, "http", r.Protocol)
+	assert.True(t, r.Started.IsZero())
+	assert.Equal(t, 1, r.Errors)
+	assert.InDelta(t, 100.0, r.ErrorRate(), 0.001)
+}
+
+func TestCompare(t *testing.T) {
+	base, run := ParseReport(baseReport), ParseReport(runReport)
+	out := Compare(base, run)
+
+	assert.Contains(t, out, "BASE(3f2a9c1)")
+	assert.Contains(t, out, "RUN(8c01d2e)")
+	assert.Regexp(t, `Requests/sec\s+100.0000\s+50.0000\s+-50.00%`, out)
+	assert.Regexp(t, `p99 \(ms\)\s+40.00\s+80.00\s+\+100.00%`, out)
+	assert.Regexp(t, `Error rate\s+2.00%\s+10.00%\s+\+8.00pp`, out)
+}
+
+func TestRegressed(t *testing.T) {
+	base, run := ParseReport(baseReport), ParseReport(runReport)
+
+	assert.True(t, Regressed(base, run, 10))
+	assert.False(t, Regressed(base, base, 10))
+	assert.False(t, Regressed(run, base, 10))
+}
+
+func TestWriteHeader(t *testing.T) {
+	at := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	id := RunID("default/fred:8080", "http://localhost:8080", at)
+	require.Len(t, id, 7)
+	assert.Equal(t, id, RunID("default/fred:8080", "http://localhost:8080", at))
+	assert.NotEqual(t, id, RunID("default/fred:8080", "http://localhost:8080", at.Add(time.Second)))
+
+	var buff bytes.Buffer
+	writeHeader(&buff, id, "http://localhost:8080", "tcp", 3, 50, at)
+	r := ParseReport(buff.String())
+
+	assert.Equal(t, id, r.ID)
+	assert.Equal(t, "tcp", r.Protocol)
+	assert.Equal(t, 3, r.Concurrency)
+	assert.Equal(t, 50, r.Requests)
+	assert.Equal(t, at, r.Started.UTC())
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const defaultTCPTimeout = 5 * time.Second
+
+// tcpWorker benchmarks raw TCP connects.
+type tcpWorker struct {
+	addr    string
+	n, c    int
+	timeout time.Duration
+}
+
+func newTCPWorker(base string, cfg *config.BenchConfig) (*tcpWorker, error) {
+	addr, err := hostPort(base)
+	if err != nil {
+		return nil, err
+	}
+	timeout := defaultTCPTimeout
+	if cfg.TCP.Timeout != "" {
+		if timeout, err = time.ParseDuration(cfg.TCP.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid tcp timeout %q: %w", cfg.TCP.Timeout, err)
+		}
+	}
+
+	return &tcpWorker{addr: addr, n: cfg.N, c: cfg.C, timeout: timeout}, nil
+}
+
+// Run connects to the target n times and reports connect latencies.
+func (t *tcpWorker) Run(ctx context.Context, w io.Writer) error {
+	d := net.Dialer{Timeout: t.timeout}
+	stats := runLoad(ctx, t.n, t.c, func(ctx context.Context) (string, error) {
+		conn, err := d.DialContext(ctx, "tcp", t.addr)
+		if err != nil {
+			return "", err
+		}
+
+		return StatusOK, conn.Close()
+	})
+
+	return stats.write(w)
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// hopHeaders tracks headers that only apply to the client proxy hop.
+var hopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Keep-Alive",
+	"Te",
+	"Trailer",
+	"Upgrade",
+}
+
+// serveHTTP serves HTTP proxy requests, tunneling CONNECT requests.
+func (s *Server) serveHTTP(ctx context.Context, conn net.Conn, br *bufio.Reader) error {
+	for {
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			return err
+		}
+		if req.Method == http.MethodConnect {
+			return s.connect(ctx, conn, br, req)
+		}
+		if req.URL.Host == "" {
+			return httpError(conn, http.StatusBadRequest, "expecting an absolute request URI")
+		}
+
+		req.RequestURI = ""
+		for _, h := range hopHeaders {
+			req.Header.Del(h)
+		}
+		resp, err := s.transport.RoundTrip(req.WithContext(ctx))
+		if err != nil {
+			return httpError(conn, http.StatusBadGateway, err.Error())
+		}
+		err = resp.Write(conn)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if req.Close || resp.Close {
+			return nil
+		}
+	}
+}
+
+func (s *Server) connect(ctx context.Context, conn net.Conn, br *bufio.Reader, req *http.Request) error {
+	upstream, err := s.dialFn(ctx, "tcp", req.Host)
+	if err != nil {
+		return httpError(conn, http.StatusBadGateway, err.Error())
+	}
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		_ = upstream.Close()
+		return err
+	}
+	pipe(ctx, &bufConn{Reader: br, Conn: conn}, upstream)
+
+	return nil
+}
+
+func httpError(w io.Writer, code int, msg string) error {
+	msg = strings.TrimSpace(msg) + "\n"
+	_, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\nContent-Type: text/plain\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s",
+		code, http.StatusText(code), len(msg), msg)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("http proxy %d: %s", code, strings.TrimSpace(msg))
+}
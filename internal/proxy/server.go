@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DialFunc dials a cluster address.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Server serves SOCKS5 and HTTP proxy requests on a single address.
+type Server struct {
+	addr      string
+	dialFn    DialFunc
+	transport *http.Transport
+	listener  net.Listener
+	cancelFn  context.CancelFunc
+	conns     atomic.Int64
+	wg        sync.WaitGroup
+	mx        sync.Mutex
+}
+
+// NewServer returns a new proxy server.
+func NewServer(addr string, fn DialFunc) *Server {
+	return &Server{
+		addr:      addr,
+		dialFn:    fn,
+		transport: &http.Transport{DialContext: fn},
+	}
+}
+
+// Addr returns the server listen address.
+func (s *Server) Addr() string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.listener != nil {
+		return s.listener.Addr().String()
+	}
+
+	return s.addr
+}
+
+// Conns returns the count of active proxied connections.
+func (s *Server) Conns() int64 {
+	return s.conns.Load()
+}
+
+// IsRunning checks if the server is listening.
+func (s *Server) IsRunning() bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.listener != nil
+}
+
+// Start starts listening for proxy requests.
+func (s *Server) Start() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.listener != nil {
+		return nil
+	}
+	l, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	var ctx context.Context
+	ctx, s.cancelFn = context.WithCancel(context.Background())
+	s.listener = l
+	s.wg.Add(1)
+	go s.serve(ctx, l)
+
+	return nil
+}
+
+// Stop stops the server and terminates all proxied connections.
+func (s *Server) Stop() {
+	s.mx.Lock()
+	if s.listener == nil {
+		s.mx.Unlock()
+		return
+	}
+	s.cancelFn()
+	_ = s.listener.Close()
+	s.listener = nil
+	s.mx.Unlock()
+
+	s.transport.CloseIdleConnections()
+	s.wg.Wait()
+}
+
+func (s *Server) serve(ctx context.Context, l net.Listener) {
+	defer s.wg.Done()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				slog.Warn("Proxy accept failed", slogs.Error, err)
+			}
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	s.conns.Add(1)
+	defer s.conns.Add(-1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	br := bufio.NewReader(conn)
+	b, err := br.Peek(1)
+	if err != nil {
+		return
+	}
+	if b[0] == socksVersion {
+		err = s.serveSOCKS(ctx, conn, br)
+	} else {
+		err = s.serveHTTP(ctx, conn, br)
+	}
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+		slog.Debug("Proxy connection failed", slogs.Error, err)
+	}
+}
+
+// pipe copies data both ways until either side is done.
+func pipe(ctx context.Context, a io.ReadWriter, b io.ReadWriteCloser) {
+	done := make(chan struct{}, 2)
+	cp := func(dst io.Writer, src io.Reader) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go cp(a, b)
+	go cp(b, a)
+	select {
+	case <-ctx.Done():
+	case <-done:
+	}
+	_ = b.Close()
+}
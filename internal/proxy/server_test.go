@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package proxy_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/derailed/k9s/internal/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerHTTP(t *testing.T) {
+	s, dialed := newTestServer(t)
+
+	u, err := url.Parse("http://" + s.Addr())
+	require.NoError(t, err)
+	c := http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(u)}}
+	resp, err := c.Get("http://fred.ns1:8080/blee")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bb, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/blee", string(bb))
+	assert.Equal(t, "fred.ns1:8080", <-dialed)
+}
+
+func TestServerConnect(t *testing.T) {
+	s, dialed := newTestServer(t)
+
+	conn, err := net.Dial("tcp", s.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = fmt.Fprint(conn, "CONNECT fred.ns1.svc:443 HTTP/1.1\r\nHost: fred.ns1.svc:443\r\n\r\n")
+	require.NoError(t, err)
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assertTunnel(t, conn, br)
+	assert.Equal(t, "fred.ns1.svc:443", <-dialed)
+}
+
+func TestServerSOCKS(t *testing.T) {
+	s, dialed := newTestServer(t)
+
+	conn, err := net.Dial("tcp", s.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte{5, 1, 0})
+	require.NoError(t, err)
+	br := bufio.NewReader(conn)
+	bb := make([]byte, 2)
+	_, err = io.ReadFull(br, bb)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{5, 0}, bb)
+
+	host := "fred.ns1"
+	req := append([]byte{5, 1, 0, 3, byte(len(host))}, host...)
+	_, err = conn.Write(append(req, 0x1f, 0x90))
+	require.NoError(t, err)
+	bb = make([]byte, 10)
+	_, err = io.ReadFull(br, bb)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0), bb[1])
+
+	assertTunnel(t, conn, br)
+	assert.Equal(t, "fred.ns1:8080", <-dialed)
+}
+
+func TestServerSOCKSDialFailed(t *testing.T) {
+	s := proxy.NewServer("127.0.0.1:0", func(context.Context, string, string) (net.Conn, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	require.NoError(t, s.Start())
+	t.Cleanup(s.Stop)
+
+	conn, err := net.Dial("tcp", s.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte{5, 1, 0, 5, 1, 0, 1, 10, 0, 0, 1, 0, 80})
+	require.NoError(t, err)
+	bb := make([]byte, 12)
+	_, err = io.ReadFull(conn, bb)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{5, 0}, bb[:2])
+	assert.Equal(t, byte(4), bb[3])
+}
+
+// Helpers...
+
+func newTestServer(t *testing.T) (*proxy.Server, chan string) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	dialed := make(chan string, 10)
+	s := proxy.NewServer("127.0.0.1:0", func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed <- addr
+		var d net.Dialer
+		return d.DialContext(ctx, network, srv.Listener.Addr().String())
+	})
+	require.NoError(t, s.Start())
+	t.Cleanup(s.Stop)
+
+	return s, dialed
+}
+
+func assertTunnel(t *testing.T, conn net.Conn, br *bufio.Reader) {
+	_, err := fmt.Fprint(conn, "GET /zorg HTTP/1.1\r\nHost: fred\r\n\r\n")
+	require.NoError(t, err)
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bb, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "/zorg", string(bb))
+}
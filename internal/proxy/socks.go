@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	socksVersion   = 0x05
+	socksNoAuth    = 0x00
+	socksNoMethods = 0xff
+	socksConnect   = 0x01
+
+	socksIPv4   = 0x01
+	socksDomain = 0x03
+	socksIPv6   = 0x04
+
+	socksOK                  = 0x00
+	socksFailure             = 0x01
+	socksHostUnreachable     = 0x04
+	socksCmdNotSupported     = 0x07
+	socksAddrTypeUnsupported = 0x08
+)
+
+var errSocksNoAuth = errors.New("socks client does not support no auth method")
+
+// serveSOCKS serves a SOCKS5 CONNECT request without authentication.
+func (s *Server) serveSOCKS(ctx context.Context, conn net.Conn, br *bufio.Reader) error {
+	if err := socksHandshake(conn, br); err != nil {
+		return err
+	}
+	addr, rep, err := socksRequest(br)
+	if err != nil {
+		_ = socksReply(conn, rep)
+		return err
+	}
+	upstream, err := s.dialFn(ctx, "tcp", addr)
+	if err != nil {
+		_ = socksReply(conn, socksHostUnreachable)
+		return fmt.Errorf("dial %s: %w", addr, err)
+	}
+	if err := socksReply(conn, socksOK); err != nil {
+		_ = upstream.Close()
+		return err
+	}
+	pipe(ctx, &bufConn{Reader: br, Conn: conn}, upstream)
+
+	return nil
+}
+
+func socksHandshake(w io.Writer, r io.Reader) error {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return err
+	}
+	if hdr[0] != socksVersion {
+		return fmt.Errorf("unsupported socks version %d", hdr[0])
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(r, methods); err != nil {
+		return err
+	}
+	for _, m := range methods {
+		if m == socksNoAuth {
+			_, err := w.Write([]byte{socksVersion, socksNoAuth})
+			return err
+		}
+	}
+	_, _ = w.Write([]byte{socksVersion, socksNoMethods})
+
+	return errSocksNoAuth
+}
+
+// socksRequest reads a connect request and returns the target address or a reply code.
+func socksRequest(r io.Reader) (string, byte, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return "", socksFailure, err
+	}
+	if hdr[0] != socksVersion {
+		return "", socksFailure, fmt.Errorf("unsupported socks version %d", hdr[0])
+	}
+	if hdr[1] != socksConnect {
+		return "", socksCmdNotSupported, fmt.Errorf("unsupported socks command %d", hdr[1])
+	}
+
+	var host string
+	switch hdr[3] {
+	case socksIPv4, socksIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if hdr[3] == socksIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", socksFailure, err
+		}
+		host = ip.String()
+	case socksDomain:
+		var n [1]byte
+		if _, err := io.ReadFull(r, n[:]); err != nil {
+			return "", socksFailure, err
+		}
+		name := make([]byte, n[0])
+		if _, err := io.ReadFull(r, name); err != nil {
+			return "", socksFailure, err
+		}
+		host = string(name)
+	default:
+		return "", socksAddrTypeUnsupported, fmt.Errorf("unsupported socks address type %d", hdr[3])
+	}
+	var port [2]byte
+	if _, err := io.ReadFull(r, port[:]); err != nil {
+		return "", socksFailure, err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:])))), socksOK, nil
+}
+
+func socksReply(w io.Writer, rep byte) error {
+	_, err := w.Write([]byte{socksVersion, rep, 0x00, socksIPv4, 0, 0, 0, 0, 0, 0})
+
+	return err
+}
+
+// bufConn reads from a buffered reader and writes to its connection.
+type bufConn struct {
+	*bufio.Reader
+	net.Conn
+}
+
+func (b *bufConn) Read(bb []byte) (int, error) {
+	return b.Reader.Read(bb)
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// AdmissionValidating tracks validating webhooks.
+	AdmissionValidating = "ValidatingWebhook"
+
+	// AdmissionMutating tracks mutating webhooks.
+	AdmissionMutating = "MutatingWebhook"
+
+	// AdmissionPolicy tracks validating admission policies.
+	AdmissionPolicy = "ValidatingAdmissionPolicy"
+
+	// AdmissionUnbound tracks policies with no bindings.
+	AdmissionUnbound = "<unbound>"
+)
+
+// Admission renders admission webhooks and policies to screen.
+type Admission struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Admission) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("BINDINGS", true); ok && re.Row.Fields[idx] == AdmissionUnbound {
+			c = model1.CompletedColor
+		}
+		if idx, ok := h.IndexOf("DENIALS", true); ok && re.Row.Fields[idx] != "0" {
+			c = model1.ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Admission) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CONFIG", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "RULES"},
+		model1.HeaderColumn{Name: "NAMESPACES"},
+		model1.HeaderColumn{Name: "OBJECTS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "FAILURE"},
+		model1.HeaderColumn{Name: "TIMEOUT", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "BINDINGS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "DENIALS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "LAST DENIAL"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Admission) Render(o any, _ string, r *model1.Row) error {
+	a, ok := o.(*AdmissionRes)
+	if !ok {
+		return fmt.Errorf("expected AdmissionRes but got %T", o)
+	}
+
+	timeout := NAValue
+	if a.Timeout > 0 {
+		timeout = strconv.Itoa(int(a.Timeout)) + "s"
+	}
+	bindings := ""
+	if a.Kind == AdmissionPolicy {
+		bindings = AdmissionUnbound
+		if len(a.Bindings) > 0 {
+			bindings = strings.Join(a.Bindings, ",")
+		}
+	}
+	last := ""
+	if a.LastDenial != "" {
+		last = a.LastDenial + " (" + timeToAge(a.LastDenialAt) + ")"
+	}
+	r.ID = a.ID
+	r.Fields = model1.Fields{
+		a.Kind,
+		a.Name,
+		a.Config,
+		strings.Join(a.Rules, "; "),
+		a.Namespaces,
+		a.Objects,
+		a.FailurePolicy,
+		timeout,
+		bindings,
+		strconv.Itoa(a.Denials),
+		last,
+		timeToAge(a.CreatedAt),
+	}
+
+	return nil
+}
+
+// AdmissionRes represents an admission webhook or policy.
+type AdmissionRes struct {
+	ID            string
+	Kind          string
+	Name, Config  string
+	Rules         []string
+	Namespaces    string
+	Objects       string
+	FailurePolicy string
+	Timeout       int32
+	Bindings      []string
+	Denials       int
+	LastDenial    string
+	LastDenialAt  time.Time
+	CreatedAt     time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*AdmissionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a *AdmissionRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.AdmissionRes
+		e model1.Fields
+	}{
+		"webhook": {
+			o: &render.AdmissionRes{
+				ID:            "admissionregistration.k8s.io/v1/validatingwebhookconfigurations|fred|validate.fred.io",
+				Kind:          render.AdmissionValidating,
+				Name:          "validate.fred.io",
+				Config:        "fred",
+				Rules:         []string{"CREATE apps/deployments", "DELETE pods"},
+				Namespaces:    "team=fred",
+				Objects:       "all",
+				FailurePolicy: "Fail",
+				Timeout:       5,
+				Denials:       3,
+				LastDenial:    "image not signed",
+				LastDenialAt:  time.Now(),
+				CreatedAt:     time.Now(),
+			},
+			e: model1.Fields{"ValidatingWebhook", "validate.fred.io", "fred", "CREATE apps/deployments; DELETE pods", "team=fred", "all", "Fail", "5s", "", "3", "image not signed (0s)"},
+		},
+		"unbound": {
+			o: &render.AdmissionRes{
+				ID:            "admissionregistration.k8s.io/v1/validatingadmissionpolicies|blee|blee",
+				Kind:          render.AdmissionPolicy,
+				Name:          "blee",
+				Config:        "blee",
+				Namespaces:    "all",
+				Objects:       "all",
+				FailurePolicy: "Fail",
+				CreatedAt:     time.Now(),
+			},
+			e: model1.Fields{"ValidatingAdmissionPolicy", "blee", "blee", "", "all", "all", "Fail", "n/a", render.AdmissionUnbound, "0", ""},
+		},
+	}
+
+	var a render.Admission
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, a.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// AlertActive tracks firing alerts.
+	AlertActive = "active"
+
+	// AlertSuppressed tracks silenced or inhibited alerts.
+	AlertSuppressed = "suppressed"
+)
+
+// Alert renders an Alertmanager alert to screen.
+type Alert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("SEVERITY", true); ok {
+			switch strings.ToLower(re.Row.Fields[idx]) {
+			case "critical", "error", "page":
+				c = model1.ErrColor
+			case "warning":
+				c = model1.PendingColor
+			}
+		}
+		if idx, ok := h.IndexOf("STATE", true); ok && re.Row.Fields[idx] == AlertSuppressed {
+			c = model1.CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SEVERITY"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "SUMMARY"},
+		model1.HeaderColumn{Name: "SILENCED BY", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "RECEIVERS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Alert) Render(o any, _ string, r *model1.Row) error {
+	a, ok := o.(*AlertRes)
+	if !ok {
+		return fmt.Errorf("expected AlertRes but got %T", o)
+	}
+
+	res := NAValue
+	if a.Resource != "" {
+		res = path.Base(a.Resource) + " " + a.FQN
+	}
+	summary := a.Annotations["summary"]
+	if summary == "" {
+		summary = a.Annotations["description"]
+	}
+	r.ID = a.Fingerprint
+	r.Fields = model1.Fields{
+		a.Labels["alertname"],
+		a.Labels["severity"],
+		a.State,
+		res,
+		summary,
+		strings.Join(a.SilencedBy, ","),
+		strings.Join(a.Receivers, ","),
+		labelsToStr(a.Labels),
+		timeToAge(a.StartsAt),
+	}
+
+	return nil
+}
+
+// labelsToStr renders labels as sorted k=v pairs.
+func labelsToStr(ll map[string]string) string {
+	kk := slices.Sorted(maps.Keys(ll))
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, k+"="+ll[k])
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// AlertRes represents an Alertmanager alert.
+type AlertRes struct {
+	Fingerprint string
+	Labels      map[string]string
+	Annotations map[string]string
+	State       string
+	SilencedBy  []string
+	Receivers   []string
+	StartsAt    time.Time
+
+	// Resource is the alerted resource gvr and FQN its path if any.
+	Resource, FQN string
+}
+
+// GetObjectKind returns a schema object.
+func (*AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a *AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.AlertRes
+		e model1.Fields
+	}{
+		"linked": {
+			o: &render.AlertRes{
+				Fingerprint: "abc123",
+				Labels:      map[string]string{"alertname": "KubePodCrashLooping", "severity": "warning", "pod": "fred"},
+				Annotations: map[string]string{"summary": "Pod is crash looping"},
+				State:       render.AlertSuppressed,
+				SilencedBy:  []string{"s1", "s2"},
+				Receivers:   []string{"slack"},
+				StartsAt:    time.Now(),
+				Resource:    "v1/pods",
+				FQN:         "ns1/fred",
+			},
+			e: model1.Fields{"KubePodCrashLooping", "warning", "suppressed", "pods ns1/fred", "Pod is crash looping", "s1,s2", "slack", "alertname=KubePodCrashLooping,pod=fred,severity=warning"},
+		},
+		"unlinked": {
+			o: &render.AlertRes{
+				Fingerprint: "def456",
+				Labels:      map[string]string{"alertname": "Watchdog"},
+				Annotations: map[string]string{"description": "Always firing"},
+				State:       render.AlertActive,
+				StartsAt:    time.Now(),
+			},
+			e: model1.Fields{"Watchdog", "", "active", "n/a", "Always firing", "", "", "alertname=Watchdog"},
+		},
+	}
+
+	var a render.Alert
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, a.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Fingerprint, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
+
+func TestAlertColorer(t *testing.T) {
+	var a render.Alert
+	h := a.Header("")
+	uu := map[string]struct {
+		severity, state string
+		e               tcell.Color
+	}{
+		"critical":   {severity: "critical", state: render.AlertActive, e: model1.ErrColor},
+		"warning":    {severity: "warning", state: render.AlertActive, e: model1.PendingColor},
+		"info":       {severity: "info", state: render.AlertActive, e: model1.StdColor},
+		"suppressed": {severity: "critical", state: render.AlertSuppressed, e: model1.CompletedColor},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := model1.RowEvent{
+				Kind: model1.EventUnchanged,
+				Row:  model1.Row{Fields: model1.Fields{"fred", u.severity, u.state, "", "", "", "", "", ""}},
+			}
+			assert.Equal(t, u.e, a.ColorerFunc()("", h, &re))
+		})
+	}
+}
@@ -23,9 +23,11 @@ import (
 var (
 	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
 	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
-	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
-	errRx   = regexp.MustCompile(`\[[45]\d{2}\]\s+(\d+)\s+responses`)
+	okRx    = regexp.MustCompile(`\[(?:2\d{2}|OK)\]\s+(\d+)\s+responses`)
+	errRx   = regexp.MustCompile(`\[(?:[45]\d{2}|[A-Z][a-z]+[A-Za-z]*)\]\s+(\d+)\s+responses`)
 	toastRx = regexp.MustCompile(`Error distribution`)
+	protoRx = regexp.MustCompile(`Protocol:\s+(\w+)`)
+	runRx   = regexp.MustCompile(`(?m)^Run:\s+(\w+)`)
 )
 
 // Benchmark renders a benchmarks to screen.
@@ -54,6 +56,8 @@ func (Benchmark) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "REQ/S", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "2XX", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "4XX/5XX", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "PROTOCOL"},
+		model1.HeaderColumn{Name: "RUN"},
 		model1.HeaderColumn{Name: "REPORT"},
 		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -78,7 +82,7 @@ func (b Benchmark) Render(o any, ns string, r *model1.Row) error {
 		return err
 	}
 	b.augmentRow(r.Fields, data)
-	r.Fields[8] = AsStatus(b.diagnose(ns, r.Fields))
+	r.Fields[10] = AsStatus(b.diagnose(ns, r.Fields))
 
 	return nil
 }
@@ -118,8 +122,8 @@ func (Benchmark) initRow(row model1.Fields, f os.FileInfo) error {
 	}
 	row[0] = tokens[0]
 	row[1] = tokens[1]
-	row[7] = f.Name()
-	row[9] = ToAge(metav1.Time{Time: f.ModTime()})
+	row[9] = f.Name()
+	row[11] = ToAge(metav1.Time{Time: f.ModTime()})
 
 	return nil
 }
@@ -155,6 +159,18 @@ func (b Benchmark) augmentRow(fields model1.Fields, data string) {
 
 	me := errRx.FindAllStringSubmatch(data, -1)
 	fields[col] = b.countReq(me)
+	col++
+
+	fields[col] = "http"
+	if mp := protoRx.FindStringSubmatch(data); len(mp) > 1 {
+		fields[col] = mp[1]
+	}
+	col++
+
+	fields[col] = MissingValue
+	if mr := runRx.FindStringSubmatch(data); len(mr) > 1 {
+		fields[col] = mr[1]
+	}
 }
 
 func (Benchmark) countReq(rr [][]string) string {
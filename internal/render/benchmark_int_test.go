@@ -24,19 +24,23 @@ func TestAugmentRow(t *testing.T) {
 	}{
 		"cool": {
 			"testdata/b1.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "100", "0"},
+			model1.Fields{"pass", "3.3544", "29.8116", "100", "0", "http", MissingValue},
 		},
 		"2XX": {
 			"testdata/b4.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "160", "0"},
+			model1.Fields{"pass", "3.3544", "29.8116", "160", "0", "http", MissingValue},
 		},
 		"4XX/5XX": {
 			"testdata/b2.txt",
-			model1.Fields{"pass", "3.3544", "29.8116", "100", "12"},
+			model1.Fields{"pass", "3.3544", "29.8116", "100", "12", "http", MissingValue},
 		},
 		"toast": {
 			"testdata/b3.txt",
-			model1.Fields{"fail", "2.3688", "35.4606", "0", "0"},
+			model1.Fields{"fail", "2.3688", "35.4606", "0", "0", "http", MissingValue},
+		},
+		"grpc": {
+			"testdata/b5.txt",
+			model1.Fields{"fail", "1.2000", "100.0000", "110", "10", "grpc", "3f2a9c1"},
 		},
 	}
 
@@ -46,10 +50,10 @@ func TestAugmentRow(t *testing.T) {
 			data, err := os.ReadFile(u.file)
 
 			require.NoError(t, err)
-			fields := make(model1.Fields, 8)
+			fields := make(model1.Fields, 9)
 			b := Benchmark{}
 			b.augmentRow(fields, string(data))
-			assert.Equal(t, u.e, fields[2:7])
+			assert.Equal(t, u.e, fields[2:9])
 		})
 	}
 }
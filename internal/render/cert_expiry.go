@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// CertKindCertificate tracks cert-manager certificates.
+	CertKindCertificate = "Certificate"
+
+	// CertKindSecret tracks TLS secrets not managed by cert-manager.
+	CertKindSecret = "Secret"
+
+	// CertExpiryCritical flags certificates expiring within a week.
+	CertExpiryCritical = 7 * 24 * time.Hour
+
+	// CertExpiryWarn flags certificates expiring within a month.
+	CertExpiryWarn = 30 * 24 * time.Hour
+
+	certNotAfterFmt = "2006-01-02 15:04"
+	certExpired     = "expired"
+)
+
+// CertExpiry renders certificates expiry to screen.
+type CertExpiry struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (CertExpiry) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("NOT AFTER", true)
+		if !ok {
+			return c
+		}
+		t, err := time.Parse(certNotAfterFmt, re.Row.Fields[idx])
+		if err != nil {
+			return c
+		}
+		switch left := time.Until(t); {
+		case left < CertExpiryCritical:
+			return model1.ErrColor
+		case left < CertExpiryWarn:
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (CertExpiry) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "ISSUER"},
+		model1.HeaderColumn{Name: "DNS NAMES"},
+		model1.HeaderColumn{Name: "NOT AFTER"},
+		model1.HeaderColumn{Name: "EXPIRES"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "RENEWAL", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "SECRET", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CertExpiry) Render(o any, _ string, r *model1.Row) error {
+	c, ok := o.(*CertExpiryRes)
+	if !ok {
+		return fmt.Errorf("expected CertExpiryRes but got %T", o)
+	}
+
+	r.ID = c.Path()
+	r.Fields = model1.Fields{
+		c.Namespace,
+		c.Name,
+		c.Kind,
+		c.Issuer,
+		abbrevList(c.DNSNames),
+		c.notAfter(),
+		c.expires(),
+		c.Ready,
+		untilTime(c.RenewalTime),
+		c.Secret,
+		AsStatus(c.diagnose()),
+		timeToAge(c.Created),
+	}
+
+	return nil
+}
+
+// CertExpiryRes represents a certificate expiry.
+type CertExpiryRes struct {
+	GVR             string
+	Kind            string
+	Namespace, Name string
+	Issuer          string
+	DNSNames        []string
+	NotAfter        time.Time
+	RenewalTime     time.Time
+	Ready           string
+	Message         string
+	Secret          string
+	Created         time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*CertExpiryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CertExpiryRes) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// Path returns the certificate path as gvr|namespace|name.
+func (c *CertExpiryRes) Path() string {
+	return strings.Join([]string{c.GVR, c.Namespace, c.Name}, "|")
+}
+
+func (c *CertExpiryRes) notAfter() string {
+	if c.NotAfter.IsZero() {
+		return MissingValue
+	}
+
+	return c.NotAfter.Local().Format(certNotAfterFmt)
+}
+
+func (c *CertExpiryRes) expires() string {
+	switch {
+	case c.NotAfter.IsZero():
+		return NAValue
+	case time.Now().After(c.NotAfter):
+		return certExpired
+	default:
+		return duration.HumanDuration(time.Until(c.NotAfter))
+	}
+}
+
+func (c *CertExpiryRes) diagnose() error {
+	switch {
+	case !c.NotAfter.IsZero() && time.Now().After(c.NotAfter):
+		return errors.New("certificate expired")
+	case c.Ready == "False" && c.Message != "":
+		return errors.New(c.Message)
+	case c.Ready == "False":
+		return errors.New("certificate not ready")
+	}
+
+	return nil
+}
+
+func untilTime(t time.Time) string {
+	switch {
+	case t.IsZero():
+		return MissingValue
+	case time.Now().After(t):
+		return "due"
+	default:
+		return duration.HumanDuration(time.Until(t))
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertExpiryRender(t *testing.T) {
+	notAfter := time.Now().Add(49 * time.Hour)
+	uu := map[string]struct {
+		o *render.CertExpiryRes
+		e model1.Fields
+	}{
+		"certificate": {
+			o: &render.CertExpiryRes{
+				GVR:         "cert-manager.io/v1/certificates",
+				Kind:        render.CertKindCertificate,
+				Namespace:   "ns1",
+				Name:        "fred",
+				Issuer:      "clusterissuer/letsencrypt",
+				DNSNames:    []string{"fred.io", "www.fred.io"},
+				NotAfter:    notAfter,
+				RenewalTime: time.Now().Add(-time.Hour),
+				Ready:       "True",
+				Secret:      "fred-tls",
+			},
+			e: model1.Fields{"ns1", "fred", "Certificate", "clusterissuer/letsencrypt", "fred.io (+1)", notAfter.Format("2006-01-02 15:04"), "2d", "True", "due", "fred-tls", ""},
+		},
+		"expired": {
+			o: &render.CertExpiryRes{
+				GVR:       "v1/secrets",
+				Kind:      render.CertKindSecret,
+				Namespace: "ns1",
+				Name:      "blee-tls",
+				Issuer:    "blee-ca",
+				NotAfter:  time.Now().Add(-time.Hour),
+				Ready:     "n/a",
+				Secret:    "blee-tls",
+			},
+			e: model1.Fields{"ns1", "blee-tls", "Secret", "blee-ca", "<none>", time.Now().Add(-time.Hour).Format("2006-01-02 15:04"), "expired", "n/a", "<none>", "blee-tls", "certificate expired"},
+		},
+		"unparsable": {
+			o: &render.CertExpiryRes{
+				GVR:       "v1/secrets",
+				Kind:      render.CertKindSecret,
+				Namespace: "ns1",
+				Name:      "bad-tls",
+				Issuer:    "<none>",
+				Ready:     "False",
+				Message:   "no pem certificate found",
+				Secret:    "bad-tls",
+			},
+			e: model1.Fields{"ns1", "bad-tls", "Secret", "<none>", "<none>", "<none>", "n/a", "False", "<none>", "bad-tls", "no pem certificate found"},
+		},
+	}
+
+	var c render.CertExpiry
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Path(), r.ID)
+			assert.Equal(t, u.e, r.Fields[:11])
+		})
+	}
+}
+
+func TestCertExpiryColorer(t *testing.T) {
+	uu := map[string]struct {
+		notAfter time.Time
+		e        tcell.Color
+	}{
+		"critical": {notAfter: time.Now().Add(48 * time.Hour), e: model1.ErrColor},
+		"warn":     {notAfter: time.Now().Add(10 * 24 * time.Hour), e: model1.PendingColor},
+		"ok":       {notAfter: time.Now().Add(90 * 24 * time.Hour), e: model1.StdColor},
+	}
+
+	var c render.CertExpiry
+	h := c.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, c.Render(&render.CertExpiryRes{Name: "fred", Ready: "True", NotAfter: u.notAfter}, "", &r))
+			assert.Equal(t, u.e, c.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+		})
+	}
+}
@@ -7,12 +7,17 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
@@ -28,6 +33,14 @@ func (Context) ColorerFunc() model1.ColorerFunc {
 		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
 			return model1.HighlightColor
 		}
+		if idx, ok := h.IndexOf("STATUS", true); ok {
+			switch strings.TrimSpace(r.Row.Fields[idx]) {
+			case client.HealthUnreachable:
+				return model1.ErrColor
+			case client.HealthDegraded:
+				return model1.PendingColor
+			}
+		}
 
 		return c
 	}
@@ -40,6 +53,10 @@ func (Context) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "CLUSTER"},
 		model1.HeaderColumn{Name: "AUTHINFO"},
 		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "NODES", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "CRED-EXPIRY"},
 	}
 }
 
@@ -62,10 +79,31 @@ func (Context) Render(o any, _ string, r *model1.Row) error {
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
 	}
+	r.Fields = append(r.Fields, healthFields(ctx.Health, time.Now())...)
 
 	return nil
 }
 
+func healthFields(h *client.ContextHealth, now time.Time) model1.Fields {
+	if h == nil {
+		return model1.Fields{"", "", "", ""}
+	}
+	nodes := NAValue
+	if h.Nodes >= 0 {
+		nodes = strconv.Itoa(h.ReadyNodes) + "/" + strconv.Itoa(h.Nodes)
+	}
+	expiry := NAValue
+	switch {
+	case h.Expiry.IsZero():
+	case !now.Before(h.Expiry):
+		expiry = "expired"
+	default:
+		expiry = duration.HumanDuration(h.Expiry.Sub(now))
+	}
+
+	return model1.Fields{h.Status, h.Version, nodes, expiry}
+}
+
 // Helpers...
 
 // NamedContext represents a named cluster context.
@@ -73,6 +111,7 @@ type NamedContext struct {
 	Name    string
 	Context *api.Context
 	Config  ContextNamer
+	Health  *client.ContextHealth
 }
 
 // ContextNamer represents a named context.
@@ -5,7 +5,9 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +18,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Len(t, c.Header(""), 4)
+	assert.Len(t, c.Header(""), 8)
 }
 
 func TestContextRender(t *testing.T) {
@@ -37,7 +39,40 @@ func TestContextRender(t *testing.T) {
 			},
 			e: model1.Row{
 				ID:     "c1",
-				Fields: model1.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: model1.Fields{"c1", "c1", "u1", "ns1", "", "", "", ""},
+			},
+		},
+		"healthy": {
+			ctx: &render.NamedContext{
+				Name:    "c2",
+				Context: &api.Context{Cluster: "c2", AuthInfo: "u2"},
+				Config:  &config{},
+				Health: &client.ContextHealth{
+					Status:     client.HealthDegraded,
+					Version:    "v1.33.1",
+					Nodes:      3,
+					ReadyNodes: 2,
+				},
+			},
+			e: model1.Row{
+				ID:     "c2",
+				Fields: model1.Fields{"c2", "c2", "u2", "", "degraded", "v1.33.1", "2/3", "n/a"},
+			},
+		},
+		"unreachable": {
+			ctx: &render.NamedContext{
+				Name:    "c3",
+				Context: &api.Context{Cluster: "c3", AuthInfo: "u3"},
+				Config:  &config{},
+				Health: &client.ContextHealth{
+					Status: client.HealthUnreachable,
+					Nodes:  -1,
+					Expiry: time.Now().Add(-time.Minute),
+				},
+			},
+			e: model1.Row{
+				ID:     "c3",
+				Fields: model1.Fields{"c3", "c3", "u3", "", "unreachable", "", "n/a", "expired"},
 			},
 		},
 	}
@@ -7,13 +7,18 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 var defaultCJHeader = model1.Header{
@@ -24,6 +29,10 @@ var defaultCJHeader = model1.Header{
 	model1.HeaderColumn{Name: "SUSPEND"},
 	model1.HeaderColumn{Name: "ACTIVE"},
 	model1.HeaderColumn{Name: "LAST_SCHEDULE", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "LAST_SUCCESS", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "SUCCESS%", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "FAIL_STREAK", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "AVG_DURATION"},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "CONTAINERS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "IMAGES", Attrs: model1.Attrs{Wide: true}},
@@ -37,6 +46,23 @@ type CronJob struct {
 	Base
 }
 
+// ColorerFunc colors a resource row.
+func (CronJob) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("FAIL_STREAK", true)
+		if !ok {
+			return c
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[idx])); err == nil && n > 0 {
+			return model1.ErrColor
+		}
+
+		return c
+	}
+}
+
 // Header returns a header row.
 func (c CronJob) Header(_ string) model1.Header {
 	return c.doHeader(defaultCJHeader)
@@ -44,18 +70,22 @@ func (c CronJob) Header(_ string) model1.Header {
 
 // Render renders a K8s resource to screen.
 func (c CronJob) Render(o any, _ string, row *model1.Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	cjr, ok := o.(*CronJobWithRuns)
 	if !ok {
-		return fmt.Errorf("expected Unstructured, but got %T", o)
+		raw, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expected CronJobWithRuns, but got %T", o)
+		}
+		cjr = &CronJobWithRuns{Raw: raw}
 	}
-	if err := c.defaultRow(raw, row); err != nil {
+	if err := c.defaultRow(cjr, row); err != nil {
 		return err
 	}
 	if c.specs.isEmpty() {
 		return nil
 	}
 
-	cols, err := c.specs.realize(raw, defaultCJHeader, row)
+	cols, err := c.specs.realize(cjr.Raw, defaultCJHeader, row)
 	if err != nil {
 		return err
 	}
@@ -65,9 +95,9 @@ func (c CronJob) Render(o any, _ string, row *model1.Row) error {
 }
 
 // Render renders a K8s resource to screen.
-func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+func (CronJob) defaultRow(cjr *CronJobWithRuns, r *model1.Row) error {
 	var cj batchv1.CronJob
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cj)
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(cjr.Raw.Object, &cj)
 	if err != nil {
 		return err
 	}
@@ -86,6 +116,10 @@ func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
 		lastScheduled,
+		cjr.Runs.lastSuccess(),
+		cjr.Runs.successRate(),
+		cjr.Runs.failureStreak(),
+		cjr.Runs.avgDuration(),
 		jobSelector(&cj.Spec.JobTemplate.Spec),
 		podContainerNames(&cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		podImageNames(&cj.Spec.JobTemplate.Spec.Template.Spec, true),
@@ -97,6 +131,72 @@ func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 	return nil
 }
 
+// CronJobWithRuns represents a cronjob and its jobs runs summary.
+type CronJobWithRuns struct {
+	Raw  *unstructured.Unstructured
+	Runs *JobRuns
+}
+
+// GetObjectKind returns a schema object.
+func (*CronJobWithRuns) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CronJobWithRuns) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// JobRuns summarizes a cronjob jobs runs.
+type JobRuns struct {
+	Succeeded, Failed, Active int
+	LastRun, LastSuccess      time.Time
+	FailureStreak             int
+	AvgDuration               time.Duration
+}
+
+// SuccessRate returns the percentage of finished runs that succeeded or -1 if none finished.
+func (j *JobRuns) SuccessRate() int {
+	if j == nil || j.Succeeded+j.Failed == 0 {
+		return -1
+	}
+
+	return j.Succeeded * 100 / (j.Succeeded + j.Failed)
+}
+
+func (j *JobRuns) lastSuccess() string {
+	if j == nil || j.LastSuccess.IsZero() {
+		return "<none>"
+	}
+
+	return timeToAge(j.LastSuccess)
+}
+
+func (j *JobRuns) successRate() string {
+	r := j.SuccessRate()
+	if r < 0 {
+		return NAValue
+	}
+
+	return strconv.Itoa(r) + "%"
+}
+
+func (j *JobRuns) failureStreak() string {
+	if j == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(j.FailureStreak)
+}
+
+func (j *JobRuns) avgDuration() string {
+	if j == nil || j.AvgDuration == 0 {
+		return NAValue
+	}
+
+	return duration.HumanDuration(j.AvgDuration)
+}
+
 // Helpers
 
 func jobSelector(spec *batchv1.JobSpec) string {
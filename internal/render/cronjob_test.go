@@ -5,6 +5,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
@@ -20,3 +21,38 @@ func TestCronJobRender(t *testing.T) {
 	assert.Equal(t, "default/hello", r.ID)
 	assert.Equal(t, model1.Fields{"default", "hello", "n/a", "*/1 * * * *", "false", "0"}, r.Fields[:6])
 }
+
+func TestCronJobRenderRuns(t *testing.T) {
+	uu := map[string]struct {
+		runs *render.JobRuns
+		e    model1.Fields
+	}{
+		"no-runs": {
+			e: model1.Fields{"<none>", "n/a", "n/a", "n/a"},
+		},
+		"unfinished": {
+			runs: &render.JobRuns{Active: 1},
+			e:    model1.Fields{"<none>", "n/a", "0", "n/a"},
+		},
+		"failing": {
+			runs: &render.JobRuns{
+				Succeeded:     3,
+				Failed:        1,
+				LastSuccess:   time.Now().Add(-2 * time.Hour),
+				FailureStreak: 1,
+				AvgDuration:   90 * time.Second,
+			},
+			e: model1.Fields{"120m", "75%", "1", "90s"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var c render.CronJob
+			r := model1.NewRow(17)
+			require.NoError(t, c.Render(&render.CronJobWithRuns{Raw: load(t, "cj"), Runs: u.runs}, "", &r))
+			assert.Equal(t, u.e, r.Fields[7:11])
+		})
+	}
+}
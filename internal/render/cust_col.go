@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
@@ -14,7 +16,11 @@ import (
 	"k8s.io/kubectl/pkg/cmd/get"
 )
 
-var fullRX = regexp.MustCompile(`^([\w\s%/-]+):?([\w\W]*?)\|?([NTWSLRH]{0,3})$`)
+var (
+	fullRX  = regexp.MustCompile(`^([\w\s%/-]+):?([\w\W]*?)\|?([NTWSLRH]{0,4})$`)
+	widthRX = regexp.MustCompile(`^(.*\|[NTWSLRH]{0,4})(\d{1,3})$`)
+	flagsRX = regexp.MustCompile(`^[NTWSLRH]{0,4}$`)
+)
 
 type colAttr byte
 
@@ -38,6 +44,7 @@ type colAttrs struct {
 	show     bool
 	hide     bool
 	capacity bool
+	width    int
 }
 
 func newColFlags(flags string) colAttrs {
@@ -78,18 +85,26 @@ type colDef struct {
 }
 
 func parse(s string) (colDef, error) {
+	var width int
+	if mm := widthRX.FindStringSubmatch(s); len(mm) == 3 {
+		width, _ = strconv.Atoi(mm[2])
+		s = strings.TrimSuffix(mm[1], "|")
+	}
 	mm := fullRX.FindStringSubmatch(s)
 	if len(mm) == 4 {
 		spec, err := get.RelaxedJSONPathExpression(mm[2])
 		if err != nil {
 			return colDef{idx: -1}, err
 		}
-		return colDef{
+		c := colDef{
 			name:     mm[1],
 			idx:      -1,
 			spec:     spec,
 			colAttrs: newColFlags(mm[3]),
-		}, nil
+		}
+		c.width = width
+
+		return c, nil
 	}
 
 	return colDef{idx: -1}, fmt.Errorf("invalid column definition %q", s)
@@ -108,6 +123,82 @@ func (c colDef) toHeaderCol() model1.HeaderColumn {
 			MXM:      c.mxm,
 			Hide:     c.hide,
 			Capacity: c.capacity,
+			Width:    c.width,
 		},
 	}
 }
+
+// ColumnName returns the name of a given column spec.
+func ColumnName(spec string) string {
+	base, _, _ := splitSpec(spec)
+	n, _, _ := strings.Cut(base, ":")
+
+	return n
+}
+
+// IsColumnHidden checks if a given column spec is hidden.
+func IsColumnHidden(spec string) bool {
+	_, flags, _ := splitSpec(spec)
+
+	return strings.ContainsRune(flags, rune(hide))
+}
+
+// ToggleColumnHide toggles the hide attribute of a given column spec.
+func ToggleColumnHide(spec string) string {
+	base, flags, width := splitSpec(spec)
+	if strings.ContainsRune(flags, rune(hide)) {
+		flags = strings.ReplaceAll(flags, string(hide), "")
+	} else {
+		flags += string(hide)
+	}
+
+	return joinSpec(base, flags, width)
+}
+
+// ColumnWidth returns the fixed width of a given column spec if any.
+func ColumnWidth(spec string) int {
+	_, _, width := splitSpec(spec)
+
+	return width
+}
+
+// SetColumnWidth sets the fixed width of a given column spec. A zero width resets it.
+func SetColumnWidth(spec string, width int) string {
+	base, flags, _ := splitSpec(spec)
+
+	return joinSpec(base, flags, max(width, 0))
+}
+
+// LabelColumnSpec returns a column spec displaying a given label.
+func LabelColumnSpec(label string) string {
+	n := strings.ToUpper(strings.ReplaceAll(label, ".", "-"))
+
+	return n + ":.metadata.labels." + strings.ReplaceAll(label, ".", `\.`)
+}
+
+func splitSpec(spec string) (base, flags string, width int) {
+	if mm := widthRX.FindStringSubmatch(spec); len(mm) == 3 {
+		width, _ = strconv.Atoi(mm[2])
+		spec = mm[1]
+	}
+	idx := strings.LastIndex(spec, "|")
+	if idx < 0 {
+		return spec, "", width
+	}
+	if !flagsRX.MatchString(spec[idx+1:]) {
+		return spec, "", width
+	}
+
+	return spec[:idx], spec[idx+1:], width
+}
+
+func joinSpec(base, flags string, width int) string {
+	if flags == "" && width == 0 {
+		return base
+	}
+	if width == 0 {
+		return base + "|" + flags
+	}
+
+	return base + "|" + flags + strconv.Itoa(width)
+}
@@ -57,6 +57,31 @@ func TestCustCol_parse(t *testing.T) {
 			},
 		},
 
+		"plain-width": {
+			s: "fred|R20",
+			e: colDef{
+				name: "fred",
+				idx:  -1,
+				colAttrs: colAttrs{
+					align: tview.AlignRight,
+					width: 20,
+				},
+			},
+		},
+
+		"spec-width": {
+			s: "fred:.metadata.name|12",
+			e: colDef{
+				name: "fred",
+				idx:  -1,
+				spec: "{.metadata.name}",
+				colAttrs: colAttrs{
+					align: tview.AlignLeft,
+					width: 12,
+				},
+			},
+		},
+
 		"plain-show": {
 			s: "fred|S",
 			e: colDef{
@@ -252,3 +277,61 @@ func TestCustCol_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestCustCol_edit(t *testing.T) {
+	uu := map[string]struct {
+		s, hide string
+		width   int
+		resized string
+		name    string
+	}{
+		"plain": {
+			s:       "fred",
+			hide:    "fred|H",
+			width:   12,
+			resized: "fred|12",
+			name:    "fred",
+		},
+		"flags": {
+			s:       "fred|WR",
+			hide:    "fred|WRH",
+			width:   5,
+			resized: "fred|WR5",
+			name:    "fred",
+		},
+		"hidden": {
+			s:       "fred:.metadata.name|RH20",
+			hide:    "fred:.metadata.name|R20",
+			resized: "fred:.metadata.name|RH",
+			name:    "fred",
+		},
+		"spec": {
+			s:       "IP:.status.podIP",
+			hide:    "IP:.status.podIP|H",
+			width:   15,
+			resized: "IP:.status.podIP|15",
+			name:    "IP",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.name, ColumnName(u.s))
+			assert.Equal(t, u.hide, ToggleColumnHide(u.s))
+			assert.Equal(t, u.s, ToggleColumnHide(u.hide))
+			assert.Equal(t, u.resized, SetColumnWidth(u.s, u.width))
+			assert.Equal(t, u.width, ColumnWidth(u.resized))
+			_, err := parse(u.hide)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLabelColumnSpec(t *testing.T) {
+	s := LabelColumnSpec("app.kubernetes.io/name")
+	assert.Equal(t, `APP-KUBERNETES-IO/NAME:.metadata.labels.app\.kubernetes\.io/name`, s)
+
+	c, err := parse(s)
+	assert.NoError(t, err)
+	assert.Equal(t, "APP-KUBERNETES-IO/NAME", c.name)
+}
@@ -17,6 +17,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// PausedStatus flags a paused deployment rollout.
+const PausedStatus = "PAUSED"
+
 var defaultDPHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAMESPACE"},
 	model1.HeaderColumn{Name: "NAME"},
@@ -24,6 +27,7 @@ var defaultDPHeader = model1.Header{
 	model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "UP-TO-DATE", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "AVAILABLE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "PAUSED"},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -39,6 +43,9 @@ func (Deployment) ColorerFunc() model1.ColorerFunc {
 	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
 		c := model1.DefaultColorer(ns, h, re)
 
+		if idx, ok := h.IndexOf("PAUSED", true); ok && strings.TrimSpace(re.Row.Fields[idx]) == PausedStatus {
+			return model1.PendingColor
+		}
 		idx, ok := h.IndexOf("READY", true)
 		if !ok {
 			return c
@@ -99,6 +106,7 @@ func (d Deployment) defaultRow(raw *unstructured.Unstructured, r *model1.Row) er
 		strconv.Itoa(int(dp.Status.AvailableReplicas)) + "/" + strconv.Itoa(int(desired)),
 		strconv.Itoa(int(dp.Status.UpdatedReplicas)),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)),
+		pausedBadge(dp.Spec.Paused),
 		mapToStr(dp.Labels),
 		AsStatus(d.diagnose(dp.Status.Replicas, dp.Status.AvailableReplicas)),
 		ToAge(dp.GetCreationTimestamp()),
@@ -114,3 +122,12 @@ func (Deployment) diagnose(desired, avail int32) error {
 
 	return nil
 }
+
+// pausedBadge flags a paused rollout.
+func pausedBadge(paused bool) string {
+	if paused {
+		return PausedStatus
+	}
+
+	return ""
+}
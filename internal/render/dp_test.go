@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestDpRender(t *testing.T) {
@@ -18,7 +19,20 @@ func TestDpRender(t *testing.T) {
 
 	require.NoError(t, c.Render(load(t, "dp"), "", &r))
 	assert.Equal(t, "icx/icx-db", r.ID)
-	assert.Equal(t, model1.Fields{"icx", "icx-db", "n/a", "1/1", "1", "1"}, r.Fields[:6])
+	assert.Equal(t, model1.Fields{"icx", "icx-db", "n/a", "1/1", "1", "1", ""}, r.Fields[:7])
+}
+
+func TestDpRenderPaused(t *testing.T) {
+	c := render.Deployment{}
+	r := model1.NewRow(7)
+	o := load(t, "dp")
+	require.NoError(t, unstructured.SetNestedField(o.Object, true, "spec", "paused"))
+
+	require.NoError(t, c.Render(o, "", &r))
+	assert.Equal(t, render.PausedStatus, r.Fields[6])
+
+	h := c.Header("")
+	assert.Equal(t, model1.PendingColor, c.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
 }
 
 func BenchmarkDpRender(b *testing.B) {
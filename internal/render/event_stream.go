@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// killReasons tracks event reasons reporting evicted or terminated workloads.
+var killReasons = map[string]struct{}{
+	"Killing":    {},
+	"Evicted":    {},
+	"Preempting": {},
+	"OOMKilling": {},
+}
+
+// EventStream renders deduplicated cluster events to screen.
+type EventStream struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (EventStream) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("REASON", true)
+		if !ok {
+			return c
+		}
+		reason := re.Row.Fields[idx]
+		if _, ok := killReasons[reason]; ok {
+			c = model1.KillColor
+		}
+		if idx, ok := h.IndexOf("TYPE", true); ok && re.Row.Fields[idx] == v1.EventTypeWarning {
+			c = model1.ErrColor
+		}
+		if strings.HasPrefix(reason, "Failed") || strings.HasSuffix(reason, "BackOff") {
+			c = model1.ErrColor
+		}
+		if idx, ok := h.IndexOf("WATCHED", true); ok && re.Row.Fields[idx] == "true" {
+			c = model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (EventStream) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "OBJECT"},
+		model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "WATCHED", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "FIRST SEEN", Attrs: model1.Attrs{Time: true, Wide: true}},
+		model1.HeaderColumn{Name: "LAST SEEN", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (EventStream) Render(o any, _ string, r *model1.Row) error {
+	e, ok := o.(*EventStreamRes)
+	if !ok {
+		return fmt.Errorf("expected EventStreamRes but got %T", o)
+	}
+
+	r.ID = e.ID
+	r.Fields = model1.Fields{
+		e.Namespace,
+		e.Type,
+		e.Reason,
+		e.Kind + "/" + e.Name,
+		strconv.Itoa(e.Count),
+		e.Message,
+		boolToStr(e.Watched),
+		timeToAge(e.FirstSeen),
+		timeToAge(e.LastSeen),
+	}
+
+	return nil
+}
+
+// EventStreamRes represents a set of recurring events.
+type EventStreamRes struct {
+	ID                  string
+	Namespace           string
+	APIVersion          string
+	Kind, Name          string
+	Type, Reason        string
+	Message             string
+	Count               int
+	Watched             bool
+	FirstSeen, LastSeen time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*EventStreamRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e *EventStreamRes) DeepCopyObject() runtime.Object {
+	return e
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStreamRender(t *testing.T) {
+	o := render.EventStreamRes{
+		ID:        "ns1|v1|Pod|fred|Warning|BackOff|abcdef0",
+		Namespace: "ns1",
+		Kind:      "Pod",
+		Name:      "fred",
+		Type:      "Warning",
+		Reason:    "BackOff",
+		Message:   "Back-off restarting failed container",
+		Count:     7,
+		Watched:   true,
+		FirstSeen: time.Now().Add(-time.Hour),
+		LastSeen:  time.Now(),
+	}
+
+	var (
+		es render.EventStream
+		r  model1.Row
+	)
+	require.NoError(t, es.Render(&o, "", &r))
+	assert.Equal(t, o.ID, r.ID)
+	assert.Equal(t, model1.Fields{"ns1", "Warning", "BackOff", "Pod/fred", "7", "Back-off restarting failed container", "true"}, r.Fields[:7])
+}
+
+func TestEventStreamColorer(t *testing.T) {
+	var es render.EventStream
+	h := es.Header("")
+	uu := map[string]struct {
+		f model1.Fields
+		e tcell.Color
+	}{
+		"normal": {
+			f: model1.Fields{"ns1", "Normal", "Pulled", "Pod/fred", "1", "", "false", "", ""},
+			e: model1.StdColor,
+		},
+		"warning": {
+			f: model1.Fields{"ns1", "Warning", "Unhealthy", "Pod/fred", "1", "", "false", "", ""},
+			e: model1.ErrColor,
+		},
+		"failed": {
+			f: model1.Fields{"ns1", "Normal", "FailedCreate", "Pod/fred", "1", "", "false", "", ""},
+			e: model1.ErrColor,
+		},
+		"killing": {
+			f: model1.Fields{"ns1", "Normal", "Killing", "Pod/fred", "1", "", "false", "", ""},
+			e: model1.KillColor,
+		},
+		"watched": {
+			f: model1.Fields{"ns1", "Warning", "BackOff", "Pod/fred", "1", "", "true", "", ""},
+			e: model1.HighlightColor,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := model1.RowEvent{Kind: model1.EventUnchanged, Row: model1.Row{Fields: u.f}}
+			assert.Equal(t, u.e, es.ColorerFunc()("", h, &re))
+		})
+	}
+}
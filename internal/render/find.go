@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FindName tracks matches on resource names.
+	FindName = "name"
+
+	// FindLabel tracks matches on resource labels.
+	FindLabel = "label"
+)
+
+// Find renders resources search results to screen.
+type Find struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Find) ColorerFunc() model1.ColorerFunc {
+	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (Find) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "FIELD"},
+		model1.HeaderColumn{Name: "MATCH"},
+		model1.HeaderColumn{Name: "SCORE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Find) Render(o any, _ string, r *model1.Row) error {
+	f, ok := o.(*FindRes)
+	if !ok {
+		return fmt.Errorf("expected FindRes but got %T", o)
+	}
+
+	r.ID = f.GVR + "|" + f.Namespace + "|" + f.Name
+	r.Fields = model1.Fields{
+		f.GVR,
+		f.Namespace,
+		f.Name,
+		f.Field,
+		f.Match,
+		strconv.Itoa(f.Score),
+	}
+
+	return nil
+}
+
+// FindRes represents a resource matching a search term.
+type FindRes struct {
+	GVR       string
+	Namespace string
+	Name      string
+	Field     string
+	Match     string
+	Score     int
+}
+
+// GetObjectKind returns a schema object.
+func (*FindRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f *FindRes) DeepCopyObject() runtime.Object {
+	return f
+}
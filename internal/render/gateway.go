@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultGwHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "CLASS"},
+	model1.HeaderColumn{Name: "ADDRESSES"},
+	model1.HeaderColumn{Name: "LISTENERS"},
+	model1.HeaderColumn{Name: "ROUTES", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "PROGRAMMED"},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+var defaultHTTPRouteHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "HOSTNAMES"},
+	model1.HeaderColumn{Name: "PARENTS"},
+	model1.HeaderColumn{Name: "BACKENDS"},
+	model1.HeaderColumn{Name: "ACCEPTED"},
+	model1.HeaderColumn{Name: "RESOLVED REFS"},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// gateway represents the gateway.networking.k8s.io Gateway fields k9s renders.
+type gateway struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		GatewayClassName string `json:"gatewayClassName"`
+		Listeners        []struct {
+			Name     string `json:"name"`
+			Protocol string `json:"protocol"`
+			Port     int32  `json:"port"`
+		} `json:"listeners"`
+	} `json:"spec"`
+	Status struct {
+		Addresses []struct {
+			Value string `json:"value"`
+		} `json:"addresses"`
+		Listeners []struct {
+			AttachedRoutes int32 `json:"attachedRoutes"`
+		} `json:"listeners"`
+		Conditions []gatewayCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+// httpRoute represents the gateway.networking.k8s.io HTTPRoute fields k9s renders.
+type httpRoute struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Hostnames  []string           `json:"hostnames"`
+		ParentRefs []GatewayParentRef `json:"parentRefs"`
+		Rules      []struct {
+			BackendRefs []GatewayBackendRef `json:"backendRefs"`
+		} `json:"rules"`
+	} `json:"spec"`
+	Status struct {
+		Parents []struct {
+			Conditions []gatewayCondition `json:"conditions"`
+		} `json:"parents"`
+	} `json:"status"`
+}
+
+// GatewayParentRef represents a gateway route parent reference.
+type GatewayParentRef struct {
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace"`
+	SectionName string `json:"sectionName"`
+}
+
+// String returns the parent as [namespace/]name[:section] given the route namespace.
+func (p GatewayParentRef) String(ns string) string {
+	s := p.Name
+	if p.Namespace != "" && p.Namespace != ns {
+		s = p.Namespace + "/" + s
+	}
+	if p.SectionName != "" {
+		s += ":" + p.SectionName
+	}
+
+	return s
+}
+
+// GatewayBackendRef represents a gateway route backend reference.
+type GatewayBackendRef struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Port      *int32 `json:"port"`
+}
+
+// IsService returns true if the backend is a core service.
+func (b GatewayBackendRef) IsService() bool {
+	return b.Group == "" && (b.Kind == "" || b.Kind == "Service")
+}
+
+// String returns the backend as [namespace/]name[:port] given the route namespace.
+func (b GatewayBackendRef) String(ns string) string {
+	s := b.Name
+	if !b.IsService() {
+		s = b.Kind + "/" + s
+	}
+	if b.Namespace != "" && b.Namespace != ns {
+		s = b.Namespace + "/" + s
+	}
+	if b.Port != nil {
+		s += ":" + strconv.Itoa(int(*b.Port))
+	}
+
+	return s
+}
+
+// Gateway renders a Gateway API gateway to screen.
+type Gateway struct {
+	Base
+}
+
+// Header returns a header row.
+func (g Gateway) Header(_ string) model1.Header {
+	return g.doHeader(defaultGwHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (g Gateway) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := g.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if g.specs.isEmpty() {
+		return nil
+	}
+	cols, err := g.specs.realize(raw, defaultGwHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (Gateway) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var gw gateway
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &gw)
+	if err != nil {
+		return err
+	}
+
+	aa := make([]string, 0, len(gw.Status.Addresses))
+	for _, a := range gw.Status.Addresses {
+		aa = append(aa, a.Value)
+	}
+	ll := make([]string, 0, len(gw.Spec.Listeners))
+	for _, l := range gw.Spec.Listeners {
+		ll = append(ll, l.Protocol+"/"+strconv.Itoa(int(l.Port)))
+	}
+	var routes int32
+	for _, l := range gw.Status.Listeners {
+		routes += l.AttachedRoutes
+	}
+	cond := findGatewayCondition(gw.Status.Conditions, "Programmed")
+
+	r.ID = client.MetaFQN(&gw.ObjectMeta)
+	r.Fields = model1.Fields{
+		gw.Namespace,
+		gw.Name,
+		gw.Spec.GatewayClassName,
+		missing(strings.Join(aa, ",")),
+		missing(strings.Join(ll, ",")),
+		strconv.Itoa(int(routes)),
+		cond.ready(),
+		mapToStr(gw.Labels),
+		AsStatus(conditionErr(cond, "gateway not programmed")),
+		ToAge(gw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// HTTPRoute renders a Gateway API http route to screen.
+type HTTPRoute struct {
+	Base
+}
+
+// Header returns a header row.
+func (h HTTPRoute) Header(_ string) model1.Header {
+	return h.doHeader(defaultHTTPRouteHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (h HTTPRoute) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := h.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if h.specs.isEmpty() {
+		return nil
+	}
+	cols, err := h.specs.realize(raw, defaultHTTPRouteHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (HTTPRoute) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var rt httpRoute
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rt)
+	if err != nil {
+		return err
+	}
+
+	pp := make([]string, 0, len(rt.Spec.ParentRefs))
+	for _, p := range rt.Spec.ParentRefs {
+		pp = append(pp, p.String(rt.Namespace))
+	}
+	bb := make([]string, 0, len(rt.Spec.Rules))
+	for _, rule := range rt.Spec.Rules {
+		for _, b := range rule.BackendRefs {
+			bb = append(bb, b.String(rt.Namespace))
+		}
+	}
+	var accepted, resolved *gatewayCondition
+	for i := range rt.Status.Parents {
+		cc := rt.Status.Parents[i].Conditions
+		if c := findGatewayCondition(cc, "Accepted"); accepted == nil || (c != nil && c.Status != string(metav1.ConditionTrue)) {
+			accepted = c
+		}
+		if c := findGatewayCondition(cc, "ResolvedRefs"); resolved == nil || (c != nil && c.Status != string(metav1.ConditionTrue)) {
+			resolved = c
+		}
+	}
+	err = conditionErr(accepted, "route not accepted")
+	if err == nil {
+		err = conditionErr(resolved, "route references not resolved")
+	}
+
+	r.ID = client.MetaFQN(&rt.ObjectMeta)
+	r.Fields = model1.Fields{
+		rt.Namespace,
+		rt.Name,
+		missing(strings.Join(rt.Spec.Hostnames, ",")),
+		missing(strings.Join(pp, ",")),
+		missing(strings.Join(bb, ",")),
+		accepted.ready(),
+		resolved.ready(),
+		mapToStr(rt.Labels),
+		AsStatus(err),
+		ToAge(rt.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// gatewayCondition represents a Gateway API resource status condition.
+type gatewayCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func findGatewayCondition(cc []gatewayCondition, kind string) *gatewayCondition {
+	for i := range cc {
+		if cc[i].Type == kind {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+func (c *gatewayCondition) ready() string {
+	if c == nil {
+		return MissingValue
+	}
+
+	return c.Status
+}
+
+// conditionErr returns an error when a condition is missing or not true.
+func conditionErr(c *gatewayCondition, msg string) error {
+	switch {
+	case c == nil:
+		return errors.New(msg)
+	case c.Status == string(metav1.ConditionTrue):
+		return nil
+	case c.Message != "":
+		return errors.New(c.Message)
+	default:
+		return fmt.Errorf("%s: %s", msg, c.Reason)
+	}
+}
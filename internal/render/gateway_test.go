@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGatewayRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "gw", "namespace": "infra"},
+		"spec": map[string]any{
+			"gatewayClassName": "istio",
+			"listeners": []any{
+				map[string]any{"name": "http", "protocol": "HTTP", "port": int64(80)},
+				map[string]any{"name": "https", "protocol": "HTTPS", "port": int64(443)},
+			},
+		},
+		"status": map[string]any{
+			"addresses": []any{map[string]any{"value": "10.0.0.1"}},
+			"listeners": []any{
+				map[string]any{"name": "http", "attachedRoutes": int64(2)},
+				map[string]any{"name": "https", "attachedRoutes": int64(1)},
+			},
+			"conditions": []any{
+				map[string]any{"type": "Programmed", "status": "False", "reason": "AddressNotAssigned"},
+			},
+		},
+	}}
+
+	var g render.Gateway
+	r := model1.NewRow(10)
+	require.NoError(t, g.Render(&o, "", &r))
+	assert.Equal(t, "infra/gw", r.ID)
+	assert.Equal(t, model1.Fields{"infra", "gw", "istio", "10.0.0.1", "HTTP/80,HTTPS/443", "3", "False", "", "gateway not programmed: AddressNotAssigned"}, r.Fields[:9])
+}
+
+func TestHTTPRouteRender(t *testing.T) {
+	uu := map[string]struct {
+		status map[string]any
+		e      model1.Fields
+	}{
+		"accepted": {
+			status: map[string]any{"parents": []any{
+				map[string]any{"conditions": []any{
+					map[string]any{"type": "Accepted", "status": "True"},
+					map[string]any{"type": "ResolvedRefs", "status": "True"},
+				}},
+			}},
+			e: model1.Fields{"ns1", "blee", "blee.io", "infra/gw:https", "api:80,other/Bucket/assets", "True", "True", "", ""},
+		},
+		"unresolved": {
+			status: map[string]any{"parents": []any{
+				map[string]any{"conditions": []any{
+					map[string]any{"type": "Accepted", "status": "True"},
+					map[string]any{"type": "ResolvedRefs", "status": "False", "reason": "BackendNotFound", "message": "service api not found"},
+				}},
+			}},
+			e: model1.Fields{"ns1", "blee", "blee.io", "infra/gw:https", "api:80,other/Bucket/assets", "True", "False", "", "service api not found"},
+		},
+		"pending": {
+			e: model1.Fields{"ns1", "blee", "blee.io", "infra/gw:https", "api:80,other/Bucket/assets", "<none>", "<none>", "", "route not accepted"},
+		},
+	}
+
+	var h render.HTTPRoute
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "blee", "namespace": "ns1"},
+				"spec": map[string]any{
+					"hostnames":  []any{"blee.io"},
+					"parentRefs": []any{map[string]any{"name": "gw", "namespace": "infra", "sectionName": "https"}},
+					"rules": []any{map[string]any{"backendRefs": []any{
+						map[string]any{"name": "api", "port": int64(80)},
+						map[string]any{"group": "storage.fred.io", "kind": "Bucket", "name": "assets", "namespace": "other"},
+					}}},
+				},
+			}}
+			if u.status != nil {
+				o.Object["status"] = u.status
+			}
+			r := model1.NewRow(10)
+			require.NoError(t, h.Render(&o, "", &r))
+			assert.Equal(t, u.e, r.Fields[:9])
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"golang.org/x/text/message"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -163,6 +164,32 @@ func naStrings(ss []string) string {
 	return strings.Join(ss, ",")
 }
 
+// abbrevList returns the first item of a list along with the remaining items count.
+func abbrevList(ss []string) string {
+	switch len(ss) {
+	case 0:
+		return MissingValue
+	case 1:
+		return ss[0]
+	default:
+		return ss[0] + " (+" + strconv.Itoa(len(ss)-1) + ")"
+	}
+}
+
+// setCustomMetrics exposes custom metrics to custom columns as .metrics.<name>.
+func setCustomMetrics(u *unstructured.Unstructured, mm map[string]string) *unstructured.Unstructured {
+	if len(mm) == 0 {
+		return u
+	}
+	m := make(map[string]any, len(mm))
+	for k, v := range mm {
+		m[k] = v
+	}
+	u.Object["metrics"] = m
+
+	return u
+}
+
 func na(s string) string {
 	return check(s, NAValue)
 }
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// JobRunSucceeded tracks a job run that completed.
+	JobRunSucceeded = "Succeeded"
+
+	// JobRunFailed tracks a job run that failed.
+	JobRunFailed = "Failed"
+
+	// JobRunRunning tracks a job run still in flight.
+	JobRunRunning = "Running"
+)
+
+// JobRun renders a cronjob job run to screen.
+type JobRun struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (JobRun) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok {
+			return c
+		}
+		switch re.Row.Fields[idx] {
+		case JobRunFailed:
+			return model1.ErrColor
+		case JobRunRunning:
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (JobRun) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "COMPLETIONS"},
+		model1.HeaderColumn{Name: "STARTED", Attrs: model1.Attrs{Time: true}},
+		model1.HeaderColumn{Name: "DURATION"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (JobRun) Render(o any, _ string, r *model1.Row) error {
+	run, ok := o.(*JobRunRes)
+	if !ok {
+		return fmt.Errorf("expected JobRunRes but got %T", o)
+	}
+
+	started, dur := "<none>", NAValue
+	if !run.Started.IsZero() {
+		started = timeToAge(run.Started)
+	}
+	if run.Duration > 0 {
+		dur = duration.HumanDuration(run.Duration)
+	}
+	r.ID = client.FQN(run.Namespace, run.Name)
+	r.Fields = model1.Fields{
+		run.Name,
+		run.Status,
+		run.Completions,
+		started,
+		dur,
+		run.Reason,
+		timeToAge(run.Created),
+	}
+
+	return nil
+}
+
+// JobRunRes represents a cronjob job run.
+type JobRunRes struct {
+	Namespace, Name string
+	Status          string
+	Completions     string
+	Reason          string
+	Started         time.Time
+	Duration        time.Duration
+	Created         time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*JobRunRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (j *JobRunRes) DeepCopyObject() runtime.Object {
+	return j
+}
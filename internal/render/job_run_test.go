@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRunRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.JobRunRes
+		e model1.Fields
+	}{
+		"failed": {
+			o: &render.JobRunRes{
+				Namespace:   "ns1",
+				Name:        "fred-28001",
+				Status:      render.JobRunFailed,
+				Completions: "0/1",
+				Reason:      "BackoffLimitExceeded",
+				Started:     time.Now().Add(-time.Hour),
+				Duration:    2 * time.Minute,
+				Created:     time.Now(),
+			},
+			e: model1.Fields{"fred-28001", "Failed", "0/1", "60m", "2m", "BackoffLimitExceeded"},
+		},
+		"pending": {
+			o: &render.JobRunRes{
+				Namespace:   "ns1",
+				Name:        "fred-28002",
+				Status:      render.JobRunRunning,
+				Completions: "0/1",
+				Created:     time.Now(),
+			},
+			e: model1.Fields{"fred-28002", "Running", "0/1", "<none>", "n/a", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				j render.JobRun
+				r model1.Row
+			)
+			require.NoError(t, j.Render(u.o, "", &r))
+			assert.Equal(t, "ns1/"+u.o.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
+}
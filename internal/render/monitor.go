@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ProbeUp tracks healthy probes.
+	ProbeUp = "Up"
+
+	// ProbeDown tracks failing probes.
+	ProbeDown = "Down"
+
+	// ProbePending tracks probes awaiting their first check.
+	ProbePending = "Pending"
+)
+
+// Monitor renders a health probe to screen.
+type Monitor struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Monitor) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("STATE", true)
+		if !ok {
+			return c
+		}
+		switch re.Row.Fields[idx] {
+		case ProbeDown:
+			c = model1.ErrColor
+		case ProbePending:
+			c = model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Monitor) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "URL"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "CODE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "LATENCY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "AVG", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "UPTIME", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "CHECKS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "FAILURES", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+		model1.HeaderColumn{Name: "ERROR", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "SINCE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Monitor) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(*ProbeRes)
+	if !ok {
+		return fmt.Errorf("expected ProbeRes but got %T", o)
+	}
+
+	code, lat, avg, uptime := NAValue, NAValue, NAValue, NAValue
+	if p.Checks > 0 {
+		if p.Code > 0 {
+			code = strconv.Itoa(p.Code)
+		}
+		lat, avg = toLatency(p.Latency), toLatency(p.AvgLatency)
+		uptime = fmt.Sprintf("%.1f%%", p.Uptime)
+	}
+	r.ID = p.ID
+	r.Fields = model1.Fields{
+		p.FQN,
+		p.Kind,
+		p.URL,
+		p.State,
+		code,
+		lat,
+		avg,
+		uptime,
+		strconv.Itoa(p.Checks),
+		strconv.Itoa(p.Failures),
+		p.LastError,
+		timeToAge(p.Since),
+	}
+
+	return nil
+}
+
+func toLatency(d time.Duration) string {
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
+
+// ProbeRes represents a health probe summary.
+type ProbeRes struct {
+	ID         string
+	Kind       string
+	FQN        string
+	URL        string
+	State      string
+	Code       int
+	Latency    time.Duration
+	AvgLatency time.Duration
+	Uptime     float64
+	Checks     int
+	Failures   int
+	LastError  string
+	LastCheck  time.Time
+	Since      time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*ProbeRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *ProbeRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.ProbeRes
+		e model1.Fields
+	}{
+		"pending": {
+			o: &render.ProbeRes{
+				ID:    "services:ns1/fred",
+				Kind:  "services",
+				FQN:   "ns1/fred",
+				URL:   "http://fred.ns1:80/",
+				State: render.ProbePending,
+				Since: time.Now(),
+			},
+			e: model1.Fields{"ns1/fred", "services", "http://fred.ns1:80/", render.ProbePending, "n/a", "n/a", "n/a", "n/a", "0", "0", ""},
+		},
+		"down": {
+			o: &render.ProbeRes{
+				ID:         "ingresses:ns1/fred",
+				Kind:       "ingresses",
+				FQN:        "ns1/fred",
+				URL:        "https://fred.example.com/",
+				State:      render.ProbeDown,
+				Code:       503,
+				Latency:    120 * time.Millisecond,
+				AvgLatency: 40 * time.Millisecond,
+				Uptime:     75,
+				Checks:     4,
+				Failures:   1,
+				LastError:  "unexpected status 503",
+				Since:      time.Now(),
+			},
+			e: model1.Fields{"ns1/fred", "ingresses", "https://fred.example.com/", render.ProbeDown, "503", "120ms", "40ms", "75.0%", "4", "1", "unexpected status 503"},
+		},
+	}
+
+	var m render.Monitor
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, m.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
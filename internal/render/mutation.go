@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// MutationApplied tracks mutations in effect.
+	MutationApplied = "APPLIED"
+
+	// MutationUndone tracks reverted mutations.
+	MutationUndone = "UNDONE"
+)
+
+// Mutation renders a journaled mutation to screen.
+type Mutation struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Mutation) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("STATE", true)
+		if ok && re.Row.Fields[idx] == MutationUndone {
+			c = model1.CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Mutation) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "ID", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "OPERATION"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Mutation) Render(o any, _ string, r *model1.Row) error {
+	m, ok := o.(*MutationRes)
+	if !ok {
+		return fmt.Errorf("expected MutationRes but got %T", o)
+	}
+
+	state := MutationApplied
+	if m.Undone {
+		state = MutationUndone
+	}
+	r.ID = strconv.Itoa(m.ID)
+	r.Fields = model1.Fields{
+		r.ID,
+		m.Op,
+		m.GVR,
+		m.FQN,
+		state,
+		timeToAge(m.Time),
+	}
+
+	return nil
+}
+
+// MutationRes represents a journaled mutation.
+type MutationRes struct {
+	ID     int
+	Op     string
+	GVR    string
+	FQN    string
+	Undone bool
+	Time   time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*MutationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (m *MutationRes) DeepCopyObject() runtime.Object {
+	return m
+}
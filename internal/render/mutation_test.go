@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutationRender(t *testing.T) {
+	var (
+		m render.Mutation
+		r model1.Row
+	)
+	o := &render.MutationRes{
+		ID:     3,
+		Op:     "Scale",
+		GVR:    "apps/v1/deployments",
+		FQN:    "ns1/dp1",
+		Undone: true,
+		Time:   time.Now(),
+	}
+
+	require.NoError(t, m.Render(o, "", &r))
+	assert.Equal(t, "3", r.ID)
+	assert.Equal(t, model1.Fields{"3", "Scale", "apps/v1/deployments", "ns1/dp1", render.MutationUndone}, r.Fields[:len(r.Fields)-1])
+}
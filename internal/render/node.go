@@ -103,7 +103,11 @@ func (n Node) Render(o any, _ string, row *model1.Row) error {
 		return nil
 	}
 
-	cols, err := n.specs.realize(nwm.Raw, defaultNOHeader, row)
+	raw := nwm.Raw
+	if len(nwm.Custom) > 0 {
+		raw = setCustomMetrics(raw.DeepCopy(), nwm.Custom)
+	}
+	cols, err := n.specs.realize(raw, defaultNOHeader, row)
 	if err != nil {
 		return err
 	}
@@ -219,6 +223,9 @@ type NodeWithMetrics struct {
 	Raw      *unstructured.Unstructured
 	MX       *mv1beta1.NodeMetrics
 	PodCount int
+
+	// Custom tracks the node custom metrics if any.
+	Custom map[string]string
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var defaultNOCHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "PODS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "%CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "CPU/U", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%CPU/U", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "MEM/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "%MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "MEM/U", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%MEM/U", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "PRESSURE"},
+	model1.HeaderColumn{Name: "TAINTS"},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// NodeCapacity renders a node capacity to screen.
+type NodeCapacity struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (NodeCapacity) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("STATUS", true); ok && !strings.HasPrefix(re.Row.Fields[idx], "Ready") {
+			return model1.ErrColor
+		}
+		if idx, ok := h.IndexOf("PRESSURE", true); ok && strings.TrimSpace(re.Row.Fields[idx]) != "" {
+			c = model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (NodeCapacity) Header(string) model1.Header {
+	return defaultNOCHeader
+}
+
+// Render renders a K8s resource to screen.
+func (NodeCapacity) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*NodeCapacityRes)
+	if !ok {
+		return fmt.Errorf("expected NodeCapacityRes but got %T", o)
+	}
+
+	cpu, mem, cpuPerc, memPerc := NAValue, NAValue, NAValue, NAValue
+	if res.HasMX {
+		cpu, mem = toMc(res.CPU), toMi(res.Mem)
+		cpuPerc, memPerc = client.ToPercentageStr(res.CPU, res.AllocCPU), client.ToPercentageStr(res.Mem, res.AllocMem)
+	}
+	r.ID = client.FQN("", res.Name)
+	r.Fields = model1.Fields{
+		res.Name,
+		res.Status(),
+		strconv.Itoa(res.Pods) + "/" + strconv.FormatInt(res.AllocPods, 10),
+		toMc(res.AllocCPU),
+		toMc(res.ReqCPU),
+		client.ToPercentageStr(res.ReqCPU, res.AllocCPU),
+		toMc(res.LimCPU),
+		client.ToPercentageStr(res.LimCPU, res.AllocCPU),
+		cpu,
+		cpuPerc,
+		toMi(res.AllocMem),
+		toMi(res.ReqMem),
+		client.ToPercentageStr(res.ReqMem, res.AllocMem),
+		toMi(res.LimMem),
+		client.ToPercentageStr(res.LimMem, res.AllocMem),
+		mem,
+		memPerc,
+		strings.Join(res.Pressures, ","),
+		strings.Join(res.Taints, ","),
+		ToAge(res.Age),
+	}
+
+	return nil
+}
+
+// NodeCapacityRes represents a node allocatable, requested and used resources.
+type NodeCapacityRes struct {
+	Name           string
+	Ready          bool
+	Unschedulable  bool
+	Pods           int
+	AllocPods      int64
+	AllocCPU, CPU  int64
+	ReqCPU, LimCPU int64
+	AllocMem, Mem  int64
+	ReqMem, LimMem int64
+	HasMX          bool
+	Pressures      []string
+	Taints         []string
+	Age            metav1.Time
+}
+
+// Status returns the node readiness status.
+func (n *NodeCapacityRes) Status() string {
+	s := "NotReady"
+	if n.Ready {
+		s = "Ready"
+	}
+	if n.Unschedulable {
+		s += ",SchedulingDisabled"
+	}
+
+	return s
+}
+
+// GetObjectKind returns a schema object.
+func (*NodeCapacityRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n *NodeCapacityRes) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeCapacityRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.NodeCapacityRes
+		e   model1.Fields
+	}{
+		"metrics": {
+			res: render.NodeCapacityRes{
+				Name:      "n1",
+				Ready:     true,
+				Pods:      2,
+				AllocPods: 110,
+				AllocCPU:  2000,
+				ReqCPU:    1000,
+				LimCPU:    2000,
+				CPU:       500,
+				AllocMem:  4096 * client.MegaByte,
+				ReqMem:    1024 * client.MegaByte,
+				LimMem:    2048 * client.MegaByte,
+				Mem:       512 * client.MegaByte,
+				HasMX:     true,
+				Pressures: []string{"MemoryPressure"},
+				Taints:    []string{"dedicated=gpu:NoSchedule"},
+			},
+			e: model1.Fields{"n1", "Ready", "2/110", "2000", "1000", "50", "2000", "100", "500", "25", "4096", "1024", "25", "2048", "50", "512", "12", "MemoryPressure", "dedicated=gpu:NoSchedule", render.UnknownValue},
+		},
+		"no-metrics": {
+			res: render.NodeCapacityRes{Name: "n1", Unschedulable: true},
+			e:   model1.Fields{"n1", "NotReady,SchedulingDisabled", "0/0", "0", "0", client.NA, "0", client.NA, render.NAValue, render.NAValue, "0", "0", client.NA, "0", client.NA, render.NAValue, render.NAValue, "", "", render.UnknownValue},
+		},
+	}
+
+	var re render.NodeCapacity
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(&u.res, "", &r))
+			assert.Equal(t, "n1", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+// Permission renders a subject effective permissions matrix to screen.
+type Permission struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Permission) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if idx, ok := h.IndexOf("SCOPE", true); ok && re.Row.Fields[idx] == WhoCanClusterScope {
+			c = model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Permission) Header(string) model1.Header {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "SCOPE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "API-GROUP"},
+	}
+	h = append(h, rbacVerbHeader()...)
+
+	return append(h, model1.HeaderColumn{Name: "GRANTED-BY", Attrs: model1.Attrs{Wide: true}})
+}
+
+// Render renders a K8s resource to screen.
+func (Permission) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(*PolicyRes)
+	if !ok {
+		return fmt.Errorf("expecting PolicyRes but got %T", o)
+	}
+
+	r.ID = p.Namespace + "|" + p.GR()
+	r.Fields = append(r.Fields,
+		p.Namespace,
+		cleanseResource(p.Resource),
+		p.Group,
+	)
+	r.Fields = append(r.Fields, asVerbs(p.Verbs)...)
+	r.Fields = append(r.Fields, p.Binding)
+
+	return nil
+}
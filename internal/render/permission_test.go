@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionRender(t *testing.T) {
+	var p render.Permission
+
+	var r model1.Row
+	o := render.PolicyRes{
+		Namespace: "ns1",
+		Binding:   "RB:fred(RO:reader),CRB:blee(CR:view)",
+		Resource:  "core/pods",
+		Group:     "core",
+		Verbs:     []string{"get", "list", "escalate"},
+	}
+
+	require.NoError(t, p.Render(&o, "", &r))
+	assert.Equal(t, "ns1|core/core/pods", r.ID)
+	assert.Equal(t, model1.Fields{
+		"ns1",
+		"pods",
+		"core",
+		"[green::b] ✓ [::]",
+		"[green::b] ✓ [::]",
+		"[orangered::b] × [::]",
+		"[orangered::b] × [::]",
+		"[orangered::b] × [::]",
+		"[orangered::b] × [::]",
+		"[orangered::b] × [::]",
+		"[orangered::b] × [::]",
+		"escalate",
+		"RB:fred(RO:reader),CRB:blee(CR:view)",
+	}, r.Fields)
+}
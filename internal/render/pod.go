@@ -140,7 +140,7 @@ func (p *Pod) Render(o any, _ string, row *model1.Row) error {
 	if p.specs.isEmpty() {
 		return nil
 	}
-	cols, err := p.specs.realize(pwm.Raw.DeepCopy(), defaultPodHeader, row)
+	cols, err := p.specs.realize(setCustomMetrics(pwm.Raw.DeepCopy(), pwm.Custom), defaultPodHeader, row)
 	if err != nil {
 		return err
 	}
@@ -297,6 +297,9 @@ func asReadinessGate(spec *v1.PodSpec, st *v1.PodStatus) string {
 type PodWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.PodMetrics
+
+	// Custom tracks the pod custom metrics if any.
+	Custom map[string]string
 }
 
 // GetObjectKind returns a schema object.
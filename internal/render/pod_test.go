@@ -6,6 +6,7 @@ package render_test
 import (
 	"testing"
 
+	cfg "github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
@@ -168,6 +169,22 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:21])
 }
 
+func TestPodRenderCustomMetrics(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:    load(t, "po"),
+		Custom: map[string]string{"gpu": "42"},
+	}
+
+	po := render.NewPod()
+	po.SetViewSetting(&cfg.ViewSetting{Columns: []string{"NAME", "GPU:.metrics.gpu"}})
+	var r model1.Row
+	require.NoError(t, po.Render(&pom, "", &r))
+
+	assert.Equal(t, model1.Fields{"nginx", "42"}, r.Fields[:2])
+	_, ok := pom.Raw.Object["metrics"]
+	assert.False(t, ok)
+}
+
 func BenchmarkPodRender(b *testing.B) {
 	pom := render.PodWithMetrics{
 		Raw: load(b, "po"),
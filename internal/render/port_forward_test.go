@@ -36,8 +36,9 @@ func TestPortForwardRender(t *testing.T) {
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
+		"Active",
 		"",
-	}, r.Fields[:8])
+	}, r.Fields[:9])
 }
 
 // Helpers...
@@ -71,3 +72,7 @@ func (fwd) Age() time.Time {
 func (fwd) Address() string {
 	return ""
 }
+
+func (fwd) Status() string {
+	return "Active"
+}
@@ -35,6 +35,9 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() time.Time
+
+	// Status returns forwarder connection status.
+	Status() string
 }
 
 // PortForward renders a portforwards to screen.
@@ -44,8 +47,19 @@ type PortForward struct {
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() model1.ColorerFunc {
-	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
-		return tcell.ColorSkyblue
+	return func(_ string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok || idx >= len(re.Row.Fields) {
+			return tcell.ColorSkyblue
+		}
+		switch status := re.Row.Fields[idx]; {
+		case strings.HasPrefix(status, "Reconnecting"):
+			return model1.PendingColor
+		case status == "Failed":
+			return model1.ErrColor
+		default:
+			return tcell.ColorSkyblue
+		}
 	}
 }
 
@@ -59,6 +73,7 @@ func (PortForward) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "URL"},
 		model1.HeaderColumn{Name: "C"},
 		model1.HeaderColumn{Name: "N"},
+		model1.HeaderColumn{Name: "STATUS"},
 		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 	}
@@ -83,6 +98,7 @@ func (PortForward) Render(o any, _ string, r *model1.Row) error {
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0], pf.Address()),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
+		pf.Status(),
 		"",
 		ToAge(metav1.Time{Time: pf.Age()}),
 	}
@@ -31,6 +31,7 @@ func (Reference) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "NAMESPACE"},
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "GVR"},
+		model1.HeaderColumn{Name: "VIA"},
 	}
 }
 
@@ -47,6 +48,7 @@ func (Reference) Render(o any, _ string, r *model1.Row) error {
 		ref.Namespace,
 		ref.Name,
 		ref.GVR,
+		ref.Via,
 	)
 
 	return nil
@@ -60,6 +62,7 @@ type ReferenceRes struct {
 	Namespace string
 	Name      string
 	GVR       string
+	Via       string
 }
 
 // GetObjectKind returns a schema object.
@@ -18,6 +18,7 @@ func TestReferenceRender(t *testing.T) {
 		Namespace: "ns1",
 		Name:      "blee",
 		GVR:       client.SecGVR.String(),
+		Via:       "env:c1,projected:certs",
 	}
 
 	var (
@@ -30,5 +31,6 @@ func TestReferenceRender(t *testing.T) {
 		"ns1",
 		"blee",
 		client.SecGVR.String(),
+		"env:c1,projected:certs",
 	}, r.Fields)
 }
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RolloutHistory renders a workload rollout revision to screen.
+type RolloutHistory struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (RolloutHistory) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("CURRENT", true); ok && re.Row.Fields[idx] == "*" {
+			c = model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (RolloutHistory) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "REVISION", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "CURRENT"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "IMAGES"},
+		model1.HeaderColumn{Name: "CHANGE-CAUSE"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (RolloutHistory) Render(o any, _ string, r *model1.Row) error {
+	rev, ok := o.(*RevisionRes)
+	if !ok {
+		return fmt.Errorf("expected RevisionRes but got %T", o)
+	}
+
+	var current string
+	if rev.Current {
+		current = "*"
+	}
+	r.ID = strconv.FormatInt(rev.Revision, 10)
+	r.Fields = model1.Fields{
+		r.ID,
+		current,
+		rev.Name,
+		fmt.Sprintf("%d/%d", rev.Ready, rev.Desired),
+		strings.Join(rev.Images, ","),
+		rev.ChangeCause,
+		timeToAge(rev.Created),
+	}
+
+	return nil
+}
+
+// RevisionRes represents a workload rollout revision.
+type RevisionRes struct {
+	Revision       int64
+	Name           string
+	Current        bool
+	Ready, Desired int64
+	Images         []string
+	ChangeCause    string
+	Created        time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*RevisionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *RevisionRes) DeepCopyObject() runtime.Object {
+	return r
+}
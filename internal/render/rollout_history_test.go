@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutHistoryRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.RevisionRes
+		e model1.Fields
+	}{
+		"current": {
+			o: &render.RevisionRes{
+				Revision:    3,
+				Name:        "fred-3",
+				Current:     true,
+				Ready:       2,
+				Desired:     3,
+				Images:      []string{"busybox:1.0", "nginx:2.0"},
+				ChangeCause: "kubectl set image",
+				Created:     time.Now(),
+			},
+			e: model1.Fields{"3", "*", "fred-3", "2/3", "busybox:1.0,nginx:2.0", "kubectl set image"},
+		},
+		"previous": {
+			o: &render.RevisionRes{
+				Revision: 2,
+				Name:     "fred-2",
+				Images:   []string{"nginx:1.0"},
+				Created:  time.Now(),
+			},
+			e: model1.Fields{"2", "", "fred-2", "0/0", "nginx:1.0", ""},
+		},
+	}
+
+	var h render.RolloutHistory
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, h.Render(u.o, "", &r))
+			assert.Equal(t, u.e[0], r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// RouteOK tracks a route whose backends all have ready endpoints.
+	RouteOK = "OK"
+
+	// RouteDegraded tracks a route backend with some endpoints not ready.
+	RouteDegraded = "Degraded"
+
+	// RouteNoEndpoints tracks a route backend without ready endpoints.
+	RouteNoEndpoints = "NoEndpoints"
+
+	// RouteBroken tracks a route pointing at a missing backend or rejected by its parent.
+	RouteBroken = "Broken"
+)
+
+var defaultRoutingHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "KIND"},
+	model1.HeaderColumn{Name: "PARENT"},
+	model1.HeaderColumn{Name: "HOSTS"},
+	model1.HeaderColumn{Name: "PATHS"},
+	model1.HeaderColumn{Name: "BACKEND"},
+	model1.HeaderColumn{Name: "ENDPOINTS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "PODS"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "REASON"},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// Routing renders a route backend resolution to screen.
+type Routing struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Routing) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("STATUS", true)
+		if !ok {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[idx]) {
+		case RouteBroken, RouteNoEndpoints:
+			return model1.ErrColor
+		case RouteDegraded:
+			return model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Routing) Header(string) model1.Header {
+	return defaultRoutingHeader
+}
+
+// Render renders a K8s resource to screen.
+func (Routing) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*RouteRes)
+	if !ok {
+		return fmt.Errorf("expected RouteRes but got %T", o)
+	}
+
+	r.ID = res.Path()
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Name,
+		res.Kind,
+		missing(res.Parent),
+		missing(strings.Join(res.Hosts, ",")),
+		missing(strings.Join(res.Paths, ",")),
+		missing(res.Backend),
+		res.endpoints(),
+		abbrevList(res.Pods),
+		res.Status,
+		res.Reason,
+		ToAge(res.Age),
+	}
+
+	return nil
+}
+
+// RouteRes represents an ingress or gateway route backend resolved to its endpoints and pods.
+type RouteRes struct {
+	GVR             string
+	Kind            string
+	Namespace, Name string
+	Parent          string
+	Hosts           []string
+	Paths           []string
+	Backend         string
+	BackendRef      string
+	Ready, Total    int
+	HasEndpoints    bool
+	Pods            []string
+	Status          string
+	Reason          string
+	Age             metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (*RouteRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *RouteRes) DeepCopyObject() runtime.Object {
+	return r
+}
+
+// Path returns the route backend path as gvr|namespace|name|backend.
+func (r *RouteRes) Path() string {
+	return strings.Join([]string{r.GVR, r.Namespace, r.Name, r.BackendRef}, "|")
+}
+
+func (r *RouteRes) endpoints() string {
+	if !r.HasEndpoints {
+		return NAValue
+	}
+
+	return strconv.Itoa(r.Ready) + "/" + strconv.Itoa(r.Total)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.RouteRes
+		e   model1.Fields
+	}{
+		"ok": {
+			res: render.RouteRes{
+				Kind:    "Ingress",
+				Parent:  "nginx",
+				Hosts:   []string{"fred.io"},
+				Paths:   []string{"/", "/api"},
+				Backend: "fe:80",
+				Ready:   2, Total: 2, HasEndpoints: true,
+				Pods:   []string{"fe-a", "fe-b"},
+				Status: render.RouteOK,
+			},
+			e: model1.Fields{"ns1", "web", "Ingress", "nginx", "fred.io", "/,/api", "fe:80", "2/2", "fe-a (+1)", "OK", ""},
+		},
+		"broken": {
+			res: render.RouteRes{
+				Kind:    "HTTPRoute",
+				Backend: "bozo:80",
+				Status:  render.RouteBroken,
+				Reason:  "service ns1/bozo not found",
+			},
+			e: model1.Fields{"ns1", "web", "HTTPRoute", "<none>", "<none>", "<none>", "bozo:80", "n/a", "<none>", "Broken", "service ns1/bozo not found"},
+		},
+	}
+
+	var re render.Routing
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.res.GVR, u.res.Namespace, u.res.Name, u.res.BackendRef = "networking.k8s.io/v1/ingresses", "ns1", "web", "Service:ns1/fe:80"
+			r := model1.NewRow(12)
+			require.NoError(t, re.Render(&u.res, "", &r))
+			assert.Equal(t, "networking.k8s.io/v1/ingresses|ns1|web|Service:ns1/fe:80", r.ID)
+			assert.Equal(t, u.e, r.Fields[:11])
+		})
+	}
+}
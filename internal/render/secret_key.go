@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretMask is shown in place of masked secret values.
+const SecretMask = "********"
+
+// SecretKey renders a secret data key to screen.
+type SecretKey struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (SecretKey) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("VALUE", true)
+		if !ok || re.Row.Fields[idx] == SecretMask {
+			return c
+		}
+
+		return model1.PendingColor
+	}
+}
+
+// Header returns a header row.
+func (SecretKey) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "VALUE"},
+		model1.HeaderColumn{Name: "ENCODING"},
+		model1.HeaderColumn{Name: "SIZE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SecretKey) Render(o any, _ string, r *model1.Row) error {
+	k, ok := o.(*SecretKeyRes)
+	if !ok {
+		return fmt.Errorf("expected SecretKeyRes but got %T", o)
+	}
+
+	r.ID = k.Key
+	r.Fields = model1.Fields{
+		k.Key,
+		k.value(),
+		k.encoding(),
+		strconv.Itoa(k.Size),
+	}
+
+	return nil
+}
+
+// SecretKeyRes represents a secret data key.
+type SecretKeyRes struct {
+	Key      string
+	Value    string
+	Size     int
+	Encoded  bool
+	Revealed bool
+}
+
+// GetObjectKind returns a schema object.
+func (*SecretKeyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *SecretKeyRes) DeepCopyObject() runtime.Object {
+	return s
+}
+
+func (s *SecretKeyRes) value() string {
+	if !s.Revealed {
+		return SecretMask
+	}
+
+	return strings.ReplaceAll(s.Value, "\n", `\n`)
+}
+
+func (s *SecretKeyRes) encoding() string {
+	switch {
+	case !s.Revealed:
+		return NAValue
+	case s.Encoded:
+		return "base64"
+	default:
+		return "text"
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretKeyRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.SecretKeyRes
+		e model1.Fields
+	}{
+		"masked": {
+			o: &render.SecretKeyRes{Key: "password", Size: 6},
+			e: model1.Fields{"password", render.SecretMask, "n/a", "6"},
+		},
+		"revealed": {
+			o: &render.SecretKeyRes{Key: "config", Value: "a: 1\nb: 2", Size: 9, Revealed: true},
+			e: model1.Fields{"config", `a: 1\nb: 2`, "text", "9"},
+		},
+		"encoded": {
+			o: &render.SecretKeyRes{Key: "password", Value: "Ymxl", Size: 3, Revealed: true, Encoded: true},
+			e: model1.Fields{"password", "Ymxl", "base64", "3"},
+		},
+	}
+
+	var sk render.SecretKey
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, sk.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Key, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
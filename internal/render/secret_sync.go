@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultExSHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STORE"},
+	model1.HeaderColumn{Name: "REFRESH INTERVAL"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "READY"},
+	model1.HeaderColumn{Name: "LAST REFRESH", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "TARGET"},
+	model1.HeaderColumn{Name: "MESSAGE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+var defaultSSHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "SYNCED"},
+	model1.HeaderColumn{Name: "LAST UPDATE", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "TARGET"},
+	model1.HeaderColumn{Name: "MESSAGE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// externalSecret represents the external-secrets.io ExternalSecret fields k9s renders.
+type externalSecret struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		RefreshInterval string `json:"refreshInterval"`
+		SecretStoreRef  struct {
+			Name string `json:"name"`
+			Kind string `json:"kind"`
+		} `json:"secretStoreRef"`
+		Target struct {
+			Name string `json:"name"`
+		} `json:"target"`
+	} `json:"spec"`
+	Status struct {
+		RefreshTime metav1.Time     `json:"refreshTime"`
+		Conditions  []syncCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+// sealedSecret represents the bitnami.com SealedSecret fields k9s renders.
+type sealedSecret struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Template struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		} `json:"template"`
+	} `json:"spec"`
+	Status *struct {
+		Conditions []syncCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+// syncCondition represents a secrets operator sync condition.
+type syncCondition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason"`
+	Message            string      `json:"message"`
+	LastUpdateTime     metav1.Time `json:"lastUpdateTime"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+func findSyncCondition(cc []syncCondition, kind string) *syncCondition {
+	for i := range cc {
+		if cc[i].Type == kind {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+func (c *syncCondition) ready() string {
+	if c == nil {
+		return MissingValue
+	}
+
+	return c.Status
+}
+
+// status returns the condition reason, defaulting to the sync state.
+func (c *syncCondition) status(synced string) string {
+	switch {
+	case c == nil:
+		return Pending
+	case c.Reason != "":
+		return c.Reason
+	case c.Status == string(metav1.ConditionTrue):
+		return synced
+	case c.Status == string(metav1.ConditionFalse):
+		return "Failed"
+	default:
+		return Pending
+	}
+}
+
+func (c *syncCondition) message() string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Message
+}
+
+func (c *syncCondition) diagnose() error {
+	switch {
+	case c == nil:
+		return errors.New("secret not synced yet")
+	case c.Status == string(metav1.ConditionTrue):
+		return nil
+	case c.Message != "":
+		return errors.New(c.Message)
+	default:
+		return fmt.Errorf("secret not synced: %s", c.status("Synced"))
+	}
+}
+
+// lastUpdate returns when the sealed secret was last unsealed.
+func (c *syncCondition) lastUpdate() string {
+	switch {
+	case c == nil:
+		return MissingValue
+	case !c.LastUpdateTime.IsZero():
+		return ToAge(c.LastUpdateTime)
+	case !c.LastTransitionTime.IsZero():
+		return ToAge(c.LastTransitionTime)
+	default:
+		return MissingValue
+	}
+}
+
+// ExternalSecretTarget returns an external secret target secret name.
+func ExternalSecretTarget(raw *unstructured.Unstructured) string {
+	if n, _, _ := unstructured.NestedString(raw.Object, "spec", "target", "name"); n != "" {
+		return n
+	}
+
+	return raw.GetName()
+}
+
+// SealedSecretTarget returns a sealed secret target secret name.
+func SealedSecretTarget(raw *unstructured.Unstructured) string {
+	if n, _, _ := unstructured.NestedString(raw.Object, "spec", "template", "metadata", "name"); n != "" {
+		return n
+	}
+
+	return raw.GetName()
+}
+
+// ExternalSecret renders an external-secrets.io ExternalSecret to screen.
+type ExternalSecret struct {
+	Base
+}
+
+// Header returns a header row.
+func (e ExternalSecret) Header(_ string) model1.Header {
+	return e.doHeader(defaultExSHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (e ExternalSecret) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := e.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if e.specs.isEmpty() {
+		return nil
+	}
+	cols, err := e.specs.realize(raw, defaultExSHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (ExternalSecret) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var es externalSecret
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &es)
+	if err != nil {
+		return err
+	}
+
+	store := es.Spec.SecretStoreRef.Name
+	if k := es.Spec.SecretStoreRef.Kind; k != "" {
+		store = strings.ToLower(k) + "/" + store
+	}
+	interval := es.Spec.RefreshInterval
+	if interval == "" {
+		interval = NAValue
+	}
+	refreshed := MissingValue
+	if !es.Status.RefreshTime.IsZero() {
+		refreshed = ToAge(es.Status.RefreshTime)
+	}
+	cond := findSyncCondition(es.Status.Conditions, "Ready")
+
+	r.ID = client.MetaFQN(&es.ObjectMeta)
+	r.Fields = model1.Fields{
+		es.Namespace,
+		es.Name,
+		store,
+		interval,
+		cond.status("SecretSynced"),
+		cond.ready(),
+		refreshed,
+		ExternalSecretTarget(raw),
+		cond.message(),
+		mapToStr(es.Labels),
+		AsStatus(cond.diagnose()),
+		ToAge(es.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// SealedSecret renders a bitnami.com SealedSecret to screen.
+type SealedSecret struct {
+	Base
+}
+
+// Header returns a header row.
+func (s SealedSecret) Header(_ string) model1.Header {
+	return s.doHeader(defaultSSHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (s SealedSecret) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := s.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if s.specs.isEmpty() {
+		return nil
+	}
+	cols, err := s.specs.realize(raw, defaultSSHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (SealedSecret) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var ss sealedSecret
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ss)
+	if err != nil {
+		return err
+	}
+
+	var cond *syncCondition
+	if ss.Status != nil {
+		cond = findSyncCondition(ss.Status.Conditions, "Synced")
+	}
+
+	r.ID = client.MetaFQN(&ss.ObjectMeta)
+	r.Fields = model1.Fields{
+		ss.Namespace,
+		ss.Name,
+		cond.status("Synced"),
+		cond.ready(),
+		cond.lastUpdate(),
+		SealedSecretTarget(raw),
+		cond.message(),
+		mapToStr(ss.Labels),
+		AsStatus(cond.diagnose()),
+		ToAge(ss.GetCreationTimestamp()),
+	}
+
+	return nil
+}
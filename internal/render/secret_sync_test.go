@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExternalSecretRender(t *testing.T) {
+	uu := map[string]struct {
+		o     *unstructured.Unstructured
+		e     model1.Fields
+		valid string
+	}{
+		"synced": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "db-creds", "namespace": "ns1"},
+				"spec": map[string]any{
+					"refreshInterval": "1h",
+					"secretStoreRef":  map[string]any{"name": "vault", "kind": "ClusterSecretStore"},
+					"target":          map[string]any{"name": "db"},
+				},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True", "reason": "SecretSynced", "message": "secret synced"},
+					},
+				},
+			}},
+			e: model1.Fields{"ns1", "db-creds", "clustersecretstore/vault", "1h", "SecretSynced", "True", "<none>", "db", "secret synced"},
+		},
+		"failed": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "api-key", "namespace": "ns1"},
+				"spec": map[string]any{
+					"secretStoreRef": map[string]any{"name": "aws"},
+				},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "False", "reason": "SecretSyncedError", "message": "could not get secret data from provider"},
+					},
+				},
+			}},
+			e:     model1.Fields{"ns1", "api-key", "aws", "n/a", "SecretSyncedError", "False", "<none>", "api-key", "could not get secret data from provider"},
+			valid: "could not get secret data from provider",
+		},
+		"pending": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "new", "namespace": "ns1"},
+				"spec":     map[string]any{"secretStoreRef": map[string]any{"name": "aws"}},
+			}},
+			e:     model1.Fields{"ns1", "new", "aws", "n/a", "Pending", "<none>", "<none>", "new", ""},
+			valid: "secret not synced yet",
+		},
+	}
+
+	var es render.ExternalSecret
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(12)
+			require.NoError(t, es.Render(u.o, "", &r))
+			assert.Equal(t, "ns1/"+u.o.GetName(), r.ID)
+			assert.Equal(t, u.e, r.Fields[:9])
+			assert.Equal(t, u.valid, r.Fields[10])
+		})
+	}
+}
+
+func TestSealedSecretRender(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		e model1.Fields
+	}{
+		"synced": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "db-creds", "namespace": "ns1"},
+				"spec": map[string]any{
+					"template": map[string]any{"metadata": map[string]any{"name": "db"}},
+				},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Synced", "status": "True"},
+					},
+				},
+			}},
+			e: model1.Fields{"ns1", "db-creds", "Synced", "True", "<none>", "db", ""},
+		},
+		"failed": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "api-key", "namespace": "ns1"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Synced", "status": "False", "message": "no key could decrypt secret"},
+					},
+				},
+			}},
+			e: model1.Fields{"ns1", "api-key", "Failed", "False", "<none>", "api-key", "no key could decrypt secret"},
+		},
+		"pending": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "new", "namespace": "ns1"},
+			}},
+			e: model1.Fields{"ns1", "new", "Pending", "<none>", "<none>", "new", ""},
+		},
+	}
+
+	var ss render.SealedSecret
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(10)
+			require.NoError(t, ss.Render(u.o, "", &r))
+			assert.Equal(t, "ns1/"+u.o.GetName(), r.ID)
+			assert.Equal(t, u.e, r.Fields[:7])
+		})
+	}
+}
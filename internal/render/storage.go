@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// storageUsageAlert tracks the claim usage percentage flagged as critical.
+const storageUsageAlert = 90
+
+var defaultStorageHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "VOLUME"},
+	model1.HeaderColumn{Name: "CAPACITY", Attrs: model1.Attrs{Capacity: true}},
+	model1.HeaderColumn{Name: "USED", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%USED", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "ACCESS MODES"},
+	model1.HeaderColumn{Name: "STORAGECLASS"},
+	model1.HeaderColumn{Name: "PROVISIONER", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "RECLAIM", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "BINDING", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "PODS"},
+	model1.HeaderColumn{Name: "REASON"},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// Storage renders a claim storage diagnostics to screen.
+type Storage struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Storage) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("STATUS", true); ok {
+			switch strings.TrimSpace(re.Row.Fields[idx]) {
+			case string(v1.ClaimLost):
+				return model1.ErrColor
+			case string(v1.ClaimPending), "Terminating":
+				return model1.PendingColor
+			}
+		}
+		if idx, ok := h.IndexOf("%USED", true); ok {
+			if n, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[idx])); err == nil && n >= storageUsageAlert {
+				return model1.ErrColor
+			}
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Storage) Header(string) model1.Header {
+	return defaultStorageHeader
+}
+
+// Render renders a K8s resource to screen.
+func (Storage) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*StorageRes)
+	if !ok {
+		return fmt.Errorf("expected StorageRes but got %T", o)
+	}
+
+	used, perc := NAValue, NAValue
+	if res.HasUsage {
+		used, perc = toMi(res.Used), client.ToPercentageStr(res.Used, res.UsageCapacity)
+	}
+	r.ID = client.FQN(res.Namespace, res.Name)
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Name,
+		res.Status(),
+		res.Volume,
+		res.Capacity,
+		used,
+		perc,
+		accessMode(res.AccessModes),
+		res.StorageClass,
+		res.Provisioner,
+		res.ReclaimPolicy,
+		res.BindingMode,
+		strings.Join(res.Pods, ","),
+		res.Reason,
+		ToAge(res.Age),
+	}
+
+	return nil
+}
+
+// StorageRes represents a claim joined with its volume, storage class, usage and consumers.
+type StorageRes struct {
+	Namespace, Name string
+	Phase           v1.PersistentVolumeClaimPhase
+	Terminating     bool
+	Volume          string
+	Capacity        string
+	AccessModes     []v1.PersistentVolumeAccessMode
+	StorageClass    string
+	Provisioner     string
+	ReclaimPolicy   string
+	BindingMode     string
+	Used            int64
+	UsageCapacity   int64
+	HasUsage        bool
+	Pods            []string
+	Reason          string
+	Age             metav1.Time
+}
+
+// Status returns the claim status.
+func (s *StorageRes) Status() string {
+	if s.Terminating {
+		return "Terminating"
+	}
+
+	return string(s.Phase)
+}
+
+// GetObjectKind returns a schema object.
+func (*StorageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *StorageRes) DeepCopyObject() runtime.Object {
+	return s
+}
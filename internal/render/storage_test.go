@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStorageRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.StorageRes
+		e model1.Fields
+	}{
+		"bound": {
+			o: &render.StorageRes{
+				Namespace:     "ns1",
+				Name:          "data",
+				Phase:         v1.ClaimBound,
+				Volume:        "pv1",
+				Capacity:      "4Gi",
+				AccessModes:   []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				StorageClass:  "fast",
+				Provisioner:   "ebs.csi.aws.com",
+				ReclaimPolicy: "Delete",
+				BindingMode:   "WaitForFirstConsumer",
+				Used:          1 << 30,
+				UsageCapacity: 4 << 30,
+				HasUsage:      true,
+				Pods:          []string{"p1", "p2"},
+				Age:           metav1.Now(),
+			},
+			e: model1.Fields{"ns1", "data", "Bound", "pv1", "4Gi", "1024", "25", "RWO", "fast", "ebs.csi.aws.com", "Delete", "WaitForFirstConsumer", "p1,p2", ""},
+		},
+		"pending": {
+			o: &render.StorageRes{
+				Namespace:    "ns1",
+				Name:         "logs",
+				Phase:        v1.ClaimPending,
+				Terminating:  true,
+				StorageClass: "bozo",
+				Reason:       `storage class "bozo" not found`,
+				Age:          metav1.Now(),
+			},
+			e: model1.Fields{"ns1", "logs", "Terminating", "", "", "n/a", "n/a", "", "bozo", "", "", "", "", `storage class "bozo" not found`},
+		},
+	}
+
+	var s render.Storage
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, s.Render(u.o, "", &r))
+			assert.Equal(t, "ns1/"+u.o.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields[:14])
+		})
+	}
+}
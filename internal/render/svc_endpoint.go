@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceEndpoint renders a service endpoint slice address to screen.
+type ServiceEndpoint struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ServiceEndpoint) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		ready, ok := h.IndexOf("READY", true)
+		if !ok {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[ready]) == "true" {
+			return c
+		}
+		serving, ok := h.IndexOf("SERVING", true)
+		if ok && strings.TrimSpace(re.Row.Fields[serving]) == "true" {
+			return model1.PendingColor
+		}
+
+		return model1.ErrColor
+	}
+}
+
+// Header returns a header row.
+func (ServiceEndpoint) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SLICE"},
+		model1.HeaderColumn{Name: "ADDRESS"},
+		model1.HeaderColumn{Name: "PORTS"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "SERVING"},
+		model1.HeaderColumn{Name: "TERMINATING"},
+		model1.HeaderColumn{Name: "POD"},
+		model1.HeaderColumn{Name: "NODE"},
+		model1.HeaderColumn{Name: "ZONE"},
+		model1.HeaderColumn{Name: "REASON"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ServiceEndpoint) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*ServiceEndpointRes)
+	if !ok {
+		return fmt.Errorf("expected ServiceEndpointRes but got %T", o)
+	}
+
+	r.ID = res.Path()
+	r.Fields = model1.Fields{
+		missing(res.Slice),
+		missing(res.Address),
+		missing(strings.Join(res.Ports, ",")),
+		boolToStr(res.Ready),
+		boolToStr(res.Serving),
+		boolToStr(res.Terminating),
+		missing(res.Pod),
+		missing(res.Node),
+		missing(res.Zone),
+		res.Reason,
+	}
+
+	return nil
+}
+
+// ServiceEndpointRes represents a service endpoint slice address mapped back to its pod and node.
+type ServiceEndpointRes struct {
+	Namespace                   string
+	Slice                       string
+	Address                     string
+	Ports                       []string
+	Ready, Serving, Terminating bool
+	Pod, Node, Zone             string
+	Reason                      string
+}
+
+// GetObjectKind returns a schema object.
+func (*ServiceEndpointRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *ServiceEndpointRes) DeepCopyObject() runtime.Object {
+	return s
+}
+
+// Path returns the endpoint path as namespace|slice|address|pod|node.
+func (s *ServiceEndpointRes) Path() string {
+	return strings.Join([]string{s.Namespace, s.Slice, s.Address, s.Pod, s.Node}, "|")
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceEndpointRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ServiceEndpointRes
+		e   model1.Fields
+		c   tcell.Color
+	}{
+		"ready": {
+			res: render.ServiceEndpointRes{
+				Namespace: "ns1", Slice: "fe-x1", Address: "10.0.0.1", Ports: []string{"http:8080/TCP"},
+				Ready: true, Serving: true, Pod: "fe-a", Node: "n1", Zone: "z1",
+			},
+			e: model1.Fields{"fe-x1", "10.0.0.1", "http:8080/TCP", "true", "true", "false", "fe-a", "n1", "z1", ""},
+			c: model1.AddColor,
+		},
+		"terminating": {
+			res: render.ServiceEndpointRes{
+				Namespace: "ns1", Slice: "fe-x1", Address: "10.0.0.2",
+				Serving: true, Terminating: true, Pod: "fe-b", Reason: "pod terminating",
+			},
+			e: model1.Fields{"fe-x1", "10.0.0.2", "<none>", "false", "true", "true", "fe-b", "<none>", "<none>", "pod terminating"},
+			c: model1.PendingColor,
+		},
+		"none": {
+			res: render.ServiceEndpointRes{Namespace: "ns1", Reason: "no pods match selector app=fe"},
+			e:   model1.Fields{"<none>", "<none>", "<none>", "false", "false", "false", "<none>", "<none>", "<none>", "no pods match selector app=fe"},
+			c:   model1.ErrColor,
+		},
+	}
+
+	var s render.ServiceEndpoint
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(10)
+			require.NoError(t, s.Render(&u.res, "", &r))
+			assert.Equal(t, u.res.Path(), r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, u.c, s.ColorerFunc()("", s.Header(""), &model1.RowEvent{Kind: model1.EventAdd, Row: r}))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// TimelineEvent tracks kubernetes events.
+	TimelineEvent = "Event"
+
+	// TimelineRollout tracks workload revisions.
+	TimelineRollout = "Rollout"
+
+	// TimelineRestart tracks container restarts.
+	TimelineRestart = "Restart"
+
+	// timelineFmat tracks sortable timeline timestamps.
+	timelineFmat = "2006-01-02 15:04:05"
+)
+
+// Timeline renders a resource timeline entry to screen.
+type Timeline struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Timeline) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("SOURCE", true); ok && re.Row.Fields[idx] == TimelineRollout {
+			c = model1.HighlightColor
+		}
+		if idx, ok := h.IndexOf("TYPE", true); ok && re.Row.Fields[idx] == v1.EventTypeWarning {
+			c = model1.ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Timeline) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "TIME"},
+		model1.HeaderColumn{Name: "SOURCE"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "OBJECT"},
+		model1.HeaderColumn{Name: "REASON"},
+		model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "MESSAGE"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Timeline) Render(o any, _ string, r *model1.Row) error {
+	t, ok := o.(*TimelineRes)
+	if !ok {
+		return fmt.Errorf("expected TimelineRes but got %T", o)
+	}
+
+	count := ""
+	if t.Count > 0 {
+		count = strconv.Itoa(t.Count)
+	}
+	r.ID = t.ID
+	r.Fields = model1.Fields{
+		t.Time.Local().Format(timelineFmat),
+		t.Source,
+		t.Type,
+		t.Object,
+		t.Reason,
+		count,
+		t.Message,
+		timeToAge(t.Time),
+	}
+
+	return nil
+}
+
+// TimelineRes represents a resource timeline entry.
+type TimelineRes struct {
+	ID      string
+	Time    time.Time
+	Source  string
+	Type    string
+	Object  string
+	Reason  string
+	Message string
+	Count   int
+}
+
+// GetObjectKind returns a schema object.
+func (*TimelineRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t *TimelineRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineRender(t *testing.T) {
+	ts := time.Date(2026, 10, 14, 10, 0, 0, 0, time.Local)
+	uu := map[string]struct {
+		o *render.TimelineRes
+		e model1.Fields
+	}{
+		"rollout": {
+			o: &render.TimelineRes{
+				ID:      "00000",
+				Time:    ts,
+				Source:  render.TimelineRollout,
+				Type:    "Normal",
+				Object:  "ReplicaSet/fred-1",
+				Reason:  "Revision 1",
+				Message: "images nginx:1.0",
+			},
+			e: model1.Fields{"2026-10-14 10:00:00", "Rollout", "Normal", "ReplicaSet/fred-1", "Revision 1", "", "images nginx:1.0"},
+		},
+		"restart": {
+			o: &render.TimelineRes{
+				ID:      "00001",
+				Time:    ts,
+				Source:  render.TimelineRestart,
+				Type:    "Warning",
+				Object:  "Pod/fred-1-a",
+				Reason:  "OOMKilled",
+				Message: "container c1 restarted (exit code 137)",
+				Count:   3,
+			},
+			e: model1.Fields{"2026-10-14 10:00:00", "Restart", "Warning", "Pod/fred-1-a", "OOMKilled", "3", "container c1 restarted (exit code 137)"},
+		},
+	}
+
+	var tl render.Timeline
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, tl.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
+
+func TestTimelineColorer(t *testing.T) {
+	var tl render.Timeline
+	h := tl.Header("")
+	uu := map[string]struct {
+		f model1.Fields
+		e tcell.Color
+	}{
+		"rollout": {
+			f: model1.Fields{"", render.TimelineRollout, "Normal", "", "", "", "", ""},
+			e: model1.HighlightColor,
+		},
+		"warning": {
+			f: model1.Fields{"", render.TimelineEvent, "Warning", "", "", "", "", ""},
+			e: model1.ErrColor,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := model1.RowEvent{Kind: model1.EventAdd, Row: model1.Row{Fields: u.f}}
+			assert.Equal(t, u.e, tl.ColorerFunc()("", h, &re))
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultVSHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "READY"},
+	model1.HeaderColumn{Name: "SOURCE"},
+	model1.HeaderColumn{Name: "RESTORE SIZE", Attrs: model1.Attrs{Capacity: true}},
+	model1.HeaderColumn{Name: "CLASS"},
+	model1.HeaderColumn{Name: "CONTENT"},
+	model1.HeaderColumn{Name: "CREATED", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "ERROR"},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+var defaultVSCHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "READY"},
+	model1.HeaderColumn{Name: "RESTORE SIZE", Attrs: model1.Attrs{Capacity: true}},
+	model1.HeaderColumn{Name: "DELETION POLICY"},
+	model1.HeaderColumn{Name: "DRIVER"},
+	model1.HeaderColumn{Name: "CLASS"},
+	model1.HeaderColumn{Name: "SNAPSHOT"},
+	model1.HeaderColumn{Name: "HANDLE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "ERROR"},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// volumeSnapshot represents the snapshot.storage.k8s.io VolumeSnapshot fields k9s renders.
+type volumeSnapshot struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		Source struct {
+			PersistentVolumeClaimName *string `json:"persistentVolumeClaimName"`
+			VolumeSnapshotContentName *string `json:"volumeSnapshotContentName"`
+		} `json:"source"`
+		VolumeSnapshotClassName *string `json:"volumeSnapshotClassName"`
+	} `json:"spec"`
+	Status *snapshotStatus `json:"status"`
+}
+
+// volumeSnapshotContent represents the snapshot.storage.k8s.io VolumeSnapshotContent fields k9s renders.
+type volumeSnapshotContent struct {
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec struct {
+		DeletionPolicy    string `json:"deletionPolicy"`
+		Driver            string `json:"driver"`
+		VolumeSnapshotRef struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"volumeSnapshotRef"`
+		VolumeSnapshotClassName *string `json:"volumeSnapshotClassName"`
+	} `json:"spec"`
+	Status *snapshotStatus `json:"status"`
+}
+
+// snapshotStatus represents a snapshot or snapshot content status.
+type snapshotStatus struct {
+	BoundVolumeSnapshotContentName *string            `json:"boundVolumeSnapshotContentName"`
+	SnapshotHandle                 *string            `json:"snapshotHandle"`
+	CreationTime                   *metav1.Time       `json:"creationTime"`
+	ReadyToUse                     *bool              `json:"readyToUse"`
+	RestoreSize                    *resource.Quantity `json:"restoreSize"`
+	Error                          *struct {
+		Message *string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *snapshotStatus) ready() *bool {
+	if s == nil {
+		return nil
+	}
+
+	return s.ReadyToUse
+}
+
+func (s *snapshotStatus) restoreSize() string {
+	if s == nil || s.RestoreSize == nil {
+		return ""
+	}
+
+	// Contents report sizes in bytes.
+	return resource.NewQuantity(s.RestoreSize.Value(), resource.BinarySI).String()
+}
+
+func (s *snapshotStatus) created() string {
+	if s == nil || s.CreationTime == nil {
+		return "<none>"
+	}
+
+	return ToAge(*s.CreationTime)
+}
+
+func (s *snapshotStatus) errMsg() string {
+	if s == nil || s.Error == nil {
+		return ""
+	}
+
+	return strPtrToStr(s.Error.Message)
+}
+
+func (s *snapshotStatus) diagnose() error {
+	if msg := s.errMsg(); msg != "" {
+		return errors.New(msg)
+	}
+	if r := s.ready(); r == nil || !*r {
+		return errors.New("snapshot not ready to use")
+	}
+
+	return nil
+}
+
+// VolumeSnapshot renders a CSI VolumeSnapshot to screen.
+type VolumeSnapshot struct {
+	Base
+}
+
+// Header returns a header row.
+func (v VolumeSnapshot) Header(_ string) model1.Header {
+	return v.doHeader(defaultVSHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (v VolumeSnapshot) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := v.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if v.specs.isEmpty() {
+		return nil
+	}
+	cols, err := v.specs.realize(raw, defaultVSHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (VolumeSnapshot) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var vs volumeSnapshot
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &vs)
+	if err != nil {
+		return err
+	}
+
+	source := strPtrToStr(vs.Spec.Source.PersistentVolumeClaimName)
+	if vs.Spec.Source.VolumeSnapshotContentName != nil {
+		source = "content/" + *vs.Spec.Source.VolumeSnapshotContentName
+	}
+	var content string
+	if vs.Status != nil {
+		content = strPtrToStr(vs.Status.BoundVolumeSnapshotContentName)
+	}
+
+	r.ID = client.MetaFQN(&vs.ObjectMeta)
+	r.Fields = model1.Fields{
+		vs.Namespace,
+		vs.Name,
+		boolPtrToStr(vs.Status.ready()),
+		source,
+		vs.Status.restoreSize(),
+		strPtrToStr(vs.Spec.VolumeSnapshotClassName),
+		content,
+		vs.Status.created(),
+		vs.Status.errMsg(),
+		mapToStr(vs.Labels),
+		AsStatus(vs.Status.diagnose()),
+		ToAge(vs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// VolumeSnapshotContent renders a CSI VolumeSnapshotContent to screen.
+type VolumeSnapshotContent struct {
+	Base
+}
+
+// Header returns a header row.
+func (v VolumeSnapshotContent) Header(_ string) model1.Header {
+	return v.doHeader(defaultVSCHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (v VolumeSnapshotContent) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := v.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if v.specs.isEmpty() {
+		return nil
+	}
+	cols, err := v.specs.realize(raw, defaultVSCHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (VolumeSnapshotContent) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
+	var vsc volumeSnapshotContent
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &vsc)
+	if err != nil {
+		return err
+	}
+
+	var handle string
+	if vsc.Status != nil {
+		handle = strPtrToStr(vsc.Status.SnapshotHandle)
+	}
+	ref := vsc.Spec.VolumeSnapshotRef
+
+	r.ID = client.FQN(client.ClusterScope, vsc.Name)
+	r.Fields = model1.Fields{
+		vsc.Name,
+		boolPtrToStr(vsc.Status.ready()),
+		vsc.Status.restoreSize(),
+		vsc.Spec.DeletionPolicy,
+		vsc.Spec.Driver,
+		strPtrToStr(vsc.Spec.VolumeSnapshotClassName),
+		client.FQN(ref.Namespace, ref.Name),
+		handle,
+		vsc.Status.errMsg(),
+		mapToStr(vsc.Labels),
+		AsStatus(vsc.Status.diagnose()),
+		ToAge(vsc.GetCreationTimestamp()),
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestVolumeSnapshotRender(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		e model1.Fields
+	}{
+		"ready": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "data-snap", "namespace": "ns1"},
+				"spec": map[string]any{
+					"source":                  map[string]any{"persistentVolumeClaimName": "data"},
+					"volumeSnapshotClassName": "csi-snap",
+				},
+				"status": map[string]any{
+					"boundVolumeSnapshotContentName": "snapcontent-1",
+					"readyToUse":                     true,
+					"restoreSize":                    "2Gi",
+				},
+			}},
+			e: model1.Fields{"ns1", "data-snap", "true", "data", "2Gi", "csi-snap", "snapcontent-1", "<none>", ""},
+		},
+		"failed": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "logs-snap", "namespace": "ns1"},
+				"spec": map[string]any{
+					"source": map[string]any{"volumeSnapshotContentName": "pre-1"},
+				},
+				"status": map[string]any{
+					"readyToUse": false,
+					"error":      map[string]any{"message": "driver timed out"},
+				},
+			}},
+			e: model1.Fields{"ns1", "logs-snap", "false", "content/pre-1", "", "", "", "<none>", "driver timed out"},
+		},
+		"pending": {
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "new-snap", "namespace": "ns1"},
+				"spec": map[string]any{
+					"source": map[string]any{"persistentVolumeClaimName": "data"},
+				},
+			}},
+			e: model1.Fields{"ns1", "new-snap", "false", "data", "", "", "", "<none>", ""},
+		},
+	}
+
+	var v render.VolumeSnapshot
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(12)
+			require.NoError(t, v.Render(u.o, "", &r))
+			assert.Equal(t, "ns1/"+u.o.GetName(), r.ID)
+			assert.Equal(t, u.e, r.Fields[:9])
+		})
+	}
+}
+
+func TestVolumeSnapshotContentRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "snapcontent-1"},
+		"spec": map[string]any{
+			"deletionPolicy":          "Delete",
+			"driver":                  "ebs.csi.aws.com",
+			"volumeSnapshotClassName": "csi-snap",
+			"volumeSnapshotRef":       map[string]any{"name": "data-snap", "namespace": "ns1"},
+		},
+		"status": map[string]any{
+			"readyToUse":     true,
+			"restoreSize":    int64(2147483648),
+			"snapshotHandle": "snap-0123",
+		},
+	}}
+
+	var v render.VolumeSnapshotContent
+	r := model1.NewRow(12)
+	require.NoError(t, v.Render(&o, "", &r))
+	assert.Equal(t, "-/snapcontent-1", r.ID)
+	assert.Equal(t, model1.Fields{"snapcontent-1", "true", "2Gi", "Delete", "ebs.csi.aws.com", "csi-snap", "ns1/data-snap", "snap-0123", ""}, r.Fields[:9])
+}
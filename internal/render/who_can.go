@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhoCanClusterScope tracks grants spanning all namespaces.
+const WhoCanClusterScope = "*"
+
+// WhoCan renders subjects allowed to perform an action to screen.
+type WhoCan struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (WhoCan) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		if idx, ok := h.IndexOf("SCOPE", true); ok && re.Row.Fields[idx] == WhoCanClusterScope {
+			c = model1.HighlightColor
+		}
+		if idx, ok := h.IndexOf("NAME", true); ok && strings.HasPrefix(re.Row.Fields[idx], "system:") {
+			c = model1.CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (WhoCan) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SCOPE"},
+		model1.HeaderColumn{Name: "BINDING"},
+		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "VERBS"},
+		model1.HeaderColumn{Name: "RESOURCE-NAMES", Attrs: model1.Attrs{Wide: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (WhoCan) Render(o any, _ string, r *model1.Row) error {
+	w, ok := o.(*WhoCanRes)
+	if !ok {
+		return fmt.Errorf("expected WhoCanRes but got %T", o)
+	}
+
+	names := strings.Join(w.ResourceNames, ",")
+	if names == "" {
+		names = WhoCanClusterScope
+	}
+	r.ID = w.ID
+	r.Fields = model1.Fields{
+		w.SubjectKind,
+		w.Subject,
+		w.Scope,
+		w.Binding,
+		w.Role,
+		strings.Join(w.Verbs, ","),
+		names,
+	}
+
+	return nil
+}
+
+// WhoCanRes represents a subject granted an action via a binding.
+type WhoCanRes struct {
+	ID            string
+	SubjectKind   string
+	Subject       string
+	Scope         string
+	Binding, Role string
+	Verbs         []string
+	ResourceNames []string
+}
+
+// GetObjectKind returns a schema object.
+func (*WhoCanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w *WhoCanRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhoCanRender(t *testing.T) {
+	uu := map[string]struct {
+		o *render.WhoCanRes
+		e model1.Fields
+	}{
+		"cluster": {
+			o: &render.WhoCanRes{
+				ID:          "rbac.authorization.k8s.io/v1/clusterrolebindings|fred|Group|devs",
+				SubjectKind: "Group",
+				Subject:     "devs",
+				Scope:       render.WhoCanClusterScope,
+				Binding:     "ClusterRoleBinding/fred",
+				Role:        "ClusterRole/view",
+				Verbs:       []string{"get", "list", "watch"},
+			},
+			e: model1.Fields{"Group", "devs", "*", "ClusterRoleBinding/fred", "ClusterRole/view", "get,list,watch", "*"},
+		},
+		"names": {
+			o: &render.WhoCanRes{
+				ID:            "rbac.authorization.k8s.io/v1/rolebindings|ns1/blee|ServiceAccount|ns1/blee",
+				SubjectKind:   "ServiceAccount",
+				Subject:       "ns1/blee",
+				Scope:         "ns1",
+				Binding:       "RoleBinding/blee",
+				Role:          "Role/blee",
+				Verbs:         []string{"get"},
+				ResourceNames: []string{"s1", "s2"},
+			},
+			e: model1.Fields{"ServiceAccount", "ns1/blee", "ns1", "RoleBinding/blee", "Role/blee", "get", "s1,s2"},
+		},
+	}
+
+	var w render.WhoCan
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, w.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
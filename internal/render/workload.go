@@ -5,13 +5,19 @@ package render
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 var defaultWKHeader = model1.Header{
@@ -20,8 +26,22 @@ var defaultWKHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "STATUS"},
 	model1.HeaderColumn{Name: "READY"},
+	model1.HeaderColumn{Name: "TREND"},
+	model1.HeaderColumn{Name: "RESTARTS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "LAST RESTART", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "HPA"},
+	model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
+	model1.HeaderColumn{Name: "MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "%MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true, Wide: true}},
+	model1.HeaderColumn{Name: "OWNER"},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "ERROR", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "REASON", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "GROUP", Attrs: model1.Attrs{Hide: true}},
 }
 
 // Workload renders a workload to screen.
@@ -34,12 +54,15 @@ func (Workload) ColorerFunc() model1.ColorerFunc {
 	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
 		c := model1.DefaultColorer(ns, h, re)
 
+		if idx, ok := h.IndexOf("ERROR", true); ok && strings.TrimSpace(re.Row.Fields[idx]) != "" {
+			return model1.ErrColor
+		}
 		idx, ok := h.IndexOf("STATUS", true)
 		if !ok {
 			return c
 		}
 		status := strings.TrimSpace(re.Row.Fields[idx])
-		if status == "DEGRADED" {
+		if status == "DEGRADED" || status == PausedStatus {
 			c = model1.PendingColor
 		}
 
@@ -58,23 +81,204 @@ func (Workload) Render(o any, _ string, r *model1.Row) error {
 	if !ok {
 		return fmt.Errorf("expected WorkloadRes but got %T", o)
 	}
+	if len(res.Row.Cells) != WKCellCount {
+		return fmt.Errorf("expected %d workload cells but got %d", WKCellCount, len(res.Row.Cells))
+	}
 
-	r.ID = fmt.Sprintf("%s|%s|%s", res.Row.Cells[0].(string), res.Row.Cells[1].(string), res.Row.Cells[2].(string))
-	r.Fields = model1.Fields{
-		res.Row.Cells[0].(string),
-		res.Row.Cells[1].(string),
-		res.Row.Cells[2].(string),
-		res.Row.Cells[3].(string),
-		res.Row.Cells[4].(string),
-		res.Row.Cells[5].(string),
-		ToAge(res.Row.Cells[6].(metav1.Time)),
+	r.ID = fmt.Sprintf("%s|%s|%s", res.Str(WKKindCell), res.Str(WKNamespaceCell), res.Str(WKNameCell))
+	mx := res.Usage.fields()
+	r.Fields = make(model1.Fields, 0, len(defaultWKHeader))
+	for _, h := range defaultWKHeader {
+		fn, ok := wkColumns[h.Name]
+		if !ok {
+			return fmt.Errorf("no workload column %q", h.Name)
+		}
+		r.Fields = append(r.Fields, fn(res, mx))
 	}
 
 	return nil
 }
 
+// Workload row cells.
+const (
+	WKKindCell = iota
+	WKNamespaceCell
+	WKNameCell
+	WKStatusCell
+	WKReadyCell
+	WKValidCell
+	WKCreatedCell
+	WKErrorCell
+	WKRestartsCell
+	WKLastRestartCell
+	WKHPACell
+	WKTrendCell
+	WKOwnerCell
+	WKReasonCell
+
+	// WKCellCount tracks the number of workload row cells.
+	WKCellCount
+)
+
+type wkColumnFn func(res *WorkloadRes, mx model1.Fields) string
+
+func wkCell(idx int) wkColumnFn {
+	return func(res *WorkloadRes, _ model1.Fields) string {
+		return res.Str(idx)
+	}
+}
+
+func wkUsage(idx int) wkColumnFn {
+	return func(_ *WorkloadRes, mx model1.Fields) string {
+		return mx[idx]
+	}
+}
+
+// wkColumns resolves the workload header columns values.
+var wkColumns = map[string]wkColumnFn{
+	"KIND": func(res *WorkloadRes, _ model1.Fields) string {
+		return res.Prefix + res.Str(WKKindCell)
+	},
+	"NAMESPACE": wkCell(WKNamespaceCell),
+	"NAME":      wkCell(WKNameCell),
+	"STATUS":    wkCell(WKStatusCell),
+	"READY":     wkCell(WKReadyCell),
+	"TREND": func(res *WorkloadRes, _ model1.Fields) string {
+		vv, _ := res.Row.Cells[WKTrendCell].([]float64)
+		return toSparkline(vv)
+	},
+	"RESTARTS": wkCell(WKRestartsCell),
+	"LAST RESTART": func(res *WorkloadRes, _ model1.Fields) string {
+		return toRestartAge(res.Time(WKLastRestartCell))
+	},
+	"HPA":    wkCell(WKHPACell),
+	"CPU":    wkUsage(0),
+	"%CPU/R": wkUsage(1),
+	"%CPU/L": wkUsage(2),
+	"MEM":    wkUsage(3),
+	"%MEM/R": wkUsage(4),
+	"%MEM/L": wkUsage(5),
+	"OWNER":  wkCell(WKOwnerCell),
+	"VALID":  wkCell(WKValidCell),
+	"ERROR":  wkCell(WKErrorCell),
+	"REASON": wkCell(WKReasonCell),
+	"AGE": func(res *WorkloadRes, _ model1.Fields) string {
+		return ToAge(res.Time(WKCreatedCell))
+	},
+	"GROUP": func(res *WorkloadRes, _ model1.Fields) string {
+		return res.Group
+	},
+}
+
+// WorkloadRes represents a workload resource.
 type WorkloadRes struct {
-	Row metav1.TableRow
+	Row    metav1.TableRow
+	UID    types.UID
+	Owners []metav1.OwnerReference
+	Labels map[string]string
+
+	// Group tracks the row sort key when workloads are grouped.
+	Group string
+
+	// Prefix decorates the row kind when workloads are grouped.
+	Prefix string
+
+	// Usage tracks the workload pods resources usage if any.
+	Usage *WorkloadUsage
+}
+
+// Str returns a string cell or blank if out of bounds or not a string.
+func (a *WorkloadRes) Str(idx int) string {
+	if idx < 0 || idx >= len(a.Row.Cells) {
+		return ""
+	}
+	s, _ := a.Row.Cells[idx].(string)
+
+	return s
+}
+
+// Time returns a time cell or zero if out of bounds or not a time.
+func (a *WorkloadRes) Time(idx int) metav1.Time {
+	if idx < 0 || idx >= len(a.Row.Cells) {
+		return metav1.Time{}
+	}
+	t, _ := a.Row.Cells[idx].(metav1.Time)
+
+	return t
+}
+
+// WorkloadUsage tracks resources usage versus requests and limits.
+type WorkloadUsage struct {
+	CPU, Mem       int64
+	ReqCPU, ReqMem int64
+	LimCPU, LimMem int64
+}
+
+// NewPodUsage returns a pod resources usage given its spec and metrics.
+func NewPodUsage(spec *v1.PodSpec, mx *mv1beta1.PodMetrics) *WorkloadUsage {
+	var ccmx []mv1beta1.ContainerMetrics
+	if mx != nil {
+		ccmx = mx.Containers
+	}
+	c, r := gatherPodMX(spec, ccmx)
+
+	return &WorkloadUsage{
+		CPU:    c.cpu,
+		Mem:    c.mem,
+		ReqCPU: r.cpu,
+		ReqMem: r.mem,
+		LimCPU: r.lcpu,
+		LimMem: r.lmem,
+	}
+}
+
+// Add accumulates another usage.
+func (u *WorkloadUsage) Add(o *WorkloadUsage) {
+	if o == nil {
+		return
+	}
+	u.CPU += o.CPU
+	u.Mem += o.Mem
+	u.ReqCPU += o.ReqCPU
+	u.ReqMem += o.ReqMem
+	u.LimCPU += o.LimCPU
+	u.LimMem += o.LimMem
+}
+
+func (u *WorkloadUsage) fields() model1.Fields {
+	if u == nil {
+		return model1.Fields{"", "", "", "", "", ""}
+	}
+
+	return model1.Fields{
+		toMc(u.CPU),
+		client.ToPercentageStr(u.CPU, u.ReqCPU),
+		client.ToPercentageStr(u.CPU, u.LimCPU),
+		toMi(u.Mem),
+		client.ToPercentageStr(u.Mem, u.ReqMem),
+		client.ToPercentageStr(u.Mem, u.LimMem),
+	}
+}
+
+var sparks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// toSparkline renders a series of ratios between 0 and 1 as a sparkline.
+func toSparkline(vv []float64) string {
+	rr := make([]rune, 0, len(vv))
+	for _, v := range vv {
+		idx := int(math.Round(max(0, min(v, 1)) * float64(len(sparks)-1)))
+		rr = append(rr, sparks[idx])
+	}
+
+	return string(rr)
+}
+
+func toRestartAge(t metav1.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return ToAge(t)
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var defaultWKHHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "WORKLOADS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "OK", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "DEGRADED", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "HEALTH"},
+}
+
+// WorkloadHealth renders a namespace workloads health roll-up to screen.
+type WorkloadHealth struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (WorkloadHealth) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+
+		idx, ok := h.IndexOf("HEALTH", true)
+		if !ok {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) == "DEGRADED" {
+			c = model1.PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (WorkloadHealth) Header(string) model1.Header {
+	return defaultWKHHeader
+}
+
+// Render renders a K8s resource to screen.
+func (WorkloadHealth) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*WorkloadHealthRes)
+	if !ok {
+		return fmt.Errorf("expected WorkloadHealthRes but got %T", o)
+	}
+
+	health := "OK"
+	if res.Degraded > 0 {
+		health = "DEGRADED"
+	}
+	r.ID = res.Namespace
+	r.Fields = model1.Fields{
+		res.Namespace,
+		strconv.Itoa(res.Total),
+		strconv.Itoa(res.OK),
+		strconv.Itoa(res.Degraded),
+		fmt.Sprintf("%d/%d", res.Ready, res.Desired),
+		PrintPerc(res.ReadyPerc()),
+		health,
+	}
+
+	return nil
+}
+
+// WorkloadHealthRes represents a namespace workloads health roll-up.
+type WorkloadHealthRes struct {
+	Namespace           string
+	Total, OK, Degraded int
+	Ready, Desired      int
+}
+
+// ReadyPerc returns the percentage of ready replicas.
+func (w *WorkloadHealthRes) ReadyPerc() int {
+	if w.Desired == 0 {
+		return 100
+	}
+
+	return w.Ready * 100 / w.Desired
+}
+
+// GetObjectKind returns a schema object.
+func (*WorkloadHealthRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w *WorkloadHealthRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkloadRender(t *testing.T) {
+	uu := map[string]struct {
+		cells         []any
+		prefix, group string
+		usage         *render.WorkloadUsage
+		id            string
+		e             model1.Fields
+	}{
+		"ok": {
+			cells: []any{"apps/v1/deployments", "ns1", "dp1", "OK", "1/1", "", metav1.Time{}, "", "2", metav1.Time{}, "cpu:10%/80% (1-3)", []float64{0, 0.5, 1}, "Rollout/fred", "Available=False"},
+			id:    "apps/v1/deployments|ns1|dp1",
+			e:     model1.Fields{"apps/v1/deployments", "ns1", "dp1", "OK", "1/1", "▁▅█", "2", "", "cpu:10%/80% (1-3)", "", "", "", "", "", "", "Rollout/fred", "", "", "Available=False", render.UnknownValue, ""},
+		},
+		"error": {
+			cells: []any{"v1/pods", "", "", render.MissingValue, "", "", metav1.Time{}, "forbidden", "", metav1.Time{}, "", []float64(nil), "", ""},
+			id:    "v1/pods||",
+			e:     model1.Fields{"v1/pods", "", "", render.MissingValue, "", "", "", "", "", "", "", "", "", "", "", "", "", "forbidden", "", render.UnknownValue, ""},
+		},
+		"usage": {
+			cells: []any{"v1/pods", "ns1", "p1", "OK", "1/1", "", metav1.Time{}, "", "", metav1.Time{}, "", []float64(nil), "", ""},
+			usage: &render.WorkloadUsage{CPU: 100, ReqCPU: 200, Mem: 64 * client.MegaByte, ReqMem: 128 * client.MegaByte, LimMem: 256 * client.MegaByte},
+			id:    "v1/pods|ns1|p1",
+			e:     model1.Fields{"v1/pods", "ns1", "p1", "OK", "1/1", "", "", "", "", "100", "50", client.NA, "64", "50", "25", "", "", "", "", render.UnknownValue, ""},
+		},
+		"grouped": {
+			cells:  []any{"apps/v1/deployments", "ns1", "dp1", "OK", "1/1", "", metav1.Time{}, "", "", metav1.Time{}, "", []float64(nil), "", ""},
+			prefix: "└─ ",
+			group:  "ns1|fred|00001",
+			id:     "apps/v1/deployments|ns1|dp1",
+			e:      model1.Fields{"└─ apps/v1/deployments", "ns1", "dp1", "OK", "1/1", "", "", "", "", "", "", "", "", "", "", "", "", "", "", render.UnknownValue, "ns1|fred|00001"},
+		},
+	}
+
+	var re render.Workload
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(&render.WorkloadRes{Row: metav1.TableRow{Cells: u.cells}, Prefix: u.prefix, Group: u.group, Usage: u.usage}, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
+
+func TestWorkloadRenderMalformed(t *testing.T) {
+	var (
+		re render.Workload
+		r  model1.Row
+	)
+	err := re.Render(&render.WorkloadRes{Row: metav1.TableRow{Cells: []any{"v1/pods", "ns1"}}}, "", &r)
+	assert.EqualError(t, err, "expected 14 workload cells but got 2")
+
+	cells := []any{"v1/pods", "ns1", "p1", 1, "1/1", "", "blee", "", "", metav1.Time{}, "", "blee", "", ""}
+	require.NoError(t, re.Render(&render.WorkloadRes{Row: metav1.TableRow{Cells: cells}}, "", &r))
+	assert.Equal(t, "v1/pods|ns1|p1", r.ID)
+	assert.Equal(t, model1.Fields{"v1/pods", "ns1", "p1", "", "1/1", "", "", "", "", "", "", "", "", "", "", "", "", "", "", render.UnknownValue, ""}, r.Fields)
+}
+
+func TestWorkloadHealthRender(t *testing.T) {
+	var (
+		re render.WorkloadHealth
+		r  model1.Row
+	)
+	o := render.WorkloadHealthRes{Namespace: "ns1", Total: 3, OK: 2, Degraded: 1, Ready: 3, Desired: 4}
+
+	require.NoError(t, re.Render(&o, "", &r))
+	assert.Equal(t, "ns1", r.ID)
+	assert.Equal(t, model1.Fields{"ns1", "3", "2", "1", "3/4", "75%", "DEGRADED"}, r.Fields)
+}
@@ -109,6 +109,12 @@ const (
 	// Path tracks a path logger key.
 	Path = "path"
 
+	// Pattern tracks a pattern logger key.
+	Pattern = "pattern"
+
+	// Action tracks an action logger key.
+	Action = "action"
+
 	// Dir tracks a directory logger key.
 	Dir = "dir"
 
@@ -228,4 +234,7 @@ const (
 
 	// Minimum tracks a minimum value logger key.
 	Minimum = "minimum"
+
+	// Condition tracks a condition logger key.
+	Condition = "condition"
 )
@@ -81,6 +81,20 @@ func (a *App) QueueUpdateDraw(f func()) {
 	}()
 }
 
+// Beep rings the terminal bell on the next redraw.
+func (a *App) Beep() {
+	a.QueueUpdateDraw(func() {
+		after := a.GetAfterDrawFunc()
+		a.SetAfterDrawFunc(func(s tcell.Screen) {
+			a.SetAfterDrawFunc(after)
+			if after != nil {
+				after(s)
+			}
+			_ = s.Beep()
+		})
+	})
+}
+
 // IsRunning checks if app is actually running.
 func (a *App) IsRunning() bool {
 	a.mx.RLock()
@@ -18,6 +18,12 @@ type Crumbs struct {
 
 	styles *config.Styles
 	stack  *model.Stack
+	tabs   tabsInfo
+}
+
+// tabsInfo tracks the workspaces count and the active one.
+type tabsInfo struct {
+	count, active int
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -58,9 +64,37 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack.
 func (*Crumbs) StackTop(model.Component) {}
 
+// Reset rebuilds the crumbs from a given views stack.
+func (c *Crumbs) Reset(cc []model.Component) {
+	c.stack = model.NewStack()
+	for _, comp := range cc {
+		c.stack.Push(comp)
+	}
+	c.refresh(c.stack.Flatten())
+}
+
+// SetTabs sets the workspaces indicator. A single tab is not displayed.
+func (c *Crumbs) SetTabs(count, active int) {
+	c.tabs = tabsInfo{count: count, active: active}
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
+	if c.tabs.count > 1 {
+		for i := range c.tabs.count {
+			bgColor := c.styles.Frame().Crumb.BgColor
+			if i == c.tabs.active {
+				bgColor = c.styles.Frame().Crumb.ActiveColor
+			}
+			_, _ = fmt.Fprintf(c, "[%s:%s:b] %d [-:%s:-]",
+				c.styles.Frame().Crumb.FgColor,
+				bgColor, i+1,
+				c.styles.Body().BgColor)
+		}
+		_, _ = fmt.Fprint(c, " ")
+	}
 	last, bgColor := len(crumbs)-1, c.styles.Frame().Crumb.BgColor
 	for i, crumb := range crumbs {
 		if i == last {
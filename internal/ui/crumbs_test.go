@@ -31,6 +31,17 @@ func TestNewCrumbs(t *testing.T) {
 	assert.Equal(t, "[#000000:#00ffff:b] <c1> [-:#000000:-] [#000000:#00ffff:b] <c2> [-:#000000:-] [#000000:#ffa500:b] <c3> [-:#000000:-] \n", v.GetText(false))
 }
 
+func TestCrumbsTabs(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.Reset([]model.Component{makeComponent("c1")})
+	v.SetTabs(2, 1)
+
+	assert.Equal(t, "[#000000:#00ffff:b] 1 [-:#000000:-][#000000:#ffa500:b] 2 [-:#000000:-] [#000000:#ffa500:b] <c1> [-:#000000:-] \n", v.GetText(false))
+
+	v.SetTabs(1, 0)
+	assert.Equal(t, "[#000000:#ffa500:b] <c1> [-:#000000:-] \n", v.GetText(false))
+}
+
 // Helpers...
 
 type c struct {
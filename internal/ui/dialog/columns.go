@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	saveLabel          = "Save"
+	columnWidthStep    = 5
+	defaultColumnWidth = 20
+	addColumnMsg       = "Enter a label key or a NAME:JSONPath column spec."
+)
+
+// ColumnsAction is called with the edited column specs.
+type ColumnsAction func(cols []string)
+
+// ShowColumns pops a dialog to edit a view columns.
+// Space toggles a column visibility, J/K reorder columns, </> adjust the column width,
+// = resets it and a adds a new label or JSONPath column. The trailing save entry
+// confirms the edits.
+func ShowColumns(styles *config.Dialog, pages *ui.Pages, title string, cols []string, action ColumnsAction) {
+	e := colEditor(slices.Clone(cols))
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetSelectedTextColor(styles.ButtonFocusFgColor.Color())
+	list.SetSelectedBackgroundColor(styles.ButtonFocusBgColor.Color())
+	refresh := func(sel int) {
+		list.Clear()
+		for _, c := range e {
+			list.AddItem(checkLabel(c, !render.IsColumnHidden(c)), "", 0, nil)
+		}
+		list.AddItem(saveLabel, "", 0, nil)
+		list.SetCurrentItem(sel)
+	}
+	refresh(0)
+
+	list.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		i := list.GetCurrentItem()
+		if evt.Rune() == 'a' {
+			showAddColumn(styles, pages, func(spec string) {
+				if spec != "" {
+					e = e.add(spec)
+				}
+				ShowColumns(styles, pages, title, e, action)
+			})
+			return nil
+		}
+		if i >= len(e) {
+			return evt
+		}
+		switch evt.Rune() {
+		case ' ':
+			e.toggle(i)
+		case 'J':
+			i = e.move(i, 1)
+		case 'K':
+			i = e.move(i, -1)
+		case '>':
+			e.resize(i, columnWidthStep)
+		case '<':
+			e.resize(i, -columnWidthStep)
+		case '=':
+			e[i] = render.SetColumnWidth(e[i], 0)
+		default:
+			return evt
+		}
+		refresh(i)
+
+		return nil
+	})
+
+	modal := ui.NewModalList("<"+title+">", list)
+	modal.SetDoneFunc(func(i int, _ string) {
+		switch {
+		case i < 0:
+			dismiss(pages)
+		case i >= len(e):
+			dismiss(pages)
+			action(e)
+		default:
+			e.toggle(i)
+			refresh(i)
+		}
+	})
+
+	pages.AddPage(dialogKey, modal, false, false)
+	pages.ShowPage(dialogKey)
+}
+
+func showAddColumn(styles *config.Dialog, pages *ui.Pages, done func(spec string)) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var col string
+	f.AddInputField("Column:", "", 40, nil, func(s string) {
+		col = s
+	})
+	f.AddButton("OK", func() {
+		dismiss(pages)
+		done(toColumnSpec(col))
+	})
+	f.AddButton("Cancel", func() {
+		dismiss(pages)
+		done("")
+	})
+	for i := range f.GetButtonCount() {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	modal := tview.NewModalForm("<Add Column>", f)
+	modal.SetText(addColumnMsg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismiss(pages)
+		done("")
+	})
+
+	pages.AddPage(dialogKey, modal, false, false)
+	pages.ShowPage(dialogKey)
+}
+
+// toColumnSpec converts a label key or a column spec to a column spec.
+func toColumnSpec(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.Contains(s, ":") {
+		return s
+	}
+
+	return render.LabelColumnSpec(s)
+}
+
+// colEditor tracks a collection of column specs being edited.
+type colEditor []string
+
+func (e colEditor) toggle(i int) {
+	e[i] = render.ToggleColumnHide(e[i])
+}
+
+func (e colEditor) move(i, delta int) int {
+	j := i + delta
+	if j < 0 || j >= len(e) {
+		return i
+	}
+	e[i], e[j] = e[j], e[i]
+
+	return j
+}
+
+func (e colEditor) resize(i, delta int) {
+	w := render.ColumnWidth(e[i])
+	if w == 0 {
+		w = defaultColumnWidth
+	}
+	e[i] = render.SetColumnWidth(e[i], max(w+delta, columnWidthStep))
+}
+
+func (e colEditor) add(spec string) colEditor {
+	n := render.ColumnName(spec)
+	for i, c := range e {
+		if render.ColumnName(c) == n {
+			e[i] = spec
+			return e
+		}
+	}
+
+	return append(e, spec)
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumnsDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+	ShowColumns(new(config.Dialog), p, "Columns", []string{"NAME", "AGE"}, func([]string) {})
+
+	d := p.GetPrimitive(dialogKey).(*ui.ModalList)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
+
+func TestColEditor(t *testing.T) {
+	e := colEditor{"NAME", "STATUS|W", "AGE"}
+
+	e.toggle(1)
+	assert.Equal(t, "STATUS|WH", e[1])
+	assert.Equal(t, 2, e.move(1, 1))
+	assert.Equal(t, colEditor{"NAME", "AGE", "STATUS|WH"}, e)
+	assert.Equal(t, 0, e.move(0, -1))
+
+	e.resize(0, columnWidthStep)
+	assert.Equal(t, "NAME|25", e[0])
+	e.resize(0, -100)
+	assert.Equal(t, "NAME|5", e[0])
+
+	e = e.add("APP:.metadata.labels.app")
+	assert.Len(t, e, 4)
+	e = e.add("AGE|T")
+	assert.Equal(t, colEditor{"NAME|5", "AGE|T", "STATUS|WH", "APP:.metadata.labels.app"}, e)
+}
+
+func TestToColumnSpec(t *testing.T) {
+	assert.Equal(t, "APP:.metadata.labels.app", toColumnSpec(" app "))
+	assert.Equal(t, "IP:.status.podIP", toColumnSpec("IP:.status.podIP"))
+	assert.Empty(t, toColumnSpec(""))
+}
@@ -13,11 +13,15 @@ import (
 const (
 	noDeletePropagation   = "None"
 	defaultPropagationIdx = 0
+	overrideMsg           = "Check Override to proceed anyway."
 )
 
 type (
 	okFunc     func(propagation *metav1.DeletionPropagation, force bool)
 	cancelFunc func()
+
+	// ImpactFunc describes a deletion impact for a given propagation policy.
+	ImpactFunc func(propagation string) string
 )
 
 var propagationOptions []string = []string{
@@ -29,7 +33,29 @@ var propagationOptions []string = []string{
 
 // ShowDelete pops a resource deletion dialog.
 func ShowDelete(styles *config.Dialog, pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	propagation, force := "", false
+	ShowDeleteImpact(styles, pages, msg, nil, ok, cancel)
+}
+
+// ShowDeleteImpact pops a resource deletion dialog describing the impact of the selected propagation policy.
+func ShowDeleteImpact(styles *config.Dialog, pages *ui.Pages, msg string, impact ImpactFunc, ok okFunc, cancel cancelFunc) {
+	ShowDeleteGuarded(styles, pages, msg, impact, "", ok, cancel)
+}
+
+// ShowDeleteGuarded pops a resource deletion dialog. When a guard warning is given, the deletion
+// is blocked until the override box is checked.
+func ShowDeleteGuarded(styles *config.Dialog, pages *ui.Pages, msg string, impact ImpactFunc, guard string, ok okFunc, cancel cancelFunc) {
+	propagation, force, override := "", false, false
+	var confirm *tview.ModalForm
+	text := func() string {
+		t := msg
+		if impact != nil {
+			t += "\n\n" + impact(propagation)
+		}
+		if guard != "" {
+			t += "\n\n" + guard + "\n" + overrideMsg
+		}
+		return t
+	}
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -39,6 +65,9 @@ func ShowDelete(styles *config.Dialog, pages *ui.Pages, msg string, ok okFunc, c
 		SetFieldTextColor(styles.FieldFgColor.Color())
 	f.AddDropDown("Propagation:", propagationOptions, defaultPropagationIdx, func(_ string, optionIndex int) {
 		propagation = propagationOptions[optionIndex]
+		if confirm != nil {
+			confirm.SetText(text())
+		}
 	})
 	propField := f.GetFormItemByLabel("Propagation:").(*tview.DropDown)
 	propField.SetListStyles(
@@ -48,11 +77,19 @@ func ShowDelete(styles *config.Dialog, pages *ui.Pages, msg string, ok okFunc, c
 	f.AddCheckbox("Force:", force, func(_ string, checked bool) {
 		force = checked
 	})
+	if guard != "" {
+		f.AddCheckbox("Override:", override, func(_ string, checked bool) {
+			override = checked
+		})
+	}
 	f.AddButton("Cancel", func() {
 		dismiss(pages)
 		cancel()
 	})
 	f.AddButton("OK", func() {
+		if guard != "" && !override {
+			return
+		}
 		switch propagation {
 		case noDeletePropagation:
 			ok(nil, force)
@@ -71,10 +108,10 @@ func ShowDelete(styles *config.Dialog, pages *ui.Pages, msg string, ok okFunc, c
 		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
 		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
 	}
-	f.SetFocus(2)
+	f.SetFocus(f.GetFormItemCount())
 
-	confirm := tview.NewModalForm("<Delete>", f)
-	confirm.SetText(msg)
+	confirm = tview.NewModalForm("<Delete>", f)
+	confirm.SetText(text())
 	confirm.SetDoneFunc(func(int, string) {
 		dismiss(pages)
 		cancel()
@@ -28,3 +28,30 @@ func TestDeleteDialog(t *testing.T) {
 	dismiss(p)
 	assert.Nil(t, p.GetPrimitive(dialogKey))
 }
+
+func TestDeleteImpactDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	var pp []string
+	impact := func(propagation string) string {
+		pp = append(pp, propagation)
+		return "blee"
+	}
+	ShowDeleteImpact(new(config.Dialog), p, "Yo", impact, func(*metav1.DeletionPropagation, bool) {}, func() {})
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+	assert.Equal(t, []string{string(metav1.DeletePropagationBackground)}, pp)
+}
+
+func TestDeleteGuardedDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowDeleteGuarded(new(config.Dialog), p, "Yo", nil, "blee", func(*metav1.DeletionPropagation, bool) {}, func() {})
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"slices"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	checkedMark   = "[x] "
+	uncheckedMark = "[ ] "
+	applyLabel    = "Apply"
+)
+
+// MultiSelectAction is called with the selected options.
+type MultiSelectAction func(selected []string)
+
+// ShowMultiSelection pops a dialog to toggle a set of options.
+// Enter toggles an option and the trailing apply entry confirms the selection.
+func ShowMultiSelection(styles *config.Dialog, pages *ui.Pages, title string, options, selected []string, action MultiSelectAction) {
+	checked := make([]bool, len(options))
+	for i, o := range options {
+		checked[i] = slices.Contains(selected, o)
+	}
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetSelectedTextColor(styles.ButtonFocusFgColor.Color())
+	list.SetSelectedBackgroundColor(styles.ButtonFocusBgColor.Color())
+	for i, option := range options {
+		list.AddItem(checkLabel(option, checked[i]), "", 0, nil)
+	}
+	list.AddItem(applyLabel, "", 0, nil)
+
+	modal := ui.NewModalList("<"+title+">", list)
+	modal.SetDoneFunc(func(i int, _ string) {
+		switch {
+		case i < 0:
+			dismiss(pages)
+		case i >= len(options):
+			dismiss(pages)
+			action(checkedOptions(options, checked))
+		default:
+			checked[i] = !checked[i]
+			list.SetItemText(i, checkLabel(options[i], checked[i]), "")
+		}
+	})
+
+	pages.AddPage(dialogKey, modal, false, false)
+	pages.ShowPage(dialogKey)
+}
+
+func checkLabel(option string, checked bool) string {
+	if checked {
+		return tview.Escape(checkedMark + option)
+	}
+
+	return tview.Escape(uncheckedMark + option)
+}
+
+func checkedOptions(options []string, checked []bool) []string {
+	ss := make([]string, 0, len(options))
+	for i, o := range options {
+		if checked[i] {
+			ss = append(ss, o)
+		}
+	}
+
+	return ss
+}
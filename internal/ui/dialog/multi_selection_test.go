@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSelectionDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+	ShowMultiSelection(new(config.Dialog), p, "Blee", []string{"ns1", "ns2"}, []string{"ns2"}, func([]string) {})
+
+	d := p.GetPrimitive(dialogKey).(*ui.ModalList)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
+
+func TestCheckedOptions(t *testing.T) {
+	oo := []string{"ns1", "ns2", "ns3"}
+
+	assert.Equal(t, []string{"ns1", "ns3"}, checkedOptions(oo, []bool{true, false, true}))
+	assert.Empty(t, checkedOptions(oo, []bool{false, false, false}))
+	assert.Equal(t, "[x[] ns1", checkLabel("ns1", true))
+}
@@ -36,6 +36,12 @@ func ComputeMaxColumns(pads MaxyPad, sortColName string, t *model1.TableData) {
 		row++
 		return true
 	})
+
+	for i, h := range t.Header() {
+		if h.Width > 0 && i < len(pads) {
+			pads[i] = h.Width
+		}
+	}
 }
 
 // IsASCII checks if table cell has all ascii characters.
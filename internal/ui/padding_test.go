@@ -77,6 +77,21 @@ func TestMaxColumn(t *testing.T) {
 			"A",
 			MaxyPad{32, 6},
 		},
+		"fixed width": {
+			model1.NewTableDataWithRows(
+				client.NewGVR("test"),
+				model1.Header{model1.HeaderColumn{Name: "A", Attrs: model1.Attrs{Width: 3}}, model1.HeaderColumn{Name: "B"}},
+				model1.NewRowEventsWithEvts(
+					model1.RowEvent{
+						Row: model1.Row{
+							Fields: model1.Fields{"hello", "world"},
+						},
+					},
+				),
+			),
+			"B",
+			MaxyPad{3, 6},
+		},
 	}
 
 	for k := range uu {
@@ -40,6 +40,19 @@ func (p *Pages) IsTopDialog() bool {
 	}
 }
 
+// InputCapturer represents a page consuming all keyboard input.
+type InputCapturer interface {
+	CapturesInput() bool
+}
+
+// IsTopInput checks if front page consumes all keyboard input.
+func (p *Pages) IsTopInput() bool {
+	_, pa := p.GetFrontPage()
+	c, ok := pa.(InputCapturer)
+
+	return ok && c.CapturesInput()
+}
+
 // Show displays a given page.
 func (p *Pages) Show(c model.Component) {
 	p.SwitchToPage(componentID(c))
@@ -4,9 +4,11 @@
 package ui
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 
 	"github.com/derailed/k9s/internal"
@@ -32,6 +34,9 @@ type (
 
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
+
+	// SortChangedFunc a table manual sort callback.
+	SortChangedFunc func(spec string)
 )
 
 // Table represents tabular data.
@@ -39,6 +44,8 @@ type Table struct {
 	*SelectTable
 	gvr            *client.GVR
 	sortCol        model1.SortColumn
+	thenCols       []model1.SortColumn
+	sortChangedFn  SortChangedFunc
 	selectedColIdx int
 	manualSort     bool
 	Path           string
@@ -50,6 +57,9 @@ type Table struct {
 	colorerFn      model1.ColorerFunc
 	decorateFn     DecorateFunc
 	wide           bool
+	autoWide       bool
+	fitCols        map[string]struct{}
+	fitWidth       int
 	toast          bool
 	hasMetrics     bool
 	ctx            context.Context
@@ -57,6 +67,7 @@ type Table struct {
 	readOnly       bool
 	noIcon         bool
 	fullGVR        bool
+	pendingSel     string
 }
 
 // NewTable returns a new table view.
@@ -91,6 +102,24 @@ func (t *Table) SetNoIcon(b bool) {
 	t.noIcon = b
 }
 
+// SetPendingSelection selects the given row once it becomes available.
+func (t *Table) SetPendingSelection(id string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.pendingSel = id
+}
+
+func (t *Table) popPendingSelection() string {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	id := t.pendingSel
+	t.pendingSel = ""
+
+	return id
+}
+
 // SetReadOnly toggles read-only mode.
 func (t *Table) SetReadOnly(ro bool) {
 	t.mx.Lock()
@@ -106,6 +135,56 @@ func (t *Table) setSortCol(sc model1.SortColumn) {
 	t.sortCol = sc
 }
 
+func (t *Table) setThenCols(cc []model1.SortColumn) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.thenCols = cc
+}
+
+func (t *Table) getThenCols() []model1.SortColumn {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.thenCols
+}
+
+// SetSortChangedFn registers a callback for manual sort changes.
+func (t *Table) SetSortChangedFn(f SortChangedFunc) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.sortChangedFn = f
+}
+
+// SortSpec returns the current sort spec ie `STATUS:asc,AGE:desc`.
+func (t *Table) SortSpec() string {
+	return model1.SortSpec(append([]model1.SortColumn{t.getSortCol()}, t.getThenCols()...)...)
+}
+
+// SetSortSpec sorts the table given a multi columns sort spec.
+func (t *Table) SetSortSpec(spec string) error {
+	cc, err := model1.ParseSortSpec(spec)
+	if err != nil {
+		return err
+	}
+	t.setSortCol(cc[0])
+	t.setThenCols(cc[1:])
+	t.setMSort(true)
+
+	return nil
+}
+
+func (t *Table) fireSortChanged() {
+	t.mx.RLock()
+	fn := t.sortChangedFn
+	t.mx.RUnlock()
+
+	if fn != nil {
+		fn(t.SortSpec())
+	}
+}
+
 func (t *Table) toggleSortCol() {
 	t.mx.Lock()
 	defer t.mx.Unlock()
@@ -222,47 +301,77 @@ func (t *Table) SelectPrevColumn() {
 
 // SortSelectedColumn sorts by the currently selected column.
 func (t *Table) SortSelectedColumn() {
+	colName := t.selectedColName()
+	if colName == "" {
+		return
+	}
+
+	sc := t.getSortCol()
+
+	// Toggle direction if same column, otherwise default to ascending
+	asc := true
+	if sc.Name == colName {
+		asc = !sc.ASC
+	}
+
+	t.SetSortCol(colName, asc)
+	t.setThenCols(nil)
+	t.setMSort(true)
+	t.fireSortChanged()
+	t.Refresh()
+}
+
+// ThenSortSelectedColumn adds the currently selected column as a sort tie breaker.
+// Toggles the column order if it is already sorted on.
+func (t *Table) ThenSortSelectedColumn() {
+	colName := t.selectedColName()
+	if colName == "" {
+		return
+	}
+
+	sc := t.getSortCol()
+	if !sc.IsSet() || sc.Name == colName {
+		t.SortSelectedColumn()
+		return
+	}
+	then := slices.Clone(t.getThenCols())
+	if idx := slices.IndexFunc(then, func(c model1.SortColumn) bool { return c.Name == colName }); idx >= 0 {
+		then[idx].ASC = !then[idx].ASC
+	} else {
+		then = append(then, model1.SortColumn{Name: colName, ASC: true})
+	}
+	t.setThenCols(then)
+	t.setMSort(true)
+	t.fireSortChanged()
+	t.Refresh()
+}
+
+// selectedColName returns the name of the currently selected column.
+func (t *Table) selectedColName() string {
 	data := t.GetFilteredData()
 	if data == nil || data.HeaderCount() == 0 {
-		return
+		return ""
 	}
 
 	idx := t.getSelectedColIdx()
 	if idx < 0 {
-		return
+		return ""
 	}
 
 	// Map visual column index to actual header column name
 	// (accounting for hidden columns)
-	header := data.Header()
 	visibleCol := 0
-	var colName string
-	for _, h := range header {
+	for _, h := range data.Header() {
 		if t.shouldExcludeColumn(h) {
 			continue
 		}
 		if visibleCol == idx {
-			colName = h.Name
-			break
+			return h.Name
 		}
 		visibleCol++
 	}
 
-	if colName == "" {
-		return
-	}
-
-	sc := t.getSortCol()
-
-	// Toggle direction if same column, otherwise default to ascending
-	asc := true
-	if sc.Name == colName {
-		asc = !sc.ASC
-	}
-
-	t.SetSortCol(colName, asc)
-	t.setMSort(true)
-	t.Refresh()
+	return ""
 }
 
 // SetViewSetting sets custom view config is present.
@@ -352,6 +461,32 @@ func (t *Table) ToggleToast() {
 	t.Refresh()
 }
 
+// SetAutoWide toggles adaptive wide columns based on the available width.
+func (t *Table) SetAutoWide(b bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.autoWide = b
+}
+
+func (t *Table) isAutoWide() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.autoWide
+}
+
+// Draw draws the table and refits wide columns when the available width changed.
+func (t *Table) Draw(screen tcell.Screen) {
+	if t.isAutoWide() {
+		if _, _, w, _ := t.GetInnerRect(); w != t.fitWidth {
+			t.fitWidth = w
+			t.Refresh()
+		}
+	}
+	t.SelectTable.Draw(screen)
+}
+
 // ToggleWide toggles wide col display.
 func (t *Table) ToggleWide() {
 	t.wide = !t.wide
@@ -450,6 +585,7 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 
 	oldSortCol := t.getSortCol()
 	t.setSortCol(data.ComputeSortCol(t.GetViewSetting(), t.getSortCol(), t.getMSort()))
+	t.setThenCols(data.ComputeThenCols(t.GetViewSetting(), t.getThenCols(), t.getMSort()))
 
 	// Initialize selected column index to match the current sort column
 	// This ensures the highlight starts at the sorted column
@@ -462,8 +598,11 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 }
 
 func (t *Table) shouldExcludeColumn(h model1.HeaderColumn) bool {
-	return (h.Hide || (!t.wide && h.Wide)) ||
-		(h.Name == "NAMESPACE" && !t.GetModel().ClusterWide()) ||
+	return (h.Hide || (!t.wide && h.Wide && !t.fits(h.Name))) || t.isUnavailable(h)
+}
+
+func (t *Table) isUnavailable(h model1.HeaderColumn) bool {
+	return (h.Name == "NAMESPACE" && !t.GetModel().ClusterWide()) ||
 		(h.MX && !t.hasMetrics) ||
 		(h.VS && vul.ImgScanner == nil)
 }
@@ -473,6 +612,11 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 	fg := t.styles.Table().Header.FgColor.Color()
 	bg := t.styles.Table().Header.BgColor.Color()
 
+	cdata.Sort(t.getSortCol(), t.getThenCols()...)
+	pads := make(MaxyPad, cdata.HeaderCount())
+	ComputeMaxColumns(pads, t.getSortCol().Name, cdata)
+	t.fitColumns(cdata.Header(), pads)
+
 	var col int
 	for _, h := range cdata.Header() {
 		if t.shouldExcludeColumn(h) {
@@ -484,10 +628,7 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 		c.SetTextColor(fg)
 		col++
 	}
-	cdata.Sort(t.getSortCol())
-
-	pads := make(MaxyPad, cdata.HeaderCount())
-	ComputeMaxColumns(pads, t.getSortCol().Name, cdata)
+	selID, selRow := t.popPendingSelection(), -1
 	cdata.RowsRange(func(row int, re model1.RowEvent) bool {
 		ore, ok := data.FindRow(re.Row.ID)
 		if !ok {
@@ -495,14 +636,77 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 			return true
 		}
 		t.buildRow(row+1, re, ore, cdata.Header(), pads)
+		if selID != "" && re.Row.ID == selID {
+			selRow = row + 1
+		}
 
 		return true
 	})
 
-	t.updateSelection(true)
+	switch {
+	case selRow > 0:
+		t.SelectRow(selRow, 0, true)
+	case selID != "" && cdata.RowCount() == 0:
+		t.SetPendingSelection(selID)
+		t.updateSelection(true)
+	default:
+		t.updateSelection(true)
+	}
 	t.UpdateTitle()
 }
 
+// fitColumns computes the wide columns that fit the available width in auto wide mode.
+// Columns listed in the view settings wide priority are considered first.
+func (t *Table) fitColumns(h model1.Header, pads MaxyPad) {
+	t.fitCols = nil
+	if t.wide || !t.isAutoWide() || t.fitWidth <= 0 {
+		return
+	}
+
+	var used int
+	cc := make([]int, 0, len(h))
+	for i, c := range h {
+		switch {
+		case i >= len(pads):
+		case c.Wide && !c.Hide && !t.isUnavailable(c):
+			cc = append(cc, i)
+		case !t.shouldExcludeColumn(c):
+			used += pads[i] + 1
+		}
+	}
+	var prio []string
+	if vs := t.GetViewSetting(); vs != nil {
+		prio = vs.WidePriority
+	}
+	slices.SortStableFunc(cc, func(a, b int) int {
+		return cmp.Compare(widePriority(prio, h[a].Name), widePriority(prio, h[b].Name))
+	})
+
+	fit := make(map[string]struct{}, len(cc))
+	for _, i := range cc {
+		if used+pads[i]+1 > t.fitWidth {
+			break
+		}
+		used += pads[i] + 1
+		fit[h[i].Name] = struct{}{}
+	}
+	t.fitCols = fit
+}
+
+func (t *Table) fits(col string) bool {
+	_, ok := t.fitCols[col]
+
+	return ok
+}
+
+func widePriority(prio []string, col string) int {
+	if idx := slices.Index(prio, col); idx >= 0 {
+		return idx
+	}
+
+	return len(prio)
+}
+
 func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads MaxyPad) {
 	color := model1.DefaultColorer
 	if t.colorerFn != nil {
@@ -539,8 +743,11 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 		if h[c].Decorator != nil {
 			field = h[c].Decorator(field)
 		}
-		if h[c].Align == tview.AlignLeft {
+		switch {
+		case h[c].Align == tview.AlignLeft:
 			field = formatCell(field, pads[c])
+		case h[c].Width > 0:
+			field = render.Truncate(field, pads[c])
 		}
 
 		cell := tview.NewTableCell(field)
@@ -569,7 +776,9 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 		}
 		sc.Name = name
 		t.setSortCol(sc)
+		t.setThenCols(nil)
 		t.setMSort(true)
+		t.fireSortChanged()
 
 		// Sync selected column index with the new sort column
 		t.initSelectedColumn()
@@ -582,6 +791,7 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 // SortInvertCmd reverses sorting order.
 func (t *Table) SortInvertCmd(*tcell.EventKey) *tcell.EventKey {
 	t.toggleSortCol()
+	t.fireSortChanged()
 	t.Refresh()
 
 	return nil
@@ -630,10 +840,18 @@ func (t *Table) NameColIndex() int {
 // AddHeaderCell configures a table cell header.
 func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
 	sc := t.getSortCol()
-	sortCol := h.Name == sc.Name
+	sortCol, asc := h.Name == sc.Name, sc.ASC
+	if !sortCol {
+		for _, c := range t.getThenCols() {
+			if c.Name == h.Name {
+				sortCol, asc = true, c.ASC
+				break
+			}
+		}
+	}
 	selectedCol := col == t.getSelectedColIdx()
 	styles := t.styles.Table()
-	c := tview.NewTableCell(columnIndicator(sortCol, selectedCol, sc.ASC, &styles, h.Name))
+	c := tview.NewTableCell(columnIndicator(sortCol, selectedCol, asc, &styles, h.Name))
 	c.SetExpansion(1)
 	c.SetSelectable(false)
 	c.SetAlign(h.Align)
@@ -732,3 +950,12 @@ func ROIndicator(ro, noIC bool) string {
 		return unlockedIC
 	}
 }
+
+// DryRunIndicator returns an icon showing whether the session is in dry run mode or not.
+func DryRunIndicator(dry, noIC bool) string {
+	if noIC || !dry {
+		return ""
+	}
+
+	return dryRunIC
+}
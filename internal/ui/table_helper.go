@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -68,6 +69,16 @@ func ExtractLabelSelector(s string) (labels.Selector, error) {
 	return labels.Parse(selStr)
 }
 
+// ExtractFieldSelector extracts field query.
+func ExtractFieldSelector(s string) (fields.Selector, error) {
+	selStr := s
+	if strings.Index(s, "-F") == 0 {
+		selStr = strings.TrimSpace(s[2:])
+	}
+
+	return fields.ParseSelector(selStr)
+}
+
 // SkinTitle decorates a title.
 func SkinTitle(fmat string, style *config.Frame) string {
 	bgColor := style.Title.BgColor
@@ -8,6 +8,7 @@ import (
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -61,3 +62,28 @@ func TestExtractLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   string
+	}{
+		"cool": {
+			sel: "-F status.phase=Pending,spec.nodeName!=node-1",
+			e:   "spec.nodeName!=node-1,status.phase=Pending",
+		},
+		"no-space": {
+			sel: "-Fstatus.phase=Pending",
+			e:   "status.phase=Pending",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := ExtractFieldSelector(u.sel)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, sel.String())
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -64,9 +65,99 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTablePendingSelection(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(new(mockModel))
+	v.SetPendingSelection("r2")
+
+	data := makeTableData()
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	v.SelectRow(1, 0, true)
+	cdata = v.Update(data, false)
+	v.UpdateUI(cdata, data)
+	assert.Equal(t, "r1", v.GetSelectedItem())
+}
+
+func TestTableAutoWide(t *testing.T) {
+	uu := map[string]struct {
+		width int
+		prio  []string
+		e     []string
+	}{
+		"narrow": {
+			width: 20,
+			e:     []string{"A", "B", "C"},
+		},
+		"one": {
+			width: 28,
+			e:     []string{"A", "B", "C", "D"},
+		},
+		"priority": {
+			width: 28,
+			prio:  []string{"E"},
+			e:     []string{"A", "B", "C", "E"},
+		},
+		"all": {
+			width: 80,
+			e:     []string{"A", "B", "C", "D", "E"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := tcell.NewSimulationScreen("UTF-8")
+			assert.NoError(t, s.Init())
+			s.SetSize(u.width, 10)
+
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.SetModel(new(wideModel))
+			v.SetAutoWide(true)
+			v.SetViewSetting(&config.ViewSetting{WidePriority: u.prio})
+			v.SetRect(0, 0, u.width, 10)
+			v.Draw(s)
+
+			assert.Equal(t, len(u.e), v.GetColumnCount())
+			for i, e := range u.e {
+				assert.Contains(t, v.GetCell(0, i).Text, "]"+e+"[")
+			}
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+type wideModel struct {
+	mockModel
+}
+
+func (*wideModel) Peek() *model1.TableData {
+	return model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "A"},
+			model1.HeaderColumn{Name: "B"},
+			model1.HeaderColumn{Name: "C"},
+			model1.HeaderColumn{Name: "D", Attrs: model1.Attrs{Wide: true}},
+			model1.HeaderColumn{Name: "E", Attrs: model1.Attrs{Wide: true}},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{
+				Row: model1.Row{
+					ID:     "r1",
+					Fields: model1.Fields{"blee", "duh", "fred", "zorg", "bozo"},
+				},
+			},
+		),
+	)
+}
+
 type mockModel struct{}
 
 var _ ui.Tabular = &mockModel{}
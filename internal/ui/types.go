@@ -19,6 +19,7 @@ import (
 const (
 	unlockedIC = "[RW]"
 	lockedIC   = "[R]"
+	dryRunIC   = "[DRY]"
 )
 
 // Namespaceable tracks namespaces.
@@ -139,7 +139,7 @@ func pluginActions(r Runner, aa *ui.KeyActions) error {
 		ro      = r.App().Config.IsReadOnly()
 	)
 	for k := range pp.Plugins {
-		if !inScope(pp.Plugins[k].Scopes, aliases) || (ro && pp.Plugins[k].Dangerous) {
+		if pp.Plugins[k].IsSink() || !inScope(pp.Plugins[k].Scopes, aliases) || (ro && pp.Plugins[k].Dangerous) {
 			continue
 		}
 		key, err := asKey(pp.Plugins[k].ShortCut)
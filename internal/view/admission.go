@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Admission presents the admission webhooks and policies viewer.
+type Admission struct {
+	ResourceViewer
+}
+
+// NewAdmission returns a new viewer.
+func NewAdmission(gvr *client.GVR) ResourceViewer {
+	a := Admission{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetSortCol("KIND", true)
+	a.GetTable().SetEnterFn(a.showConfig)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Admission) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (a *Admission) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failure", a.GetTable().SortColCmd("FAILURE", true), false),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Denials", a.GetTable().SortColCmd("DENIALS", false), false),
+	})
+}
+
+// showConfig navigates to the webhook configuration or policy.
+func (*Admission) showConfig(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	gvr, n, ok := dao.ParseAdmissionID(path)
+	if !ok {
+		return
+	}
+	app.gotoResource(gvr.String(), n, false, true)
+}
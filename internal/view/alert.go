@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	silenceDurationInput = "duration"
+	silenceCommentInput  = "comment"
+	alertCallTimeout     = 30 * time.Second
+)
+
+// Alert presents an Alertmanager alerts viewer.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new viewer.
+func NewAlert(gvr *client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetSortCol("NAME", true)
+	a.GetTable().SetEnterFn(a.showResource)
+	a.AddBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.alertContext)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Alert) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (a *Alert) alertContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAlertmanager, a.config())
+}
+
+func (a *Alert) config() *data.Alertmanager {
+	ct, err := a.App().Config.K9s.ActiveContext()
+	if err != nil {
+		return nil
+	}
+
+	return ct.Alertmanager
+}
+
+func (a *Alert) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftV: ui.NewKeyAction("Sort Severity", a.GetTable().SortColCmd("SEVERITY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", a.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd("RESOURCE", true), false),
+	})
+	if a.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyS: ui.NewKeyAction("Silence", a.silenceCmd, true),
+		ui.KeyU: ui.NewKeyAction("Expire Silences", a.expireCmd, true),
+	})
+}
+
+// selectedAlerts returns the currently selected or marked alerts.
+func (a *Alert) selectedAlerts(ctx context.Context, c *dao.Alertmanager) ([]*render.AlertRes, error) {
+	sel := make(map[string]struct{})
+	for _, fp := range a.GetTable().GetSelectedItems() {
+		sel[fp] = struct{}{}
+	}
+	aa, err := c.Alerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*render.AlertRes, 0, len(sel))
+	for _, al := range aa {
+		if _, ok := sel[al.Fingerprint]; ok {
+			res = append(res, al)
+		}
+	}
+
+	return res, nil
+}
+
+func (a *Alert) client() (*dao.Alertmanager, error) {
+	return dao.NewAlertmanager(a.App().factory, a.config())
+}
+
+func (a *Alert) silenceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if len(a.GetTable().GetSelectedItems()) == 0 {
+		return evt
+	}
+	c, err := a.client()
+	if err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	inputs := []config.PluginInput{
+		{Name: silenceDurationInput, Label: "Duration", Type: config.InputTypeString, Default: a.config().SilenceDurationOrDefault().String()},
+		{Name: silenceCommentInput, Label: "Comment", Type: config.InputTypeString, Required: true},
+	}
+	d := a.App().Styles.Dialog()
+	dialog.ShowPluginInputs(&d, a.App().Content.Pages, "Silence Alerts", inputs,
+		func(msg string) {
+			a.App().Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			dur, err := time.ParseDuration(unquote(vv[silenceDurationInput]))
+			if err != nil || dur <= 0 {
+				a.App().Flash().Errf("Invalid silence duration %q", vv[silenceDurationInput])
+				return
+			}
+			go a.silence(c, dur, unquote(vv[silenceCommentInput]))
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func (a *Alert) silence(c *dao.Alertmanager, d time.Duration, comment string) {
+	ctx, cancel := context.WithTimeout(context.Background(), alertCallTimeout)
+	defer cancel()
+
+	aa, err := a.selectedAlerts(ctx, c)
+	if err != nil {
+		a.App().QueueUpdateDraw(func() { a.App().Flash().Err(err) })
+		return
+	}
+	ids := make([]string, 0, len(aa))
+	for _, al := range aa {
+		id, err := c.Silence(ctx, al.Labels, d, comment)
+		if err != nil {
+			slog.Error("Alert silence failed", slogs.ID, al.Fingerprint, slogs.Error, err)
+			a.App().QueueUpdateDraw(func() { a.App().Flash().Err(err) })
+			return
+		}
+		ids = append(ids, id)
+	}
+	a.App().QueueUpdateDraw(func() {
+		a.App().Flash().Infof("Silenced %d alert(s) for %s %s", len(ids), d, strings.Join(ids, ","))
+		a.GetTable().ClearMarks()
+	})
+	a.Refresh()
+}
+
+func (a *Alert) expireCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if len(a.GetTable().GetSelectedItems()) == 0 {
+		return evt
+	}
+	c, err := a.client()
+	if err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	d := a.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, a.App().Content.Pages, "Expire Silences", "Expire silences for the selected alert(s)?", func() {
+		go a.expire(c)
+	}, func() {})
+
+	return nil
+}
+
+func (a *Alert) expire(c *dao.Alertmanager) {
+	ctx, cancel := context.WithTimeout(context.Background(), alertCallTimeout)
+	defer cancel()
+
+	aa, err := a.selectedAlerts(ctx, c)
+	if err != nil {
+		a.App().QueueUpdateDraw(func() { a.App().Flash().Err(err) })
+		return
+	}
+	var count int
+	for _, al := range aa {
+		for _, id := range al.SilencedBy {
+			if err := c.Expire(ctx, id); err != nil {
+				a.App().QueueUpdateDraw(func() { a.App().Flash().Err(err) })
+				return
+			}
+			count++
+		}
+	}
+	a.App().QueueUpdateDraw(func() {
+		if count == 0 {
+			a.App().Flash().Warn("No silences found for the selected alert(s)")
+			return
+		}
+		a.App().Flash().Infof("Expired %d silence(s)", count)
+		a.GetTable().ClearMarks()
+	})
+	a.Refresh()
+}
+
+// showResource navigates to the alerted resource.
+func (*Alert) showResource(app *App, t ui.Tabular, _ *client.GVR, path string) {
+	data := t.Peek()
+	re, ok := data.FindRow(path)
+	if !ok {
+		return
+	}
+	idx, ok := data.IndexOfHeader("RESOURCE")
+	if !ok || re.Row.Fields[idx] == render.NAValue {
+		app.Flash().Warn("No resource could be derived from the alert labels")
+		return
+	}
+	res, fqn, _ := strings.Cut(re.Row.Fields[idx], " ")
+	if res == client.NsGVR.R() {
+		app.gotoResource(res, fqn, false, true)
+		return
+	}
+	ns, _ := client.Namespaced(fqn)
+	app.gotoResource(strings.TrimSpace(res+" "+ns), fqn, false, true)
+}
+
+func unquote(s string) string {
+	if u, err := strconv.Unquote(s); err == nil {
+		return u
+	}
+
+	return s
+}
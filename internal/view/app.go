@@ -10,6 +10,7 @@ import (
 	"maps"
 	"os"
 	"os/signal"
+	"slices"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -18,9 +19,14 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/proxy"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -46,12 +52,17 @@ type App struct {
 	version string
 	*ui.App
 	Content       *PageStack
+	tabs          tabs
+	body          *tview.Flex
+	pin           *pinPane
 	command       *Command
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
 	cmdHistory    *model.History
 	filterHistory *model.History
+	auditor       *audit.Logger
+	proxy         *proxy.Server
 	conRetry      int32
 	showHeader    bool
 	showLogo      bool
@@ -96,13 +107,23 @@ func (a *App) Init(version string, _ int) error {
 	a.version = model.NormalizeVersion(version)
 
 	ctx := context.WithValue(context.Background(), internal.KeyApp, a)
-	if err := a.Content.Init(ctx); err != nil {
+	if err := a.initStack(ctx, a.Content); err != nil {
 		return err
 	}
-	a.Content.AddListener(a.Crumbs())
-	a.Content.AddListener(a.Menu())
+	a.tabs.tt = []*tab{{stack: a.Content}}
 
 	a.App.Init()
+	dao.SetDryRun(a.Config.K9s.IsDryRun())
+	if l, err := audit.New(a.Config.K9s.Audit); err != nil {
+		slog.Error("Unable to initialize audit log", slogs.Error, err)
+	} else {
+		a.auditor = l
+	}
+	a.initMetricsProvider()
+	dao.Monitor().Configure(a.Config.K9s.Monitor.IntervalOrDefault(), a.Config.K9s.Monitor.TimeoutOrDefault())
+	dao.Monitor().SetListener(a)
+	dao.Notifications().Configure(a.Config.K9s.Notifications)
+	dao.Notifications().SetListener(a)
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
 
@@ -112,6 +133,11 @@ func (a *App) Init(version string, _ int) error {
 		ns := a.Config.ActiveNamespace()
 		a.factory = watch.NewFactory(a.Conn())
 		a.initFactory(ns)
+		restorePortForwards(a)
+		dao.Notifications().Start(a.factory)
+		if a.Config.K9s.ClusterProxy.Enable {
+			a.proxyCmd()
+		}
 
 		a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
 		a.clusterModel.AddListener(a.clusterInfo())
@@ -169,7 +195,9 @@ func (a *App) layout(ctx context.Context) {
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	a.body = tview.NewFlex().SetDirection(tview.FlexRow)
+	a.body.AddItem(a.Content, 0, contentProportion, true)
+	main.AddItem(a.body, 0, 10, true)
 	if !a.Config.K9s.IsCrumbsless() {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
@@ -244,7 +272,7 @@ func (a *App) contextNames() ([]string, error) {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
-	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
+	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() && !a.Content.IsTopInput() {
 		return k.Action(evt)
 	}
 
@@ -253,15 +281,20 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 func (a *App) bindKeys() {
 	a.AddActions(ui.NewKeyActionsFromMap(ui.KeyMap{
-		tcell.KeyCtrlE:     ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		tcell.KeyCtrlG:     ui.NewSharedKeyAction("ToggleCrumbs", a.toggleCrumbsCmd, false),
-		ui.KeyHelp:         ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		ui.KeyLeftBracket:  ui.NewSharedKeyAction("Go Back", a.previousCommand, false),
-		ui.KeyRightBracket: ui.NewSharedKeyAction("Go Forward", a.nextCommand, false),
-		ui.KeyDash:         ui.NewSharedKeyAction("Last View", a.lastCommand, false),
-		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
-		tcell.KeyCtrlC:     ui.NewKeyAction("Quit", a.quitCmd, false),
+		tcell.KeyCtrlE:       ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
+		tcell.KeyCtrlG:       ui.NewSharedKeyAction("ToggleCrumbs", a.toggleCrumbsCmd, false),
+		ui.KeyHelp:           ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		ui.KeyLeftBracket:    ui.NewSharedKeyAction("Go Back", a.previousCommand, false),
+		ui.KeyRightBracket:   ui.NewSharedKeyAction("Go Forward", a.nextCommand, false),
+		ui.KeyDash:           ui.NewSharedKeyAction("Last View", a.lastCommand, false),
+		tcell.KeyCtrlA:       ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlN:       ui.NewSharedKeyAction("New Tab", a.newTabCmd, false),
+		tcell.KeyCtrlX:       ui.NewSharedKeyAction("Close Tab", a.closeTabCmd, false),
+		tcell.KeyCtrlRightSq: ui.NewSharedKeyAction("Next Tab", a.nextTabCmd, false),
+		tcell.KeyCtrlY:       ui.NewSharedKeyAction("Toggle Pin", a.pinCmd, false),
+		tcell.KeyCtrlV:       ui.NewSharedKeyAction("Switch Pane", a.switchPaneCmd, false),
+		tcell.KeyEnter:       ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlC:       ui.NewKeyAction("Quit", a.quitCmd, false),
 	}))
 }
 
@@ -344,6 +377,9 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	if err := a.kubeConfigWatcher(ctx); err != nil {
+		slog.Warn("Kubeconfig watcher failed", slogs.Error, err)
+	}
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -423,6 +459,12 @@ func (a *App) refreshCluster(context.Context) error {
 		a.BailOut(1)
 	}
 	if count > 0 {
+		if cfg := a.Conn().Config(); cfg != nil {
+			if cred, err := cfg.Credential(); err == nil && cred.Expires() && cred.Remaining(time.Now()) <= 0 {
+				a.Status(model.FlashErr, fmt.Sprintf("Credentials expired. Re-authenticate to reconnect [%d/%d]", count, maxConnRetry))
+				return fmt.Errorf("conn check failed (%d/%d)", count, maxConnRetry)
+			}
+		}
 		a.Status(model.FlashWarn, fmt.Sprintf("Dial K8s Toast [%d/%d]", count, maxConnRetry))
 		return fmt.Errorf("conn check failed (%d/%d)", count, maxConnRetry)
 	}
@@ -456,6 +498,26 @@ func (a *App) switchNS(ns string) error {
 	return a.factory.SetActiveNS(ns)
 }
 
+// gotoLanding navigates to a namespace default view if one is configured.
+func (a *App) gotoLanding(ns string) bool {
+	l, ok := a.Config.FavNamespaceLanding(ns)
+	if !ok {
+		return false
+	}
+	a.gotoResource(landingCmd(ns, l), "", false, true)
+
+	return true
+}
+
+func landingCmd(ns string, l data.Landing) string {
+	c := l.View + " " + ns
+	if l.Filter != "" {
+		c += " /" + l.Filter
+	}
+
+	return c
+}
+
 func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	contextName, ok := ci.HasContext()
 	if (!ok || a.Config.ActiveContextName() == contextName) && !force {
@@ -464,11 +526,19 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	a.Halt()
 	defer a.Resume()
 	{
+		a.closeOtherTabs()
+		a.unpinView()
+		// The proxy dials through the current connection, stop it prior to switching.
+		if a.proxy != nil {
+			a.stopProxy()
+			slog.Info("Cluster proxy stopped on context switch")
+		}
 		a.Config.Reset()
 		ct, err := a.Config.ActivateContext(contextName)
 		if err != nil {
 			return err
 		}
+		a.initMetricsProvider()
 		if cns, ok := ci.NSArg(); ok {
 			ct.Namespace.Active = cns
 		}
@@ -498,7 +568,12 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		}
 
 		if a.factory != nil {
+			dao.Monitor().Clear()
+			dao.ClearWorkloadTables()
+			dao.ClearLogFlags()
 			a.initFactory(ns)
+			restorePortForwards(a)
+			dao.Notifications().Start(a.factory)
 		}
 
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
@@ -521,6 +596,19 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	return nil
 }
 
+// initMetricsProvider sets up the active context metrics provider.
+func (a *App) initMetricsProvider() {
+	if a.Conn() == nil {
+		return
+	}
+	p, err := a.Config.K9s.Metrics.NewProvider(a.Conn())
+	if err != nil {
+		slog.Error("Unable to initialize metrics provider", slogs.Error, err)
+		return
+	}
+	client.SetMetricsProvider(a.Conn().ActiveContext(), p)
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -538,7 +626,14 @@ func (a *App) BailOut(exitCode int) {
 		slog.Error("Unable to nuke k9s shell pod", slogs.Error, err)
 	}
 
+	a.saveSession()
 	a.stopImgScanner()
+	a.stopProxy()
+	dao.Monitor().Clear()
+	dao.Notifications().Stop()
+	if err := a.auditor.Close(); err != nil {
+		slog.Error("Unable to close audit log", slogs.Error, err)
+	}
 	a.factory.Terminate()
 	a.App.BailOut(exitCode)
 }
@@ -547,6 +642,7 @@ func (a *App) BailOut(exitCode int) {
 func (a *App) Run() error {
 	a.Resume()
 
+	session := a.Config.Session()
 	go func() {
 		if !a.Config.K9s.IsSplashless() {
 			<-time.After(splashDelay)
@@ -557,6 +653,7 @@ func (a *App) Run() error {
 			if a.CmdBuff().IsActive() {
 				a.SetFocus(a.Prompt())
 			}
+			a.offerSession(session)
 		})
 	}()
 
@@ -673,6 +770,223 @@ func (a *App) cowCmd(msg string) {
 	dialog.ShowError(&d, a.Content.Pages, msg)
 }
 
+func (a *App) dryRunCmd() {
+	dry := a.Config.K9s.ToggleDryRun()
+	dao.SetDryRun(dry)
+	if dry {
+		a.Flash().Warn("Dry run mode on. Delete, scale and patch operations are validated but not applied")
+		return
+	}
+	a.Flash().Info("Dry run mode off")
+}
+
+// proxyCmd toggles the local SOCKS5/HTTP proxy routing into the cluster.
+func (a *App) proxyCmd() {
+	if a.proxy != nil && a.proxy.IsRunning() {
+		a.stopProxy()
+		a.Flash().Info("Cluster proxy stopped")
+		return
+	}
+	if a.factory == nil {
+		a.Flash().Errf("Cluster proxy requires a cluster connection")
+		return
+	}
+
+	d := dao.NewClusterDialer(a.factory, a.Config.ActiveNamespace())
+	a.proxy = proxy.NewServer(a.Config.K9s.ClusterProxy.AddressOrDefault(), d.DialContext)
+	if err := a.proxy.Start(); err != nil {
+		a.proxy = nil
+		a.Flash().Errf("Cluster proxy failed to start: %s", err)
+		return
+	}
+	slog.Info("Cluster proxy started", slogs.Address, a.proxy.Addr())
+	a.Flash().Infof("Cluster proxy (socks5/http) listening on %s", a.proxy.Addr())
+}
+
+func (a *App) stopProxy() {
+	if a.proxy == nil {
+		return
+	}
+	a.proxy.Stop()
+	a.proxy = nil
+}
+
+// ProbeChanged alerts when a health probe goes down or recovers.
+func (a *App) ProbeChanged(p *render.ProbeRes) {
+	a.QueueUpdateDraw(func() {
+		if p.State == render.ProbeDown {
+			a.Flash().Errf("Monitor %s %s is down: %s", p.Kind, p.FQN, p.LastError)
+			return
+		}
+		a.Flash().Infof("Monitor %s %s recovered", p.Kind, p.FQN)
+	})
+}
+
+// Notify signals a triggered watched condition.
+func (a *App) Notify(n dao.Notification) {
+	if n.Has(config.NotifyBell) {
+		a.Beep()
+	}
+	if !n.Has(config.NotifyBanner) {
+		return
+	}
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warnf("[%s] %s %s %s", n.Rule, n.GVR.R(), n.FQN, n.Message)
+	})
+}
+
+// audit records a user initiated action that succeeded.
+func (a *App) audit(action string, gvr *client.GVR, path string) {
+	a.auditResult(action, gvr, path, nil)
+}
+
+// auditResult records a user initiated action outcome.
+func (a *App) auditResult(action string, gvr *client.GVR, path string, err error) {
+	if a.auditor == nil {
+		return
+	}
+	e := audit.Event{
+		Action:   action,
+		Resource: gvr.String(),
+		Path:     path,
+		Context:  a.Config.ActiveContextName(),
+		DryRun:   dao.IsDryRun(),
+		Outcome:  audit.OutcomeFor(err),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if a.Conn() != nil {
+		cfg := a.Conn().Config()
+		e.User, _ = cfg.CurrentUserName()
+		e.Cluster, _ = cfg.CurrentClusterName()
+	}
+	a.auditor.Log(e)
+}
+
+// impersonateCmd switches the identity k9s acts as and reconnects to the active context.
+func (a *App) impersonateCmd(user string, groups []string) {
+	if a.factory == nil || a.Conn() == nil {
+		a.Flash().Errf("Impersonation requires a cluster connection")
+		return
+	}
+	cfg := a.Conn().Config()
+	pu, _ := cfg.ImpersonateUser()
+	pgg, _ := cfg.CurrentGroupNames()
+	cfg.Impersonate(user, groups)
+	if err := useContext(a, a.Config.ActiveContextName()); err != nil {
+		cfg.Impersonate(pu, pgg)
+		a.Flash().Errf("Impersonation failed: %s", err)
+		return
+	}
+	a.clearHistory()
+	if user == "" {
+		a.Flash().Info("Impersonation off")
+		return
+	}
+	if len(groups) > 0 {
+		a.Flash().Warnf("Impersonating %s (groups: %s)", user, strings.Join(groups, ","))
+		return
+	}
+	a.Flash().Warnf("Impersonating %s", user)
+}
+
+func (a *App) undoCmd() {
+	a.journalCmd("Undo", audit.UndoAction, dao.Journal().Undo)
+}
+
+func (a *App) redoCmd() {
+	a.journalCmd("Redo", audit.RedoAction, dao.Journal().Redo)
+}
+
+func (a *App) journalCmd(op, action string, fn func(context.Context, client.Connection) (*dao.JournalEntry, error)) {
+	if a.Config.IsReadOnly() {
+		a.auditResult(action, client.NoGVR, "", fmt.Errorf("%w: read-only mode", audit.ErrDenied))
+		a.Flash().Errf("%s is disabled in read-only mode", op)
+		return
+	}
+	if a.Conn() == nil {
+		a.Flash().Errf("%s failed: no connection available", op)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	e, err := fn(ctx, a.Conn())
+	if e != nil {
+		a.auditResult(action, e.GVR, e.FQN, err)
+	}
+	if err != nil {
+		a.Flash().Errf("%s failed: %s", op, err)
+		return
+	}
+	a.Flash().Info(dryRunMsg(fmt.Sprintf("%s %s %s %s", op, strings.ToLower(string(e.Op)), e.GVR, e.FQN)))
+}
+
+func (a *App) columnsCmd() {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		a.Flash().Errf("Column editor is not available on this view")
+		return
+	}
+	gvr, ns := v.GVR().String(), v.GetTable().GetModel().GetNamespace()
+	// Save back under the key the view settings are resolved from ie gvr@ns.
+	key, ok := a.CustomView().ViewSettingKey(gvr, ns)
+	if !ok {
+		key = gvr
+	}
+	vs := a.CustomView().ViewSetting(gvr, ns)
+	if vs == nil {
+		vs = new(config.ViewSetting)
+	}
+	cols := slices.Clone(vs.Columns)
+	for _, h := range v.GetTable().GetModel().Peek().Header() {
+		if !slices.ContainsFunc(cols, func(c string) bool { return render.ColumnName(c) == h.Name }) {
+			cols = append(cols, h.Name)
+		}
+	}
+
+	d := a.Styles.Dialog()
+	dialog.ShowColumns(&d, a.Content.Pages, "Columns "+gvr, cols, func(cc []string) {
+		vs.Columns = cc
+		a.CustomView().SetViewSetting(key, *vs)
+		if err := a.CustomView().Save(config.AppViewsFile); err != nil {
+			a.Flash().Errf("Unable to save column settings: %s", err)
+			return
+		}
+		a.Flash().Infof("Columns saved for %s", gvr)
+	})
+}
+
+func (a *App) nodeShellCmd(node string) {
+	if a.Config.IsReadOnly() {
+		a.Flash().Errf("Node shell is disabled in read-only mode")
+		return
+	}
+	ct, err := a.Config.K9s.ActiveContext()
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if !ct.FeatureGates.NodeShell || a.Config.K9s.ShellPod == nil {
+		a.Flash().Errf("Node shell requires the nodeShell feature gate and a shellPod configuration")
+		return
+	}
+	v, ok := a.Content.Top().(ResourceViewer)
+	if node == "" && ok && v.GVR() == client.NodeGVR {
+		_, node = client.Namespaced(v.GetTable().GetSelectedItem())
+	}
+	if node == "" {
+		a.Flash().Errf("Invalid command. Use `shell xxx`")
+		return
+	}
+	if _, err := dao.FetchNode(context.Background(), a.factory, node); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	launchNodeShell(a.Content.Top(), a, node)
+}
+
 func (a *App) dirCmd(path string, pushCmd bool) error {
 	slog.Debug("Exec Dir command", slogs.Path, path)
 	_, err := os.Stat(path)
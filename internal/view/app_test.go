@@ -15,5 +15,5 @@ func TestAppNew(t *testing.T) {
 	a := view.NewApp(mock.NewMockConfig(t))
 	_ = a.Init("blee", 10)
 
-	assert.Equal(t, 14, a.GetActions().Len())
+	assert.Equal(t, 19, a.GetActions().Len())
 }
@@ -4,16 +4,21 @@
 package view
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -35,10 +40,58 @@ func NewBenchmark(gvr *client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftD, ui.NewKeyAction("Compare Runs", b.compareCmd, true))
+}
+
+// compareCmd compares two marked runs or the selected run against its previous run.
+func (b *Benchmark) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetTable().GetSelectedItems()
+	switch len(paths) {
+	case 0:
+		return evt
+	case 1:
+		prev, err := previousRun(paths[0])
+		if err != nil {
+			b.App().Flash().Err(err)
+			return nil
+		}
+		paths = []string{prev, paths[0]}
+	case 2:
+	default:
+		b.App().Flash().Warn("Mark at most 2 benchmark runs to compare")
+		return nil
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		return cmp.Compare(runStamp(a), runStamp(b))
+	})
+
+	rr := make([]perf.Report, 0, len(paths))
+	for _, p := range paths {
+		bb, err := os.ReadFile(p)
+		if err != nil {
+			b.App().Flash().Errf("Unable to load bench file %s", err)
+			return nil
+		}
+		rr = append(rr, perf.ParseReport(string(bb)))
+	}
+	if perf.Regressed(rr[0], rr[1], regressionTolerance) {
+		b.App().Flash().Warnf("Performance regression detected on %s", fileToSubject(paths[1]))
+	}
+
+	details := NewDetails(b.App(), "Compare", fileToSubject(paths[1]), contentTXT, true).Update(perf.Compare(rr[0], rr[1]))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -58,12 +111,63 @@ func (b *Benchmark) viewBench(app *App, _ ui.Tabular, _ *client.GVR, path string
 
 func (b *Benchmark) benchFile() string {
 	r := b.GetTable().GetSelectedRowIndex()
-	return ui.TrimCell(b.GetTable().SelectTable, r, 7)
+	return ui.TrimCell(b.GetTable().SelectTable, r, 9)
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// regressionTolerance tracks the latency/error rate percentage increase flagged as a regression.
+const regressionTolerance = 10.0
+
+// runStamp extracts a run timestamp from a bench file name.
+func runStamp(path string) int64 {
+	n := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	i := strings.LastIndex(n, "_")
+	if i < 0 {
+		return 0
+	}
+	ts, err := strconv.ParseInt(n[i+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return ts
+}
+
+// previousRun locates the most recent run of the same target prior to a given bench file.
+func previousRun(path string) (string, error) {
+	n := filepath.Base(path)
+	i := strings.LastIndex(n, "_")
+	if i < 0 {
+		return "", fmt.Errorf("invalid bench file %s", n)
+	}
+	prefix, stamp := n[:i+1], runStamp(path)
+	ee, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+	var (
+		prev   string
+		prevTS int64
+	)
+	for _, e := range ee {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), prefix) {
+			continue
+		}
+		ts, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(e.Name(), prefix), ".txt"), 10, 64)
+		if err != nil || ts >= stamp || ts <= prevTS {
+			continue
+		}
+		prev, prevTS = e.Name(), ts
+	}
+	if prev == "" {
+		return "", fmt.Errorf("no previous run found for %s", fileToSubject(path))
+	}
+
+	return filepath.Join(filepath.Dir(path), prev), nil
+}
+
 func fileToSubject(path string) string {
 	tokens := strings.Split(path, "/")
 	ee := strings.Split(tokens[len(tokens)-1], "_")
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunStamp(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    int64
+	}{
+		"plain":   {path: "/tmp/default_fred_100.txt", e: 100},
+		"port":    {path: "/tmp/default_fred_8080_200.txt", e: 200},
+		"invalid": {path: "/tmp/default_fred.txt"},
+		"none":    {path: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, runStamp(u.path))
+		})
+	}
+}
+
+func TestPreviousRun(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{
+		"default_fred_100.txt",
+		"default_fred_200.txt",
+		"default_fred_300.txt",
+		"default_fred_blee_250.txt",
+		"default_zorg_280.txt",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), nil, 0o600))
+	}
+
+	uu := map[string]struct {
+		file, e string
+		err     bool
+	}{
+		"latest":  {file: "default_fred_300.txt", e: "default_fred_200.txt"},
+		"middle":  {file: "default_fred_200.txt", e: "default_fred_100.txt"},
+		"first":   {file: "default_fred_100.txt", err: true},
+		"other":   {file: "default_zorg_280.txt", err: true},
+		"invalid": {file: "fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			prev, err := previousRun(filepath.Join(dir, u.file))
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(dir, u.e), prev)
+		})
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
@@ -43,6 +45,7 @@ type Browser struct {
 	mx         sync.RWMutex
 	updating   bool
 	firstView  atomic.Int32
+	labelIdx   map[string][]string
 }
 
 // NewBrowser returns a new browser.
@@ -98,11 +101,15 @@ func (b *Browser) Init(ctx context.Context) error {
 	b.SetReadOnly(b.app.Config.IsReadOnly())
 	b.SetNoIcon(b.app.Config.K9s.UI.NoIcons)
 	b.SetFullGVR(b.app.Config.K9s.UI.UseFullGVRTitle)
+	b.SetAutoWide(b.app.Config.K9s.UI.AutoWide)
 
 	b.bindKeys(b.Actions())
 	for _, f := range b.bindKeysFn {
 		f(b.Actions())
 	}
+	if b.CmdBuff().Empty() {
+		b.restoreFilter()
+	}
 	b.accessor, err = dao.AccessorFor(b.app.factory, b.GVR())
 	if err != nil {
 		return err
@@ -133,6 +140,9 @@ func (b *Browser) suggestFilter() model.SuggestionFunc {
 			}
 			return b.App().filterHistory.List()
 		}
+		if ll := labelSuggestions(s, b.labelIndex()); len(ll) > 0 {
+			return ll
+		}
 
 		s = strings.ToLower(s)
 		for _, h := range b.App().filterHistory.List() {
@@ -153,6 +163,7 @@ func (b *Browser) bindKeys(aa *ui.KeyActions) {
 		ui.KeyQ:         ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
 		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", b.filterCmd, false),
 		tcell.KeyHelp:   ui.NewSharedKeyAction("Help", b.helpCmd, false),
+		tcell.KeyCtrlT:  ui.NewKeyAction("Saved Filters", b.savedFiltersCmd, true),
 	})
 }
 
@@ -227,6 +238,9 @@ func (b *Browser) BufferCompleted(text, _ string) {
 // BufferActive indicates the buff activity changed.
 func (b *Browser) BufferActive(state bool, _ model.BufferKind) {
 	if state {
+		b.mx.Lock()
+		b.labelIdx = nil
+		b.mx.Unlock()
 		return
 	}
 	if err := b.GetModel().Refresh(b.GetContext()); err != nil {
@@ -263,6 +277,13 @@ func (b *Browser) prepareContext() context.Context {
 	if b.contextFn != nil {
 		ctx = b.contextFn(ctx)
 	}
+	if txt := b.CmdBuff().GetText(); internal.IsFieldSelector(txt) {
+		if sel, err := ui.ExtractFieldSelector(txt); err == nil {
+			ctx = context.WithValue(ctx, internal.KeyFields, joinFields(ctx, sel.String()))
+		} else {
+			b.App().Flash().Errf("Invalid field selector %q: %s", txt, err)
+		}
+	}
 	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
 		b.Path = path
 	}
@@ -399,6 +420,18 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if err := diffResources(b.app, b.GVR(), sels); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -409,17 +442,21 @@ func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !b.CmdBuff().InCmdMode() {
-		hasFilter := !b.CmdBuff().Empty()
+		hasFilter, hasFields := !b.CmdBuff().Empty(), internal.IsFieldSelector(b.CmdBuff().GetText())
 		b.CmdBuff().ClearText(false)
 		if hasFilter {
 			b.GetModel().SetLabelSelector(labels.Everything())
+			if hasFields {
+				b.Start()
+			}
 			b.Refresh()
 		}
 		return b.App().PrevCmd(evt)
 	}
 
+	hasFields := internal.IsFieldSelector(b.CmdBuff().GetText())
 	b.CmdBuff().Reset()
-	if internal.IsLabelSelector(b.CmdBuff().GetText()) {
+	if hasFields || internal.IsLabelSelector(b.CmdBuff().GetText()) {
 		b.Start()
 	}
 	b.Refresh()
@@ -427,13 +464,117 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) savedFiltersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	vs := b.viewSetting()
+	names := vs.FilterNames()
+	if len(names) == 0 {
+		b.App().Flash().Warnf("No saved filters defined for %s", b.GVR())
+		return nil
+	}
+
+	opts := make([]string, 0, len(names)+1)
+	last := b.App().Config.LastFilter(b.GVR().String())
+	for _, n := range names {
+		f, _ := vs.Filter(n)
+		if n == last {
+			n = "* " + n
+		}
+		opts = append(opts, fmt.Sprintf("%s (%s)", n, f))
+	}
+	opts = append(opts, "<clear>")
+
+	d := b.App().Styles.Dialog()
+	dialog.ShowSelection(&d, b.App().Content.Pages, "Saved Filters", opts, func(i int) {
+		if i < 0 {
+			return
+		}
+		var name string
+		if i < len(names) {
+			name = names[i]
+		}
+		b.switchFilter(name)
+	})
+
+	return nil
+}
+
+// switchFilter applies a saved filter and tracks it as the view last used filter.
+// An empty name clears the current filter.
+func (b *Browser) switchFilter(name string) {
+	f, _ := b.viewSetting().Filter(name)
+	b.applyFilter(f)
+	if internal.IsLabelSelector(f) || internal.IsFieldSelector(f) || f == "" {
+		b.Start()
+	}
+	b.Refresh()
+
+	b.App().Config.SetLastFilter(b.GVR().String(), name)
+	if err := b.App().Config.Save(true); err != nil {
+		slog.Error("Unable to save last used filter", slogs.Error, err)
+	}
+	if name == "" {
+		b.App().Flash().Info("Filter cleared")
+		return
+	}
+	b.App().Flash().Infof("Using %q filter", name)
+}
+
+// restoreFilter applies the view last used saved filter if any.
+func (b *Browser) restoreFilter() {
+	name := b.App().Config.LastFilter(b.GVR().String())
+	if name == "" {
+		return
+	}
+	if f, ok := b.viewSetting().Filter(name); ok {
+		b.applyFilter(f)
+	}
+}
+
+// labelIndex returns the labels of the cached resources for label selectors completion.
+func (b *Browser) labelIndex() map[string][]string {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.labelIdx == nil && dao.IsK8sMeta(b.meta) && b.app.factory != nil {
+		b.labelIdx = dao.LabelIndex(b.app.factory, b.GVR(), b.GetNamespace())
+		if b.labelIdx == nil {
+			b.labelIdx = make(map[string][]string)
+		}
+	}
+
+	return b.labelIdx
+}
+
+// viewSetting returns the view custom settings if any.
+func (b *Browser) viewSetting() *config.ViewSetting {
+	if vs := b.GetTable().GetViewSetting(); vs != nil {
+		return vs
+	}
+
+	return b.App().CustomView().ViewSetting(b.GVR().String(), b.GetNamespace())
+}
+
+func (b *Browser) applyFilter(f string) {
+	if !internal.IsLabelSelector(f) {
+		b.GetModel().SetLabelSelector(labels.Everything())
+		b.SetFilter(f, true)
+		return
+	}
+	sel, err := ui.ExtractLabelSelector(f)
+	if err != nil {
+		b.App().Flash().Errf("Invalid label selector %q: %s", f, err)
+		return
+	}
+	b.SetLabelSelector(sel, true)
+}
+
 func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !b.CmdBuff().IsActive() {
 		return evt
 	}
 
 	b.CmdBuff().SetActive(false)
-	if internal.IsLabelSelector(b.CmdBuff().GetText()) {
+	if txt := b.CmdBuff().GetText(); internal.IsLabelSelector(txt) || internal.IsFieldSelector(txt) {
 		b.Start()
 		return nil
 	}
@@ -527,16 +668,7 @@ func editRes(app *App, gvr *client.GVR, path string) error {
 		return fmt.Errorf("current user can't edit resource %s", gvr)
 	}
 
-	args := make([]string, 0, 10)
-	args = append(args, "edit", gvr.FQN(n))
-	if ns != client.BlankNamespace {
-		args = append(args, "-n", ns)
-	}
-	if err := runK(app, &shellOpts{clear: true, args: args}); err != nil {
-		app.Flash().Errf("Edit command failed: %s", err)
-	}
-
-	return nil
+	return editManifest(app, gvr, path)
 }
 
 func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -555,6 +687,9 @@ func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 		b.App().Flash().Err(err)
 		return nil
 	}
+	if b.app.gotoLanding(ns) {
+		return nil
+	}
 
 	if err := b.app.switchNS(ns); err != nil {
 		b.App().Flash().Err(err)
@@ -602,6 +737,7 @@ func (b *Browser) defaultContext() context.Context {
 	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, b.app.factory.Client().HasMetrics())
+	ctx = context.WithValue(ctx, internal.KeyPageSize, b.app.Config.K9s.GetListPageSize())
 
 	return ctx
 }
@@ -640,6 +776,7 @@ func (b *Browser) refreshActions() {
 	if !dao.IsK9sMeta(b.meta) {
 		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
 		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		aa.Add(ui.KeyShiftW, ui.NewKeyAction("Diff", b.diffCmd, true))
 	}
 	for _, f := range b.bindKeysFn {
 		f(aa)
@@ -698,9 +835,9 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 	dialog.ShowConfirm(&d, b.app.Content.Pages, "Confirm Delete", msg, func() {
 		b.ShowDeleted()
 		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR().R())
+			b.app.Flash().Info(dryRunMsg(fmt.Sprintf("Delete %d marked %s", len(selections), b.GVR().R())))
 		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+			b.app.Flash().Info(dryRunMsg(fmt.Sprintf("Delete resource %s %s", b.GVR(), selections[0])))
 		}
 		for _, sel := range selections {
 			nuker, ok := b.accessor.(dao.Nuker)
@@ -711,6 +848,7 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
+				b.app.audit(audit.DeleteAction, b.GVR(), sel)
 				b.app.factory.DeleteForwarder(sel)
 			}
 			b.GetTable().DeleteMark(sel)
@@ -723,9 +861,9 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 	okFn := func(propagation *metav1.DeletionPropagation, force bool) {
 		b.ShowDeleted()
 		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
+			b.app.Flash().Info(dryRunMsg(fmt.Sprintf("Delete %d marked %s", len(selections), b.GVR())))
 		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+			b.app.Flash().Info(dryRunMsg(fmt.Sprintf("Delete resource %s %s", b.GVR(), selections[0])))
 		}
 		for _, sel := range selections {
 			grace := dao.DefaultGrace
@@ -735,6 +873,7 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
+				b.app.audit(audit.DeleteAction, b.GVR(), sel)
 				b.app.factory.DeleteForwarder(sel)
 			}
 			b.GetTable().DeleteMark(sel)
@@ -742,5 +881,24 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 		b.refresh()
 	}
 	d := b.app.Styles.Dialog()
-	dialog.ShowDelete(&d, b.app.Content.Pages, msg, okFn, func() {})
+	dialog.ShowDeleteGuarded(&d, b.app.Content.Pages, msg, nil, disruptionsGuard(b.app, b.GVR(), selections), okFn, func() {})
+}
+
+// disruptionsGuard describes the disruption budgets violated by removing the given resources if any.
+func disruptionsGuard(app *App, gvr *client.GVR, paths []string) string {
+	res, err := dao.AccessorFor(app.factory, gvr)
+	if err != nil {
+		return ""
+	}
+	d, ok := res.(dao.Disruptor)
+	if !ok {
+		return ""
+	}
+	vv, err := d.Disruptions(paths)
+	if err != nil {
+		slog.Warn("Unable to evaluate disruption budgets", slogs.GVR, gvr, slogs.Error, err)
+		return ""
+	}
+
+	return dao.DisruptionsSummary(vv)
 }
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// CertExpiry presents a certificates expiry viewer.
+type CertExpiry struct {
+	ResourceViewer
+}
+
+// NewCertExpiry returns a new viewer.
+func NewCertExpiry(gvr *client.GVR) ResourceViewer {
+	c := CertExpiry{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetEnterFn(c.describeCert)
+	c.GetTable().SetSortCol("NOT AFTER", true)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *CertExpiry) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", c.describeCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Expiry", c.GetTable().SortColCmd("NOT AFTER", true), false),
+	})
+	if c.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Renew", c.renewCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (c *CertExpiry) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	c.describeCert(c.App(), nil, c.GVR(), path)
+
+	return nil
+}
+
+func (*CertExpiry) describeCert(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	gvr, fqn, err := dao.ParseWorkloadPath(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	describeResource(app, nil, gvr, fqn)
+}
+
+func (c *CertExpiry) renewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	gvr, fqn, err := dao.ParseWorkloadPath(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if gvr != client.CertGVR {
+		c.App().Flash().Warnf("Secret %s is not managed by cert-manager and can not be renewed", fqn)
+		return nil
+	}
+
+	d := c.App().Styles.Dialog()
+	msg := fmt.Sprintf("Renew certificate %s?", fqn)
+	dialog.ShowConfirm(&d, c.App().Content.Pages, "Confirm Renew", msg, func() {
+		var ce dao.CertExpiry
+		ce.Init(c.App().factory, client.CexGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := ce.Renew(ctx, fqn); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().audit(audit.RenewAction, client.CertGVR, fqn)
+		c.App().Flash().Info(dryRunMsg(fmt.Sprintf("Renewal triggered for certificate %s", fqn)))
+	}, func() {})
+
+	return nil
+}
@@ -6,6 +6,7 @@ package view
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -15,6 +16,19 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// credWarnThreshold tracks how early expiring credentials are flagged.
+const credWarnThreshold = 5 * time.Minute
+
+type credState int
+
+const (
+	credOK credState = iota
+	credExpiring
+	credExpired
+	credFailed
 )
 
 var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
@@ -23,8 +37,9 @@ var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
 type ClusterInfo struct {
 	*tview.Table
 
-	app    *App
-	styles *config.Styles
+	app       *App
+	styles    *config.Styles
+	credState credState
 }
 
 // NewClusterInfo returns a new cluster info view.
@@ -111,6 +126,8 @@ func (*ClusterInfo) warnCell(s string, w bool) string {
 
 // ClusterInfoChanged notifies the cluster meta was changed.
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
+	st := credStatus(curr, time.Now())
+	c.alertCredential(curr, st)
 	c.app.QueueUpdateDraw(func() {
 		c.Clear()
 		c.layout()
@@ -119,9 +136,12 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
 		if ic := ui.ROIndicator(c.app.Config.IsReadOnly(), c.app.Config.K9s.UI.NoIcons); ic != "" {
 			context += " " + ic
 		}
+		if ic := ui.DryRunIndicator(c.app.Config.K9s.IsDryRun(), c.app.Config.K9s.UI.NoIcons); ic != "" {
+			context += " " + ic
+		}
 		row := c.setCell(0, context)
 		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
+		row = c.setCell(row, c.userCell(curr, st))
 		if curr.K9sLatest != "" {
 			row = c.setCell(row, fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest))
 		} else {
@@ -140,6 +160,56 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
 	})
 }
 
+func (c *ClusterInfo) userCell(m *model.ClusterMeta, st credState) string {
+	var label string
+	switch st {
+	case credFailed:
+		label = "failed"
+	case credExpired:
+		label = "expired"
+	default:
+		if m.CredExpiry.IsZero() {
+			return m.User
+		}
+		label = duration.HumanDuration(time.Until(m.CredExpiry))
+	}
+
+	return c.warnCell(m.User+" ("+m.CredKind+" "+label+")", st != credOK)
+}
+
+// alertCredential prompts for re-authentication once credentials near expiry or fail.
+func (c *ClusterInfo) alertCredential(m *model.ClusterMeta, st credState) {
+	if st == c.credState {
+		return
+	}
+	c.credState = st
+	switch st {
+	case credExpiring:
+		c.app.Flash().Warnf("Credentials for %s expire in %s. Re-authenticate to avoid interruptions",
+			m.User, duration.HumanDuration(time.Until(m.CredExpiry)))
+	case credExpired:
+		c.app.Flash().Errf("Credentials for %s expired. Re-authenticate to resume", m.User)
+	case credFailed:
+		c.app.Flash().Errf("Unable to refresh %s credentials: %s", m.CredKind, m.CredError)
+	case credOK:
+	}
+}
+
+func credStatus(m *model.ClusterMeta, now time.Time) credState {
+	switch {
+	case m.CredError != "":
+		return credFailed
+	case m.CredExpiry.IsZero():
+		return credOK
+	case !now.Before(m.CredExpiry):
+		return credExpired
+	case m.CredExpiry.Sub(now) < credWarnThreshold:
+		return credExpiring
+	default:
+		return credOK
+	}
+}
+
 const defconFmt = "%s %s level!"
 
 func (c *ClusterInfo) setDefCon(cpu, mem int) {
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredStatus(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		m *model.ClusterMeta
+		e credState
+	}{
+		"none": {
+			m: &model.ClusterMeta{},
+			e: credOK,
+		},
+		"valid": {
+			m: &model.ClusterMeta{CredExpiry: now.Add(time.Hour)},
+			e: credOK,
+		},
+		"expiring": {
+			m: &model.ClusterMeta{CredExpiry: now.Add(time.Minute)},
+			e: credExpiring,
+		},
+		"expired": {
+			m: &model.ClusterMeta{CredExpiry: now.Add(-time.Minute)},
+			e: credExpired,
+		},
+		"failed": {
+			m: &model.ClusterMeta{CredError: "boom", CredExpiry: now.Add(time.Hour)},
+			e: credFailed,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, credStatus(u.m, now))
+		})
+	}
+}
@@ -190,6 +190,60 @@ func (c *Interpreter) IsXrayCmd() bool {
 	return xrayCmd.Has(c.cmd)
 }
 
+// IsDryRunCmd returns true if dry-run cmd is detected.
+func (c *Interpreter) IsDryRunCmd() bool {
+	return dryRunCmd.Has(c.cmd)
+}
+
+// IsProxyCmd returns true if cluster proxy cmd is detected.
+func (c *Interpreter) IsProxyCmd() bool {
+	return proxyCmd.Has(c.cmd)
+}
+
+// IsUndoCmd returns true if undo cmd is detected.
+func (c *Interpreter) IsUndoCmd() bool {
+	return undoCmd.Has(c.cmd)
+}
+
+// IsRedoCmd returns true if redo cmd is detected.
+func (c *Interpreter) IsRedoCmd() bool {
+	return redoCmd.Has(c.cmd)
+}
+
+// IsFindCmd returns true if find cmd is detected.
+func (c *Interpreter) IsFindCmd() bool {
+	return findCmd.Has(c.cmd)
+}
+
+// IsColumnsCmd returns true if columns cmd is detected.
+func (c *Interpreter) IsColumnsCmd() bool {
+	return columnsCmd.Has(c.cmd)
+}
+
+// IsShellCmd returns true if node shell cmd is detected.
+func (c *Interpreter) IsShellCmd() bool {
+	return shellCmd.Has(c.cmd)
+}
+
+// ShellArg returns the node to shell into if any.
+func (c *Interpreter) ShellArg() (string, bool) {
+	if !c.IsShellCmd() {
+		return "", false
+	}
+
+	return c.Args(), true
+}
+
+// FindArg returns the search term.
+func (c *Interpreter) FindArg() (string, bool) {
+	if !c.IsFindCmd() {
+		return "", false
+	}
+	term := c.Args()
+
+	return term, term != ""
+}
+
 // IsContextCmd returns true if context cmd is detected.
 func (c *Interpreter) IsContextCmd() bool {
 	return contextCmd.Has(c.cmd)
@@ -210,6 +264,31 @@ func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
 }
 
+// IsWhoCanCmd returns true if rbac reverse lookup cmd is detected.
+func (c *Interpreter) IsWhoCanCmd() bool {
+	return whoCanCmd.Has(c.cmd)
+}
+
+// IsImpersonateCmd returns true if impersonation cmd is detected.
+func (c *Interpreter) IsImpersonateCmd() bool {
+	return impersonateCmd.Has(c.cmd)
+}
+
+// IsSplitCmd returns true if split view cmd is detected.
+func (c *Interpreter) IsSplitCmd() bool {
+	return splitCmd.Has(c.cmd)
+}
+
+// IsFleetCmd returns true if fleet broadcast cmd is detected.
+func (c *Interpreter) IsFleetCmd() bool {
+	return fleetCmd.Has(c.cmd)
+}
+
+// IsTabCmd returns true if workspace tab cmd is detected.
+func (c *Interpreter) IsTabCmd() bool {
+	return tabCmd.Has(c.cmd)
+}
+
 // ContextArg returns context cmd arg.
 func (c *Interpreter) ContextArg() (string, bool) {
 	if c.IsContextCmd() || strings.Contains(c.line, contextFlag) {
@@ -258,6 +337,82 @@ func (c *Interpreter) RBACArgs() (subject, verb string, ok bool) {
 	return
 }
 
+// WhoCanArgs returns the verb and resource to lookup.
+func (c *Interpreter) WhoCanArgs() (verb, res string, ok bool) {
+	if !c.IsWhoCanCmd() {
+		return
+	}
+	tt := whoCanRX.FindStringSubmatch(c.line)
+	if len(tt) < 3 {
+		return
+	}
+	verb, res, ok = tt[1], tt[2], true
+
+	return
+}
+
+// ImpersonateArgs returns the user and comma separated groups to act as.
+// A blank user clears impersonation.
+func (c *Interpreter) ImpersonateArgs() (user string, groups []string, ok bool) {
+	if !c.IsImpersonateCmd() {
+		return
+	}
+	tt := asRX.FindStringSubmatch(c.line)
+	if len(tt) < 3 {
+		return
+	}
+	user, ok = tt[1], true
+	for g := range strings.SplitSeq(tt[2], ",") {
+		if g != "" {
+			groups = append(groups, g)
+		}
+	}
+
+	return
+}
+
+// SplitArgs returns the resource and the [context][/namespace] panes targets.
+// A single target pairs the current context with the given one.
+func (c *Interpreter) SplitArgs() (res string, targets []string, ok bool) {
+	if !c.IsSplitCmd() {
+		return
+	}
+	tt := splitRX.FindStringSubmatch(c.line)
+	if len(tt) < 4 {
+		return
+	}
+	res, targets, ok = tt[1], []string{tt[2]}, true
+	if tt[3] != "" {
+		targets = append(targets, tt[3])
+	}
+
+	return
+}
+
+// FleetArgs returns the resource, the comma separated contexts globs and namespace if any.
+func (c *Interpreter) FleetArgs() (res, contexts, ns string, ok bool) {
+	if !c.IsFleetCmd() {
+		return
+	}
+	tt := splitRX.FindStringSubmatch(c.line)
+	if len(tt) < 4 {
+		return
+	}
+	res, contexts, ns, ok = tt[1], tt[2], tt[3], true
+
+	return
+}
+
+// TabArgs returns the tab operation and its argument if any.
+func (c *Interpreter) TabArgs() (op, arg string, ok bool) {
+	if !c.IsTabCmd() {
+		return
+	}
+	op, arg, _ = strings.Cut(c.Args(), " ")
+
+	return op, strings.TrimSpace(arg), true
+}
+
 // XrayArgs return the gvr and ns if any.
 func (c *Interpreter) XrayArgs() (cmd, namespace string, ok bool) {
 	if !c.IsXrayCmd() {
@@ -413,6 +413,81 @@ func TestDirCmd(t *testing.T) {
 	}
 }
 
+func TestFindCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		term string
+	}{
+		"empty": {},
+
+		"happy": {
+			cmd:  "find fred",
+			ok:   true,
+			term: "fred",
+		},
+
+		"multi": {
+			cmd:  "find app=fred",
+			ok:   true,
+			term: "app=fred",
+		},
+
+		"toast-noterm": {
+			cmd: "find",
+		},
+
+		"toast": {
+			cmd: "finder fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			term, ok := p.FindArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.term, term)
+		})
+	}
+}
+
+func TestShellCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		node string
+	}{
+		"empty": {},
+
+		"happy": {
+			cmd:  "shell n1",
+			ok:   true,
+			node: "n1",
+		},
+
+		"selected": {
+			cmd: "shell",
+			ok:  true,
+		},
+
+		"toast": {
+			cmd: "shells n1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			node, ok := p.ShellArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.node, node)
+		})
+	}
+}
+
 func TestRBACCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd      string
@@ -463,6 +538,250 @@ func TestRBACCmd(t *testing.T) {
 	}
 }
 
+func TestWhoCanCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd       string
+		ok        bool
+		verb, res string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "who-can get",
+		},
+		"toast-1": {
+			cmd: "who-cant get pods",
+		},
+		"happy": {
+			cmd:  "who-can get pods",
+			ok:   true,
+			verb: "get",
+			res:  "pods",
+		},
+		"alias": {
+			cmd:  "whocan  delete  deployments.apps ",
+			ok:   true,
+			verb: "delete",
+			res:  "deployments.apps",
+		},
+		"subresource": {
+			cmd:  "who-can create pods/exec",
+			ok:   true,
+			verb: "create",
+			res:  "pods/exec",
+		},
+		"non-resource": {
+			cmd:  "who-can get /metrics",
+			ok:   true,
+			verb: "get",
+			res:  "/metrics",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			verb, res, ok := p.WhoCanArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.verb, verb)
+				assert.Equal(t, u.res, res)
+			}
+		})
+	}
+}
+
+func TestSplitCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		ok      bool
+		res     string
+		targets []string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "split po",
+		},
+		"toast-1": {
+			cmd: "splits po prod",
+		},
+		"single": {
+			cmd:     "split po staging",
+			ok:      true,
+			res:     "po",
+			targets: []string{"staging"},
+		},
+		"pair": {
+			cmd:     "compare  deploy  prod/default  staging/default ",
+			ok:      true,
+			res:     "deploy",
+			targets: []string{"prod/default", "staging/default"},
+		},
+		"namespace": {
+			cmd:     "split po /kube-system",
+			ok:      true,
+			res:     "po",
+			targets: []string{"/kube-system"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, targets, ok := p.SplitArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.res, res)
+				assert.Equal(t, u.targets, targets)
+			}
+		})
+	}
+}
+
+func TestFleetCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd          string
+		ok           bool
+		res, ctx, ns string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "fleet po",
+		},
+		"toast-1": {
+			cmd: "split po prod,staging",
+		},
+		"contexts": {
+			cmd: "fleet po prod,staging",
+			ok:  true,
+			res: "po",
+			ctx: "prod,staging",
+		},
+		"all": {
+			cmd: "broadcast  deploy  all  kube-system ",
+			ok:  true,
+			res: "deploy",
+			ctx: "all",
+			ns:  "kube-system",
+		},
+		"glob": {
+			cmd: "fleet svc prod-* default",
+			ok:  true,
+			res: "svc",
+			ctx: "prod-*",
+			ns:  "default",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, ctx, ns, ok := p.FleetArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.res, res)
+				assert.Equal(t, u.ctx, ctx)
+				assert.Equal(t, u.ns, ns)
+			}
+		})
+	}
+}
+
+func TestTabCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd     string
+		ok      bool
+		op, arg string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "tabby",
+		},
+		"bare": {
+			cmd: "tab",
+			ok:  true,
+		},
+		"new": {
+			cmd: "tab new po kube-system",
+			ok:  true,
+			op:  "new",
+			arg: "po kube-system",
+		},
+		"close": {
+			cmd: "tabs close",
+			ok:  true,
+			op:  "close",
+		},
+		"index": {
+			cmd: "tab  2 ",
+			ok:  true,
+			op:  "2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			op, arg, ok := p.TabArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.op, op)
+				assert.Equal(t, u.arg, arg)
+			}
+		})
+	}
+}
+
+func TestImpersonateCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok     bool
+		user   string
+		groups []string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "as fred devs extra",
+		},
+		"clear": {
+			cmd: "as",
+			ok:  true,
+		},
+		"user": {
+			cmd:  "as fred",
+			ok:   true,
+			user: "fred",
+		},
+		"sa": {
+			cmd:  "impersonate s:ns1/fred",
+			ok:   true,
+			user: "s:ns1/fred",
+		},
+		"groups": {
+			cmd:    "as  fred  devs,,ops ",
+			ok:     true,
+			user:   "fred",
+			groups: []string{"devs", "ops"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			user, groups, ok := p.ImpersonateArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.user, user)
+				assert.Equal(t, u.groups, groups)
+			}
+		})
+	}
+}
+
 func TestContextCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -541,6 +860,34 @@ func TestHelpCmd(t *testing.T) {
 	}
 }
 
+func TestColumnsCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "columns",
+			ok:  true,
+		},
+		"short": {
+			cmd: "cols",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "col",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.ok, p.IsColumnsCmd())
+		})
+	}
+}
+
 func TestBailCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
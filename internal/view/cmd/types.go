@@ -33,7 +33,10 @@ var (
 		labelFlagIn,
 		labelFlagNotin,
 	}
-	rbacRX = regexp.MustCompile(`^can\s+([ugs]):\s*([\w-:]+)\s*$`)
+	rbacRX   = regexp.MustCompile(`^can\s+([ugs]):\s*([\w-:]+)\s*$`)
+	whoCanRX = regexp.MustCompile(`^\S+\s+([\w*-]+)\s+([\w./*-]+)\s*$`)
+	asRX     = regexp.MustCompile(`^\S+(?:\s+(\S+)(?:\s+(\S+))?)?\s*$`)
+	splitRX  = regexp.MustCompile(`^\S+\s+(\S+)\s+(\S+)(?:\s+(\S+))?\s*$`)
 
 	contextCmd = sets.New(
 		"ctx",
@@ -74,4 +77,47 @@ var (
 		"xr",
 		"xray",
 	)
+	dryRunCmd = sets.New(
+		"dry-run",
+		"dryrun",
+	)
+	undoCmd = sets.New(
+		"undo",
+	)
+	redoCmd = sets.New(
+		"redo",
+	)
+	findCmd = sets.New(
+		"find",
+	)
+	columnsCmd = sets.New(
+		"columns",
+		"cols",
+	)
+	shellCmd = sets.New(
+		"shell",
+	)
+	proxyCmd = sets.New(
+		"proxy",
+	)
+	whoCanCmd = sets.New(
+		"who-can",
+		"whocan",
+	)
+	impersonateCmd = sets.New(
+		"as",
+		"impersonate",
+	)
+	splitCmd = sets.New(
+		"split",
+		"compare",
+	)
+	fleetCmd = sets.New(
+		"fleet",
+		"broadcast",
+	)
+	tabCmd = sets.New(
+		"tab",
+		"tabs",
+	)
 )
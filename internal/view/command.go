@@ -9,9 +9,11 @@ import (
 	"log/slog"
 	"regexp"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
@@ -172,6 +174,109 @@ func (c *Command) xrayCmd(p *cmd.Interpreter, pushCmd bool) error {
 	return c.exec(p, client.XGVR, NewXray(gvr), true, pushCmd)
 }
 
+func (c *Command) findCmd(p *cmd.Interpreter, term string, pushCmd bool) error {
+	if c.app.factory == nil {
+		return fmt.Errorf("no connection available")
+	}
+
+	return c.exec(p, client.FndGVR, NewFind(term), false, pushCmd)
+}
+
+func (c *Command) whoCanCmd(p *cmd.Interpreter) error {
+	verb, res, ok := p.WhoCanArgs()
+	if !ok {
+		return errors.New("invalid command. use `who-can verb resource[/subresource]`")
+	}
+	if c.alias == nil {
+		return fmt.Errorf("no connection available")
+	}
+	q := dao.NewWhoCanQuery(verb, res)
+	if !q.IsNonResource() {
+		if gvr, ok := c.alias.Resolve(cmd.NewInterpreter(q.Resource)); ok {
+			q.Group, q.Resource = gvr.G(), gvr.R()
+		}
+	}
+
+	return c.app.inject(NewWhoCan(q), true)
+}
+
+func (c *Command) splitCmd(p *cmd.Interpreter) error {
+	res, specs, ok := p.SplitArgs()
+	if !ok {
+		return errors.New("invalid command. use `split resource [context][/namespace] [[context][/namespace]]`")
+	}
+	if c.alias == nil {
+		return fmt.Errorf("no connection available")
+	}
+	gvr, ok := c.alias.Resolve(cmd.NewInterpreter(res))
+	if !ok {
+		return fmt.Errorf("`%s` command not found", res)
+	}
+	ct, ns := c.app.Config.ActiveContextName(), c.app.Config.ActiveNamespace()
+	if len(specs) == 1 {
+		specs = append([]string{""}, specs...)
+	}
+	tt := make([]SplitTarget, 0, len(specs))
+	for _, s := range specs {
+		tt = append(tt, NewSplitTarget(s, ct, ns))
+	}
+
+	return c.app.inject(NewSplit(gvr, tt...), false)
+}
+
+func (c *Command) tabCmd(p *cmd.Interpreter) error {
+	op, arg, _ := p.TabArgs()
+	switch op {
+	case "", tabNew:
+		return c.app.newTab(arg)
+	case tabClose:
+		return c.app.closeTab()
+	case tabNext:
+		return c.app.switchTab(c.app.tabs.cycle(1))
+	case tabPrev:
+		return c.app.switchTab(c.app.tabs.cycle(-1))
+	}
+	n, err := strconv.Atoi(op)
+	if err != nil {
+		return errors.New("invalid command. use `tab [new [command]|close|next|prev|N]`")
+	}
+
+	return c.app.switchTab(n - 1)
+}
+
+func (c *Command) fleetCmd(p *cmd.Interpreter) error {
+	res, contexts, ns, ok := p.FleetArgs()
+	if !ok {
+		return errors.New("invalid command. use `fleet resource context1,context2|all [namespace]`")
+	}
+	if c.alias == nil {
+		return fmt.Errorf("no connection available")
+	}
+	gvr, ok := c.alias.Resolve(cmd.NewInterpreter(res))
+	if !ok {
+		return fmt.Errorf("`%s` command not found", res)
+	}
+	if ns == "" {
+		ns = c.app.Config.ActiveNamespace()
+	}
+
+	return c.app.inject(NewFleet(gvr, contexts, ns), false)
+}
+
+// impersonatedUser expands a s:[ns/]name service account shorthand to its user name.
+func (c *Command) impersonatedUser(s string) string {
+	n, ok := strings.CutPrefix(s, "s:")
+	if !ok {
+		return s
+	}
+	ns, name := client.Namespaced(n)
+	if ns == "" {
+		ns = client.CleanseNamespace(c.app.Config.ActiveNamespace())
+	}
+
+	return "system:serviceaccount:" + ns + ":" + name
+}
+
 // Run execs the command by showing associated display.
 func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack, pushCmd bool) error {
 	if c.specialCmd(p, pushCmd) {
@@ -287,12 +392,53 @@ func (c *Command) specialCmd(p *cmd.Interpreter, pushCmd bool) bool {
 		if err := c.xrayCmd(p, pushCmd); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsDryRunCmd():
+		c.app.dryRunCmd()
+	case p.IsProxyCmd():
+		c.app.proxyCmd()
+	case p.IsFindCmd():
+		if term, ok := p.FindArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `find xxx`")
+		} else if err := c.findCmd(p, term, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsUndoCmd():
+		c.app.undoCmd()
+	case p.IsRedoCmd():
+		c.app.redoCmd()
+	case p.IsColumnsCmd():
+		c.app.columnsCmd()
+	case p.IsShellCmd():
+		node, _ := p.ShellArg()
+		c.app.nodeShellCmd(node)
 	case p.IsRBACCmd():
 		if cat, sub, ok := p.RBACArgs(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `can [u|g|s]:xxx`")
 		} else if err := c.app.inject(NewPolicy(c.app, cat, sub), true); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsImpersonateCmd():
+		if user, groups, ok := p.ImpersonateArgs(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `as [user|s:ns/name] [group1,group2]`")
+		} else {
+			c.app.impersonateCmd(c.impersonatedUser(user), groups)
+		}
+	case p.IsWhoCanCmd():
+		if err := c.whoCanCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsSplitCmd():
+		if err := c.splitCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsFleetCmd():
+		if err := c.fleetCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsTabCmd():
+		if err := c.tabCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsContextCmd():
 		if err := c.contextCmd(p, pushCmd); err != nil {
 			c.app.Flash().Err(err)
@@ -378,6 +524,7 @@ func (c *Command) exec(p *cmd.Interpreter, gvr *client.GVR, comp model.Component
 	if err := c.app.inject(comp, clearStack); err != nil {
 		return err
 	}
+	c.app.audit(audit.ViewAction, gvr, p.GetLine())
 	if pushCmd {
 		c.app.cmdHistory.Push(p.GetLine())
 	}
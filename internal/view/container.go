@@ -29,7 +29,7 @@ type Container struct {
 // NewContainer returns a new container view.
 func NewContainer(gvr *client.GVR) ResourceViewer {
 	c := Container{}
-	c.ResourceViewer = NewLogsExtender(NewBrowser(gvr), c.logOptions)
+	c.ResourceViewer = NewRunExtender(NewLogsExtender(NewBrowser(gvr), c.logOptions), c.execTargets)
 	c.SetEnvFn(c.k9sEnv)
 	c.GetTable().SetEnterFn(c.viewLogs)
 	c.GetTable().SetDecorateFn(c.decorateRows)
@@ -77,6 +77,13 @@ func (c *Container) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyB: ui.NewKeyActionWithOpts(
+			"Browse Files",
+			c.browseFilesCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
@@ -90,6 +97,7 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true),
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Logs Diff", c.logsDiffCmd, true),
 	})
 }
 
@@ -102,6 +110,16 @@ func (c *Container) k9sEnv() Env {
 	return env
 }
 
+func (c *Container) execTargets() []dao.ExecTarget {
+	cc := c.GetTable().GetSelectedItems()
+	tt := make([]dao.ExecTarget, 0, len(cc))
+	for _, co := range cc {
+		tt = append(tt, dao.ExecTarget{Path: c.GetTable().Path, Container: co})
+	}
+
+	return tt
+}
+
 func (c *Container) logOptions(prev bool) (*dao.LogOptions, error) {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -128,6 +146,18 @@ func (c *Container) viewLogs(*App, ui.Tabular, *client.GVR, string) {
 
 // Handlers...
 
+func (c *Container) logsDiffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	if err := c.App().inject(NewLogDiff(c.App(), c.GetTable().Path, co), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *Container) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -180,6 +210,18 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) browseFilesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+	if err := browseFiles(c.App(), c.GetTable().Path, co); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *Container) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
@@ -79,12 +80,29 @@ func jobCtx(fqn, uid string) ContextFunc {
 }
 
 func (c *CronJob) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftH, ui.NewKeyAction("History", c.historyCmd, true))
+	if c.App().Config.IsReadOnly() {
+		return
+	}
 	aa.Bulk(ui.KeyMap{
 		ui.KeyT: ui.NewKeyAction("Trigger", c.triggerCmd, true),
 		ui.KeyS: ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
 	})
 }
 
+func (c *CronJob) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(newJobRunsFor(path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *CronJob) triggerCmd(evt *tcell.EventKey) *tcell.EventKey {
 	fqns := c.GetTable().GetSelectedItems()
 	if len(fqns) == 0 {
@@ -111,7 +129,8 @@ func (c *CronJob) triggerCmd(evt *tcell.EventKey) *tcell.EventKey {
 			if err := runner.Run(fqn); err != nil {
 				c.App().Flash().Errf("CronJob trigger failed for %s: %v", fqn, err)
 			} else {
-				c.App().Flash().Infof("Triggered Job %s %s", c.GVR(), fqn)
+				c.App().audit(audit.TriggerAction, c.GVR(), fqn)
+				c.App().Flash().Info(dryRunMsg(fmt.Sprintf("Triggered Job %s %s", c.GVR(), fqn)))
 			}
 		}
 	}, func() {})
@@ -170,5 +189,11 @@ func (c *CronJob) showSuspendDialog(cell *tview.TableCell, sel string) {
 			c.App().Flash().Errf("Cronjob %s failed for %v", strings.ToLower(title), err)
 			return
 		}
+		action, state := audit.SuspendAction, "suspended"
+		if title == "Resume" {
+			action, state = audit.ResumeAction, "resumed"
+		}
+		c.App().audit(action, c.GVR(), sel)
+		c.App().Flash().Info(dryRunMsg(fmt.Sprintf("CronJob %s %s", sel, state)))
 	}, func() {})
 }
@@ -24,13 +24,20 @@ type Deploy struct {
 // NewDeploy returns a new deployment view.
 func NewDeploy(gvr *client.GVR) ResourceViewer {
 	var d Deploy
-	d.ResourceViewer = NewPortForwardExtender(
-		NewVulnerabilityExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewImageExtender(
-						NewOwnerExtender(
-							NewLogsExtender(NewBrowser(gvr), d.logOptions),
+	d.ResourceViewer = NewRolloutExtender(
+		NewTimelineExtender(
+			NewPortForwardExtender(
+				NewVulnerabilityExtender(
+					NewRestartExtender(
+						NewScaleExtender(
+							NewImageExtender(
+								NewOwnerExtender(
+									NewLogGrepExtender(
+										NewLogsExtender(NewBrowser(gvr), d.logOptions),
+										d.selector,
+									),
+								),
+							),
 						),
 					),
 				),
@@ -47,6 +54,37 @@ func (d *Deploy) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyZ: ui.NewKeyAction("ReplicaSets", d.replicaSetsCmd, true),
 	})
+	if d.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftZ: ui.NewKeyActionWithOpts("Pause Rollout", d.pauseCmd(true),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftU: ui.NewKeyActionWithOpts("Resume Rollout", d.pauseCmd(false),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+	})
+}
+
+func (d *Deploy) pauseCmd(paused bool) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		paths := d.GetTable().GetSelectedItems()
+		if len(paths) == 0 || paths[0] == "" {
+			return evt
+		}
+		tt := make([]rolloutTarget, 0, len(paths))
+		for _, path := range paths {
+			tt = append(tt, rolloutTarget{gvr: client.DpGVR, path: path})
+		}
+		pauseRollouts(d.App(), tt, paused, d.Refresh)
+
+		return nil
+	}
 }
 
 func (d *Deploy) logOptions(prev bool) (*dao.LogOptions, error) {
@@ -86,6 +124,15 @@ func (d *Deploy) showPods(app *App, _ ui.Tabular, _ *client.GVR, fqn string) {
 	showPodsFromSelector(app, fqn, dp.Spec.Selector)
 }
 
+func (d *Deploy) selector(fqn string) (*metav1.LabelSelector, error) {
+	dp, err := d.getInstance(fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	return dp.Spec.Selector, nil
+}
+
 func (d *Deploy) getInstance(fqn string) (*appsv1.Deployment, error) {
 	var dp dao.Deployment
 	dp.Init(d.App().factory, d.GVR())
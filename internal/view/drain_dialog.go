@@ -61,12 +61,22 @@ func ShowDrain(view ResourceViewer, sels []string, opts dao.DrainOptions, okFn D
 	f.AddCheckbox("Disable Eviction:", opts.DisableEviction, func(_ string, v bool) {
 		opts.DisableEviction = v
 	})
+	guard, override := disruptionsGuard(view.App(), view.GVR(), sels), false
+	if guard != "" {
+		f.AddCheckbox("Override:", override, func(_ string, v bool) {
+			override = v
+		})
+	}
 
 	pages := view.App().Content.Pages
 	f.AddButton("Cancel", func() {
 		DismissDrain(view, pages)
 	})
 	f.AddButton("OK", func() {
+		if guard != "" && !override {
+			view.App().Flash().Warn("Drain blocked by disruption budgets. Check Override to proceed")
+			return
+		}
 		DismissDrain(view, pages)
 		okFn(view, sels, opts)
 	})
@@ -79,6 +89,9 @@ func ShowDrain(view ResourceViewer, sels []string, opts dao.DrainOptions, okFn D
 		path += fmt.Sprintf("(%d) nodes", len(sels))
 	}
 	path += "?"
+	if guard != "" {
+		path += "\n\n" + guard + "\nCheck Override to proceed anyway."
+	}
 	modal.SetText(path)
 	modal.SetDoneFunc(func(int, string) {
 		DismissDrain(view, pages)
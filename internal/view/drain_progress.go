@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	drainRunning   = "draining"
+	drainCanceled  = "canceled"
+	drainCompleted = "completed"
+)
+
+// drainTracker tracks nodes drain progress.
+type drainTracker struct {
+	nodes  []string
+	pods   map[string][]string
+	states map[string]dao.DrainEvent
+	errs   map[string]error
+	status string
+	dryRun bool
+	mx     sync.RWMutex
+}
+
+func newDrainTracker(nodes []string) *drainTracker {
+	return &drainTracker{
+		nodes:  nodes,
+		pods:   make(map[string][]string, len(nodes)),
+		states: make(map[string]dao.DrainEvent),
+		errs:   make(map[string]error),
+		status: drainRunning,
+		dryRun: dao.IsDryRun(),
+	}
+}
+
+func (t *drainTracker) update(evt dao.DrainEvent) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if _, ok := t.states[evt.Pod]; !ok {
+		t.pods[evt.Node] = append(t.pods[evt.Node], evt.Pod)
+	}
+	t.states[evt.Pod] = evt
+}
+
+func (t *drainTracker) nodeDone(node string, err error) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.errs[node] = err
+}
+
+func (t *drainTracker) finish(canceled bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.status = drainCompleted
+	if canceled {
+		t.status = drainCanceled
+	}
+}
+
+// render returns the drain progress as yaml.
+func (t *drainTracker) render() string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %s\n", t.status)
+	if t.dryRun {
+		b.WriteString("dryRun: true\n")
+	}
+	b.WriteString("nodes:\n")
+	for _, n := range t.nodes {
+		fmt.Fprintf(&b, "- name: %s\n", n)
+		err, done := t.errs[n]
+		switch {
+		case err != nil:
+			fmt.Fprintf(&b, "  status: failed\n  error: %q\n", err.Error())
+		case done:
+			b.WriteString("  status: drained\n")
+		default:
+			b.WriteString("  status: " + t.status + "\n")
+		}
+		var evicted int
+		var remaining []dao.DrainEvent
+		for _, p := range t.pods[n] {
+			if evt := t.states[p]; evt.State == dao.DrainEvicted {
+				evicted++
+			} else {
+				remaining = append(remaining, evt)
+			}
+		}
+		fmt.Fprintf(&b, "  evicted: %d\n", evicted)
+		if len(remaining) == 0 {
+			b.WriteString("  remaining: []\n")
+			continue
+		}
+		b.WriteString("  remaining:\n")
+		for _, evt := range remaining {
+			fmt.Fprintf(&b, "  - pod: %s\n    state: %s\n", evt.Pod, evt.State)
+			if evt.Err != nil {
+				fmt.Fprintf(&b, "    error: %q\n", evt.Err.Error())
+			}
+		}
+	}
+
+	return b.String()
+}
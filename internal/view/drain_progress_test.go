@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainTrackerRender(t *testing.T) {
+	tr := newDrainTracker([]string{"n1", "n2"})
+	tr.update(dao.DrainEvent{Node: "n1", Pod: "default/p1", State: dao.DrainPending})
+	tr.update(dao.DrainEvent{Node: "n1", Pod: "default/p2", State: dao.DrainPending})
+	tr.update(dao.DrainEvent{Node: "n1", Pod: "default/p1", State: dao.DrainEvicted})
+	tr.update(dao.DrainEvent{Node: "n1", Pod: "default/p2", State: dao.DrainFailed, Err: errors.New("blee")})
+
+	assert.Equal(t, `status: draining
+nodes:
+- name: n1
+  status: draining
+  evicted: 1
+  remaining:
+  - pod: default/p2
+    state: failed
+    error: "blee"
+- name: n2
+  status: draining
+  evicted: 0
+  remaining: []
+`, tr.render())
+
+	tr.nodeDone("n1", errors.New("zorg"))
+	tr.nodeDone("n2", nil)
+	tr.finish(true)
+	assert.Equal(t, `status: canceled
+nodes:
+- name: n1
+  status: failed
+  error: "zorg"
+  evicted: 1
+  remaining:
+  - pod: default/p2
+    state: failed
+    error: "blee"
+- name: n2
+  status: drained
+  evicted: 0
+  remaining: []
+`, tr.render())
+}
+
+func TestDrainTrackerRenderDryRun(t *testing.T) {
+	dao.SetDryRun(true)
+	defer dao.SetDryRun(false)
+
+	tr := newDrainTracker([]string{"n1"})
+	tr.nodeDone("n1", nil)
+	tr.finish(false)
+	assert.Equal(t, `status: completed
+dryRun: true
+nodes:
+- name: n1
+  status: drained
+  evicted: 0
+  remaining: []
+`, tr.render())
+}
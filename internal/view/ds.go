@@ -20,12 +20,16 @@ type DaemonSet struct {
 // NewDaemonSet returns a new viewer.
 func NewDaemonSet(gvr *client.GVR) ResourceViewer {
 	var d DaemonSet
-	d.ResourceViewer = NewPortForwardExtender(
-		NewVulnerabilityExtender(
-			NewRestartExtender(
-				NewImageExtender(
-					NewOwnerExtender(
-						NewLogsExtender(NewBrowser(gvr), d.logOptions),
+	d.ResourceViewer = NewRolloutExtender(
+		NewTimelineExtender(
+			NewPortForwardExtender(
+				NewVulnerabilityExtender(
+					NewRestartExtender(
+						NewImageExtender(
+							NewOwnerExtender(
+								NewLogsExtender(NewBrowser(gvr), d.logOptions),
+							),
+						),
 					),
 				),
 			),
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const editPreviewTitle = "Edit Preview"
+
+// manifestEdit tracks a resource manifest edit session.
+type manifestEdit struct {
+	app  *App
+	gvr  *client.GVR
+	path string
+	live *unstructured.Unstructured
+	raw  []byte
+}
+
+// editManifest edits a resource manifest locally then previews the changes versus
+// the live state along with the schema validation results prior to applying them.
+func editManifest(app *App, gvr *client.GVR, path string) error {
+	live, err := dao.FetchManifest(context.Background(), app.Conn(), gvr, path)
+	if err != nil {
+		return err
+	}
+	raw, err := dao.EditableManifest(live)
+	if err != nil {
+		return err
+	}
+	e := manifestEdit{app: app, gvr: gvr, path: path, live: live, raw: raw}
+
+	return e.edit(raw)
+}
+
+// edit launches the editor on a given manifest and previews the outcome.
+func (e *manifestEdit) edit(raw []byte) error {
+	f, err := os.CreateTemp("", "k9s-edit-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(raw); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if !edit(e.app, &shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil
+	}
+	edited, err := os.ReadFile(f.Name())
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(edited, e.raw) {
+		e.app.Flash().Info("Edit cancelled, no changes made")
+		return nil
+	}
+
+	return e.preview(edited)
+}
+
+// preview shows the edited manifest changes and validation results.
+func (e *manifestEdit) preview(edited []byte) error {
+	o, verr := dao.ParseManifest(edited)
+	if verr == nil {
+		verr = dao.ValidateManifest(e.app.Conn(), edited)
+	}
+	var changes dao.ManifestChanges
+	if o != nil {
+		changes = dao.DiffManifests(e.live, o)
+	}
+
+	details := NewDetails(e.app, editPreviewTitle, e.path, contentTXT, true).Update(editReport(dao.FieldManagers(e.live), changes, verr))
+	if err := e.app.inject(details, false); err != nil {
+		return err
+	}
+	details.Actions().Add(ui.KeyE, ui.NewKeyAction("Edit", func(*tcell.EventKey) *tcell.EventKey {
+		e.app.Content.Pop()
+		if err := e.edit(edited); err != nil {
+			e.app.Flash().Err(err)
+		}
+		return nil
+	}, true))
+	if verr == nil && len(changes) > 0 {
+		details.Actions().Bulk(ui.KeyMap{
+			ui.KeyA: ui.NewKeyAction("Apply", func(*tcell.EventKey) *tcell.EventKey {
+				e.apply(o)
+				return nil
+			}, true),
+			ui.KeyShiftA: ui.NewKeyAction("Server Apply", func(*tcell.EventKey) *tcell.EventKey {
+				e.serverApply(o, false)
+				return nil
+			}, true),
+		})
+	}
+	e.app.Menu().HydrateMenu(details.Hints())
+
+	return nil
+}
+
+func (e *manifestEdit) apply(o *unstructured.Unstructured) {
+	if err := dao.ApplyManifest(context.Background(), e.app.Conn(), e.gvr, e.path, e.live, o); err != nil {
+		e.app.Flash().Errf("Edit failed: %s", err)
+		return
+	}
+	e.app.audit(audit.EditAction, e.gvr, e.path)
+	e.app.Content.Pop()
+	e.app.Flash().Infof("%s edited", e.path)
+}
+
+// serverApply server side applies the edited manifest and offers to force
+// the apply when other field managers own the changed fields.
+func (e *manifestEdit) serverApply(o *unstructured.Unstructured, force bool) {
+	err := dao.ServerApply(context.Background(), e.app.Conn(), e.gvr, e.path, e.live, o, force)
+	var cc dao.ApplyConflicts
+	if errors.As(err, &cc) {
+		msg := fmt.Sprintf("%s.\nForce apply and take over these fields ownership?", cc.Error())
+		d := e.app.Styles.Dialog()
+		dialog.ShowConfirm(&d, e.app.Content.Pages, "Apply Conflicts", msg, func() {
+			e.serverApply(o, true)
+		}, func() {})
+		return
+	}
+	if err != nil {
+		e.app.Flash().Errf("Server apply failed: %s", err)
+		return
+	}
+	e.app.audit(audit.EditAction, e.gvr, e.path)
+	e.app.Content.Pop()
+	if force {
+		e.app.Flash().Warnf("%s force applied as %s", e.path, dao.ApplyFieldManager)
+		return
+	}
+	e.app.Flash().Infof("%s applied as %s", e.path, dao.ApplyFieldManager)
+}
+
+// editReport renders the validation results followed by the changes.
+func editReport(managers []string, changes dao.ManifestChanges, err error) string {
+	var b strings.Builder
+	switch {
+	case err != nil:
+		b.WriteString("# Validation failed. Press `e` to fix the manifest\n")
+		for l := range strings.SplitSeq(err.Error(), "\n") {
+			fmt.Fprintf(&b, "#   %s\n", strings.TrimSpace(l))
+		}
+	case len(changes) == 0:
+		b.WriteString("# Manifest is valid but has no changes\n")
+	default:
+		b.WriteString("# Manifest is valid. Press `a` to update or `A` to server side apply the changes\n")
+	}
+	if len(managers) > 0 {
+		fmt.Fprintf(&b, "# Field managers: %s\n", strings.Join(managers, ", "))
+	}
+	if len(changes) > 0 {
+		fmt.Fprintf(&b, "\n# %d changes versus live state\n%s\n", len(changes), changes)
+	}
+
+	return b.String()
+}
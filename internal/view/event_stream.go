@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EventStream presents a deduplicated cluster wide events viewer.
+type EventStream struct {
+	ResourceViewer
+
+	filter dao.StreamFilter
+}
+
+// NewEventStream returns a new viewer.
+func NewEventStream(gvr *client.GVR) ResourceViewer {
+	e := EventStream{
+		ResourceViewer: NewBrowser(gvr),
+		filter:         dao.StreamAll,
+	}
+	e.GetTable().SetSortCol("LAST SEEN", false)
+	e.GetTable().SetEnterFn(e.showObject)
+	e.AddBindKeysFn(e.bindKeys)
+	e.SetContextFn(e.streamContext)
+
+	return &e
+}
+
+// Init initializes the view.
+func (e *EventStream) Init(ctx context.Context) error {
+	if err := e.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	e.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (e *EventStream) streamContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyStreamFilter, e.filter)
+}
+
+func (e *EventStream) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyCtrlZ: ui.NewKeyAction("Cycle Severity", e.cycleFilterCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Watch Reason", e.watchCmd, true),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", false), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
+		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftL:   ui.NewKeyAction("Sort Last Seen", e.GetTable().SortColCmd("LAST SEEN", false), false),
+	})
+}
+
+func (e *EventStream) cycleFilterCmd(*tcell.EventKey) *tcell.EventKey {
+	e.filter = e.filter.Next()
+	switch e.filter {
+	case dao.StreamWarnings:
+		e.App().Flash().Info("Showing warning events only")
+	case dao.StreamWatched:
+		e.App().Flash().Infof("Showing watched reasons only %v", dao.EventWatch().Reasons())
+	default:
+		e.App().Flash().Info("Showing all events")
+	}
+	e.Start()
+
+	return nil
+}
+
+func (e *EventStream) watchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ref, ok := dao.ParseEventStreamID(e.GetTable().GetSelectedItem())
+	if !ok {
+		return evt
+	}
+	if dao.EventWatch().Toggle(ref.Reason) {
+		e.App().Flash().Infof("Watching event reason %s", ref.Reason)
+	} else {
+		e.App().Flash().Infof("Unwatched event reason %s", ref.Reason)
+	}
+	e.Refresh()
+
+	return nil
+}
+
+// showObject navigates to the resource an event is about.
+func (*EventStream) showObject(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	ref, ok := dao.ParseEventStreamID(path)
+	if !ok {
+		return
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	gvr, namespaced, found := dao.MetaAccess.GVK2GVR(gv, ref.Kind)
+	if !found {
+		app.Flash().Err(fmt.Errorf("unsupported GVK: %s/%s", ref.APIVersion, ref.Kind))
+		return
+	}
+	fqn := ref.Name
+	if namespaced {
+		fqn = client.FQN(ref.Namespace, ref.Name)
+	}
+	app.gotoResource(gvr.String(), fqn, false, true)
+}
@@ -17,6 +17,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
@@ -48,6 +49,7 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	record            string
 }
 
 func (s shellOpts) String() string {
@@ -62,23 +64,8 @@ func runK(a *App, opts *shellOpts) error {
 	if err != nil {
 		return fmt.Errorf("kubectl command is not in your path: %w", err)
 	}
-	args := []string{opts.args[0]}
-	if u, err := a.Conn().Config().ImpersonateUser(); err == nil {
-		args = append(args, "--as", u)
-	}
-	if g, err := a.Conn().Config().ImpersonateGroups(); err == nil {
-		args = append(args, "--as-group", g)
-	}
-	if isInsecure := a.Conn().Config().Flags().Insecure; isInsecure != nil && *isInsecure {
-		args = append(args, "--insecure-skip-tls-verify")
-	}
-	args = append(args, "--context", a.Config.K9s.ActiveContextName())
-	if cfg := a.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
-		args = append(args, "--kubeconfig", *cfg)
-	}
-	if len(args) > 0 {
-		opts.args = append(args, opts.args[1:]...)
-	}
+	args := append([]string{opts.args[0]}, kubectlFlags(a)...)
+	opts.args = append(args, opts.args[1:]...)
 	opts.binary = bin
 
 	suspended, errChan, stChan := run(a, opts)
@@ -96,6 +83,26 @@ func runK(a *App, opts *shellOpts) error {
 	return errs
 }
 
+// kubectlFlags returns the kubectl flags matching the current connection.
+func kubectlFlags(a *App) []string {
+	var args []string
+	if u, err := a.Conn().Config().ImpersonateUser(); err == nil {
+		args = append(args, "--as", u)
+	}
+	if g, err := a.Conn().Config().ImpersonateGroups(); err == nil {
+		args = append(args, "--as-group", g)
+	}
+	if isInsecure := a.Conn().Config().Flags().Insecure; isInsecure != nil && *isInsecure {
+		args = append(args, "--insecure-skip-tls-verify")
+	}
+	args = append(args, "--context", a.Config.K9s.ActiveContextName())
+	if cfg := a.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
+		args = append(args, "--kubeconfig", *cfg)
+	}
+
+	return args
+}
+
 func run(a *App, opts *shellOpts) (ok bool, errC chan error, outC chan string) {
 	errChan := make(chan error, 1)
 	statusChan := make(chan string, 1)
@@ -291,6 +298,9 @@ func clearScreen() {
 	fmt.Print("\033[H\033[2J")
 }
 
+// nsenterArgs enters the node host namespaces via its init process.
+var nsenterArgs = []string{"nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "--"}
+
 const (
 	k9sShell           = "k9s-shell"
 	k9sShellRetryCount = 50
@@ -309,6 +319,7 @@ func launchNodeShell(v model.Igniter, a *App, node string) {
 		err := launchShellPod(ctx, a, node)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) {
+				a.auditResult(audit.ExecAction, client.NodeGVR, node, err)
 				a.Flash().Errf("Launching node shell failed: %s", err)
 			}
 			return
@@ -358,19 +369,28 @@ func sshIn(a *App, fqn, co string) error {
 		args = append(args, cfg.Command...)
 		args = append(args, cfg.Args...)
 	} else {
-		if platform == windowsOS {
+		switch {
+		case platform == windowsOS:
 			args = append(args, "--", "cmd", "/c", winShellCheck)
+		case cfg.NSEnter:
+			args = append(args, nsenterArgs...)
 		}
 		args = append(args, "sh", "-c", shellCheck)
 	}
 	slog.Debug("Running command with args", slogs.Args, args)
 
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
-	err = runK(a, &shellOpts{
+	opts := shellOpts{
 		clear:  true,
 		banner: c.Sprintf(bannerFmt, fqn, co),
-		args:   args},
-	)
+		args:   args,
+	}
+	recordSession(a, &opts, "node-"+fqn)
+	if opts.record != "" && len(cfg.Command) == 0 && platform != windowsOS {
+		opts.args[len(opts.args)-1] = sizedShellCheck()
+	}
+	err = runK(a, &opts)
+	a.auditResult(audit.ExecAction, client.PodGVR, fqn, err)
 	if err != nil {
 		return fmt.Errorf("shell exec failed: %w", err)
 	}
@@ -459,7 +479,7 @@ func k9sShellPodName() string {
 
 func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 	var grace int64
-	var priv = true
+	var priv = cfg.IsPrivileged()
 
 	slog.Debug("Shell pod config", slogs.ShellPodCfg, cfg)
 	c := v1.Container{
@@ -470,7 +490,7 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			{
 				Name:      "root-vol",
 				MountPath: "/host",
-				ReadOnly:  true,
+				ReadOnly:  cfg.Profile != config.ShellProfileSysadmin,
 			},
 		},
 		Resources: asResource(cfg.Limits),
@@ -490,6 +510,11 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			},
 		},
 	}
+	if cfg.Profile == config.ShellProfileNetadmin {
+		c.SecurityContext.Capabilities = &v1.Capabilities{
+			Add: []v1.Capability{"NET_ADMIN", "NET_RAW"},
+		}
+	}
 	if len(cfg.Command) != 0 {
 		c.Command = cfg.Command
 	}
@@ -524,6 +549,7 @@ func k9sShellPod(node string, cfg *config.ShellPod) *v1.Pod {
 			RestartPolicy:                 v1.RestartPolicyNever,
 			HostPID:                       true,
 			HostNetwork:                   true,
+			HostIPC:                       cfg.Profile != "" && cfg.Profile != config.ShellProfileLegacy,
 			ImagePullSecrets:              cfg.ImagePullSecrets,
 			TerminationGracePeriodSeconds: &grace,
 			Volumes:                       v,
@@ -571,7 +597,9 @@ func pipe(_ context.Context, opts *shellOpts, statusChan chan<- string, w, e *by
 			}()
 			return nil
 		}
-		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		out, done := recordedOutput(opts, os.Stdout)
+		defer done()
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, out, out
 		_, _ = cmd.Stdout.Write([]byte(opts.banner))
 
 		slog.Debug("Exec started")
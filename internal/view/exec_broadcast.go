@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	broadcastTitle    = "Broadcast"
+	broadcastPaneFmt  = " [aqua::b]%s[-::-] "
+	broadcastPrompt   = "> "
+	broadcastMaxLines = 1_000
+)
+
+// broadcastPane tracks a pod shell session.
+type broadcastPane struct {
+	path  string
+	view  *tview.TextView
+	out   io.Writer
+	msg   io.Writer
+	stdin io.WriteCloser
+}
+
+// paneWriter serializes pane updates onto the ui thread.
+type paneWriter struct {
+	app    *App
+	w      io.Writer
+	escape bool
+}
+
+func (p paneWriter) Write(bb []byte) (int, error) {
+	cp := make([]byte, len(bb))
+	copy(cp, bb)
+	if p.escape {
+		cp = []byte(tview.Escape(string(cp)))
+	}
+	p.app.QueueUpdateDraw(func() {
+		_, _ = p.w.Write(cp)
+	})
+
+	return len(bb), nil
+}
+
+// BroadcastExec sends commands typed once to shells in several pods.
+type BroadcastExec struct {
+	*tview.Flex
+
+	app      *App
+	paths    []string
+	grid     *tview.Grid
+	input    *tview.InputField
+	panes    []*broadcastPane
+	actions  *ui.KeyActions
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+var _ model.Component = (*BroadcastExec)(nil)
+
+// NewBroadcastExec returns a new broadcast exec viewer.
+func NewBroadcastExec(app *App, paths []string) *BroadcastExec {
+	return &BroadcastExec{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		app:     app,
+		paths:   paths,
+		grid:    tview.NewGrid(),
+		input:   tview.NewInputField(),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*BroadcastExec) SetCommand(*cmd.Interpreter)            {}
+func (*BroadcastExec) SetFilter(string, bool)                 {}
+func (*BroadcastExec) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (b *BroadcastExec) Init(context.Context) error {
+	rows, cols := broadcastLayout(len(b.paths))
+	b.grid.SetRows(make([]int, rows)...)
+	b.grid.SetColumns(make([]int, cols)...)
+	for i, path := range b.paths {
+		v := tview.NewTextView()
+		v.SetDynamicColors(true)
+		v.SetScrollable(true)
+		v.SetMaxLines(broadcastMaxLines)
+		v.SetBorder(true)
+		v.SetBorderPadding(0, 0, 1, 1)
+		_, po := client.Namespaced(path)
+		v.SetTitle(fmt.Sprintf(broadcastPaneFmt, po))
+		b.grid.AddItem(v, i/cols, i%cols, 1, 1, 0, 0, false)
+		b.panes = append(b.panes, &broadcastPane{path: path, view: v})
+	}
+
+	b.input.SetLabel(broadcastPrompt)
+	b.input.SetBorder(true)
+	b.input.SetBorderPadding(0, 0, 1, 1)
+	b.input.SetTitle(fmt.Sprintf(" %s([hilite:bg:b]%d pods[fg:bg:-]) ", broadcastTitle, len(b.paths)))
+	b.input.SetInputCapture(b.keyboard)
+
+	b.AddItem(b.grid, 0, 1, false)
+	b.AddItem(b.input, 3, 0, true)
+
+	b.bindKeys()
+	b.StylesChanged(b.app.Styles)
+	b.app.Styles.AddListener(b)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*BroadcastExec) InCmdMode() bool {
+	return false
+}
+
+// CapturesInput routes all keystrokes to the broadcast prompt.
+func (*BroadcastExec) CapturesInput() bool {
+	return true
+}
+
+// Name returns the component name.
+func (*BroadcastExec) Name() string { return broadcastTitle }
+
+// Start launches the pods shells.
+func (b *BroadcastExec) Start() {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	var ctx context.Context
+	ctx, b.cancelFn = context.WithCancel(context.Background())
+	for _, p := range b.panes {
+		p.out = paneWriter{
+			app:    b.app,
+			w:      tview.ANSIWriter(p.view, b.app.Styles.Views().Log.FgColor.String(), b.app.Styles.Views().Log.BgColor.String()),
+			escape: true,
+		}
+		p.msg = paneWriter{app: b.app, w: p.view}
+		if err := b.launch(ctx, p); err != nil {
+			fmt.Fprintf(p.msg, "[red::b]Exec failed: %s[-::-]\n", tview.Escape(err.Error()))
+		}
+	}
+}
+
+// Stop terminates the pods shells.
+func (b *BroadcastExec) Stop() {
+	b.mx.Lock()
+	if b.cancelFn != nil {
+		b.cancelFn()
+		b.cancelFn = nil
+	}
+	for _, p := range b.panes {
+		if p.stdin != nil {
+			_ = p.stdin.Close()
+			p.stdin = nil
+		}
+	}
+	b.mx.Unlock()
+	b.app.Styles.RemoveListener(b)
+}
+
+// Hints returns menu hints.
+func (b *BroadcastExec) Hints() model.MenuHints {
+	return b.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*BroadcastExec) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (b *BroadcastExec) StylesChanged(s *config.Styles) {
+	b.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	b.grid.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	b.input.SetBackgroundColor(s.BgColor())
+	b.input.SetFieldBackgroundColor(s.BgColor())
+	b.input.SetFieldTextColor(s.FgColor())
+	b.input.SetLabelColor(s.Frame().Crumb.ActiveColor.Color())
+	b.input.SetBorderColor(s.Frame().Border.FocusColor.Color())
+	for _, p := range b.panes {
+		p.view.SetBackgroundColor(s.Views().Log.BgColor.Color())
+		p.view.SetTextColor(s.Views().Log.FgColor.Color())
+		p.view.SetBorderColor(s.Frame().Border.FgColor.Color())
+	}
+}
+
+func (b *BroadcastExec) bindKeys() {
+	b.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", b.app.PrevCmd, true),
+		tcell.KeyEnter:  ui.NewKeyAction("Broadcast", b.sendCmd, true),
+		tcell.KeyCtrlL:  ui.NewKeyAction("Clear", b.clearCmd, true),
+	})
+}
+
+func (b *BroadcastExec) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := b.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (b *BroadcastExec) launch(ctx context.Context, p *broadcastPane) error {
+	bin, err := exec.LookPath("kubectl")
+	if errors.Is(err, exec.ErrDot) {
+		return fmt.Errorf("kubectl command must not be in the current working directory: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("kubectl command is not in your path: %w", err)
+	}
+	args := broadcastArgs(p.path, kubectlFlags(b.app))
+	slog.Debug("Broadcast exec", slogs.Bin, bin, slogs.Args, strings.Join(args, " "))
+
+	c := exec.CommandContext(ctx, bin, args...)
+	c.Stdout, c.Stderr = p.out, p.out
+	if p.stdin, err = c.StdinPipe(); err != nil {
+		return err
+	}
+	if err := c.Start(); err != nil {
+		return err
+	}
+	b.app.audit(audit.ExecAction, client.PodGVR, p.path)
+	go func() {
+		err := c.Wait()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			fmt.Fprintf(p.msg, "\n[red::b]Session terminated: %s[-::-]\n", tview.Escape(err.Error()))
+			return
+		}
+		fmt.Fprint(p.msg, "\n[orange::b]Session terminated[-::-]\n")
+	}()
+
+	return nil
+}
+
+// sendCmd writes the prompt command to all pods shells.
+func (b *BroadcastExec) sendCmd(*tcell.EventKey) *tcell.EventKey {
+	line := b.input.GetText()
+	if strings.TrimSpace(line) == "" {
+		return nil
+	}
+	b.input.SetText("")
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	for _, p := range b.panes {
+		if p.stdin == nil {
+			continue
+		}
+		fmt.Fprintf(p.msg, "[aqua::b]%s%s[-::-]\n", broadcastPrompt, tview.Escape(line))
+		if _, err := io.WriteString(p.stdin, line+"\n"); err != nil {
+			slog.Warn("Broadcast write failed", slogs.Path, p.path, slogs.Error, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *BroadcastExec) clearCmd(*tcell.EventKey) *tcell.EventKey {
+	for _, p := range b.panes {
+		p.view.Clear()
+	}
+
+	return nil
+}
+
+// broadcastArgs returns the kubectl args to start a non interactive pod shell.
+func broadcastArgs(path string, flags []string) []string {
+	ns, po := client.Namespaced(path)
+	args := append([]string{"exec", "-i"}, flags...)
+	if ns != client.BlankNamespace {
+		args = append(args, "-n", ns)
+	}
+
+	return append(args, po, "--", "sh")
+}
+
+// broadcastLayout computes a panes grid dimensions.
+func broadcastLayout(n int) (rows, cols int) {
+	if n <= 0 {
+		return 1, 1
+	}
+	cols = int(math.Ceil(math.Sqrt(float64(n))))
+	rows = int(math.Ceil(float64(n) / float64(cols)))
+
+	return rows, cols
+}
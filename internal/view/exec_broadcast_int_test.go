@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcastArgs(t *testing.T) {
+	uu := map[string]struct {
+		path  string
+		flags []string
+		e     []string
+	}{
+		"namespaced": {
+			path:  "fred/p1",
+			flags: []string{"--context", "ctx1"},
+			e:     []string{"exec", "-i", "--context", "ctx1", "-n", "fred", "p1", "--", "sh"},
+		},
+		"no-ns": {
+			path: "p1",
+			e:    []string{"exec", "-i", "p1", "--", "sh"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, broadcastArgs(u.path, u.flags))
+		})
+	}
+}
+
+func TestBroadcastLayout(t *testing.T) {
+	uu := map[string]struct {
+		n, rows, cols int
+	}{
+		"none":  {n: 0, rows: 1, cols: 1},
+		"one":   {n: 1, rows: 1, cols: 1},
+		"three": {n: 3, rows: 2, cols: 2},
+		"four":  {n: 4, rows: 2, cols: 2},
+		"five":  {n: 5, rows: 2, cols: 3},
+		"ten":   {n: 10, rows: 3, cols: 4},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rows, cols := broadcastLayout(u.n)
+			assert.Equal(t, u.rows, rows)
+			assert.Equal(t, u.cols, cols)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestK9sShellPodProfiles(t *testing.T) {
+	uu := map[string]struct {
+		profile           string
+		priv, ro, hostIPC bool
+		caps              []v1.Capability
+	}{
+		"legacy": {
+			priv: true,
+			ro:   true,
+		},
+		"general": {
+			profile: config.ShellProfileGeneral,
+			ro:      true,
+			hostIPC: true,
+		},
+		"sysadmin": {
+			profile: config.ShellProfileSysadmin,
+			priv:    true,
+			hostIPC: true,
+		},
+		"netadmin": {
+			profile: config.ShellProfileNetadmin,
+			ro:      true,
+			hostIPC: true,
+			caps:    []v1.Capability{"NET_ADMIN", "NET_RAW"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewShellPod()
+			cfg.Profile = u.profile
+			po := k9sShellPod("n1", cfg)
+
+			assert.Equal(t, "n1", po.Spec.NodeName)
+			assert.True(t, po.Spec.HostPID)
+			assert.Equal(t, u.hostIPC, po.Spec.HostIPC)
+			co := po.Spec.Containers[0]
+			assert.Equal(t, u.priv, *co.SecurityContext.Privileged)
+			assert.Equal(t, u.ro, co.VolumeMounts[0].ReadOnly)
+			if u.caps == nil {
+				assert.Nil(t, co.SecurityContext.Capabilities)
+			} else {
+				assert.Equal(t, u.caps, co.SecurityContext.Capabilities.Add)
+			}
+		})
+	}
+}
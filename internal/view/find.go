@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Find presents resources search results across cached informers.
+type Find struct {
+	ResourceViewer
+
+	term string
+}
+
+// NewFind returns a new viewer.
+func NewFind(term string) ResourceViewer {
+	f := Find{
+		ResourceViewer: NewBrowser(client.FndGVR),
+		term:           term,
+	}
+	f.GetTable().SetSortCol("SCORE", false)
+	f.GetTable().SetEnterFn(f.showRes)
+	f.AddBindKeysFn(f.bindKeys)
+	f.SetContextFn(f.findContext)
+
+	return &f
+}
+
+func (f *Find) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", f.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Score", f.GetTable().SortColCmd("SCORE", false), false),
+	})
+}
+
+func (f *Find) findContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyFind, f.term)
+}
+
+func (*Find) showRes(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	gotoPath(app, path)
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	fleetTitle = "fleet"
+	fleetAll   = "all"
+)
+
+// Fleet presents a read-only resource view merged across several contexts.
+type Fleet struct {
+	*Table
+
+	spec      string
+	ns        string
+	members   model.Fleet
+	factories []*watch.Factory
+	cancelFn  context.CancelFunc
+}
+
+// NewFleet returns a new fleet view given a comma separated list of contexts globs.
+func NewFleet(gvr *client.GVR, spec, ns string) *Fleet {
+	return &Fleet{
+		Table: NewTable(gvr),
+		spec:  spec,
+		ns:    ns,
+	}
+}
+
+// Init initializes the view.
+func (f *Fleet) Init(ctx context.Context) error {
+	if err := f.Table.Init(ctx); err != nil {
+		return err
+	}
+	if f.app.Conn() == nil {
+		return fmt.Errorf("no connection available")
+	}
+	meta, err := dao.MetaAccess.MetaFor(f.GVR())
+	if err != nil {
+		return err
+	}
+	if r, ok := model.Registry[f.GVR()]; ok && r.Renderer != nil {
+		f.SetColorerFn(r.Renderer.ColorerFunc())
+	}
+	cc, err := f.app.Conn().Config().Contexts()
+	if err != nil {
+		return err
+	}
+	names, err := matchContexts(f.spec, cc)
+	if err != nil {
+		return err
+	}
+	if err := f.dial(names); err != nil {
+		return err
+	}
+
+	ns := client.CleanseNamespace(f.ns)
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	f.GetModel().SetNamespace(ns)
+	f.Extras = fmt.Sprintf("%d contexts", len(f.members))
+	f.SetSortCol(model.FleetContextCol, true)
+	f.Actions().Bulk(ui.KeyMap{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", f.gotoCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.resetCmd, false),
+		ui.KeyShiftX:    ui.NewKeyAction("Sort Context", f.SortColCmd(model.FleetContextCol, true), false),
+	})
+
+	return nil
+}
+
+// dial connects to the fleet contexts concurrently.
+func (f *Fleet) dial(names []string) error {
+	ff, ee := make([]*watch.Factory, len(names)), make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, n := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ff[i], ee[i] = dialContext(f.app.Conn().Config(), n)
+		}()
+	}
+	wg.Wait()
+
+	var errs error
+	for i, n := range names {
+		if ee[i] != nil {
+			slog.Warn("Fleet dial failed", slogs.Context, n, slogs.Error, ee[i])
+			errs = errors.Join(errs, ee[i])
+			continue
+		}
+		f.members = append(f.members, model.FleetMember{Context: n, Factory: ff[i]})
+		f.factories = append(f.factories, ff[i])
+	}
+	if len(f.members) == 0 {
+		return errs
+	}
+	if errs != nil {
+		f.app.Flash().Warnf("Fleet skipped unreachable contexts: %s", errs)
+	}
+
+	return nil
+}
+
+// matchContexts returns the sorted context names matching a comma separated list of globs.
+func matchContexts(spec string, cc map[string]*api.Context) ([]string, error) {
+	var names []string
+	for n := range cc {
+		if spec == fleetAll {
+			names = append(names, n)
+			continue
+		}
+		for g := range strings.SplitSeq(spec, ",") {
+			if ok, err := path.Match(g, n); err != nil {
+				return nil, fmt.Errorf("invalid context pattern %q: %w", g, err)
+			} else if ok {
+				names = append(names, n)
+				break
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no contexts matching %q", spec)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// Name returns the component name.
+func (*Fleet) Name() string { return fleetTitle }
+
+// Start initializes the fleet watch loop.
+func (f *Fleet) Start() {
+	f.Stop()
+	f.Table.Start()
+	for _, fac := range f.factories {
+		fac.Start(f.ns)
+	}
+	f.GetModel().AddListener(f)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFleet, f.members)
+	ctx = context.WithValue(ctx, internal.KeyGVR, f.GVR())
+	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(f.ns))
+	ctx = context.WithValue(ctx, internal.KeyPageSize, f.app.Config.K9s.GetListPageSize())
+	ctx, f.cancelFn = context.WithCancel(ctx)
+	if err := f.GetModel().Watch(ctx); err != nil {
+		f.app.Flash().Errf("Fleet watcher failed for %s -- %s", f.GVR(), err)
+	}
+}
+
+// Stop terminates the fleet watch loop.
+func (f *Fleet) Stop() {
+	if f.cancelFn != nil {
+		f.cancelFn()
+		f.cancelFn = nil
+	}
+	f.GetModel().RemoveListener(f)
+	f.Table.Stop()
+	for _, fac := range f.factories {
+		fac.Terminate()
+	}
+}
+
+// InCmdMode checks if prompt is active.
+func (f *Fleet) InCmdMode() bool {
+	return f.CmdBuff().InCmdMode()
+}
+
+// SetFilter sets the filter text.
+func (f *Fleet) SetFilter(q string, wipe bool) {
+	f.CmdBuff().SetText(q, "", wipe)
+}
+
+// SetLabelSelector sets the label selector.
+func (f *Fleet) SetLabelSelector(sel labels.Selector, _ bool) {
+	f.GetModel().SetLabelSelector(sel)
+}
+
+// TableDataChanged notifies the fleet data changed.
+func (f *Fleet) TableDataChanged(data *model1.TableData) {
+	cdata := f.Update(data, f.hasMetrics())
+	f.app.QueueUpdateDraw(func() {
+		f.UpdateUI(cdata, data)
+	})
+}
+
+// TableNoData notifies the fleet has no data.
+func (f *Fleet) TableNoData(data *model1.TableData) {
+	f.TableDataChanged(data)
+}
+
+// TableLoadFailed notifies the fleet load failed.
+func (f *Fleet) TableLoadFailed(err error) {
+	f.app.QueueUpdateDraw(func() {
+		f.app.Flash().Err(err)
+	})
+}
+
+func (f *Fleet) hasMetrics() bool {
+	for _, m := range f.members {
+		if m.Factory.Client().HasMetrics() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gotoCmd switches to the selected resource context.
+func (f *Fleet) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ct, fqn, ok := model.ParseFleetID(f.GetSelectedItem())
+	if !ok {
+		return evt
+	}
+	ns, _ := client.Namespaced(fqn)
+	c := f.GVR().String()
+	if ns != "" {
+		c += " " + ns
+	}
+	f.app.gotoResource(c+" @"+ct, fqn, true, true)
+
+	return nil
+}
+
+func (f *Fleet) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !f.CmdBuff().InCmdMode() {
+		f.CmdBuff().ClearText(false)
+		return f.app.PrevCmd(evt)
+	}
+	f.CmdBuff().Reset()
+	f.GetModel().SetLabelSelector(labels.Everything())
+	f.Refresh()
+
+	return nil
+}
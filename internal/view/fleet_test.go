@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestMatchContexts(t *testing.T) {
+	cc := map[string]*api.Context{
+		"prod-us":   {},
+		"prod-eu":   {},
+		"staging":   {},
+		"kind-fred": {},
+	}
+	uu := map[string]struct {
+		spec string
+		e    []string
+		err  string
+	}{
+		"all": {
+			spec: "all",
+			e:    []string{"kind-fred", "prod-eu", "prod-us", "staging"},
+		},
+		"list": {
+			spec: "staging,kind-fred",
+			e:    []string{"kind-fred", "staging"},
+		},
+		"glob": {
+			spec: "prod-*,staging",
+			e:    []string{"prod-eu", "prod-us", "staging"},
+		},
+		"none": {
+			spec: "dev",
+			err:  `no contexts matching "dev"`,
+		},
+		"bad": {
+			spec: "prod-[",
+			err:  `invalid context pattern "prod-[": syntax error in pattern`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			nn, err := matchContexts(u.spec, cc)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
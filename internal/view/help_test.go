@@ -25,7 +25,7 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp(app)
 
 	require.NoError(t, v.Init(ctx))
-	assert.Equal(t, 20, v.GetRowCount())
+	assert.Equal(t, 27, v.GetRowCount())
 	assert.Equal(t, 8, v.GetColumnCount())
 	assert.Equal(t, "<a>", strings.TrimSpace(v.GetCell(1, 0).Text))
 	assert.Equal(t, "Attach", strings.TrimSpace(v.GetCell(1, 1).Text))
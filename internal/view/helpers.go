@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -45,6 +47,15 @@ func aliases(m *v1.APIResource, aa sets.Set[string]) sets.Set[string] {
 	return ss
 }
 
+// dryRunMsg decorates an operation outcome when running in dry run mode.
+func dryRunMsg(msg string) string {
+	if !dao.IsDryRun() {
+		return msg
+	}
+
+	return "Dry run: " + msg + " (validated by server, no changes applied)"
+}
+
 var bracketRX = regexp.MustCompile(`\[(.+)\[\]`)
 
 func sanitizeEsc(s string) string {
@@ -286,3 +297,51 @@ func linesWithRegions(lines []string, matches fuzzy.Matches) []string {
 	}
 	return ll
 }
+
+// joinFields combines a field selector with the one already tracked by the context if any.
+func joinFields(ctx context.Context, sel string) string {
+	if fsel, ok := ctx.Value(internal.KeyFields).(string); ok && fsel != "" {
+		return fsel + "," + sel
+	}
+
+	return sel
+}
+
+// labelSuggestions completes the last term of a label selector filter given the known labels.
+// Keys complete while in label mode (-l) and values complete once an operator is entered.
+func labelSuggestions(s string, idx map[string][]string) []string {
+	if len(idx) == 0 || s == "-l" {
+		return nil
+	}
+	sel, labelMode := strings.CutPrefix(s, "-l")
+	if labelMode {
+		sel = strings.TrimLeft(sel, " ")
+	} else if !strings.Contains(s, "=") || strings.Contains(s, " ") {
+		return nil
+	}
+
+	term := sel[strings.LastIndex(sel, ",")+1:]
+	var ss []string
+	for _, op := range []string{"!=", "==", "="} {
+		k, partial, ok := strings.Cut(term, op)
+		if !ok {
+			continue
+		}
+		for _, v := range idx[strings.TrimSpace(k)] {
+			if v != partial && strings.HasPrefix(v, partial) {
+				ss = append(ss, strings.TrimPrefix(v, partial))
+			}
+		}
+		return ss
+	}
+	if !labelMode {
+		return nil
+	}
+	for _, k := range slices.Sorted(maps.Keys(idx)) {
+		if strings.HasPrefix(k, term) {
+			ss = append(ss, strings.TrimPrefix(k, term)+"=")
+		}
+	}
+
+	return ss
+}
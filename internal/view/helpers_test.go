@@ -350,3 +350,30 @@ func Test_sanitizeEsc(t *testing.T) {
 		})
 	}
 }
+
+func Test_labelSuggestions(t *testing.T) {
+	idx := map[string][]string{
+		"app":  {"blee", "fred", "fred-canary"},
+		"tier": {"cache", "web"},
+	}
+	uu := map[string]struct {
+		s string
+		e []string
+	}{
+		"blank":       {s: "-l"},
+		"keys":        {s: "-l ", e: []string{"app=", "tier="}},
+		"key-prefix":  {s: "-l ti", e: []string{"er="}},
+		"values":      {s: "-l app=fr", e: []string{"ed", "ed-canary"}},
+		"exact":       {s: "-l app=fred", e: []string{"-canary"}},
+		"not-eq":      {s: "app=web,tier!=c", e: []string{"ache"}},
+		"bare-key":    {s: "app"},
+		"regex":       {s: "fred blee="},
+		"unknown-key": {s: "-l zorg="},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, labelSuggestions(u.s, idx))
+		})
+	}
+}
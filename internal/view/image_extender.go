@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
@@ -138,6 +139,7 @@ func (s *ImageExtender) makeSetImageForm(fqn string) (*tview.Form, error) {
 				return
 			}
 			s.App().Flash().Infof("Resource %s:%s image updated successfully", s.GVR(), fqn)
+			watchRollouts(s.App(), []rolloutTarget{{gvr: s.GVR(), path: fqn}})
 		}).
 		AddButton("Cancel", func() {
 			s.dismissDialog()
@@ -148,6 +150,9 @@ func (s *ImageExtender) makeSetImageForm(fqn string) (*tview.Form, error) {
 		f.AddInputField(ctn.name, ctn.dockerImage, 0, nil, func(changed string) {
 			ctn.newDockerImage = changed
 		})
+		if field, ok := f.GetFormItem(f.GetFormItemCount() - 1).(*tview.InputField); ok {
+			s.suggestTags(field, ctn.dockerImage)
+		}
 	}
 
 	for i := range f.GetButtonCount() {
@@ -159,6 +164,37 @@ func (s *ImageExtender) makeSetImageForm(fqn string) (*tview.Form, error) {
 	return f, nil
 }
 
+// suggestTags autocompletes an image field with the image repository recent tags.
+func (s *ImageExtender) suggestTags(field *tview.InputField, image string) {
+	cfg := s.App().Config.K9s.Registries
+	if cfg.Disable {
+		return
+	}
+	var (
+		ref  = dao.ParseImageRef(image)
+		tags []string
+		mx   sync.RWMutex
+	)
+	field.SetAutocompleteFunc(func(text string) []string {
+		mx.RLock()
+		defer mx.RUnlock()
+		return imageSuggestions(ref, tags, text)
+	})
+	go func() {
+		tt, err := dao.ImageTags(context.Background(), cfg, image)
+		if err != nil {
+			slog.Warn("Unable to fetch image tags",
+				slogs.Image, image,
+				slogs.Error, err,
+			)
+			return
+		}
+		mx.Lock()
+		tags = tt
+		mx.Unlock()
+	}()
+}
+
 func (s *ImageExtender) dismissDialog() {
 	s.App().Content.RemovePage(imageKey)
 }
@@ -189,3 +225,21 @@ func (s *ImageExtender) setImages(ctx context.Context, path string, imageSpecs d
 
 	return resourceWPodSpec.SetImages(ctx, path, imageSpecs)
 }
+
+// Helpers...
+
+// imageSuggestions returns the images matching the tag being typed.
+func imageSuggestions(ref dao.ImageRef, tags []string, text string) []string {
+	prefix, ok := strings.CutPrefix(text, ref.Name+":")
+	if !ok {
+		return nil
+	}
+	ss := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t != prefix && strings.HasPrefix(t, prefix) {
+			ss = append(ss, ref.WithTag(t))
+		}
+	}
+
+	return ss
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageSuggestions(t *testing.T) {
+	ref := dao.ParseImageRef("ghcr.io/fred/blee:1.2")
+	tags := []string{"1.10", "1.2.1", "1.2", "latest"}
+
+	uu := map[string]struct {
+		text string
+		e    []string
+	}{
+		"current": {
+			text: "ghcr.io/fred/blee:1.2",
+			e:    []string{"ghcr.io/fred/blee:1.2.1"},
+		},
+		"all": {
+			text: "ghcr.io/fred/blee:",
+			e:    []string{"ghcr.io/fred/blee:1.10", "ghcr.io/fred/blee:1.2.1", "ghcr.io/fred/blee:1.2", "ghcr.io/fred/blee:latest"},
+		},
+		"no-match": {
+			text: "ghcr.io/fred/blee:2",
+			e:    []string{},
+		},
+		"other-image": {
+			text: "nginx:1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, imageSuggestions(ref, tags, u.text))
+		})
+	}
+}
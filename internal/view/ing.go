@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr *client.GVR) ResourceViewer {
+	return &Ingress{
+		ResourceViewer: NewMonitorExtender(NewBrowser(gvr)),
+	}
+}
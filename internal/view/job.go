@@ -4,17 +4,25 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const jobRerunDialogKey = "jobRerun"
+
 // Job represents a job viewer.
 type Job struct {
 	ResourceViewer
@@ -29,12 +37,130 @@ func NewJob(gvr *client.GVR) ResourceViewer {
 			NewLogsExtender(NewBrowser(gvr), j.logOptions),
 		),
 	)
+	j.AddBindKeysFn(j.bindKeys)
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetSortCol("AGE", true)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa *ui.KeyActions) {
+	if j.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Re-run", j.rerunCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (j *Job) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := j.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	job, err := j.getInstance(path)
+	if err != nil {
+		j.App().Flash().Err(err)
+		return nil
+	}
+
+	j.Stop()
+	defer j.Start()
+	confirm := tview.NewModalForm("<Re-run>", j.makeRerunForm(path, &job.Spec.Template.Spec))
+	confirm.SetText(fmt.Sprintf("Re-run job %s as a clone?", path))
+	confirm.SetDoneFunc(func(int, string) {
+		j.dismissDialog()
+	})
+	j.App().Content.AddPage(jobRerunDialogKey, confirm, false, false)
+	j.App().Content.ShowPage(jobRerunDialogKey)
+
+	return nil
+}
+
+func (j *Job) makeRerunForm(path string, spec *v1.PodSpec) *tview.Form {
+	styles := j.App().Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var co, env, args string
+	if len(spec.Containers) > 0 {
+		co = spec.Containers[0].Name
+	}
+	if len(spec.Containers) > 1 {
+		cc := make([]string, 0, len(spec.Containers))
+		for _, c := range spec.Containers {
+			cc = append(cc, c.Name)
+		}
+		f.AddDropDown("Container:", cc, 0, func(option string, _ int) {
+			co = option
+		})
+	}
+	f.AddInputField("Env:", "", 0, nil, func(changed string) {
+		env = changed
+	})
+	f.AddInputField("Args:", "", 0, nil, func(changed string) {
+		args = changed
+	})
+	if field, ok := f.GetFormItemByLabel("Env:").(*tview.InputField); ok {
+		field.SetPlaceholder("KEY=VALUE,...")
+	}
+	if field, ok := f.GetFormItemByLabel("Args:").(*tview.InputField); ok {
+		field.SetPlaceholder("keep current args")
+	}
+
+	f.AddButton("OK", func() {
+		defer j.dismissDialog()
+		if err := j.rerun(path, co, env, args); err != nil {
+			j.App().Flash().Err(err)
+		}
+	})
+	f.AddButton("Cancel", func() {
+		j.dismissDialog()
+	})
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	return f
+}
+
+func (j *Job) rerun(path, co, env, args string) error {
+	ee, err := dao.ParseEnvOverrides(env)
+	if err != nil {
+		return err
+	}
+	aa, err := dao.ParseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	var job dao.Job
+	job.Init(j.App().factory, client.JobGVR)
+	ctx, cancel := context.WithTimeout(context.Background(), j.App().Conn().Config().CallTimeout())
+	defer cancel()
+	fqn, err := job.Clone(ctx, path, dao.JobOverrides{Container: co, Env: ee, Args: aa})
+	if err != nil {
+		return err
+	}
+	j.App().audit(audit.CloneAction, client.JobGVR, path)
+	j.App().Flash().Info(dryRunMsg(fmt.Sprintf("Job %s re-run as %s", path, fqn)))
+
+	return nil
+}
+
+func (j *Job) dismissDialog() {
+	j.App().Content.RemovePage(jobRerunDialogKey)
+}
+
 func (*Job) showPods(app *App, _ ui.Tabular, gvr *client.GVR, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
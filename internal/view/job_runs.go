@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// JobRuns presents a cronjob jobs runs history.
+type JobRuns struct {
+	ResourceViewer
+}
+
+// NewJobRuns returns a new viewer.
+func NewJobRuns(gvr *client.GVR) ResourceViewer {
+	r := JobRuns{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetSortCol("STARTED", true)
+	r.GetTable().SetEnterFn(r.showPods)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// newJobRunsFor returns a viewer listing a given cronjob runs.
+func newJobRunsFor(path string) ResourceViewer {
+	v := NewJobRuns(client.JrGVR)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+
+	return v
+}
+
+// Init initializes the view.
+func (r *JobRuns) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (r *JobRuns) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyShiftT, ui.NewKeyAction("Sort Started", r.GetTable().SortColCmd("STARTED", false), false))
+}
+
+func (*JobRuns) showPods(app *App, t ui.Tabular, _ *client.GVR, path string) {
+	new(Job).showPods(app, t, client.JobGVR, path)
+}
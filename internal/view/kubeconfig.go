@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/fsnotify/fsnotify"
+)
+
+// kubeConfigDebounce coalesces bursts of kubeconfig writes into a single reload.
+const kubeConfigDebounce = 500 * time.Millisecond
+
+// kubeConfigWatcher hot reloads contexts and credentials on kubeconfig files changes.
+func (a *App) kubeConfigWatcher(ctx context.Context) error {
+	if a.Conn() == nil || a.Conn().Config() == nil {
+		return nil
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	// Watch parent dirs as editors and kubectl replace files rather than writing in place.
+	files := make(map[string]struct{})
+	for _, f := range a.Conn().Config().KubeConfigFiles() {
+		f, err := filepath.Abs(f)
+		if err != nil {
+			continue
+		}
+		files[f] = struct{}{}
+		dir := filepath.Dir(f)
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		if err := w.Add(dir); err != nil {
+			slog.Warn("Kubeconfig watch failed", slogs.Dir, dir, slogs.Error, err)
+		}
+	}
+
+	go func() {
+		var reload <-chan time.Time
+		for {
+			select {
+			case evt := <-w.Events:
+				if evt.Op == fsnotify.Chmod {
+					continue
+				}
+				if _, ok := files[filepath.Clean(evt.Name)]; ok {
+					slog.Debug("Kubeconfig change detected", slogs.FileName, evt.Name)
+					reload = time.After(kubeConfigDebounce)
+				}
+			case <-reload:
+				reload = nil
+				a.reloadKubeConfig()
+			case err := <-w.Errors:
+				slog.Warn("Kubeconfig watcher failed", slogs.Error, err)
+				return
+			case <-ctx.Done():
+				slog.Debug("Kubeconfig watcher canceled")
+				if err := w.Close(); err != nil {
+					slog.Error("Closing kubeconfig watcher", slogs.Error, err)
+				}
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// reloadKubeConfig refreshes the connection from the kubeconfig files and reconnects
+// when the active context credentials changed.
+func (a *App) reloadKubeConfig() {
+	ct := a.Conn().ActiveContext()
+	before, _ := a.Conn().Config().CredentialKey()
+	if err := a.Conn().ReloadConfig(); err != nil {
+		slog.Warn("Kubeconfig reload failed", slogs.Context, ct, slogs.Error, err)
+		a.Status(model.FlashWarn, fmt.Sprintf("Kubeconfig reload failed: %s", err))
+		return
+	}
+	after, err := a.Conn().Config().CredentialKey()
+	if err != nil || before == after {
+		a.QueueUpdateDraw(func() {
+			a.Flash().Info("Kubeconfig reloaded")
+		})
+		return
+	}
+
+	slog.Info("Active context credentials changed", slogs.Context, ct)
+	a.Status(model.FlashWarn, fmt.Sprintf("Credentials changed for context %q", ct))
+	a.QueueUpdateDraw(func() {
+		if err := a.switchContext(cmd.NewInterpreter("ctx "+ct), true); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+}
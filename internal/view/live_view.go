@@ -12,9 +12,11 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
@@ -41,6 +43,7 @@ type LiveView struct {
 	cancel                    context.CancelFunc
 	fullScreen                bool
 	managedField              bool
+	clean                     bool
 	autoRefresh               bool
 }
 
@@ -161,7 +164,11 @@ func (v *LiveView) bindKeys() {
 		v.actions.Add(ui.KeyE, ui.NewKeyAction("Edit", v.editCmd, true))
 	}
 	if v.title == yamlAction {
-		v.actions.Add(ui.KeyM, ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true))
+		v.actions.Bulk(ui.KeyMap{
+			ui.KeyM:      ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true),
+			ui.KeyT:      ui.NewKeyAction("Toggle Clean", v.toggleCleanCmd, true),
+			ui.KeyShiftC: ui.NewKeyAction("Copy Clean", v.cpCleanCmd, true),
+		})
 	}
 	if _, ok := v.model.(model.EncDecResourceViewer); ok {
 		v.actions.Add(ui.KeyX, ui.NewKeyAction("Toggle Decode", v.toggleEncodedDecodedCmd, true))
@@ -275,12 +282,54 @@ func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	v.managedField = !v.managedField
-	v.model.SetOptions(v.defaultCtx(), map[string]bool{model.ManagedFieldsOpts: v.managedField})
+	v.setOptions()
 
 	v.app.Flash().Info("toggled managed fields")
 	return nil
 }
 
+func (v *LiveView) toggleCleanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	v.clean = !v.clean
+	v.setOptions()
+
+	v.app.Flash().Info("toggled clean manifest")
+	return nil
+}
+
+// cpCleanCmd copies the resource manifest without its server populated and
+// defaulted fields, ie to recreate the resource elsewhere.
+func (v *LiveView) cpCleanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	m, ok := v.model.(*model.YAML)
+	if !ok || v.app.InCmdMode() {
+		return evt
+	}
+	raw, err := m.ToYAML(v.defaultCtx(), m.GVR(), m.GetPath(), false)
+	if err == nil {
+		raw, err = dao.CleanYAML(raw)
+	}
+	if err == nil {
+		err = clipboardWrite(raw)
+	}
+	if err != nil {
+		v.app.Flash().Err(err)
+		return nil
+	}
+	v.app.Flash().Info("Clean manifest copied to clipboard...")
+
+	return nil
+}
+
+func (v *LiveView) setOptions() {
+	v.model.SetOptions(v.defaultCtx(), map[string]bool{
+		model.ManagedFieldsOpts: v.managedField,
+		model.CleanOpts:         v.clean,
+	})
+}
+
 func (v *LiveView) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.app.InCmdMode() {
 		return evt
@@ -377,14 +426,24 @@ func (v *LiveView) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (v *LiveView) saveCmd(*tcell.EventKey) *tcell.EventKey {
+	if m, ok := v.model.(model.EncDecResourceViewer); ok && m.Decoded() {
+		d := v.app.Styles.Dialog()
+		msg := "Screen dump will contain decoded secret values in clear. Save anyway?"
+		dialog.ShowConfirm(&d, v.app.Content.Pages, "Confirm Save", msg, v.save, func() {})
+		return nil
+	}
+	v.save()
+
+	return nil
+}
+
+func (v *LiveView) save() {
 	name := fmt.Sprintf("%s--%s", strings.Replace(v.model.GetPath(), "/", "-", 1), strings.ToLower(v.title))
 	if _, err := saveYAML(v.app.Config.K9s.ContextScreenDumpDir(), name, sanitizeEsc(v.text.GetText(true))); err != nil {
 		v.app.Flash().Err(err)
 	} else {
 		v.app.Flash().Infof("File %q saved successfully!", name)
 	}
-
-	return nil
 }
 
 func (v *LiveView) updateTitle() {
@@ -34,6 +34,7 @@ const (
 	logFmt              = "([hilite:bg:]%s[-:bg:-])[[green:bg:b]%s[-:bg:-]] "
 	logCoFmt            = "([hilite:bg:]%s:[hilite:bg:b]%s[-:bg:-])[[green:bg:b]%s[-:bg:-]] "
 	defaultFlushTimeout = 50 * time.Millisecond
+	logAlertThrottle    = 5 * time.Second
 )
 
 // Log represents a generic log viewer.
@@ -51,6 +52,8 @@ type Log struct {
 	follow            bool
 	columnLock        bool
 	requestOneRefresh bool
+	alerts            map[string]time.Time
+	initFilter        string
 }
 
 var _ model.Component = (*Log)(nil)
@@ -58,8 +61,9 @@ var _ model.Component = (*Log)(nil)
 // NewLog returns a new viewer.
 func NewLog(gvr *client.GVR, opts *dao.LogOptions) *Log {
 	return &Log{
-		Flex:  tview.NewFlex(),
-		model: model.NewLog(gvr, opts, defaultFlushTimeout),
+		Flex:   tview.NewFlex(),
+		model:  model.NewLog(gvr, opts, defaultFlushTimeout),
+		alerts: make(map[string]time.Time),
 	}
 }
 
@@ -92,6 +96,10 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	l.logs.SetText("[orange::d]" + logMessage)
 	l.logs.SetWrap(l.app.Config.K9s.Logger.TextWrap)
 	l.logs.SetMaxLines(l.app.Config.K9s.Logger.BufferSize)
+	if l.initFilter != "" {
+		l.logs.cmdBuff.SetText(l.initFilter, "", true)
+		l.model.Filter(l.initFilter)
+	}
 
 	l.ansiWriter = tview.ANSIWriter(l.logs, l.app.Styles.Views().Log.FgColor.String(), l.app.Styles.Views().Log.BgColor.String())
 	l.AddItem(l.logs, 0, 1, true)
@@ -107,6 +115,11 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	l.columnLock = l.app.Config.K9s.Logger.ColumnLock
 
 	l.model.ToggleShowTimestamp(l.app.Config.K9s.Logger.ShowTime)
+	l.initJSON()
+	l.initBackend()
+	if c := l.app.Config.K9s.Logger.Capture; c.Enabled {
+		l.model.SetCapture(dao.NewLogCapture(l.app.Config.K9s.ContextLogCaptureDir(), c.MaxSizeOrDefault(), c.MaxFilesOrDefault()))
+	}
 
 	return nil
 }
@@ -122,6 +135,46 @@ func (l *Log) LogCanceled() {
 	l.Flush([][]byte{[]byte("\n🏁 [red::b]Stream exited! No more logs...")})
 }
 
+// LogAlerted notifies a log line matched an alerting highlight rule.
+func (l *Log) LogAlerted(item *dao.LogItem, rule config.LogHighlight) {
+	if rule.Flag {
+		if fqn, ok := l.alertedPod(item); ok {
+			dao.FlagLogPod(fqn, rule.Pattern)
+		}
+	}
+	l.mx.Lock()
+	if time.Since(l.alerts[rule.Pattern]) < logAlertThrottle {
+		l.mx.Unlock()
+		return
+	}
+	l.alerts[rule.Pattern] = time.Now()
+	l.mx.Unlock()
+
+	if rule.Beep {
+		l.app.Beep()
+	}
+	if rule.Notify {
+		src := item.ID()
+		if src == "" {
+			src = l.model.GetPath()
+		}
+		l.app.QueueUpdateDraw(func() {
+			l.app.Flash().Warnf("Log alert on %s matching %q", src, rule.Pattern)
+		})
+	}
+}
+
+// alertedPod returns the fqn of the pod an alerted log line came from.
+func (l *Log) alertedPod(item *dao.LogItem) (string, bool) {
+	path := l.model.GetPath()
+	if item.Pod != "" {
+		ns, _ := client.Namespaced(path)
+		return client.FQN(ns, item.Pod), true
+	}
+
+	return path, l.model.GVR() == client.PodGVR
+}
+
 // LogStop disables log flushes.
 func (l *Log) LogStop() {
 	slog.Debug("Logs watcher stopped!")
@@ -236,6 +289,7 @@ func (l *Log) Start() {
 func (l *Log) Stop() {
 	l.model.RemoveListener(l)
 	l.model.Stop()
+	l.model.CloseSinks()
 	l.cancel()
 	l.app.Styles.RemoveListener(l)
 	l.logs.cmdBuff.RemoveListener(l)
@@ -254,6 +308,7 @@ func (l *Log) bindKeys() {
 		ui.Key4:         ui.NewKeyAction("15m", l.sinceCmd(15*60), true),
 		ui.Key5:         ui.NewKeyAction("30m", l.sinceCmd(30*60), true),
 		ui.Key6:         ui.NewKeyAction("1h", l.sinceCmd(60*60), true),
+		ui.KeyR:         ui.NewKeyAction("Time Range", l.timeRangeCmd, true),
 		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", l.filterCmd, false),
 		tcell.KeyEscape: ui.NewKeyAction("Back", l.resetCmd, false),
 		ui.KeyQ:         ui.NewKeyAction("Back", l.resetCmd, false),
@@ -263,6 +318,8 @@ func (l *Log) bindKeys() {
 		ui.KeyShiftL:    ui.NewKeyAction("Toggle ColumnLock", l.toggleColumnLockCmd, true),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
+		ui.KeyJ:         ui.NewKeyAction("Toggle JSON", l.toggleJSONCmd, true),
+		ui.KeyShiftJ:    ui.NewKeyAction("JSON Options", l.jsonOptionsCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", cpCmd(l.app.Flash(), l.logs.TextView), true),
@@ -270,6 +327,7 @@ func (l *Log) bindKeys() {
 	if l.model.HasDefaultContainer() {
 		l.logs.Actions().Add(ui.KeyA, ui.NewKeyAction("Toggle AllContainers", l.toggleAllContainers, true))
 	}
+	l.bindSinks()
 }
 
 func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -315,6 +373,9 @@ func (l *Log) updateTitle() {
 	if l.model.IsHead() {
 		since = "head"
 	}
+	if r, ok := l.model.TimeRange(); ok {
+		since = r
+	}
 
 	title := " Logs"
 	if l.model.LogOptions().Previous {
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// initBackend wires the active context historical logs backend if any.
+func (l *Log) initBackend() {
+	ct, err := l.app.Config.K9s.ActiveContext()
+	if err != nil || ct.LogBackend == nil {
+		return
+	}
+	b, err := dao.NewLogBackend(ct.LogBackend)
+	if err != nil {
+		slog.Warn("Invalid log backend configuration", slogs.Error, err)
+		l.app.Flash().Warnf("Log backend disabled: %s", err)
+		return
+	}
+	l.model.SetBackend(b, ct.LogBackend.LimitOrDefault())
+}
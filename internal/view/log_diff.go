@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	logDiffTitle  = "Logs Diff"
+	logDiffFmt    = " %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	crashRegion   = "crash"
+	logDiffLoader = "[orange::d]Loading logs..."
+)
+
+// LogDiff presents a container previous vs current instance logs side by side.
+type LogDiff struct {
+	*tview.Flex
+
+	app         *App
+	path, co    string
+	header      *tview.TextView
+	prev, curr  *tview.TextView
+	actions     *ui.KeyActions
+	diff        *dao.LogDiff
+	onlyChanges bool
+	cancelFn    context.CancelFunc
+	mx          sync.Mutex
+}
+
+var _ model.Component = (*LogDiff)(nil)
+
+// NewLogDiff returns a new logs diff viewer.
+func NewLogDiff(app *App, path, co string) *LogDiff {
+	return &LogDiff{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		path:    path,
+		co:      co,
+		header:  tview.NewTextView(),
+		prev:    tview.NewTextView(),
+		curr:    tview.NewTextView(),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*LogDiff) SetCommand(*cmd.Interpreter)            {}
+func (*LogDiff) SetFilter(string, bool)                 {}
+func (*LogDiff) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (l *LogDiff) Init(context.Context) error {
+	l.SetBorder(true)
+	l.SetDirection(tview.FlexRow)
+	styles := l.app.Styles.Frame()
+	l.SetTitle(ui.SkinTitle(fmt.Sprintf(logDiffFmt, logDiffTitle, l.path+":"+l.co), &styles))
+
+	l.header.SetDynamicColors(true).SetTextAlign(tview.AlignCenter)
+	for _, t := range []*tview.TextView{l.prev, l.curr} {
+		t.SetDynamicColors(true).SetRegions(true).SetScrollable(true).SetWrap(false)
+		t.SetBorder(true).SetBorderPadding(0, 0, 1, 1)
+		t.SetText(logDiffLoader)
+	}
+	l.prev.SetTitle(" Previous ")
+	l.curr.SetTitle(" Current ")
+
+	panes := tview.NewFlex().SetDirection(tview.FlexColumn)
+	panes.AddItem(l.prev, 0, 1, true)
+	panes.AddItem(l.curr, 0, 1, false)
+	l.AddItem(l.header, 1, 1, false)
+	l.AddItem(panes, 0, 1, true)
+
+	l.bindKeys()
+	l.SetInputCapture(l.keyboard)
+	l.StylesChanged(l.app.Styles)
+	l.app.Styles.AddListener(l)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*LogDiff) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*LogDiff) Name() string { return logDiffTitle }
+
+// Start fetches the logs.
+func (l *LogDiff) Start() {
+	l.refresh()
+}
+
+// Stop terminates the viewer.
+func (l *LogDiff) Stop() {
+	l.mx.Lock()
+	if l.cancelFn != nil {
+		l.cancelFn()
+		l.cancelFn = nil
+	}
+	l.mx.Unlock()
+	l.app.Styles.RemoveListener(l)
+}
+
+// Hints returns menu hints.
+func (l *LogDiff) Hints() model.MenuHints {
+	return l.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*LogDiff) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (l *LogDiff) StylesChanged(s *config.Styles) {
+	l.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	l.header.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	for _, t := range []*tview.TextView{l.prev, l.curr} {
+		t.SetBackgroundColor(s.Views().Log.BgColor.Color())
+		t.SetTextColor(s.Views().Log.FgColor.Color())
+		t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	}
+}
+
+func (l *LogDiff) bindKeys() {
+	l.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", l.app.PrevCmd, false),
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", l.switchPaneCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Jump To Crash", l.jumpToCrashCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Toggle Changes Only", l.toggleChangesCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Refresh", l.refreshCmd, true),
+	})
+}
+
+func (l *LogDiff) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := l.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (l *LogDiff) refresh() {
+	l.mx.Lock()
+	if l.cancelFn != nil {
+		l.cancelFn()
+	}
+	var ctx context.Context
+	ctx, l.cancelFn = context.WithTimeout(context.Background(), l.app.Conn().Config().CallTimeout())
+	l.mx.Unlock()
+
+	go func() {
+		header, diff, err := l.load(ctx)
+		l.app.QueueUpdateDraw(func() {
+			if err != nil {
+				l.app.Flash().Err(err)
+				l.prev.SetText(tview.Escape(err.Error()))
+				l.curr.Clear()
+				return
+			}
+			l.diff = diff
+			l.header.SetText(header)
+			l.render()
+			l.jumpToCrash()
+		})
+	}()
+}
+
+func (l *LogDiff) load(ctx context.Context) (string, *dao.LogDiff, error) {
+	po, err := fetchPod(l.app.factory, l.path)
+	if err != nil {
+		return "", nil, err
+	}
+	header := terminationInfo(po, l.co)
+
+	var p dao.Pod
+	p.Init(l.app.factory, client.PodGVR)
+	lines := int64(l.app.Config.K9s.Logger.BufferSize)
+	prev, err := p.FetchLogs(ctx, l.path, l.co, true, lines)
+	if err != nil {
+		return "", nil, fmt.Errorf("no previous instance logs for %s: %w", l.co, err)
+	}
+	curr, err := p.FetchLogs(ctx, l.path, l.co, false, lines)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return header, dao.DiffLogs(prev, curr), nil
+}
+
+func (l *LogDiff) render() {
+	if l.diff == nil {
+		return
+	}
+	l.prev.SetText(l.renderLines(l.diff.Previous, "orange", l.diff.Crash))
+	l.curr.SetText(l.renderLines(l.diff.Current, "green", -1))
+}
+
+func (l *LogDiff) renderLines(ll []dao.LogDiffLine, color string, crash int) string {
+	var b strings.Builder
+	for i, line := range ll {
+		if l.onlyChanges && !line.Unique && i != crash {
+			continue
+		}
+		text := tview.Escape(line.Text)
+		switch {
+		case i == crash:
+			b.WriteString(`["` + crashRegion + `"][red::b]` + text + `[-::-][""]`)
+		case line.Unique:
+			b.WriteString("[" + color + "::]" + text + "[-::]")
+		default:
+			b.WriteString(text)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (l *LogDiff) jumpToCrash() {
+	if l.diff == nil || l.diff.Crash < 0 {
+		return
+	}
+	l.prev.Highlight(crashRegion)
+	l.prev.ScrollToHighlight()
+	l.curr.ScrollToBeginning()
+	l.app.SetFocus(l.prev)
+}
+
+func (l *LogDiff) switchPaneCmd(*tcell.EventKey) *tcell.EventKey {
+	if l.prev.HasFocus() {
+		l.app.SetFocus(l.curr)
+	} else {
+		l.app.SetFocus(l.prev)
+	}
+
+	return nil
+}
+
+func (l *LogDiff) jumpToCrashCmd(*tcell.EventKey) *tcell.EventKey {
+	l.jumpToCrash()
+
+	return nil
+}
+
+func (l *LogDiff) toggleChangesCmd(*tcell.EventKey) *tcell.EventKey {
+	l.onlyChanges = !l.onlyChanges
+	l.render()
+	l.jumpToCrash()
+
+	return nil
+}
+
+func (l *LogDiff) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	l.refresh()
+
+	return nil
+}
+
+// terminationInfo describes a container last termination.
+func terminationInfo(po *v1.Pod, co string) string {
+	for _, s := range po.Status.ContainerStatuses {
+		if s.Name != co {
+			continue
+		}
+		t := s.LastTerminationState.Terminated
+		if t == nil {
+			return fmt.Sprintf("[orange::b]No previous termination recorded[-::-] Restarts: %d", s.RestartCount)
+		}
+		return fmt.Sprintf("[red::b]Last terminated:[-::-] %s (exit code %d) at %s [gray::]Restarts:[-::] %d",
+			t.Reason, t.ExitCode, t.FinishedAt.Local().Format("2006-01-02 15:04:05"), s.RestartCount)
+	}
+
+	return "[orange::b]No status for container " + tview.Escape(co)
+}
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	logGrepTitle = "Logs Grep"
+	logGrepFmt   = " %s([hilite:bg:b]%s[fg:bg:-])[[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
+)
+
+var logGrepHeader = []string{"TIME", "POD", "CONTAINER", "LINE"}
+
+// LogGrep presents log lines matching a pattern across the pods of a workload.
+type LogGrep struct {
+	*tview.Table
+
+	app      *App
+	path     string
+	sel      labels.Selector
+	rx       *regexp.Regexp
+	actions  *ui.KeyActions
+	matches  []dao.LogMatch
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+var _ model.Component = (*LogGrep)(nil)
+
+// NewLogGrep returns a new logs search viewer.
+func NewLogGrep(app *App, path string, sel labels.Selector, rx *regexp.Regexp) *LogGrep {
+	return &LogGrep{
+		Table:   tview.NewTable(),
+		app:     app,
+		path:    path,
+		sel:     sel,
+		rx:      rx,
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*LogGrep) SetCommand(*cmd.Interpreter)            {}
+func (*LogGrep) SetFilter(string, bool)                 {}
+func (*LogGrep) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (l *LogGrep) Init(context.Context) error {
+	l.SetBorder(true)
+	l.SetBorderPadding(0, 0, 1, 1)
+	l.SetSelectable(true, false)
+	l.SetFixed(1, 0)
+	l.updateTitle()
+	l.SetCell(0, 0, tview.NewTableCell("[orange::d]Searching logs...").SetSelectable(false))
+
+	l.bindKeys()
+	l.SetInputCapture(l.keyboard)
+	l.StylesChanged(l.app.Styles)
+	l.app.Styles.AddListener(l)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*LogGrep) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*LogGrep) Name() string { return logGrepTitle }
+
+// Start runs the search.
+func (l *LogGrep) Start() {
+	l.refresh()
+}
+
+// Stop terminates the viewer.
+func (l *LogGrep) Stop() {
+	l.mx.Lock()
+	if l.cancelFn != nil {
+		l.cancelFn()
+		l.cancelFn = nil
+	}
+	l.mx.Unlock()
+	l.app.Styles.RemoveListener(l)
+}
+
+// Hints returns menu hints.
+func (l *LogGrep) Hints() model.MenuHints {
+	return l.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*LogGrep) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (l *LogGrep) StylesChanged(s *config.Styles) {
+	l.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	l.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	l.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(s.Table().CursorFgColor.Color()).
+		Background(s.Table().CursorBgColor.Color()).
+		Attributes(tcell.AttrBold))
+	l.render()
+}
+
+func (l *LogGrep) bindKeys() {
+	l.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", l.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", l.app.PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Jump To Stream", l.jumpCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Refresh", l.refreshCmd, true),
+	})
+}
+
+func (l *LogGrep) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := l.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (l *LogGrep) updateTitle() {
+	styles := l.app.Styles.Frame()
+	l.SetTitle(ui.SkinTitle(fmt.Sprintf(logGrepFmt, logGrepTitle, l.path+":"+l.rx.String(), len(l.matches)), &styles))
+}
+
+func (l *LogGrep) refresh() {
+	l.mx.Lock()
+	if l.cancelFn != nil {
+		l.cancelFn()
+	}
+	var ctx context.Context
+	ctx, l.cancelFn = context.WithTimeout(context.Background(), l.app.Conn().Config().CallTimeout())
+	l.mx.Unlock()
+
+	ns, _ := client.Namespaced(l.path)
+	lines := int64(l.app.Config.K9s.Logger.BufferSize)
+	go func() {
+		mm, err := dao.GrepLogs(ctx, l.app.factory, ns, l.sel, l.rx, lines)
+		l.app.QueueUpdateDraw(func() {
+			if err != nil {
+				l.app.Flash().Err(err)
+			} else {
+				l.app.Flash().Infof("Found %d matching lines", len(mm))
+			}
+			l.matches = mm
+			l.updateTitle()
+			l.render()
+		})
+	}()
+}
+
+func (l *LogGrep) render() {
+	if l.matches == nil {
+		return
+	}
+	l.Clear()
+	fg := l.app.Styles.Table().Header.FgColor.Color()
+	for c, h := range logGrepHeader {
+		l.SetCell(0, c, tview.NewTableCell(h).SetTextColor(fg).SetAttributes(tcell.AttrBold).SetSelectable(false))
+	}
+	for i, m := range l.matches {
+		_, po := client.Namespaced(m.Path)
+		msg := m.Line
+		if _, rest, ok := strings.Cut(m.Line, " "); ok {
+			msg = rest
+		}
+		l.SetCell(i+1, 0, tview.NewTableCell(m.Timestamp()))
+		l.SetCell(i+1, 1, tview.NewTableCell(tview.Escape(po)))
+		l.SetCell(i+1, 2, tview.NewTableCell(tview.Escape(m.Container)))
+		l.SetCell(i+1, 3, tview.NewTableCell(highlightMatches(l.rx, msg)).SetExpansion(1))
+	}
+	if len(l.matches) > 0 {
+		l.Select(1, 0)
+	}
+}
+
+// highlightMatches escapes a line and colorizes the pattern matches.
+func highlightMatches(rx *regexp.Regexp, s string) string {
+	var (
+		b    strings.Builder
+		last int
+	)
+	for _, loc := range rx.FindAllStringIndex(s, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+		b.WriteString(tview.Escape(s[last:loc[0]]))
+		b.WriteString("[orange::b]" + tview.Escape(s[loc[0]:loc[1]]) + "[-::-]")
+		last = loc[1]
+	}
+	b.WriteString(tview.Escape(s[last:]))
+
+	return b.String()
+}
+
+// jumpCmd shows the selected match container logs filtered by the pattern.
+func (l *LogGrep) jumpCmd(*tcell.EventKey) *tcell.EventKey {
+	row, _ := l.GetSelection()
+	if row < 1 || row > len(l.matches) {
+		return nil
+	}
+	m := l.matches[row-1]
+	cfg := l.app.Config.K9s.Logger
+	opts := dao.LogOptions{
+		Path:            m.Path,
+		Container:       m.Container,
+		Lines:           cfg.TailCount,
+		SinceSeconds:    cfg.SinceSeconds,
+		SingleContainer: true,
+		ShowTimestamp:   cfg.ShowTime,
+	}
+	v := NewLog(client.PodGVR, &opts)
+	v.initFilter = l.rx.String()
+	if err := l.app.inject(v, false); err != nil {
+		l.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (l *LogGrep) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	l.refresh()
+
+	return nil
+}
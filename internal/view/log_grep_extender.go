@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const logGrepInput = "pattern"
+
+// SelectorFunc returns the pods selector of a workload.
+type SelectorFunc func(path string) (*metav1.LabelSelector, error)
+
+// LogGrepExtender adds pods logs search actions to a given viewer.
+type LogGrepExtender struct {
+	ResourceViewer
+
+	selectorFn SelectorFunc
+}
+
+// NewLogGrepExtender returns a new extender.
+func NewLogGrepExtender(v ResourceViewer, f SelectorFunc) ResourceViewer {
+	l := LogGrepExtender{
+		ResourceViewer: v,
+		selectorFn:     f,
+	}
+	l.AddBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+// BindKeys injects new menu actions.
+func (l *LogGrepExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftL, ui.NewKeyAction("Grep Logs", l.grepCmd, true))
+}
+
+func (l *LogGrepExtender) grepCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := l.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, _ := client.Namespaced(path)
+	if _, err := l.App().factory.CanForResource(ns, client.PodGVR, client.ListAccess); err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	sel, err := l.selectorFn(path)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	lsel, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+
+	inputs := []config.PluginInput{
+		{Name: logGrepInput, Label: "Pattern (regex)", Type: config.InputTypeString, Required: true},
+	}
+	d := l.App().Styles.Dialog()
+	dialog.ShowPluginInputs(&d, l.App().Content.Pages, fmt.Sprintf("Grep %s Logs", singularize(l.GVR().R())), inputs,
+		func(msg string) {
+			l.App().Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			rx, err := regexp.Compile(vv[logGrepInput])
+			if err != nil {
+				l.App().Flash().Errf("Invalid pattern: %s", err)
+				return
+			}
+			if err := l.App().inject(NewLogGrep(l.App(), path, lsel, rx), false); err != nil {
+				l.App().Flash().Err(err)
+			}
+		},
+		func() {},
+	)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightMatches(t *testing.T) {
+	uu := map[string]struct {
+		rx, s, e string
+	}{
+		"none": {
+			rx: `boom`,
+			s:  "all good",
+			e:  "all good",
+		},
+		"multi": {
+			rx: `(?i)error`,
+			s:  "Error: disk error",
+			e:  "[orange::b]Error[-::-]: disk [orange::b]error[-::-]",
+		},
+		"escaped": {
+			rx: `fail`,
+			s:  "[app] fail [x]",
+			e:  "[app[] [orange::b]fail[-::-] [x[]",
+		},
+		"empty-match": {
+			rx: `z*`,
+			s:  "blee",
+			e:  "blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, highlightMatches(regexp.MustCompile(u.rx), u.s))
+		})
+	}
+}
@@ -26,6 +26,7 @@ type LogIndicator struct {
 	allContainers              bool
 	shouldDisplayAllContainers bool
 	columnLock                 bool
+	json                       bool
 }
 
 // NewLogIndicator returns a new indicator.
@@ -86,6 +87,17 @@ func (l *LogIndicator) FullScreen() bool {
 	return l.fullScreen
 }
 
+// JSON reports the current structured logs mode.
+func (l *LogIndicator) JSON() bool {
+	return l.json
+}
+
+// SetJSON sets the structured logs mode.
+func (l *LogIndicator) SetJSON(b bool) {
+	l.json = b
+	l.Refresh()
+}
+
 // ToggleColumnLock toggles the current column lock mode.
 func (l *LogIndicator) ToggleColumnLock() {
 	l.columnLock = !l.columnLock
@@ -165,6 +177,12 @@ func (l *LogIndicator) Refresh() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "FullScreen", spacer)...)
 	}
 
+	if l.JSON() {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "JSON", spacer)...)
+	} else {
+		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "JSON", spacer)...)
+	}
+
 	if l.Timestamp() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOnFmt, "Timestamps", spacer)...)
 	} else {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/stretchr/testify/assert"
@@ -27,10 +28,10 @@ func TestLogAutoScroll(t *testing.T) {
 	v.GetModel().Set(ii)
 	v.GetModel().Notify()
 
-	assert.Len(t, v.Hints(), 18)
+	assert.Len(t, v.Hints(), 21)
 
 	v.toggleAutoScrollCmd(nil)
-	assert.Equal(t, "Autoscroll:Off     ColumnLock:Off     FullScreen:Off     Timestamps:Off     Wrap:Off", v.Indicator().GetText(true))
+	assert.Equal(t, "Autoscroll:Off     ColumnLock:Off     FullScreen:Off     JSON:Off     Timestamps:Off     Wrap:Off", v.Indicator().GetText(true))
 }
 
 func TestLogColumnLock(t *testing.T) {
@@ -160,8 +161,9 @@ func (l *logList) LogChanged(ll [][]byte) {
 		l.lines += string(line)
 	}
 }
-func (*logList) LogCanceled()      {}
-func (*logList) LogStop()          {}
-func (*logList) LogResume()        {}
-func (l *logList) LogCleared()     { l.clear++ }
-func (l *logList) LogFailed(error) { l.fail++ }
+func (*logList) LogCanceled()                                 {}
+func (*logList) LogStop()                                     {}
+func (*logList) LogResume()                                   {}
+func (l *logList) LogCleared()                                { l.clear++ }
+func (l *logList) LogFailed(error)                            { l.fail++ }
+func (*logList) LogAlerted(*dao.LogItem, config.LogHighlight) {}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	jsonFieldsInput = "fields"
+	jsonHiddenInput = "hidden"
+	jsonLevelInput  = "level"
+	jsonPrettyInput = "pretty"
+	jsonAnyLevel    = "any"
+)
+
+// initJSON resolves the structured logs settings from the configuration and pod annotations.
+func (l *Log) initJSON() {
+	var meta *metav1.ObjectMeta
+	if path := l.model.GetPath(); path != "" && l.app.factory != nil && isPodLog(l.model.GVR()) {
+		if po, err := fetchPod(l.app.factory, path); err == nil {
+			meta = &po.ObjectMeta
+		} else {
+			slog.Debug("Unable to resolve structured logs annotations",
+				slogs.FQN, path,
+				slogs.Error, err,
+			)
+		}
+	}
+	cfg := dao.ContainerLogJSON(meta, l.model.GetContainer(), l.app.Config.K9s.Logger.JSON)
+	l.model.SetJSON(cfg)
+	l.indicator.SetJSON(cfg.Enabled)
+}
+
+func isPodLog(gvr *client.GVR) bool {
+	return gvr == client.PodGVR || gvr == client.CoGVR
+}
+
+func (l *Log) toggleJSONCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.indicator.SetJSON(!l.indicator.JSON())
+	l.model.ToggleJSON(l.indicator.JSON())
+
+	return nil
+}
+
+func (l *Log) jsonOptionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	cfg := l.model.JSON()
+	level := cfg.Level
+	if level == "" {
+		level = jsonAnyLevel
+	}
+	inputs := []config.PluginInput{
+		{Name: jsonFieldsInput, Label: "Show Fields", Type: config.InputTypeString, Default: strings.Join(cfg.Fields, ",")},
+		{Name: jsonHiddenInput, Label: "Hide Fields", Type: config.InputTypeString, Default: strings.Join(cfg.HiddenFields, ",")},
+		{Name: jsonLevelInput, Label: "Min Level", Type: config.InputTypeDropdown, Options: append([]string{jsonAnyLevel}, config.LogLevels...), Default: level},
+		{Name: jsonPrettyInput, Label: "Pretty Print", Type: config.InputTypeBool, Default: strconv.FormatBool(cfg.Pretty)},
+	}
+	d := l.app.Styles.Dialog()
+	dialog.ShowPluginInputs(&d, l.app.Content.Pages, "Structured Logs", inputs,
+		func(msg string) {
+			l.app.Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			cfg.Fields, cfg.HiddenFields = splitFields(vv[jsonFieldsInput]), splitFields(vv[jsonHiddenInput])
+			cfg.Level, cfg.Pretty, cfg.Enabled = vv[jsonLevelInput], vv[jsonPrettyInput] == "true", true
+			if cfg.Level == jsonAnyLevel {
+				cfg.Level = ""
+			}
+			l.indicator.SetJSON(true)
+			l.model.SetJSON(cfg.Validate())
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func splitFields(s string) []string {
+	var ff []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			ff = append(ff, f)
+		}
+	}
+
+	return ff
+}
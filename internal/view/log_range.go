@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	logFromInput  = "from"
+	logUntilInput = "until"
+)
+
+func (l *Log) timeRangeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	opts := l.model.LogOptions()
+	inputs := []config.PluginInput{
+		{Name: logFromInput, Label: "From (1h, 2d, 2006-01-02 15:04)", Type: config.InputTypeString, Default: rangeTime(opts.From)},
+		{Name: logUntilInput, Label: "Until (blank to follow)", Type: config.InputTypeString, Default: rangeTime(opts.Until)},
+	}
+	d := l.app.Styles.Dialog()
+	dialog.ShowPluginInputs(&d, l.app.Content.Pages, "Logs Time Range", inputs,
+		func(msg string) {
+			l.app.Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			now := time.Now()
+			from, err := dao.ParseLogTime(vv[logFromInput], now)
+			if err != nil {
+				l.app.Flash().Err(err)
+				return
+			}
+			until, err := dao.ParseLogTime(vv[logUntilInput], now)
+			if err != nil {
+				l.app.Flash().Err(err)
+				return
+			}
+			l.logs.Clear()
+			if name, ok := l.model.BackendName(); ok && !from.IsZero() {
+				l.app.Flash().Infof("Fetching historical logs from %s...", name)
+			}
+			if err := l.model.SetTimeRange(l.getContext(), from, until); err != nil {
+				l.app.Flash().Err(err)
+				return
+			}
+			l.requestOneRefresh = true
+			l.updateTitle()
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func rangeTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Local().Format(time.DateTime)
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// logsScope tracks the plugins scope for the log view.
+const logsScope = "logs"
+
+// bindSinks binds log sink plugins scoped to the log view.
+func (l *Log) bindSinks() {
+	if l.app.Conn() == nil || !l.app.Conn().ConnectionOK() {
+		return
+	}
+	path, err := l.app.Config.ContextPluginsPath()
+	if err != nil {
+		slog.Warn("Unable to resolve plugins path", slogs.Error, err)
+		return
+	}
+	pp := config.NewPlugins()
+	if err := pp.Load(path, true); err != nil {
+		slog.Warn("Plugins load failed", slogs.Error, err)
+	}
+
+	names := make([]string, 0, len(pp.Plugins))
+	for k := range pp.Plugins {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var (
+		errs error
+		aa   = l.logs.Actions()
+	)
+	for _, k := range names {
+		p := pp.Plugins[k]
+		if !p.IsSink() || !inScope(p.Scopes, sets.New(logsScope)) {
+			continue
+		}
+		key, err := asKey(p.ShortCut)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if _, ok := aa.Get(key); ok && !p.Override {
+			errs = errors.Join(errs, fmt.Errorf("duplicate plugin key found for %q in %q", p.ShortCut, k))
+			continue
+		}
+		aa.Add(key, ui.NewKeyActionWithOpts(
+			p.Description,
+			l.sinkCmd(k, &p),
+			ui.ActionOpts{
+				Visible: true,
+				Plugin:  true,
+			},
+		))
+	}
+	if errs != nil {
+		l.app.Flash().Warnf("Log sinks load failed: %s", errs)
+	}
+}
+
+// sinkCmd either sends the current buffer or toggles streaming to a log sink.
+func (l *Log) sinkCmd(name string, p *config.Plugin) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if l.app.InCmdMode() {
+			return evt
+		}
+		if p.Sink.Stream && l.model.HasSink(name) {
+			if err := l.model.RemoveSink(name); err != nil {
+				l.app.Flash().Err(err)
+				return nil
+			}
+			l.app.Flash().Infof("Log sink %q stopped", p.Description)
+			return nil
+		}
+
+		spec, err := l.sinkSpec(p)
+		if err != nil {
+			l.app.Flash().Err(err)
+			return nil
+		}
+		if p.Sink.Stream {
+			spec.Stream = true
+			s, err := dao.NewLogSink(context.Background(), spec)
+			if err != nil {
+				l.app.Flash().Err(err)
+				return nil
+			}
+			l.model.AddSink(name, s)
+			l.app.Flash().Infof("Log sink %q streaming...", p.Description)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), l.app.Conn().Config().CallTimeout())
+		s, err := dao.NewLogSink(ctx, spec)
+		if err != nil {
+			cancel()
+			l.app.Flash().Err(err)
+			return nil
+		}
+		l.app.Flash().Infof("Sending logs to %q...", p.Description)
+		go func() {
+			defer cancel()
+			if err := l.model.SendTo(s); err != nil {
+				slog.Error("Log sink failed", slogs.Plugin, name, slogs.Error, err)
+				l.app.Flash().Err(err)
+				return
+			}
+			l.app.Flash().Infof("Logs sent to %q", p.Description)
+		}()
+
+		return nil
+	}
+}
+
+// sinkSpec resolves a sink plugin endpoint with the log view environment.
+func (l *Log) sinkSpec(p *config.Plugin) (dao.LogSinkSpec, error) {
+	env := l.sinkEnv()
+	spec := dao.LogSinkSpec{
+		Method:  p.Sink.Method,
+		Headers: make(map[string]string, len(p.Sink.Headers)),
+		Binary:  p.Command,
+		Args:    make([]string, 0, len(p.Args)),
+	}
+	var err error
+	if spec.URL, err = env.Substitute(p.Sink.URL); err != nil {
+		return spec, err
+	}
+	for k, v := range p.Sink.Headers {
+		if spec.Headers[k], err = env.Substitute(v); err != nil {
+			return spec, err
+		}
+	}
+	for _, a := range p.Args {
+		arg, err := env.Substitute(a)
+		if err != nil {
+			return spec, err
+		}
+		spec.Args = append(spec.Args, arg)
+	}
+	for k, v := range env {
+		spec.Env = append(spec.Env, k+"="+v)
+	}
+
+	return spec, nil
+}
+
+func (l *Log) sinkEnv() Env {
+	env := k8sEnv(l.app.Conn().Config())
+	ns, n := client.Namespaced(l.model.GetPath())
+	env["NAMESPACE"], env["NAME"] = ns, n
+	env["POD"], env["CONTAINER"] = n, l.model.GetContainer()
+
+	return env
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Monitor presents the health probes viewer.
+type Monitor struct {
+	ResourceViewer
+}
+
+// NewMonitor returns a new viewer.
+func NewMonitor(gvr *client.GVR) ResourceViewer {
+	m := Monitor{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetSortCol("NAME", true)
+	m.GetTable().SetEnterFn(m.showResource)
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *Monitor) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", m.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Latency", m.GetTable().SortColCmd("LATENCY", false), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Uptime", m.GetTable().SortColCmd("UPTIME", true), false),
+	})
+}
+
+// showResource navigates to the probed resource.
+func (*Monitor) showResource(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	kind, fqn, ok := strings.Cut(path, ":")
+	if !ok {
+		return
+	}
+	ns, _ := client.Namespaced(fqn)
+	app.gotoResource(kind+" "+ns, fqn, false, true)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// MonitorExtender adds synthetic health checks extensions.
+type MonitorExtender struct {
+	ResourceViewer
+}
+
+// NewMonitorExtender returns a new extender.
+func NewMonitorExtender(r ResourceViewer) ResourceViewer {
+	m := MonitorExtender{ResourceViewer: r}
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *MonitorExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyM, ui.NewKeyAction("Toggle Monitor", m.toggleMonitorCmd, true))
+}
+
+func (m *MonitorExtender) toggleMonitorCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := m.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if dao.Monitor().Remove(dao.ProbeID(m.GVR(), path)) {
+		m.App().Flash().Infof("Monitor stopped for %s", path)
+		return nil
+	}
+	p, err := newProbe(m.App(), m.GVR(), path)
+	if err != nil {
+		m.App().Flash().Err(err)
+		return nil
+	}
+	dao.Monitor().Add(p)
+	m.App().Flash().Infof("Monitoring %s via %s", path, p.URL())
+
+	return nil
+}
+
+func newProbe(a *App, gvr *client.GVR, path string) (*dao.Probe, error) {
+	cfg := a.Config.K9s.Monitor
+	switch gvr {
+	case client.SvcGVR:
+		return dao.NewServiceProbe(a.factory, path, cfg.PathOrDefault())
+	case client.IngGVR:
+		return dao.NewIngressProbe(a.factory, path, cfg.PathOrDefault())
+	default:
+		return nil, fmt.Errorf("monitors are not supported on %s", gvr)
+	}
+}
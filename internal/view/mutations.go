@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Mutations presents the session mutations journal viewer.
+type Mutations struct {
+	ResourceViewer
+}
+
+// NewMutations returns a new viewer.
+func NewMutations(gvr *client.GVR) ResourceViewer {
+	m := Mutations{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetSortCol("ID", false)
+	m.GetTable().SetEnterFn(m.showManifest)
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *Mutations) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyU: ui.NewKeyActionWithOpts("Undo", m.undoCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftU: ui.NewKeyActionWithOpts("Redo", m.redoCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftI: ui.NewKeyAction("Sort ID", m.GetTable().SortColCmd("ID", false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Operation", m.GetTable().SortColCmd("OPERATION", true), false),
+	})
+}
+
+func (m *Mutations) undoCmd(*tcell.EventKey) *tcell.EventKey {
+	m.App().undoCmd()
+	m.Refresh()
+
+	return nil
+}
+
+func (m *Mutations) redoCmd(*tcell.EventKey) *tcell.EventKey {
+	m.App().redoCmd()
+	m.Refresh()
+
+	return nil
+}
+
+func (*Mutations) showManifest(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	var mm dao.Mutations
+	raw, err := mm.Manifest(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Prior State", "mutation "+path, contentYAML, true).Update(raw)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
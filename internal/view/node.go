@@ -6,11 +6,14 @@ package view
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -19,6 +22,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const nodeLogQueryInput = "query"
+
 // Node represents a node view.
 type Node struct {
 	ResourceViewer
@@ -66,6 +71,14 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Dangerous: true,
 			},
 		),
+		ui.KeyShiftB: ui.NewKeyActionWithOpts(
+			"Bulk Action",
+			n.bulkCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
 	})
 	ct, err := n.App().Config.K9s.ActiveContext()
 	if err != nil {
@@ -83,10 +96,58 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 	}
 
 	aa.Bulk(ui.KeyMap{
-		ui.KeyY: ui.NewKeyAction(yamlAction, n.yamlCmd, true),
+		ui.KeyY:      ui.NewKeyAction(yamlAction, n.yamlCmd, true),
+		ui.KeyL:      ui.NewKeyAction("Logs", n.logsCmd, true),
+		ui.KeyShiftL: ui.NewKeyAction("Logs Query", n.logsQueryCmd, true),
 	})
 }
 
+func (n *Node) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	n.showLogs(path, dao.DefaultNodeLogQuery)
+
+	return nil
+}
+
+func (n *Node) logsQueryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	inputs := []config.PluginInput{
+		{Name: nodeLogQueryInput, Label: "Service or /var/log file", Type: config.InputTypeString, Required: true, Default: dao.DefaultNodeLogQuery},
+	}
+	d := n.App().Styles.Dialog()
+	dialog.ShowPluginInputs(&d, n.App().Content.Pages, "Node Logs "+path, inputs,
+		func(msg string) {
+			n.App().Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			n.showLogs(path, vv[nodeLogQueryInput])
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func (n *Node) showLogs(path, query string) {
+	cfg := n.App().Config.K9s.Logger
+	opts := dao.LogOptions{
+		Path:          path,
+		Container:     query,
+		Lines:         cfg.TailCount,
+		ShowTimestamp: cfg.ShowTime,
+	}
+	if err := n.App().inject(NewLog(n.GVR(), &opts), false); err != nil {
+		n.App().Flash().Err(err)
+	}
+}
+
 func (n *Node) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
 	showPods(a, n.GetTable().GetSelectedItem(), nil, "spec.nodeName="+path)
 }
@@ -118,20 +179,58 @@ func drainNode(v ResourceViewer, sels []string, opts dao.DrainOptions) {
 		return
 	}
 
-	v.Stop()
-	defer v.Start()
-	{
-		d := NewDetails(v.App(), "Drain Progress", "nodes", contentYAML, true)
-		if err := v.App().inject(d, false); err != nil {
-			v.App().Flash().Err(err)
-		}
+	ctx, cancel := context.WithCancel(context.Background())
+	t := newDrainTracker(sels)
+	title := "Drain Progress"
+	if dao.IsDryRun() {
+		title += " (dry run)"
+	}
+	d := NewDetails(v.App(), title, "nodes", contentYAML, true).Update(t.render())
+	d.Actions().Add(ui.KeyX, ui.NewKeyAction("Cancel Drain", func(*tcell.EventKey) *tcell.EventKey {
+		cancel()
+		v.App().Flash().Warn("Canceling drain...")
+		return nil
+	}, true))
+	opts.OnProgress = func(evt dao.DrainEvent) {
+		t.update(evt)
+		v.App().QueueUpdateDraw(func() {
+			d.Update(t.render())
+		})
+	}
+	if err := v.App().inject(d, false); err != nil {
+		v.App().Flash().Err(err)
+	}
+
+	go func() {
+		defer cancel()
+		var errs int
 		for _, sel := range sels {
-			if err := m.Drain(sel, opts, d.GetWriter()); err != nil {
-				v.App().Flash().Err(err)
+			if ctx.Err() != nil {
+				break
+			}
+			err := m.Drain(ctx, sel, opts, io.Discard)
+			v.App().auditResult(audit.DrainAction, v.GVR(), sel, err)
+			if err != nil {
+				errs++
 			}
+			t.nodeDone(sel, err)
 		}
+		// Capture the cancel state now since the deferred cancel fires before the draw.
+		canceled := ctx.Err() != nil
+		t.finish(canceled)
+		v.App().QueueUpdateDraw(func() {
+			d.Update(t.render())
+			switch {
+			case canceled:
+				v.App().Flash().Warn("Drain canceled")
+			case errs > 0:
+				v.App().Flash().Errf("Drain failed for %d/%d nodes", errs, len(sels))
+			default:
+				v.App().Flash().Info(dryRunMsg(fmt.Sprintf("Drained %d nodes", len(sels))))
+			}
+		})
 		v.Refresh()
-	}
+	}()
 }
 
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
@@ -164,12 +263,22 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
-			for _, s := range sels {
-				if err := m.ToggleCordon(s, cordon); err != nil {
-					n.App().Flash().Err(err)
-				}
+			action := audit.UncordonAction
+			if cordon {
+				action = audit.CordonAction
 			}
-			n.Refresh()
+			go func() {
+				for _, s := range sels {
+					err := m.ToggleCordon(s, cordon)
+					n.App().auditResult(action, n.GVR(), s, err)
+					if err != nil {
+						n.App().QueueUpdateDraw(func() {
+							n.App().Flash().Err(err)
+						})
+					}
+				}
+				n.Refresh()
+			}()
 		}, func() {})
 
 		return nil
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const labelsInput = "labels"
+
+var nodeOps = []dao.NodeOp{
+	dao.NodeCordon,
+	dao.NodeUncordon,
+	dao.NodeLabel,
+	dao.NodeTaint,
+}
+
+var nodeOpActions = map[dao.NodeOp]string{
+	dao.NodeCordon:   audit.CordonAction,
+	dao.NodeUncordon: audit.UncordonAction,
+	dao.NodeLabel:    audit.LabelAction,
+	dao.NodeTaint:    audit.TaintAction,
+}
+
+func (n *Node) bulkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := n.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+
+	opts := make([]string, 0, len(nodeOps))
+	for _, op := range nodeOps {
+		opts = append(opts, string(op))
+	}
+	d := n.App().Styles.Dialog()
+	dialog.ShowSelection(&d, n.App().Content.Pages, fmt.Sprintf("Bulk Action [%d]", len(paths)), opts, func(i int) {
+		if i < 0 || i >= len(nodeOps) {
+			return
+		}
+		n.showBulkDialog(nodeOps[i], paths)
+	})
+
+	return nil
+}
+
+func (n *Node) showBulkDialog(op dao.NodeOp, paths []string) {
+	d := n.App().Styles.Dialog()
+	msg := fmt.Sprintf("%s %d marked nodes?", op, len(paths))
+	switch op {
+	case dao.NodeCordon, dao.NodeUncordon:
+		dialog.ShowConfirm(&d, n.App().Content.Pages, "Confirm "+string(op), msg, func() {
+			n.runBulk(paths, &dao.NodeBulkOpts{Op: op})
+		}, func() {})
+	case dao.NodeLabel:
+		inputs := []config.PluginInput{
+			{Name: labelsInput, Label: "Labels (k=v,k-)", Type: config.InputTypeString, Required: true},
+		}
+		dialog.ShowPluginInputs(&d, n.App().Content.Pages, msg, inputs,
+			func(msg string) {
+				n.App().Flash().Warn(msg)
+			},
+			func(vv dialog.PluginInputValues) {
+				ll, err := dao.ParseNodeLabels(vv[labelsInput])
+				if err != nil {
+					n.App().Flash().Err(err)
+					return
+				}
+				n.runBulk(paths, &dao.NodeBulkOpts{Op: op, Labels: ll})
+			},
+			func() {},
+		)
+	case dao.NodeTaint:
+		tt, err := n.editTaints()
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		if tt == nil {
+			return
+		}
+		n.runBulk(paths, &dao.NodeBulkOpts{Op: op, Taints: tt})
+	}
+}
+
+// editTaints opens the taints template in the user's editor.
+func (n *Node) editTaints() ([]dao.TaintSpec, error) {
+	f, err := os.CreateTemp("", "k9s-taints-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.WriteString(dao.NodeTaintsTemplate); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	n.Stop()
+	defer n.Start()
+	if !edit(n.App(), &shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, nil
+	}
+	raw, err := os.ReadFile(f.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return dao.ParseNodeTaints(raw)
+}
+
+func (n *Node) runBulk(paths []string, opts *dao.NodeBulkOpts) {
+	var no dao.Node
+	no.Init(n.App().factory, n.GVR())
+
+	n.App().Flash().Infof("%s %d nodes...", opts.Op, len(paths))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+		defer cancel()
+		rr := no.Bulk(ctx, paths, opts)
+		for _, r := range rr {
+			n.App().auditResult(nodeOpActions[opts.Op], n.GVR(), r.Path, r.Err)
+		}
+		n.App().QueueUpdateDraw(func() {
+			n.bulkDone(opts.Op, rr)
+		})
+	}()
+}
+
+func (n *Node) bulkDone(op dao.NodeOp, rr []dao.NodeResult) {
+	var errs int
+	for _, r := range rr {
+		if r.Err != nil {
+			errs++
+			continue
+		}
+		n.GetTable().DeleteMark(r.Path)
+	}
+	if errs == 0 {
+		n.App().Flash().Infof("%s succeeded for %d nodes", op, len(rr))
+	} else {
+		n.App().Flash().Warnf("%s failed for %d/%d nodes", op, errs, len(rr))
+	}
+	n.Refresh()
+
+	details := NewDetails(n.App(), fmt.Sprintf("Bulk %s", op), n.GVR().R(), contentYAML, true).Update(nodeBulkReport(rr))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+}
+
+func nodeBulkReport(rr []dao.NodeResult) string {
+	var b strings.Builder
+	b.WriteString("results:\n")
+	for _, r := range rr {
+		fmt.Fprintf(&b, "- node: %s\n", r.Path)
+		if r.Err != nil {
+			fmt.Fprintf(&b, "  status: failed\n  error: %q\n", r.Err.Error())
+			continue
+		}
+		b.WriteString("  status: ok\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// NodeCapacity presents a nodes allocatable vs requested vs used resources viewer.
+type NodeCapacity struct {
+	ResourceViewer
+}
+
+// NewNodeCapacity returns a new viewer.
+func NewNodeCapacity(gvr *client.GVR) ResourceViewer {
+	n := NodeCapacity{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetEnterFn(n.showPods)
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetSortCol("%CPU/R", false)
+
+	return &n
+}
+
+func (n *NodeCapacity) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, ui.KeyShiftS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Sort %CPU/R", n.GetTable().SortColCmd("%CPU/R", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort %MEM/R", n.GetTable().SortColCmd("%MEM/R", false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort %CPU/U", n.GetTable().SortColCmd("%CPU/U", false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort %MEM/U", n.GetTable().SortColCmd("%MEM/U", false), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
+	})
+}
+
+func (n *NodeCapacity) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
+	showPods(a, n.GetTable().GetSelectedItem(), nil, "spec.nodeName="+path)
+}
@@ -4,8 +4,13 @@
 package view
 
 import (
+	"log/slog"
+	"strconv"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -19,6 +24,8 @@ const (
 // Namespace represents a namespace viewer.
 type Namespace struct {
 	ResourceViewer
+
+	favorites map[int]string
 }
 
 // NewNamespace returns a new viewer.
@@ -37,11 +44,46 @@ func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
 	})
+	n.favoriteActions(aa)
+}
+
+// favoriteActions binds number keys to favorite namespaces.
+func (n *Namespace) favoriteActions(aa *ui.KeyActions) {
+	n.favorites = make(map[int]string, data.MaxFavoritesNS)
+	index := 1
+	for _, ns := range n.App().Config.FavNamespaces() {
+		if ns == client.NamespaceAll {
+			continue
+		}
+		numKey, ok := ui.NumKeys[index]
+		if !ok {
+			break
+		}
+		aa.Add(numKey, ui.NewKeyAction(ns, n.favoriteCmd, true))
+		n.favorites[index] = ns
+		index++
+	}
+}
+
+func (n *Namespace) favoriteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	i, err := strconv.Atoi(string(evt.Rune()))
+	if err != nil {
+		slog.Error("Unable to convert keystroke", slogs.Error, err)
+		return nil
+	}
+	if ns, ok := n.favorites[i]; ok {
+		n.switchNs(n.App(), nil, nil, ns)
+	}
+
+	return nil
 }
 
 func (n *Namespace) switchNs(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 	n.useNamespace(path)
 	_, ns := client.Namespaced(path)
+	if app.gotoLanding(ns) {
+		return
+	}
 	app.gotoResource(client.PodGVR.String()+" "+ns, "", false, true)
 }
 
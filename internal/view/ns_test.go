@@ -17,5 +17,5 @@ func TestNSCleanser(t *testing.T) {
 
 	require.NoError(t, ns.Init(makeCtx(t)))
 	assert.Equal(t, "Namespaces", ns.Name())
-	assert.Len(t, ns.Hints(), 8)
+	assert.Len(t, ns.Hints(), 10)
 }
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Permission presents a subject effective permissions matrix across all its bound roles.
+type Permission struct {
+	ResourceViewer
+
+	subjectKind, subjectName string
+}
+
+// NewPermission returns a new viewer.
+func NewPermission(kind, name string) *Permission {
+	p := Permission{
+		ResourceViewer: NewBrowser(client.PermGVR),
+		subjectKind:    kind,
+		subjectName:    name,
+	}
+	p.AddBindKeysFn(p.bindKeys)
+	p.GetTable().SetSortCol("SCOPE", true)
+	p.SetContextFn(p.subjectCtx)
+	p.GetTable().SetEnterFn(blankEnterFn)
+
+	return &p
+}
+
+func (p *Permission) subjectCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeySubjectKind, p.subjectKind)
+	ctx = context.WithValue(ctx, internal.KeyPath, p.subjectKind+":"+p.subjectName)
+	return context.WithValue(ctx, internal.KeySubjectName, p.subjectName)
+}
+
+func (p *Permission) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Scope", p.GetTable().SortColCmd("SCOPE", true), false),
+		ui.KeyShiftG: ui.NewKeyAction("Sort API-Group", p.GetTable().SortColCmd("API-GROUP", true), false),
+	})
+}
@@ -175,6 +175,11 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d := p.App().Styles.Dialog()
 	dialog.ShowConfirm(&d, p.App().Content.Pages, "Delete", msg, func() {
 		for _, s := range selections {
+			if fwd, ok := p.App().factory.ForwarderFor(s); ok {
+				if pf, ok := fwd.(*dao.PortForwarder); ok {
+					unpersistForward(p.App(), pf)
+				}
+			}
 			var pf dao.PortForward
 			pf.Init(p.App().factory, client.PfGVR)
 			if err := pf.Delete(context.Background(), s, nil, dao.DefaultGrace); err != nil {
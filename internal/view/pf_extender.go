@@ -128,21 +128,14 @@ func ensurePodPortFwdAllowed(factory dao.Factory, podName string) error {
 	return nil
 }
 
-func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForwarder) {
+func runForward(v ResourceViewer, pf *dao.PortForwarder, f *portforward.PortForwarder) {
 	v.App().factory.AddForwarder(pf)
 
 	v.App().QueueUpdateDraw(func() {
 		DismissPortForwards(v, v.App().Content.Pages)
 	})
 
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		v.App().Flash().Warnf("PortForward failed for %s: %s. Deleting!", pf.ID(), err)
-	}
-	v.App().QueueUpdateDraw(func() {
-		v.App().factory.DeleteForwarder(pf.ID())
-		pf.SetActive(false)
-	})
+	keepForward(v.App(), pf, f)
 }
 
 func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
@@ -150,12 +143,15 @@ func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
 		return err
 	}
 
+	sel := forwardSelector(v, path)
 	tt := make([]string, 0, len(pts))
 	for _, pt := range pts {
 		if _, ok := v.App().factory.ForwarderFor(dao.PortForwardID(path, pt.Container, pt.PortMap())); ok {
 			return fmt.Errorf("port-forward is already active on pod %s", path)
 		}
 		pf := dao.NewPortForwarder(v.App().factory)
+		pf.SetSelector(sel)
+		pf.SetKeepAlive(!v.App().Config.K9s.PortForward.DisableReconnect)
 		fwd, err := pf.Start(path, pt)
 		if err != nil {
 			return err
@@ -164,6 +160,7 @@ func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
 			slogs.PFID, pf.ID(),
 			slogs.PFTunnel, pt,
 		)
+		persistForward(v.App(), pf)
 		go runForward(v, pf, fwd)
 		tt = append(tt, pt.LocalPort)
 	}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/client-go/tools/portforward"
+)
+
+const maxReconnectDelay = 30 * time.Second
+
+var errForwardStopped = errors.New("port-forward stopped")
+
+// keepForward runs a port-forward and re-establishes it when its pod goes away.
+func keepForward(a *App, pf *dao.PortForwarder, f *portforward.PortForwarder) {
+	for {
+		pf.SetActive(true)
+		err := f.ForwardPorts()
+		pf.SetActive(false)
+		if pf.Stopped() {
+			return
+		}
+		if !pf.KeepAlive() || a.Config.K9s.PortForward.DisableReconnect {
+			if err != nil {
+				a.Flash().Warnf("PortForward failed for %s: %s. Deleting!", pf.ID(), err)
+			}
+			a.QueueUpdateDraw(func() {
+				a.factory.DeleteForwarder(pf.ID())
+			})
+			return
+		}
+		slog.Warn("Port-forward lost. Reconnecting...", slogs.PFID, pf.ID(), slogs.Error, err)
+
+		npf, nf, err := reconnectForward(a, pf)
+		if errors.Is(err, errForwardStopped) {
+			return
+		}
+		if err != nil {
+			a.Flash().Warnf("PortForward reconnect failed for %s: %s. Deleting!", pf.ID(), err)
+			a.QueueUpdateDraw(func() {
+				a.factory.DeleteForwarder(pf.ID())
+			})
+			return
+		}
+		a.Flash().Infof("PortForward re-established on %s", npf.Path())
+		pf, f = npf, nf
+	}
+}
+
+// reconnectForward re-resolves a ready backing pod and restarts the forward on it.
+func reconnectForward(a *App, pf *dao.PortForwarder) (*dao.PortForwarder, *portforward.PortForwarder, error) {
+	ns, n := client.Namespaced(pf.Path())
+	po := strings.Split(n, "|")[0]
+	maxRetries := a.Config.K9s.PortForward.MaxRetriesOrDefault()
+	for i := 1; i <= maxRetries; i++ {
+		pf.SetStatus(dao.PFReconnecting, i)
+		time.Sleep(reconnectDelay(i))
+		if pf.Stopped() {
+			return nil, nil, errForwardStopped
+		}
+		fqn, err := dao.ReadyPod(a.factory, ns, pf.Selector(), po)
+		if err != nil {
+			slog.Debug("No ready pod for port-forward", slogs.PFID, pf.ID(), slogs.Retry, i, slogs.Error, err)
+			continue
+		}
+		npf := dao.NewPortForwarder(a.factory)
+		npf.SetSelector(pf.Selector())
+		npf.SetKeepAlive(true)
+		f, err := npf.Start(fqn, pf.Tunnel())
+		if err != nil {
+			slog.Debug("Port-forward restart failed", slogs.PFID, pf.ID(), slogs.Retry, i, slogs.Error, err)
+			continue
+		}
+		a.QueueUpdateDraw(func() {
+			a.factory.DeleteForwarder(pf.ID())
+			a.factory.AddForwarder(npf)
+		})
+		persistForward(a, npf)
+
+		return npf, f, nil
+	}
+	pf.SetStatus(dao.PFFailed, maxRetries)
+
+	return nil, nil, fmt.Errorf("giving up after %d attempts", maxRetries)
+}
+
+// reconnectDelay backs off linearly between reconnect attempts.
+func reconnectDelay(attempt int) time.Duration {
+	return min(time.Duration(attempt)*time.Second, maxReconnectDelay)
+}
+
+// restorePortForwards re-establishes the current context persisted port-forwards.
+func restorePortForwards(a *App) {
+	if !a.Config.K9s.PortForward.Persist {
+		return
+	}
+	restoreForwards(a)
+}
+
+// restoreForwards re-establishes the current context saved port-forwards.
+func restoreForwards(a *App) {
+	if a.factory == nil {
+		return
+	}
+	for _, spec := range a.Config.PortForwards() {
+		go func(spec data.PortForward) {
+			if err := restoreForward(a, spec); err != nil {
+				slog.Warn("Unable to restore port-forward",
+					slogs.FQN, client.FQN(spec.Namespace, spec.Pod),
+					slogs.Port, spec.LocalPort,
+					slogs.Error, err,
+				)
+				a.Flash().Warnf("Unable to restore port-forward %s: %s", spec.LocalPort, err)
+			}
+		}(spec)
+	}
+}
+
+func restoreForward(a *App, spec data.PortForward) error {
+	fqn, err := dao.ReadyPod(a.factory, spec.Namespace, spec.Selector, spec.Pod)
+	if err != nil {
+		return err
+	}
+	pt := port.NewPortTunnel(spec.Address, spec.Container, spec.LocalPort, spec.ContainerPort)
+	if pt.Address == "" {
+		pt.Address = a.Config.K9s.PortForwardAddress
+	}
+	if _, ok := a.factory.ForwarderFor(dao.PortForwardID(fqn, pt.Container, pt.PortMap())); ok {
+		return nil
+	}
+	if err := (port.PortTunnels{pt}).CheckAvailable(context.Background()); err != nil {
+		return err
+	}
+	pf := dao.NewPortForwarder(a.factory)
+	pf.SetSelector(spec.Selector)
+	pf.SetKeepAlive(!a.Config.K9s.PortForward.DisableReconnect)
+	f, err := pf.Start(fqn, pt)
+	if err != nil {
+		return err
+	}
+	a.factory.AddForwarder(pf)
+	a.Flash().Infof("PortForward restored %s", pt.LocalPort)
+	keepForward(a, pf, f)
+
+	return nil
+}
+
+// persistForward saves a port-forward in the current context when forwards or session persistence is on.
+func persistForward(a *App, pf *dao.PortForwarder) {
+	if !a.Config.K9s.PortForward.Persist && !a.Config.K9s.Session.Persist {
+		return
+	}
+	a.Config.AddPortForward(forwardSpec(pf))
+	if err := a.Config.Save(true); err != nil {
+		slog.Error("Unable to persist port-forward", slogs.PFID, pf.ID(), slogs.Error, err)
+	}
+}
+
+// unpersistForward removes a port-forward from the current context persisted forwards.
+func unpersistForward(a *App, pf *dao.PortForwarder) {
+	if !a.Config.RemovePortForward(forwardSpec(pf)) {
+		return
+	}
+	if err := a.Config.Save(true); err != nil {
+		slog.Error("Unable to save port-forwards", slogs.PFID, pf.ID(), slogs.Error, err)
+	}
+}
+
+func forwardSpec(pf *dao.PortForwarder) data.PortForward {
+	ns, n := client.Namespaced(pf.Path())
+	t := pf.Tunnel()
+
+	return data.PortForward{
+		Namespace:     ns,
+		Pod:           strings.Split(n, "|")[0],
+		Selector:      pf.Selector(),
+		Container:     t.Container,
+		Address:       t.Address,
+		LocalPort:     t.LocalPort,
+		ContainerPort: t.ContainerPort,
+	}
+}
+
+// forwardSelector returns the pods selector used to fail over a forward. Services and
+// workloads use their own selector, pods fall back to their owner selector.
+func forwardSelector(v ResourceViewer, path string) string {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		return podSelector(v.App().factory, path)
+	}
+	if s, ok := res.(dao.Selectable); ok {
+		if fqn := v.GetTable().GetSelectedItem(); fqn != "" {
+			sel, err := s.Selector(fqn)
+			if err == nil {
+				return sel
+			}
+			slog.Warn("Unable to resolve resource selector", slogs.FQN, fqn, slogs.Error, err)
+		}
+	}
+
+	return podSelector(v.App().factory, path)
+}
+
+// podSelector returns the pod owner selector or blank if none.
+func podSelector(f dao.Factory, path string) string {
+	path = strings.Split(path, "|")[0]
+	po, err := fetchPod(f, path)
+	if err != nil {
+		return ""
+	}
+	sel, err := dao.PodSelector(f, po)
+	if err != nil {
+		slog.Warn("Unable to resolve pod selector", slogs.FQN, path, slogs.Error, err)
+		return ""
+	}
+
+	return sel
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectDelay(t *testing.T) {
+	uu := map[int]time.Duration{
+		1:   time.Second,
+		5:   5 * time.Second,
+		100: maxReconnectDelay,
+	}
+
+	for k, e := range uu {
+		assert.Equal(t, e, reconnectDelay(k))
+	}
+}
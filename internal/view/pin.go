@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	contentProportion = 10
+	pinProportion     = 5
+)
+
+// pinPane hosts a view pinned below the views stack, ie a log tail or events.
+type pinPane struct {
+	*tview.Flex
+
+	app  *App
+	comp model.Component
+}
+
+func newPinPane(a *App, c model.Component) *pinPane {
+	p := pinPane{
+		Flex: tview.NewFlex().SetDirection(tview.FlexRow),
+		app:  a,
+		comp: c,
+	}
+	p.AddItem(c, 0, 1, true)
+	p.SetInputCapture(p.keyboard)
+
+	return &p
+}
+
+// keyboard hands focus back to the views stack instead of navigating back.
+func (p *pinPane) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	switch ui.AsKey(evt) {
+	case tcell.KeyEscape, ui.KeyQ:
+		if p.comp.InCmdMode() {
+			return evt
+		}
+		p.app.focusContent()
+		return nil
+	}
+
+	return evt
+}
+
+// pinView moves the top view to a pane at the bottom of the screen.
+// The pinned view keeps running while navigating other views above it.
+func (a *App) pinView() error {
+	top := a.Content.Top()
+	if top == nil {
+		return errors.New("no view to pin")
+	}
+	a.unpinView()
+	root := a.Content.IsLast()
+	a.Content.Pop()
+
+	a.pinComponent(top)
+	if root {
+		a.gotoResource(podCmd, "", true, true)
+		return nil
+	}
+	a.focusContent()
+
+	return nil
+}
+
+// pinComponent shows a component in the pinned pane, replacing any pinned view.
+func (a *App) pinComponent(c model.Component) {
+	a.unpinView()
+	a.pin = newPinPane(a, c)
+	a.body.AddItem(a.pin, 0, pinProportion, false)
+	c.Start()
+}
+
+// unpinView closes the pinned pane if any.
+func (a *App) unpinView() {
+	if a.pin == nil {
+		return
+	}
+	a.body.RemoveItem(a.pin)
+	a.pin.comp.Stop()
+	a.pin = nil
+	a.focusContent()
+}
+
+// focusContent focuses the views stack top view.
+func (a *App) focusContent() {
+	if top := a.Content.Top(); top != nil {
+		a.Menu().HydrateMenu(top.Hints())
+		a.SetFocus(top)
+	}
+}
+
+func (a *App) pinCmd(*tcell.EventKey) *tcell.EventKey {
+	if a.pin != nil {
+		a.unpinView()
+		return nil
+	}
+	if err := a.pinView(); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) switchPaneCmd(*tcell.EventKey) *tcell.EventKey {
+	if a.pin == nil {
+		a.Flash().Warn("No pinned view")
+		return nil
+	}
+	if a.pin.HasFocus() {
+		a.focusContent()
+		return nil
+	}
+	a.Menu().HydrateMenu(a.pin.comp.Hints())
+	a.SetFocus(a.pin.comp)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestPinView(t *testing.T) {
+	a := NewApp(mock.NewMockConfig(t))
+	require.NoError(t, a.Init("blee", 10))
+
+	c1, c2 := newPinComp("c1"), newPinComp("c2")
+	a.Content.Push(c1)
+	a.Content.Push(c2)
+	require.NoError(t, a.pinView())
+
+	assert.Equal(t, c1, a.Content.Top())
+	assert.Equal(t, c2, a.pin.comp)
+	assert.True(t, c2.running)
+	assert.Equal(t, a.pin, a.body.ItemAt(1))
+
+	evt := tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Nil(t, a.pin.keyboard(evt))
+	assert.Len(t, a.Content.Peek(), 1)
+
+	a.unpinView()
+	assert.Nil(t, a.pin)
+	assert.False(t, c2.running)
+	assert.Nil(t, a.body.ItemAt(1))
+}
+
+// Helpers...
+
+type pinComp struct {
+	*tview.Box
+
+	name    string
+	running bool
+}
+
+func newPinComp(n string) *pinComp {
+	return &pinComp{Box: tview.NewBox(), name: n}
+}
+
+func (*pinComp) Init(context.Context) error             { return nil }
+func (c *pinComp) Start()                               { c.running = true }
+func (c *pinComp) Stop()                                { c.running = false }
+func (c *pinComp) Name() string                         { return c.name }
+func (*pinComp) Hints() model.MenuHints                 { return nil }
+func (*pinComp) ExtraHints() map[string]string          { return nil }
+func (*pinComp) InCmdMode() bool                        { return false }
+func (*pinComp) SetCommand(*cmd.Interpreter)            {}
+func (*pinComp) SetFilter(string, bool)                 {}
+func (*pinComp) SetLabelSelector(labels.Selector, bool) {}
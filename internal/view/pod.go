@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
@@ -50,26 +51,36 @@ type Pod struct {
 // NewPod returns a new viewer.
 func NewPod(gvr *client.GVR) ResourceViewer {
 	var p Pod
-	p.ResourceViewer = NewPortForwardExtender(
-		NewOwnerExtender(
-			NewVulnerabilityExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), p.logOptions),
+	p.ResourceViewer = NewTimelineExtender(
+		NewRunExtender(
+			NewPortForwardExtender(
+				NewOwnerExtender(
+					NewVulnerabilityExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), p.logOptions),
+						),
+					),
 				),
 			),
+			p.execTargets,
 		),
 	)
 	p.AddBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
-	p.GetTable().SetDecorateFn(p.portForwardIndicator)
+	p.GetTable().SetDecorateFn(p.decorateRows)
 
 	return &p
 }
 
+func (p *Pod) decorateRows(data *model1.TableData) {
+	p.portForwardIndicator(data)
+	logFlagIndicator(data)
+	decorateCpuMemHeaderRows(p.App(), data)
+}
+
 func (p *Pod) portForwardIndicator(data *model1.TableData) {
 	ff := p.App().factory.Forwarders()
 
-	defer decorateCpuMemHeaderRows(p.App(), data)
 	idx, ok := data.IndexOfHeader("PF")
 	if !ok {
 		return
@@ -83,6 +94,30 @@ func (p *Pod) portForwardIndicator(data *model1.TableData) {
 	})
 }
 
+// logFlagIndicator invalidates pods whose logs matched a flagging highlight rule.
+func logFlagIndicator(data *model1.TableData) {
+	idx, ok := data.IndexOfHeader("VALID")
+	if !ok {
+		return
+	}
+
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		pattern, ok := dao.LogPodFlag(re.Row.ID)
+		if !ok || idx >= len(re.Row.Fields) {
+			return true
+		}
+		msg := fmt.Sprintf("log alert %q", pattern)
+		switch v := strings.TrimSpace(re.Row.Fields[idx]); {
+		case strings.Contains(v, msg):
+		case v != "":
+			re.Row.Fields[idx] = v + "," + msg
+		default:
+			re.Row.Fields[idx] = msg
+		}
+		return true
+	})
+}
+
 func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		tcell.KeyCtrlK: ui.NewKeyActionWithOpts(
@@ -120,6 +155,27 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyX: ui.NewKeyActionWithOpts(
+			"Debug",
+			p.debugCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyB: ui.NewKeyActionWithOpts(
+			"Browse Files",
+			p.browseFilesCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftX: ui.NewKeyActionWithOpts(
+			"Broadcast Shell",
+			p.broadcastCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
@@ -184,6 +240,40 @@ func (p *Pod) showNode(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) execTargets() []dao.ExecTarget {
+	paths := p.GetTable().GetSelectedItems()
+	tt := make([]dao.ExecTarget, 0, len(paths))
+	for _, path := range paths {
+		tt = append(tt, dao.ExecTarget{Path: path})
+	}
+
+	return tt
+}
+
+func (p *Pod) browseFilesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := browseFiles(p.App(), path, ""); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) broadcastCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := p.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+	if err := p.App().inject(NewBroadcastExec(p.App(), paths), false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := p.GetTable().GetSelectedItems()
 	if len(selections) == 0 {
@@ -201,15 +291,16 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 	if len(selections) > 1 {
-		p.App().Flash().Infof("Delete %d marked %s", len(selections), p.GVR())
+		p.App().Flash().Info(dryRunMsg(fmt.Sprintf("Delete %d marked %s", len(selections), p.GVR())))
 	} else {
-		p.App().Flash().Infof("Delete resource %s %s", p.GVR(), selections[0])
+		p.App().Flash().Info(dryRunMsg(fmt.Sprintf("Delete resource %s %s", p.GVR(), selections[0])))
 	}
 	p.GetTable().ShowDeleted()
 	for _, path := range selections {
 		if err := nuker.Delete(context.Background(), path, nil, dao.NowGrace); err != nil {
 			p.App().Flash().Errf("Delete failed with %s", err)
 		} else {
+			p.App().audit(audit.DeleteAction, p.GVR(), path)
 			p.App().factory.DeleteForwarder(path)
 		}
 		p.GetTable().DeleteMark(path)
@@ -409,11 +500,25 @@ func shellIn(a *App, fqn, co string) error {
 
 	args := computeShellArgs(fqn, co, a.Conn().Config().Flags(), platform)
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
-	return runK(a, &shellOpts{
+	opts := shellOpts{
 		clear:  true,
 		banner: c.Sprintf(bannerFmt, fqn, co),
-		args:   args},
-	)
+		args:   args,
+	}
+	recordSession(a, &opts, fqn+"-"+co)
+	if opts.record != "" && platform != windowsOS {
+		opts.args[len(opts.args)-1] = sizedShellCheck()
+	}
+	err = runK(a, &opts)
+	a.auditResult(audit.ExecAction, client.PodGVR, fqn, err)
+	if err != nil {
+		return err
+	}
+	if opts.record != "" {
+		a.Flash().Infof("Session recorded to %s", opts.record)
+	}
+
+	return nil
 }
 
 func containerAttachIn(a *App, comp model.Component, path, co string) error {
@@ -453,7 +558,9 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 func attachIn(a *App, path, co string) {
 	args := buildShellArgs("attach", path, co, a.Conn().Config().Flags())
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
-	if err := runK(a, &shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}); err != nil {
+	opts := shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}
+	recordSession(a, &opts, path+"-"+co)
+	if err := runK(a, &opts); err != nil {
 		a.Flash().Errf("Attach exec failed: %s", err)
 	}
 }
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	debugImageInput  = "image"
+	debugTargetInput = "target"
+	debugNoTarget    = "<none>"
+)
+
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	targets := []string{debugNoTarget}
+	for _, co := range pod.Spec.Containers {
+		targets = append(targets, co.Name)
+	}
+	cfg := p.App().Config.K9s.DebugContainer
+	inputs := []config.PluginInput{
+		{Name: debugImageInput, Label: "Image", Type: config.InputTypeString, Required: true, Default: cfg.ImageOrDefault()},
+		{Name: debugTargetInput, Label: "Target Container", Type: config.InputTypeDropdown, Options: targets, Default: targets[len(targets)-1]},
+	}
+	d := p.App().Styles.Dialog()
+	dialog.ShowPluginInputs(&d, p.App().Content.Pages, "Debug "+path, inputs,
+		func(msg string) {
+			p.App().Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			opts := dao.DebugOptions{
+				Image:   vv[debugImageInput],
+				Target:  vv[debugTargetInput],
+				Profile: cfg.Profile,
+				Command: cfg.Command,
+			}
+			if opts.Target == debugNoTarget {
+				opts.Target = ""
+			}
+			p.launchDebug(path, &opts)
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func (p *Pod) launchDebug(path string, opts *dao.DebugOptions) {
+	var po dao.Pod
+	po.Init(p.App().factory, client.PodGVR)
+
+	msg := fmt.Sprintf("Launching debug container on %s...", path)
+	d := p.App().Styles.Dialog()
+	dialog.ShowPrompt(&d, p.App().Content.Pages, "Launching", msg, func(ctx context.Context) {
+		co, err := po.Debug(ctx, path, opts)
+		if err != nil {
+			p.App().QueueUpdate(func() {
+				p.App().Flash().Errf("Debug container injection failed: %s", err)
+			})
+			return
+		}
+		if dao.IsDryRun() {
+			p.App().QueueUpdate(func() {
+				p.App().Flash().Info(dryRunMsg(fmt.Sprintf("Debug container %s injected in %s", co, path)))
+			})
+			return
+		}
+		if err := po.WaitForDebugContainer(ctx, path, co); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				p.App().QueueUpdate(func() {
+					p.App().Flash().Err(err)
+				})
+			}
+			return
+		}
+
+		go resumeAttachIn(p.App(), p, path, co)
+	}, func() {})
+}
@@ -0,0 +1,451 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	podFSTitle      = "Files"
+	podFSFmt        = " %s([hilite:bg:b]%s[fg:bg:-])[[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+	podFSLocalInput = "local"
+	progressEvery   = 256 * 1024
+	transferTimeout = 10 * time.Minute
+)
+
+var podFSHeader = []string{"NAME", "SIZE", "MODE", "MODIFIED"}
+
+// PodFS browses a container filesystem.
+type PodFS struct {
+	*tview.Table
+
+	app      *App
+	path, co string
+	dir      string
+	entries  []dao.FileEntry
+	actions  *ui.KeyActions
+	pod      dao.Pod
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+var _ model.Component = (*PodFS)(nil)
+
+// NewPodFS returns a new container filesystem browser.
+func NewPodFS(app *App, path, co string) *PodFS {
+	return &PodFS{
+		Table:   tview.NewTable(),
+		app:     app,
+		path:    path,
+		co:      co,
+		dir:     "/",
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*PodFS) SetCommand(*cmd.Interpreter)            {}
+func (*PodFS) SetFilter(string, bool)                 {}
+func (*PodFS) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (p *PodFS) Init(context.Context) error {
+	p.pod.Init(p.app.factory, client.PodGVR)
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetSelectable(true, false)
+	p.SetFixed(1, 0)
+	p.updateTitle()
+
+	p.bindKeys()
+	p.SetInputCapture(p.keyboard)
+	p.StylesChanged(p.app.Styles)
+	p.app.Styles.AddListener(p)
+	p.app.audit(audit.ExecAction, client.PodGVR, p.path)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*PodFS) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*PodFS) Name() string { return podFSTitle }
+
+// Start lists the current directory.
+func (p *PodFS) Start() {
+	p.refresh()
+}
+
+// Stop terminates the viewer.
+func (p *PodFS) Stop() {
+	p.mx.Lock()
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+	p.mx.Unlock()
+	p.app.Styles.RemoveListener(p)
+}
+
+// Hints returns menu hints.
+func (p *PodFS) Hints() model.MenuHints {
+	return p.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*PodFS) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (p *PodFS) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	p.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	p.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(s.Table().CursorFgColor.Color()).
+		Background(s.Table().CursorBgColor.Color()).
+		Attributes(tcell.AttrBold))
+	p.render()
+}
+
+func (p *PodFS) bindKeys() {
+	p.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape:     ui.NewKeyAction("Back", p.app.PrevCmd, false),
+		ui.KeyQ:             ui.NewKeyAction("Back", p.app.PrevCmd, false),
+		tcell.KeyEnter:      ui.NewKeyAction("Open", p.openCmd, true),
+		tcell.KeyBackspace2: ui.NewKeyAction("Parent Dir", p.upCmd, true),
+		tcell.KeyBackspace:  ui.NewKeyAction("Parent Dir", p.upCmd, false),
+		ui.KeyR:             ui.NewKeyAction("Refresh", p.refreshCmd, true),
+		ui.KeyD:             ui.NewKeyAction("Download", p.downloadCmd, true),
+	})
+	if !p.app.Config.IsReadOnly() {
+		p.actions.Add(ui.KeyU, ui.NewKeyActionWithOpts("Upload", p.uploadCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
+}
+
+func (p *PodFS) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := p.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (p *PodFS) updateTitle() {
+	styles := p.app.Styles.Frame()
+	p.SetTitle(ui.SkinTitle(fmt.Sprintf(podFSFmt, podFSTitle, p.path+":"+p.co, p.dir), &styles))
+}
+
+func (p *PodFS) context() context.Context {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.cancelFn != nil {
+		p.cancelFn()
+	}
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithTimeout(context.Background(), p.app.Conn().Config().CallTimeout())
+
+	return ctx
+}
+
+func (p *PodFS) refresh() {
+	p.list(p.dir, "")
+}
+
+// list shows a directory content and selects a given entry if any.
+func (p *PodFS) list(dir, sel string) {
+	ctx := p.context()
+	go func() {
+		ee, err := p.pod.ListDir(ctx, p.path, p.co, dir)
+		p.app.QueueUpdateDraw(func() {
+			if err != nil {
+				p.app.Flash().Err(err)
+				return
+			}
+			p.dir, p.entries = dir, ee
+			p.updateTitle()
+			p.render()
+			p.selectEntry(sel)
+		})
+	}()
+}
+
+func (p *PodFS) render() {
+	p.Clear()
+	fg := p.app.Styles.Table().Header.FgColor.Color()
+	for c, h := range podFSHeader {
+		p.SetCell(0, c, tview.NewTableCell(h).SetTextColor(fg).SetAttributes(tcell.AttrBold).SetSelectable(false))
+	}
+	for i, e := range p.entries {
+		name := tview.Escape(e.Name)
+		switch {
+		case e.IsDir():
+			name = "[aqua::b]" + name + "/[-::-]"
+		case e.IsLink():
+			name = "[orange::]" + name + "[-::-] -> " + tview.Escape(e.Link)
+		}
+		p.SetCell(i+1, 0, tview.NewTableCell(name).SetExpansion(1))
+		p.SetCell(i+1, 1, tview.NewTableCell(fileSize(e.Size)).SetAlign(tview.AlignRight))
+		p.SetCell(i+1, 2, tview.NewTableCell(e.Mode))
+		p.SetCell(i+1, 3, tview.NewTableCell(tview.Escape(e.ModTime)))
+	}
+	if len(p.entries) > 0 {
+		p.Select(1, 0)
+	}
+}
+
+func (p *PodFS) selectEntry(name string) {
+	for i, e := range p.entries {
+		if e.Name == name {
+			p.Select(i+1, 0)
+			return
+		}
+	}
+}
+
+func (p *PodFS) selected() (dao.FileEntry, bool) {
+	row, _ := p.GetSelection()
+	if row < 1 || row > len(p.entries) {
+		return dao.FileEntry{}, false
+	}
+
+	return p.entries[row-1], true
+}
+
+func (p *PodFS) openCmd(*tcell.EventKey) *tcell.EventKey {
+	e, ok := p.selected()
+	if !ok {
+		return nil
+	}
+	fqn := path.Join(p.dir, e.Name)
+	if e.IsDir() || e.IsLink() {
+		p.open(fqn, e.IsLink())
+		return nil
+	}
+	p.view(fqn)
+
+	return nil
+}
+
+// open lists a directory. Links that are not directories are viewed instead.
+func (p *PodFS) open(dir string, link bool) {
+	if !link {
+		p.list(dir, "")
+		return
+	}
+	ctx := p.context()
+	go func() {
+		ee, err := p.pod.ListDir(ctx, p.path, p.co, dir)
+		p.app.QueueUpdateDraw(func() {
+			if err != nil {
+				p.view(dir)
+				return
+			}
+			p.dir, p.entries = dir, ee
+			p.updateTitle()
+			p.render()
+		})
+	}()
+}
+
+func (p *PodFS) view(file string) {
+	maxSize := p.app.Config.K9s.FileBrowser.MaxViewSizeOrDefault()
+	ctx := p.context()
+	go func() {
+		bb, truncated, err := p.pod.ReadFile(ctx, p.path, p.co, file, maxSize)
+		p.app.QueueUpdateDraw(func() {
+			if err != nil {
+				p.app.Flash().Err(err)
+				return
+			}
+			if truncated {
+				p.app.Flash().Warnf("File exceeds %s. Showing partial content", fileSize(maxSize))
+			}
+			details := NewDetails(p.app, "File", p.path+":"+file, contentTXT, true).Update(tview.Escape(string(bb)))
+			if err := p.app.inject(details, false); err != nil {
+				p.app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func (p *PodFS) upCmd(*tcell.EventKey) *tcell.EventKey {
+	if p.dir == "/" {
+		return nil
+	}
+	p.list(path.Dir(p.dir), path.Base(p.dir))
+
+	return nil
+}
+
+func (p *PodFS) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	p.refresh()
+
+	return nil
+}
+
+func (p *PodFS) downloadCmd(*tcell.EventKey) *tcell.EventKey {
+	e, ok := p.selected()
+	if !ok {
+		return nil
+	}
+	src := path.Join(p.dir, e.Name)
+	inputs := []config.PluginInput{
+		{
+			Name:     podFSLocalInput,
+			Label:    "Local directory",
+			Type:     config.InputTypeString,
+			Required: true,
+			Default:  p.app.Config.K9s.ContextScreenDumpDir(),
+		},
+	}
+	d := p.app.Styles.Dialog()
+	dialog.ShowPluginInputs(&d, p.app.Content.Pages, "Download "+e.Name, inputs,
+		func(msg string) {
+			p.app.Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			dst := vv[podFSLocalInput]
+			if err := ensureDir(dst); err != nil {
+				p.app.Flash().Err(err)
+				return
+			}
+			p.transfer(trDownload, src, func(ctx context.Context, fn dao.ProgressFunc) error {
+				return p.pod.Download(ctx, p.path, p.co, src, dst, p.app.Config.K9s.FileBrowser.MaxTransferSizeOrDefault(), fn)
+			}, func() {
+				p.app.Flash().Infof("%s downloaded to %s", src, filepath.Join(dst, e.Name))
+			})
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func (p *PodFS) uploadCmd(*tcell.EventKey) *tcell.EventKey {
+	dir := p.dir
+	inputs := []config.PluginInput{
+		{Name: podFSLocalInput, Label: "Local path", Type: config.InputTypeString, Required: true},
+	}
+	d := p.app.Styles.Dialog()
+	dialog.ShowPluginInputs(&d, p.app.Content.Pages, "Upload To "+dir, inputs,
+		func(msg string) {
+			p.app.Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			src := filepath.Clean(vv[podFSLocalInput])
+			p.transfer(trUpload, src, func(ctx context.Context, fn dao.ProgressFunc) error {
+				return p.pod.Upload(ctx, p.path, p.co, src, dir, p.app.Config.K9s.FileBrowser.MaxTransferSizeOrDefault(), fn)
+			}, func() {
+				p.app.Flash().Infof("%s uploaded to %s", src, dir)
+				p.list(dir, filepath.Base(src))
+			})
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+// transfer runs a transfer in the background and reports its progress.
+func (p *PodFS) transfer(op, subject string, run func(context.Context, dao.ProgressFunc) error, done func()) {
+	p.app.Flash().Infof("%s %s...", op, subject)
+	var last int64
+	progress := func(n int64) {
+		if n-last < progressEvery {
+			return
+		}
+		last = n
+		p.app.QueueUpdateDraw(func() {
+			p.app.Flash().Infof("%s %s... %s", op, subject, fileSize(n))
+		})
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), transferTimeout)
+		defer cancel()
+		start := time.Now()
+		err := run(ctx, progress)
+		p.app.QueueUpdateDraw(func() {
+			switch {
+			case errors.Is(err, dao.ErrTransferTooLarge):
+				p.app.Flash().Errf("%s %s aborted: %s", op, subject, err)
+			case err != nil:
+				p.app.Flash().Errf("%s %s failed: %s", op, subject, err)
+			default:
+				done()
+				if d := time.Since(start); d > time.Second {
+					p.app.Flash().Infof("%s %s completed in %s", op, subject, d.Round(time.Second))
+				}
+			}
+		})
+	}()
+}
+
+// fileSize humanizes a bytes count.
+func fileSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// browseFiles opens a container filesystem browser, picking the container if needed.
+func browseFiles(a *App, path, co string) error {
+	if co != "" {
+		return a.inject(NewPodFS(a, path, co), false)
+	}
+	pod, err := fetchPod(a.factory, path)
+	if err != nil {
+		return err
+	}
+	cc := fetchContainers(&pod.ObjectMeta, &pod.Spec, false)
+	if co, ok := dao.GetDefaultContainer(&pod.ObjectMeta, &pod.Spec); ok || len(cc) == 1 {
+		if !ok {
+			co = cc[0]
+		}
+		return a.inject(NewPodFS(a, path, co), false)
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		if err := a.inject(NewPodFS(a, path, co), false); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+
+	return a.inject(picker, false)
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
@@ -80,3 +83,28 @@ func TestComputeShellArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestLogFlagIndicator(t *testing.T) {
+	defer dao.ClearLogFlags()
+	dao.FlagLogPod("ns1/p1", "panic:")
+	dao.FlagLogPod("ns1/p2", "OOMKilled")
+
+	data := model1.NewTableDataWithRows(
+		client.PodGVR,
+		model1.Header{{Name: "NAME"}, {Name: "VALID"}},
+		model1.NewRowEventsWithEvts(
+			model1.NewRowEvent(model1.EventAdd, model1.Row{ID: "ns1/p1", Fields: model1.Fields{"p1", ""}}),
+			model1.NewRowEvent(model1.EventAdd, model1.Row{ID: "ns1/p2", Fields: model1.Fields{"p2", "blee"}}),
+			model1.NewRowEvent(model1.EventAdd, model1.Row{ID: "ns1/p3", Fields: model1.Fields{"p3", ""}}),
+		),
+	)
+	logFlagIndicator(data)
+	logFlagIndicator(data)
+
+	var vv []string
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		vv = append(vv, re.Row.Fields[1])
+		return true
+	})
+	assert.Equal(t, []string{`log alert "panic:"`, `blee,log alert "OOMKilled"`, ""}, vv)
+}
@@ -28,6 +28,24 @@ func (p *PersistentVolumeClaim) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU: ui.NewKeyAction("UsedBy", p.refCmd, true),
 	})
+	if p.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyS, ui.NewKeyActionWithOpts("Snapshot", p.snapshotCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (p *PersistentVolumeClaim) snapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	snapshotClaim(p.App(), path)
+
+	return nil
 }
 
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -17,5 +17,5 @@ func TestRbacNew(t *testing.T) {
 
 	require.NoError(t, v.Init(makeCtx(t)))
 	assert.Equal(t, "Rbac", v.Name())
-	assert.Len(t, v.Hints(), 6)
+	assert.Len(t, v.Hints(), 8)
 }
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"github.com/derailed/k9s/internal/cast"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+const (
+	defaultTermWidth  = 80
+	defaultTermHeight = 24
+	replayBannerFmt   = "<<K9s-Replay>> %s | Duration: %s | Ctrl-C to stop \n"
+	replayDoneMsg     = "\n<<K9s-Replay>> Done! Press <enter> to return..."
+)
+
+// recordSession records an interactive session to the screen dumps dir when enabled.
+func recordSession(a *App, opts *shellOpts, name string) {
+	if !a.Config.K9s.Recording.Enabled {
+		return
+	}
+	if err := ensureDir(a.Config.K9s.ContextScreenDumpDir()); err != nil {
+		slog.Warn("Unable to create recordings dir", slogs.Error, err)
+		return
+	}
+	opts.record = a.Config.K9s.ContextRecordingPath(name)
+}
+
+// sizedShellCheck propagates the local terminal size to the remote shell since the
+// recorded session output is no longer a terminal.
+func sizedShellCheck() string {
+	w, h := termSize()
+
+	return fmt.Sprintf("stty cols %d rows %d 2>/dev/null; %s", w, h, shellCheck)
+}
+
+func termSize() (width, height int) {
+	w, h, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || w <= 0 || h <= 0 {
+		return defaultTermWidth, defaultTermHeight
+	}
+
+	return w, h
+}
+
+// recordedOutput tees a session output to a recording.
+func recordedOutput(opts *shellOpts, out io.Writer) (io.Writer, func()) {
+	if opts.record == "" {
+		return out, func() {}
+	}
+	w, h := termSize()
+	rec, err := cast.NewRecorder(opts.record, opts.String(), w, h)
+	if err != nil {
+		slog.Warn("Session recording failed", slogs.Path, opts.record, slogs.Error, err)
+		return out, func() {}
+	}
+	slog.Debug("Recording session", slogs.Path, opts.record)
+
+	return io.MultiWriter(out, rec), func() {
+		if err := rec.Close(); err != nil {
+			slog.Warn("Session recording close failed", slogs.Path, opts.record, slogs.Error, err)
+		}
+	}
+}
+
+// replaySession replays a session recording in the terminal.
+func replaySession(a *App, path string) error {
+	rec, err := cast.Load(path)
+	if err != nil {
+		return err
+	}
+
+	a.Halt()
+	defer a.Resume()
+
+	var errs error
+	a.Suspend(func() {
+		clearScreen()
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+
+		c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+		_, _ = c.Printf(replayBannerFmt, rec.Header.Title, rec.Duration().Round(1e9))
+		if err := rec.Play(ctx, os.Stdout, 1, a.Config.K9s.Recording.MaxIdleOrDefault()); err != nil && ctx.Err() == nil {
+			errs = err
+		}
+		fmt.Print(replayDoneMsg)
+		_, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+		clearScreen()
+	})
+
+	return errs
+}
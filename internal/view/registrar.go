@@ -14,6 +14,8 @@ func loadCustomViewers() MetaViewers {
 	appsViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
+	storageViewers(m)
+	secretSyncViewers(m)
 	crdViewers(m)
 	helmViewers(m)
 
@@ -57,12 +59,30 @@ func coreViewers(vv MetaViewers) {
 	vv[client.PvcGVR] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
+	vv[client.IngGVR] = MetaViewer{
+		viewerFn: NewIngress,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
 	vv[client.WkGVR] = MetaViewer{
 		viewerFn: NewWorkload,
 	}
+	vv[client.WkhGVR] = MetaViewer{
+		viewerFn: NewWorkloadHealth,
+	}
+	vv[client.NocGVR] = MetaViewer{
+		viewerFn: NewNodeCapacity,
+	}
+	vv[client.StoGVR] = MetaViewer{
+		viewerFn: NewStorage,
+	}
+	vv[client.CexGVR] = MetaViewer{
+		viewerFn: NewCertExpiry,
+	}
+	vv[client.RtGVR] = MetaViewer{
+		viewerFn: NewRouting,
+	}
 	vv[client.CtGVR] = MetaViewer{
 		viewerFn: NewContext,
 	}
@@ -78,6 +98,18 @@ func miscViewers(vv MetaViewers) {
 	vv[client.SdGVR] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.MutGVR] = MetaViewer{
+		viewerFn: NewMutations,
+	}
+	vv[client.MonGVR] = MetaViewer{
+		viewerFn: NewMonitor,
+	}
+	vv[client.AlGVR] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.AdmGVR] = MetaViewer{
+		viewerFn: NewAdmission,
+	}
 	vv[client.BeGVR] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
@@ -87,6 +119,24 @@ func miscViewers(vv MetaViewers) {
 	vv[client.RefGVR] = MetaViewer{
 		viewerFn: NewReference,
 	}
+	vv[client.TlGVR] = MetaViewer{
+		viewerFn: NewTimeline,
+	}
+	vv[client.RevGVR] = MetaViewer{
+		viewerFn: NewRolloutHistory,
+	}
+	vv[client.JrGVR] = MetaViewer{
+		viewerFn: NewJobRuns,
+	}
+	vv[client.SkGVR] = MetaViewer{
+		viewerFn: NewSecretKeys,
+	}
+	vv[client.SepGVR] = MetaViewer{
+		viewerFn: NewServiceEndpoints,
+	}
+	vv[client.EsGVR] = MetaViewer{
+		viewerFn: NewEventStream,
+	}
 	vv[client.PuGVR] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -140,6 +190,24 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func storageViewers(vv MetaViewers) {
+	vv[client.VsGVR] = MetaViewer{
+		viewerFn: NewVolumeSnapshot,
+	}
+}
+
+func secretSyncViewers(vv MetaViewers) {
+	vv[client.SsGVR] = MetaViewer{
+		viewerFn: NewSealedSecret,
+	}
+	vv[client.ExsGVR] = MetaViewer{
+		viewerFn: NewExternalSecret,
+	}
+	vv[client.ExsBetaGVR] = MetaViewer{
+		viewerFn: NewExternalSecret,
+	}
+}
+
 func crdViewers(vv MetaViewers) {
 	vv[client.CrdGVR] = MetaViewer{
 		viewerFn: NewCRD,
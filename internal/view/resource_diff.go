@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	resDiffTitle  = "Diff"
+	resDiffLoader = "[orange::d]Loading manifests..."
+)
+
+// ResourceDiff presents two resources or a workload and one of its revisions side by side.
+type ResourceDiff struct {
+	*tview.Flex
+
+	app          *App
+	gvr          *client.GVR
+	left, right  string
+	revisions    bool
+	rev          int64
+	header       *tview.TextView
+	lpane, rpane *tview.TextView
+	actions      *ui.KeyActions
+	diff         *dao.SideBySide
+	onlyChanges  bool
+}
+
+var _ model.Component = (*ResourceDiff)(nil)
+
+// NewResourceDiff returns a viewer comparing two resources manifests.
+func NewResourceDiff(app *App, gvr *client.GVR, left, right string) *ResourceDiff {
+	return newResourceDiff(app, gvr, left, right, false)
+}
+
+// NewRevisionDiff returns a viewer comparing a workload against one of its revisions.
+// A zero revision designates the previous revision.
+func NewRevisionDiff(app *App, gvr *client.GVR, path string, rev int64) *ResourceDiff {
+	d := newResourceDiff(app, gvr, path, "", true)
+	d.rev = rev
+
+	return d
+}
+
+func newResourceDiff(app *App, gvr *client.GVR, left, right string, revisions bool) *ResourceDiff {
+	return &ResourceDiff{
+		Flex:      tview.NewFlex(),
+		app:       app,
+		gvr:       gvr,
+		left:      left,
+		right:     right,
+		revisions: revisions,
+		header:    tview.NewTextView(),
+		lpane:     tview.NewTextView(),
+		rpane:     tview.NewTextView(),
+		actions:   ui.NewKeyActions(),
+	}
+}
+
+func (*ResourceDiff) SetCommand(*cmd.Interpreter)            {}
+func (*ResourceDiff) SetFilter(string, bool)                 {}
+func (*ResourceDiff) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (d *ResourceDiff) Init(context.Context) error {
+	d.SetBorder(true)
+	d.SetDirection(tview.FlexRow)
+	styles := d.app.Styles.Frame()
+	d.SetTitle(ui.SkinTitle(fmt.Sprintf(logDiffFmt, resDiffTitle, d.gvr.R()), &styles))
+
+	d.header.SetDynamicColors(true).SetTextAlign(tview.AlignCenter)
+	for _, t := range []*tview.TextView{d.lpane, d.rpane} {
+		t.SetDynamicColors(true).SetScrollable(true).SetWrap(false)
+		t.SetBorder(true).SetBorderPadding(0, 0, 1, 1)
+		t.SetText(resDiffLoader)
+	}
+
+	panes := tview.NewFlex().SetDirection(tview.FlexColumn)
+	panes.AddItem(d.lpane, 0, 1, true)
+	panes.AddItem(d.rpane, 0, 1, false)
+	d.AddItem(d.header, 1, 1, false)
+	d.AddItem(panes, 0, 1, true)
+
+	d.bindKeys()
+	d.SetInputCapture(d.keyboard)
+	d.StylesChanged(d.app.Styles)
+	d.app.Styles.AddListener(d)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*ResourceDiff) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*ResourceDiff) Name() string { return resDiffTitle }
+
+// Start loads the manifests.
+func (d *ResourceDiff) Start() {
+	d.refresh()
+}
+
+// Stop terminates the viewer.
+func (d *ResourceDiff) Stop() {
+	d.app.Styles.RemoveListener(d)
+}
+
+// Hints returns menu hints.
+func (d *ResourceDiff) Hints() model.MenuHints {
+	return d.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*ResourceDiff) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (d *ResourceDiff) StylesChanged(s *config.Styles) {
+	d.SetBackgroundColor(s.BgColor())
+	d.header.SetBackgroundColor(s.BgColor())
+	for _, t := range []*tview.TextView{d.lpane, d.rpane} {
+		t.SetBackgroundColor(s.BgColor())
+		t.SetTextColor(s.FgColor())
+		t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	}
+	d.render()
+}
+
+func (d *ResourceDiff) bindKeys() {
+	d.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", d.app.PrevCmd, false),
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", d.switchPaneCmd, true),
+		ui.KeyD:         ui.NewKeyAction("Toggle Changes Only", d.toggleChangesCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Refresh", d.refreshCmd, true),
+	})
+	if d.revisions {
+		d.actions.Bulk(ui.KeyMap{
+			ui.KeyO: ui.NewKeyAction("Older Revision", d.stepRevisionCmd(1), true),
+			ui.KeyN: ui.NewKeyAction("Newer Revision", d.stepRevisionCmd(-1), true),
+		})
+	}
+}
+
+// keyboard scrolls both panes in lockstep since their rows are aligned.
+func (d *ResourceDiff) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := d.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+	focused, other := d.lpane, d.rpane
+	if d.rpane.HasFocus() {
+		focused, other = d.rpane, d.lpane
+	}
+	focused.InputHandler()(evt, func(p tview.Primitive) { d.app.SetFocus(p) })
+	other.ScrollTo(focused.GetScrollOffset())
+
+	return nil
+}
+
+func (d *ResourceDiff) refresh() {
+	go func() {
+		diff, err := d.load()
+		d.app.QueueUpdateDraw(func() {
+			if err != nil {
+				d.app.Flash().Err(err)
+				d.lpane.SetText(tview.Escape(err.Error()))
+				d.rpane.Clear()
+				return
+			}
+			d.diff, d.rev = diff, diff.Revision
+			d.render()
+			d.lpane.ScrollToBeginning()
+			d.rpane.ScrollToBeginning()
+		})
+	}()
+}
+
+func (d *ResourceDiff) load() (*dao.SideBySide, error) {
+	if d.revisions {
+		return dao.DiffRevision(d.app.factory, d.gvr, d.left, d.rev)
+	}
+
+	return dao.DiffResources(d.app.factory, d.gvr, d.left, d.right)
+}
+
+func (d *ResourceDiff) render() {
+	if d.diff == nil {
+		return
+	}
+	d.lpane.SetTitle(" " + tview.Escape(d.diff.Left) + " ")
+	d.rpane.SetTitle(" " + tview.Escape(d.diff.Right) + " ")
+	d.header.SetText(diffHeader(d.diff))
+
+	style := d.app.Styles.Views().Yaml
+	var l, r strings.Builder
+	for _, row := range d.diff.Rows {
+		if d.onlyChanges && row.Kind == dao.DiffSame {
+			continue
+		}
+		switch row.Kind {
+		case dao.DiffChanged:
+			l.WriteString(diffLine(style, "~", "orange", row.Left))
+			r.WriteString(diffLine(style, "~", "orange", row.Right))
+		case dao.DiffRemoved:
+			l.WriteString(diffLine(style, "-", "red", row.Left))
+			r.WriteString("\n")
+		case dao.DiffAdded:
+			l.WriteString("\n")
+			r.WriteString(diffLine(style, "+", "green", row.Right))
+		default:
+			l.WriteString(diffLine(style, " ", "", row.Left))
+			r.WriteString(diffLine(style, " ", "", row.Right))
+		}
+	}
+	d.lpane.SetText(l.String())
+	d.rpane.SetText(r.String())
+}
+
+func (d *ResourceDiff) switchPaneCmd(*tcell.EventKey) *tcell.EventKey {
+	if d.lpane.HasFocus() {
+		d.app.SetFocus(d.rpane)
+	} else {
+		d.app.SetFocus(d.lpane)
+	}
+
+	return nil
+}
+
+func (d *ResourceDiff) toggleChangesCmd(*tcell.EventKey) *tcell.EventKey {
+	d.onlyChanges = !d.onlyChanges
+	d.render()
+
+	return nil
+}
+
+func (d *ResourceDiff) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	d.refresh()
+
+	return nil
+}
+
+// stepRevisionCmd moves to an older or newer revision, skipping the most recent
+// one since it matches the live state.
+func (d *ResourceDiff) stepRevisionCmd(offset int) func(*tcell.EventKey) *tcell.EventKey {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		if d.diff == nil {
+			return nil
+		}
+		idx := slices.Index(d.diff.Revisions, d.rev) + offset
+		if idx < 1 || idx >= len(d.diff.Revisions) {
+			d.app.Flash().Warn("No more revisions")
+			return nil
+		}
+		d.rev = d.diff.Revisions[idx]
+		d.refresh()
+
+		return nil
+	}
+}
+
+// diffLine renders a syntax highlighted manifest line with its change gutter.
+func diffLine(style config.Yaml, mark, color, line string) string {
+	if color == "" {
+		return mark + " " + colorizeYAML(style, line) + "\n"
+	}
+
+	return "[" + color + "::b]" + mark + "[-::-] [:" + diffBgColor(color) + ":]" + colorizeYAML(style, line) + "[:-:]\n"
+}
+
+func diffBgColor(color string) string {
+	switch color {
+	case "red":
+		return "#3f1d1d"
+	case "green":
+		return "#1d3f1d"
+	default:
+		return "#3f331d"
+	}
+}
+
+func diffHeader(d *dao.SideBySide) string {
+	s := fmt.Sprintf("[aqua::b]%d[-::-] changed lines", d.Changes())
+	if len(d.Revisions) == 0 {
+		return s
+	}
+	rr := make([]string, 0, len(d.Revisions))
+	for _, r := range d.Revisions {
+		if r == d.Revision {
+			rr = append(rr, fmt.Sprintf("[orange::b]%d[-::-]", r))
+			continue
+		}
+		rr = append(rr, fmt.Sprintf("%d", r))
+	}
+
+	return s + " [gray::]Revisions:[-::] " + strings.Join(rr, " ")
+}
+
+// diffResources compares two marked resources or a workload against its previous revision.
+func diffResources(app *App, gvr *client.GVR, sels []string) error {
+	var v *ResourceDiff
+	switch {
+	case len(sels) == 2:
+		v = NewResourceDiff(app, gvr, sels[0], sels[1])
+	case len(sels) == 1 && slices.Contains([]*client.GVR{client.DpGVR, client.StsGVR, client.DsGVR}, gvr):
+		v = NewRevisionDiff(app, gvr, sels[0], 0)
+	default:
+		return fmt.Errorf("mark two %s to diff them", gvr.R())
+	}
+
+	return app.inject(v, false)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -63,13 +64,17 @@ func (r *RestartExtender) restartCmd(*tcell.EventKey) *tcell.EventKey {
 		Ack: func(opts *metav1.PatchOptions) bool {
 			ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
 			defer cancel()
+			tt := make([]rolloutTarget, 0, len(paths))
 			for _, path := range paths {
 				if err := r.restartRollout(ctx, path, opts); err != nil {
 					r.App().Flash().Err(err)
 				} else {
-					r.App().Flash().Infof("Restart in progress for `%s...", path)
+					r.App().audit(audit.RestartAction, r.GVR(), path)
+					r.App().Flash().Info(dryRunMsg(fmt.Sprintf("Restart in progress for `%s...", path)))
+					tt = append(tt, rolloutTarget{gvr: r.GVR(), path: path})
 				}
 			}
+			watchRollouts(r.App(), tt)
 			return true
 		},
 		Cancel: func() {},
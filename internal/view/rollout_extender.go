@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// RolloutExtender adds rollout history extensions.
+type RolloutExtender struct {
+	ResourceViewer
+}
+
+// NewRolloutExtender returns a new extender.
+func NewRolloutExtender(r ResourceViewer) ResourceViewer {
+	x := RolloutExtender{ResourceViewer: r}
+	x.AddBindKeysFn(x.bindKeys)
+
+	return &x
+}
+
+func (r *RolloutExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftH, ui.NewKeyAction("History", r.historyCmd, true))
+}
+
+func (r *RolloutExtender) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := r.App().inject(newRolloutHistoryFor(r.GVR(), path), false); err != nil {
+		r.App().Flash().Err(err)
+	}
+
+	return nil
+}
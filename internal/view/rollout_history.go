@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// RolloutHistory presents a workload rollout revisions.
+type RolloutHistory struct {
+	ResourceViewer
+
+	workload *client.GVR
+	path     string
+}
+
+// NewRolloutHistory returns a new viewer.
+func NewRolloutHistory(gvr *client.GVR) ResourceViewer {
+	r := RolloutHistory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetSortCol("REVISION", false)
+	r.GetTable().SetEnterFn(r.diffRevision)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// newRolloutHistoryFor returns a viewer listing a given workload revisions.
+func newRolloutHistoryFor(gvr *client.GVR, path string) ResourceViewer {
+	v := NewRolloutHistory(client.RevGVR)
+	r := v.(*RolloutHistory)
+	r.workload, r.path = gvr, path
+	r.SetContextFn(timelineContext(gvr, path))
+
+	return r
+}
+
+// Init initializes the view.
+func (r *RolloutHistory) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (r *RolloutHistory) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", r.GetTable().SortColCmd("REVISION", false), false),
+		ui.KeyD:      ui.NewKeyAction("Diff", r.diffCmd, true),
+	})
+	if !r.App().Config.IsReadOnly() {
+		aa.Add(tcell.KeyCtrlL, ui.NewKeyActionWithOpts("Rollback", r.rollbackCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
+}
+
+func (r *RolloutHistory) diffRevision(app *App, _ ui.Tabular, _ *client.GVR, rev string) {
+	n, err := strconv.ParseInt(rev, 10, 64)
+	if err != nil || r.workload == nil {
+		return
+	}
+	if err := app.inject(NewRevisionDiff(app, r.workload, r.path, n), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (r *RolloutHistory) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev := r.GetTable().GetSelectedItem()
+	if rev == "" {
+		return evt
+	}
+	r.diffRevision(r.App(), r.GetTable().GetModel(), r.GVR(), rev)
+
+	return nil
+}
+
+func (r *RolloutHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev := r.GetTable().GetSelectedItem()
+	if rev == "" || r.workload == nil {
+		return evt
+	}
+	n, err := strconv.ParseInt(rev, 10, 64)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rollback %s %s to revision %d?", singularize(r.workload.R()), r.path, n)
+	d := r.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, r.App().Content.Pages, "Rollback", msg, func() {
+		var h dao.RolloutHistory
+		h.Init(r.App().factory, client.RevGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		res, err := h.Rollback(ctx, r.workload, r.path, n)
+		if err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().audit(audit.RollbackAction, r.workload, r.path)
+		r.App().Flash().Info(dryRunMsg(fmt.Sprintf("%s %s", r.path, res)))
+		r.Refresh()
+		watchRollouts(r.App(), []rolloutTarget{{gvr: r.workload, path: r.path}})
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+// pauseRollouts pauses or resumes deployments rollouts once confirmed.
+// Resumed rollouts are tracked in the rollout progress pane.
+func pauseRollouts(app *App, tt []rolloutTarget, paused bool, done func()) {
+	verb, state, action := "Resume", "resumed", audit.ResumeAction
+	if paused {
+		verb, state, action = "Pause", "paused", audit.PauseAction
+	}
+	msg := fmt.Sprintf("%s rollout for deployment %s?", verb, tt[0].path)
+	if len(tt) > 1 {
+		msg = fmt.Sprintf("%s rollouts for %d deployments?", verb, len(tt))
+	}
+
+	d := app.Styles.Dialog()
+	dialog.ShowConfirm(&d, app.Content.Pages, "Confirm "+verb, msg, func() {
+		var dp dao.Deployment
+		dp.Init(app.factory, client.DpGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		updated := make([]rolloutTarget, 0, len(tt))
+		for _, t := range tt {
+			if err := dp.Pause(ctx, t.path, paused); err != nil {
+				app.Flash().Err(err)
+				continue
+			}
+			app.audit(action, client.DpGVR, t.path)
+			app.Flash().Info(dryRunMsg(fmt.Sprintf("Rollout %s for %s", state, t.path)))
+			updated = append(updated, t)
+		}
+		done()
+		if !paused {
+			watchRollouts(app, updated)
+		}
+	}, func() {})
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	rolloutWatchTitle   = "Rollout"
+	rolloutWatchRate    = time.Second
+	rolloutProgressBars = 20
+)
+
+// rolloutTarget represents a workload being rolled out.
+type rolloutTarget struct {
+	gvr  *client.GVR
+	path string
+}
+
+// RolloutWatch tracks workloads rollout progress until completion.
+type RolloutWatch struct {
+	*tview.TextView
+
+	app      *App
+	targets  []rolloutTarget
+	statuses []*dao.RolloutStatus
+	actions  *ui.KeyActions
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+var _ model.Component = (*RolloutWatch)(nil)
+
+// NewRolloutWatch returns a new rollout progress viewer.
+func NewRolloutWatch(app *App, tt []rolloutTarget) *RolloutWatch {
+	return &RolloutWatch{
+		TextView: tview.NewTextView(),
+		app:      app,
+		targets:  tt,
+		actions:  ui.NewKeyActions(),
+	}
+}
+
+func (*RolloutWatch) SetCommand(*cmd.Interpreter)            {}
+func (*RolloutWatch) SetFilter(string, bool)                 {}
+func (*RolloutWatch) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (r *RolloutWatch) Init(context.Context) error {
+	r.SetBorder(true)
+	r.SetBorderPadding(0, 0, 1, 1)
+	r.SetDynamicColors(true).SetScrollable(true).SetWrap(false)
+	subject := r.targets[0].path
+	if len(r.targets) > 1 {
+		subject = fmt.Sprintf("%d workloads", len(r.targets))
+	}
+	styles := r.app.Styles.Frame()
+	r.SetTitle(ui.SkinTitle(fmt.Sprintf(logDiffFmt, rolloutWatchTitle, subject), &styles))
+	r.bindKeys()
+	r.SetInputCapture(r.keyboard)
+	r.StylesChanged(r.app.Styles)
+	r.app.Styles.AddListener(r)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*RolloutWatch) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*RolloutWatch) Name() string { return rolloutWatchTitle }
+
+// Start polls the rollouts progress until they complete.
+func (r *RolloutWatch) Start() {
+	r.mx.Lock()
+	if r.cancelFn != nil {
+		r.cancelFn()
+	}
+	var ctx context.Context
+	ctx, r.cancelFn = context.WithCancel(context.Background())
+	r.mx.Unlock()
+
+	go func() {
+		t := time.NewTicker(rolloutWatchRate)
+		defer t.Stop()
+		for {
+			ss, done := r.poll()
+			r.app.QueueUpdateDraw(func() {
+				r.statuses = ss
+				r.render()
+			})
+			if done {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+}
+
+// Stop terminates the viewer.
+func (r *RolloutWatch) Stop() {
+	r.mx.Lock()
+	if r.cancelFn != nil {
+		r.cancelFn()
+		r.cancelFn = nil
+	}
+	r.mx.Unlock()
+	r.app.Styles.RemoveListener(r)
+}
+
+// Hints returns menu hints.
+func (r *RolloutWatch) Hints() model.MenuHints {
+	return r.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*RolloutWatch) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (r *RolloutWatch) StylesChanged(s *config.Styles) {
+	r.SetBackgroundColor(s.BgColor())
+	r.SetTextColor(s.FgColor())
+	r.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+}
+
+func (r *RolloutWatch) bindKeys() {
+	r.actions.Bulk(ui.KeyMap{
+		ui.KeyH: ui.NewKeyAction("History", r.historyCmd, true),
+		ui.KeyT: ui.NewKeyAction("Timeline", r.timelineCmd, true),
+	})
+}
+
+func (r *RolloutWatch) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := r.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// poll fetches the rollouts progress and checks if they all completed.
+func (r *RolloutWatch) poll() ([]*dao.RolloutStatus, bool) {
+	ss, done := make([]*dao.RolloutStatus, 0, len(r.targets)), true
+	for _, t := range r.targets {
+		st, err := dao.RolloutProgress(r.app.factory, t.gvr, t.path)
+		if err != nil {
+			st = &dao.RolloutStatus{GVR: t.gvr, FQN: t.path, Stuck: true, Message: err.Error()}
+		}
+		done = done && st.Done
+		ss = append(ss, st)
+	}
+
+	return ss, done
+}
+
+func (r *RolloutWatch) render() {
+	var b strings.Builder
+	for _, st := range r.statuses {
+		b.WriteString(rolloutLine(st) + "\n")
+		if !st.Stuck {
+			continue
+		}
+		reason := st.Reason
+		if reason == "" {
+			reason = "Failed"
+		}
+		fmt.Fprintf(&b, "  [red::b]%s[-::-] %s\n", tview.Escape(reason), tview.Escape(st.Message))
+		for _, s := range st.Suggestions {
+			fmt.Fprintf(&b, "  [aqua::]→[-::] %s\n", tview.Escape(s))
+		}
+	}
+	if r.done() {
+		b.WriteString("[gray::]Press ctrl-y to close[-::]")
+	}
+	r.SetText(b.String())
+}
+
+func (r *RolloutWatch) done() bool {
+	return len(r.statuses) > 0 && !slices.ContainsFunc(r.statuses, func(s *dao.RolloutStatus) bool { return !s.Done })
+}
+
+// target returns the first stuck rollout or the first rollout.
+func (r *RolloutWatch) target() rolloutTarget {
+	for i, st := range r.statuses {
+		if st.Stuck && i < len(r.targets) {
+			return r.targets[i]
+		}
+	}
+
+	return r.targets[0]
+}
+
+func (r *RolloutWatch) historyCmd(*tcell.EventKey) *tcell.EventKey {
+	t := r.target()
+	if err := r.app.inject(newRolloutHistoryFor(t.gvr, t.path), false); err != nil {
+		r.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (r *RolloutWatch) timelineCmd(*tcell.EventKey) *tcell.EventKey {
+	t := r.target()
+	v := NewTimeline(client.TlGVR)
+	v.SetContextFn(timelineContext(t.gvr, t.path))
+	if err := r.app.inject(v, false); err != nil {
+		r.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// rolloutLine renders a rollout progress bar along with its replicas counts.
+func rolloutLine(st *dao.RolloutStatus) string {
+	filled := rolloutProgressBars
+	if st.Desired > 0 {
+		filled = int(min(st.Updated, st.Desired) * rolloutProgressBars / st.Desired)
+	}
+	state, color := "In progress", "orange"
+	switch {
+	case st.Done:
+		state, color = "Complete", "green"
+	case st.Stuck:
+		state, color = "Stuck", "red"
+	}
+
+	return fmt.Sprintf("[::b]%s %s[::-] [%s::]%s[gray::]%s[-::] updated %d/%d ready %d/%d available %d/%d [%s::b]%s[-::-]",
+		singularize(st.GVR.R()), tview.Escape(st.FQN),
+		color, strings.Repeat("█", filled), strings.Repeat("░", rolloutProgressBars-filled),
+		st.Updated, st.Desired, st.Ready, st.Desired, st.Available, st.Desired,
+		color, state,
+	)
+}
+
+// watchRollouts shows the given workloads rollout progress in the pinned pane.
+func watchRollouts(app *App, tt []rolloutTarget) {
+	if dao.IsDryRun() {
+		return
+	}
+	tt = slices.DeleteFunc(tt, func(t rolloutTarget) bool {
+		return t.gvr != client.DpGVR && t.gvr != client.StsGVR && t.gvr != client.DsGVR
+	})
+	if len(tt) == 0 {
+		return
+	}
+	v := NewRolloutWatch(app, tt)
+	if err := v.Init(context.Background()); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.pinComponent(v)
+}
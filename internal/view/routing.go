@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Routing presents an ingresses and gateway routes backends viewer.
+type Routing struct {
+	ResourceViewer
+}
+
+// NewRouting returns a new viewer.
+func NewRouting(gvr *client.GVR) ResourceViewer {
+	r := Routing{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetEnterFn(r.describeRoute)
+	r.GetTable().SetSortCol("STATUS", true)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *Routing) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", r.describeCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Backend Service", r.backendCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+func (r *Routing) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	r.describeRoute(r.App(), nil, r.GVR(), path)
+
+	return nil
+}
+
+func (*Routing) describeRoute(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	gvr, fqn, _, err := dao.ParseRoutePath(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	describeResource(app, nil, gvr, fqn)
+}
+
+func (r *Routing) backendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	_, fqn, svc, err := dao.ParseRoutePath(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	if svc == "" {
+		r.App().Flash().Warnf("Route %s backend is not a service", fqn)
+		return nil
+	}
+	ns, _ := client.Namespaced(svc)
+	r.App().gotoResource(client.SvcGVR.String()+" "+ns, svc, false, true)
+
+	return nil
+}
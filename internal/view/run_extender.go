@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	runCmdInput       = "command"
+	runContainerInput = "container"
+)
+
+// ExecTargetsFunc returns the containers to run a command into.
+type ExecTargetsFunc func() []dao.ExecTarget
+
+// RunExtender adds a run command on selection action to a given viewer.
+type RunExtender struct {
+	ResourceViewer
+
+	targetsFn ExecTargetsFunc
+}
+
+// NewRunExtender returns a new extender.
+func NewRunExtender(v ResourceViewer, f ExecTargetsFunc) ResourceViewer {
+	r := RunExtender{
+		ResourceViewer: v,
+		targetsFn:      f,
+	}
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// BindKeys injects new menu actions.
+func (r *RunExtender) bindKeys(aa *ui.KeyActions) {
+	if r.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyShiftR, ui.NewKeyActionWithOpts("Run Command", r.runCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (r *RunExtender) runCmd(evt *tcell.EventKey) *tcell.EventKey {
+	tt := r.targetsFn()
+	if len(tt) == 0 {
+		return evt
+	}
+
+	inputs := []config.PluginInput{
+		{Name: runCmdInput, Label: "Command", Type: config.InputTypeString, Required: true},
+	}
+	if hasDefaultContainers(tt) {
+		inputs = append(inputs, config.PluginInput{
+			Name:  runContainerInput,
+			Label: "Container (blank for default)",
+			Type:  config.InputTypeString,
+		})
+	}
+	title := fmt.Sprintf("Run Command On %s", tt[0].Path)
+	if len(tt) > 1 {
+		title = fmt.Sprintf("Run Command On %d Targets", len(tt))
+	}
+	d := r.App().Styles.Dialog()
+	dialog.ShowPluginInputs(&d, r.App().Content.Pages, title, inputs,
+		func(msg string) {
+			r.App().Flash().Warn(msg)
+		},
+		func(vv dialog.PluginInputValues) {
+			if co := vv[runContainerInput]; co != "" {
+				for i := range tt {
+					if tt[i].Container == "" {
+						tt[i].Container = co
+					}
+				}
+			}
+			if err := r.App().inject(NewRunResults(r.App(), tt, vv[runCmdInput]), false); err != nil {
+				r.App().Flash().Err(err)
+			}
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+func hasDefaultContainers(tt []dao.ExecTarget) bool {
+	for _, t := range tt {
+		if t.Container == "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	runResultsTitle = "Run"
+	runResultsFmt   = " %s([hilite:bg:b]%s[fg:bg:-])[[count:bg:b]%d/%d[fg:bg:-]][fg:bg:-] "
+	runOutputMax    = 120
+)
+
+var runResultsHeader = []string{"POD", "CONTAINER", "EXIT", "ELAPSED", "OUTPUT"}
+
+// RunResults presents a command outcome across several containers.
+type RunResults struct {
+	*tview.Table
+
+	app      *App
+	targets  []dao.ExecTarget
+	command  string
+	actions  *ui.KeyActions
+	results  []dao.ExecResult
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+var _ model.Component = (*RunResults)(nil)
+
+// NewRunResults returns a new command results viewer.
+func NewRunResults(app *App, tt []dao.ExecTarget, command string) *RunResults {
+	return &RunResults{
+		Table:   tview.NewTable(),
+		app:     app,
+		targets: tt,
+		command: command,
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*RunResults) SetCommand(*cmd.Interpreter)            {}
+func (*RunResults) SetFilter(string, bool)                 {}
+func (*RunResults) SetLabelSelector(labels.Selector, bool) {}
+
+// Init initializes the viewer.
+func (r *RunResults) Init(context.Context) error {
+	r.SetBorder(true)
+	r.SetBorderPadding(0, 0, 1, 1)
+	r.SetSelectable(true, false)
+	r.SetFixed(1, 0)
+	r.updateTitle()
+	r.SetCell(0, 0, tview.NewTableCell("[orange::d]Running command...").SetSelectable(false))
+
+	r.bindKeys()
+	r.SetInputCapture(r.keyboard)
+	r.StylesChanged(r.app.Styles)
+	r.app.Styles.AddListener(r)
+	r.run()
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*RunResults) InCmdMode() bool {
+	return false
+}
+
+// Name returns the component name.
+func (*RunResults) Name() string { return runResultsTitle }
+
+// Start starts the viewer.
+func (*RunResults) Start() {}
+
+// Stop terminates the viewer.
+func (r *RunResults) Stop() {
+	r.mx.Lock()
+	if r.cancelFn != nil {
+		r.cancelFn()
+		r.cancelFn = nil
+	}
+	r.mx.Unlock()
+	r.app.Styles.RemoveListener(r)
+}
+
+// Hints returns menu hints.
+func (r *RunResults) Hints() model.MenuHints {
+	return r.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*RunResults) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (r *RunResults) StylesChanged(s *config.Styles) {
+	r.SetBackgroundColor(s.Views().Log.BgColor.Color())
+	r.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	r.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(s.Table().CursorFgColor.Color()).
+		Background(s.Table().CursorBgColor.Color()).
+		Attributes(tcell.AttrBold))
+	r.render()
+}
+
+func (r *RunResults) bindKeys() {
+	r.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", r.app.PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("View Output", r.viewCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Rerun", r.rerunCmd, true),
+	})
+}
+
+func (r *RunResults) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := r.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (r *RunResults) updateTitle() {
+	var failed int
+	for _, res := range r.results {
+		if res.ExitCode != 0 {
+			failed++
+		}
+	}
+	styles := r.app.Styles.Frame()
+	r.SetTitle(ui.SkinTitle(fmt.Sprintf(runResultsFmt, runResultsTitle, r.command, len(r.results)-failed, len(r.targets)), &styles))
+}
+
+func (r *RunResults) run() {
+	r.mx.Lock()
+	if r.cancelFn != nil {
+		r.cancelFn()
+	}
+	var ctx context.Context
+	ctx, r.cancelFn = context.WithTimeout(context.Background(), r.app.Conn().Config().CallTimeout())
+	r.mx.Unlock()
+
+	for _, t := range r.targets {
+		r.app.audit(audit.ExecAction, client.PodGVR, t.Path)
+	}
+	go func() {
+		rr := dao.RunCmd(ctx, r.app.factory, r.targets, []string{"sh", "-c", r.command})
+		r.app.QueueUpdateDraw(func() {
+			r.results = rr
+			r.app.Flash().Infof("Command completed on %d targets", len(rr))
+			r.updateTitle()
+			r.render()
+		})
+	}()
+}
+
+func (r *RunResults) render() {
+	if r.results == nil {
+		return
+	}
+	r.Clear()
+	fg := r.app.Styles.Table().Header.FgColor.Color()
+	for c, h := range runResultsHeader {
+		r.SetCell(0, c, tview.NewTableCell(h).SetTextColor(fg).SetAttributes(tcell.AttrBold).SetSelectable(false))
+	}
+	for i, res := range r.results {
+		_, po := client.Namespaced(res.Path)
+		r.SetCell(i+1, 0, tview.NewTableCell(tview.Escape(po)))
+		r.SetCell(i+1, 1, tview.NewTableCell(tview.Escape(res.Container)))
+		r.SetCell(i+1, 2, tview.NewTableCell(exitCodeCell(res.ExitCode)))
+		r.SetCell(i+1, 3, tview.NewTableCell(res.Elapsed.Round(time.Millisecond).String()))
+		r.SetCell(i+1, 4, tview.NewTableCell(tview.Escape(outputSummary(&res))).SetExpansion(1))
+	}
+	if len(r.results) > 0 {
+		r.Select(1, 0)
+	}
+}
+
+func exitCodeCell(code int) string {
+	switch {
+	case code == 0:
+		return "[green::b]0[-::-]"
+	case code < 0:
+		return "[red::b]n/a[-::-]"
+	default:
+		return "[red::b]" + strconv.Itoa(code) + "[-::-]"
+	}
+}
+
+// outputSummary returns the result first output line.
+func outputSummary(r *dao.ExecResult) string {
+	out := r.Stdout
+	switch {
+	case r.Err != nil:
+		out = r.Err.Error()
+	case strings.TrimSpace(out) == "":
+		out = r.Stderr
+	}
+	out, _, _ = strings.Cut(strings.TrimSpace(out), "\n")
+	if len(out) > runOutputMax {
+		out = out[:runOutputMax] + "…"
+	}
+
+	return out
+}
+
+// resultOutput returns the result full output.
+func resultOutput(r *dao.ExecResult) string {
+	var b strings.Builder
+	if r.Err != nil {
+		b.WriteString("[red::b]" + tview.Escape(r.Err.Error()) + "[-::-]\n")
+	}
+	b.WriteString(tview.Escape(r.Stdout))
+	if r.Stderr != "" {
+		b.WriteString("\n[orange::b]stderr:[-::-]\n" + tview.Escape(r.Stderr))
+	}
+
+	return b.String()
+}
+
+func (r *RunResults) viewCmd(*tcell.EventKey) *tcell.EventKey {
+	row, _ := r.GetSelection()
+	if row < 1 || row > len(r.results) {
+		return nil
+	}
+	res := r.results[row-1]
+	details := NewDetails(r.app, "Output", res.Path+":"+res.Container, contentTXT, true).Update(resultOutput(&res))
+	if err := r.app.inject(details, false); err != nil {
+		r.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (r *RunResults) rerunCmd(*tcell.EventKey) *tcell.EventKey {
+	r.results = nil
+	r.Clear()
+	r.SetCell(0, 0, tview.NewTableCell("[orange::d]Running command...").SetSelectable(false))
+	r.updateTitle()
+	r.run()
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputSummary(t *testing.T) {
+	uu := map[string]struct {
+		r dao.ExecResult
+		e string
+	}{
+		"stdout": {
+			r: dao.ExecResult{Stdout: "\nfred\nblee\n", Stderr: "oops"},
+			e: "fred",
+		},
+		"stderr": {
+			r: dao.ExecResult{Stderr: "sh: nslookup: not found\n", ExitCode: 127},
+			e: "sh: nslookup: not found",
+		},
+		"error": {
+			r: dao.ExecResult{Stdout: "fred", Err: errors.New("boom"), ExitCode: -1},
+			e: "boom",
+		},
+		"truncated": {
+			r: dao.ExecResult{Stdout: strings.Repeat("x", runOutputMax+10)},
+			e: strings.Repeat("x", runOutputMax) + "…",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, outputSummary(&u.r))
+		})
+	}
+}
@@ -32,6 +32,7 @@ func NewServiceAccount(gvr *client.GVR) ResourceViewer {
 func (s *ServiceAccount) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:        ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Permissions", s.permissionCmd, true),
 		tcell.KeyEnter: ui.NewKeyAction("Rules", s.policyCmd, true),
 	})
 }
@@ -56,6 +57,18 @@ func (s *ServiceAccount) policyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (s *ServiceAccount) permissionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := s.App().inject(NewPermission(sa, path), false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func scanSARefs(evt *tcell.EventKey, a *App, t *Table, gvr *client.GVR) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -185,11 +186,12 @@ func (s *ScaleExtender) makeScaleForm(fqns []string) (*tview.Form, error) {
 				s.App().Flash().Err(err)
 				return
 			}
+			s.App().audit(audit.ScaleAction, s.GVR(), fqn)
 		}
 		if len(fqns) != 1 {
-			s.App().Flash().Infof("[%d] %s scaled successfully", len(fqns), singularize(s.GVR().R()))
+			s.App().Flash().Info(dryRunMsg(fmt.Sprintf("[%d] %s scaled successfully", len(fqns), singularize(s.GVR().R()))))
 		} else {
-			s.App().Flash().Infof("%s %s scaled successfully", s.GVR().R(), fqns[0])
+			s.App().Flash().Info(dryRunMsg(fmt.Sprintf("%s %s scaled successfully", s.GVR().R(), fqns[0])))
 		}
 	})
 	f.AddButton("Cancel", func() {
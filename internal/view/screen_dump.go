@@ -6,8 +6,10 @@ package view
 import (
 	"context"
 	"log/slog"
+	"path/filepath"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/cast"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/slogs"
@@ -50,6 +52,12 @@ func (s *ScreenDump) edit(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 
 	s.Stop()
 	defer s.Start()
+	if filepath.Ext(path) == cast.Ext {
+		if err := replaySession(app, path); err != nil {
+			app.Flash().Errf("Replay failed: %s", err)
+		}
+		return
+	}
 	if !edit(app, &shellOpts{clear: true, args: []string{path}}) {
 		app.Flash().Errf("Failed to launch editor")
 	}
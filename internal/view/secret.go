@@ -5,11 +5,8 @@ package view
 
 import (
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config/data"
-	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	"k8s.io/apimachinery/pkg/labels"
 )
 
 // Secret presents a secret viewer.
@@ -43,27 +40,7 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if path == "" {
 		return evt
 	}
-
-	o, err := s.App().factory.Get(s.GVR(), path, true, labels.Everything())
-	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
-	}
-
-	mm, err := dao.ExtractSecrets(o)
-	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
-	}
-
-	raw, err := data.WriteYAML(mm)
-	if err != nil {
-		s.App().Flash().Errf("Error decoding secret %s", err)
-		return nil
-	}
-
-	details := NewDetails(s.App(), "Secret Decoder", path, contentYAML, true).Update(string(raw))
-	if err := s.App().inject(details, false); err != nil {
+	if err := s.App().inject(newSecretKeysFor(path), false); err != nil {
 		s.App().Flash().Err(err)
 	}
 
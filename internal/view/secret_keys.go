@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// SecretKeys presents a secret keys decoder. Values are masked unless revealed.
+type SecretKeys struct {
+	ResourceViewer
+
+	path     string
+	decode   bool
+	revealed sets.Set[string]
+}
+
+// NewSecretKeys returns a new viewer.
+func NewSecretKeys(gvr *client.GVR) ResourceViewer {
+	s := SecretKeys{
+		ResourceViewer: NewBrowser(gvr),
+		decode:         true,
+		revealed:       sets.New[string](),
+	}
+	s.GetTable().SetSortCol("KEY", true)
+	s.AddBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.secretContext)
+
+	return &s
+}
+
+// newSecretKeysFor returns a viewer decoding a given secret.
+func newSecretKeysFor(path string) ResourceViewer {
+	v := NewSecretKeys(client.SkGVR)
+	v.(*SecretKeys).path = path
+
+	return v
+}
+
+// Init initializes the view.
+func (s *SecretKeys) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (s *SecretKeys) secretContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, s.path)
+	ctx = context.WithValue(ctx, internal.KeyDecode, s.decode)
+
+	return context.WithValue(ctx, internal.KeyRevealed, sets.List(s.revealed))
+}
+
+func (s *SecretKeys) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		tcell.KeyEnter: ui.NewKeyAction("Reveal", s.revealCmd, true),
+		ui.KeyX:        ui.NewKeyAction("Toggle Decode", s.toggleDecodeCmd, true),
+		ui.KeyC:        ui.NewKeyAction("Copy Value", s.cpValueCmd, true),
+		tcell.KeyCtrlS: ui.NewKeyAction("Save", s.saveCmd, false),
+	})
+}
+
+func (s *SecretKeys) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	if s.revealed.Has(key) {
+		s.revealed.Delete(key)
+	} else {
+		s.revealed.Insert(key)
+	}
+	s.GetTable().SetPendingSelection(key)
+	s.Start()
+
+	return nil
+}
+
+func (s *SecretKeys) toggleDecodeCmd(*tcell.EventKey) *tcell.EventKey {
+	s.decode = !s.decode
+	if s.decode {
+		s.App().Flash().Info("Showing decoded values")
+	} else {
+		s.App().Flash().Info("Showing base64 encoded values")
+	}
+	s.Start()
+
+	return nil
+}
+
+func (s *SecretKeys) cpValueCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	v, err := dao.SecretValue(s.App().factory, s.path, key)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if err := clipboardWrite(v); err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	s.App().Flash().Infof("Value for key %q copied to clipboard...", key)
+
+	return nil
+}
+
+// saveCmd dumps the keys table. Revealed values are only dumped once confirmed.
+func (s *SecretKeys) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.revealed.Len() == 0 {
+		return s.GetTable().saveCmd(evt)
+	}
+
+	d := s.App().Styles.Dialog()
+	msg := "Screen dump will contain revealed secret values in clear. Save anyway?"
+	dialog.ShowConfirm(&d, s.App().Content.Pages, "Confirm Save", msg, func() {
+		s.GetTable().saveCmd(evt)
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// targetFunc returns a secrets operator resource target secret name.
+type targetFunc func(*unstructured.Unstructured) string
+
+// SealedSecret represents a sealed secret viewer.
+type SealedSecret struct {
+	ResourceViewer
+}
+
+// NewSealedSecret returns a new viewer.
+func NewSealedSecret(gvr *client.GVR) ResourceViewer {
+	s := SealedSecret{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *SealedSecret) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyT, ui.NewKeyAction("Target Secret", targetSecretCmd(s, render.SealedSecretTarget), true))
+}
+
+// ExternalSecret represents an external secret viewer.
+type ExternalSecret struct {
+	ResourceViewer
+}
+
+// NewExternalSecret returns a new viewer.
+func NewExternalSecret(gvr *client.GVR) ResourceViewer {
+	e := ExternalSecret{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.AddBindKeysFn(e.bindKeys)
+
+	return &e
+}
+
+func (e *ExternalSecret) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyT, ui.NewKeyAction("Target Secret", targetSecretCmd(e, render.ExternalSecretTarget), true))
+	if e.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Refresh", e.refreshCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (e *ExternalSecret) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := e.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	d := e.App().Styles.Dialog()
+	msg := fmt.Sprintf("Force refresh external secret %s from its store?", path)
+	dialog.ShowConfirm(&d, e.App().Content.Pages, "Confirm Refresh", msg, func() {
+		var es dao.ExternalSecret
+		es.Init(e.App().factory, e.GVR())
+		ctx, cancel := context.WithTimeout(context.Background(), e.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := es.Refresh(ctx, path); err != nil {
+			e.App().Flash().Err(err)
+			return
+		}
+		e.App().audit(audit.RefreshAction, e.GVR(), path)
+		e.App().Flash().Info(dryRunMsg(fmt.Sprintf("Refresh requested for external secret %s", path)))
+	}, func() {})
+
+	return nil
+}
+
+// targetSecretCmd jumps to the secret a secrets operator resource manages.
+func targetSecretCmd(v ResourceViewer, target targetFunc) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		o, err := v.App().factory.Get(v.GVR(), path, true, labels.Everything())
+		if err != nil {
+			v.App().Flash().Err(err)
+			return nil
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			v.App().Flash().Errf("expecting unstructured but got %T", o)
+			return nil
+		}
+		ns, _ := client.Namespaced(path)
+		v.App().gotoResource(client.SecGVR.String()+" "+ns, client.FQN(ns, target(u)), false, true)
+
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/view/cmd"
+)
+
+// sessionSaveDelay coalesces bursts of stack changes into a single save.
+const sessionSaveDelay = 500 * time.Millisecond
+
+// sessionKeeper persists the current context views stack and filters as they change.
+type sessionKeeper struct {
+	app   *App
+	mx    sync.Mutex
+	timer *time.Timer
+}
+
+func newSessionKeeper(a *App) *sessionKeeper {
+	return &sessionKeeper{app: a}
+}
+
+// StackPushed notifies a new view was pushed.
+func (s *sessionKeeper) StackPushed(model.Component) {
+	s.schedule()
+}
+
+// StackPopped notifies a view was popped.
+func (s *sessionKeeper) StackPopped(_, _ model.Component) {
+	s.schedule()
+}
+
+// StackTop notifies the top of the stack.
+func (*sessionKeeper) StackTop(model.Component) {}
+
+func (s *sessionKeeper) schedule() {
+	if !s.app.Config.K9s.Session.Persist {
+		return
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.timer = time.AfterFunc(sessionSaveDelay, func() {
+		s.app.QueueUpdate(s.app.saveSession)
+	})
+}
+
+// saveSession persists the current context views stack when session persistence is on.
+func (a *App) saveSession() {
+	if !a.Config.K9s.Session.Persist || a.Content.Empty() {
+		return
+	}
+	ss := sessionState(a.Content.Peek())
+	if slices.Equal(ss, a.Config.Session()) {
+		return
+	}
+	a.Config.SetSession(ss)
+	if err := a.Config.Save(true); err != nil {
+		slog.Error("Unable to save session", slogs.Error, err)
+	}
+}
+
+// sessionState returns the command driven views along with their filters.
+// Drill down views are skipped as they can't be restored from a command.
+func sessionState(cc []model.Component) []data.ViewState {
+	ss := make([]data.ViewState, 0, len(cc))
+	for _, c := range cc {
+		rv, ok := c.(ResourceViewer)
+		if !ok {
+			continue
+		}
+		t := rv.GetTable()
+		if t.command == nil || t.Path != "" {
+			continue
+		}
+		ss = append(ss, data.ViewState{
+			Command: contextRX.ReplaceAllString(t.command.GetLine(), ""),
+			Filter:  t.CmdBuff().GetText(),
+		})
+	}
+
+	return ss
+}
+
+// restorable checks if a saved session holds more than the default launch view.
+func restorable(ss []data.ViewState, forwards int) bool {
+	if len(ss) > 1 || forwards > 0 {
+		return true
+	}
+
+	return len(ss) == 1 && ss[0].Filter != ""
+}
+
+// offerSession prompts to restore the current context last session if any.
+func (a *App) offerSession(ss []data.ViewState) {
+	var forwards int
+	if !a.Config.K9s.PortForward.Persist {
+		forwards = len(a.Config.PortForwards())
+	}
+	if !a.Config.K9s.Session.Persist || !restorable(ss, forwards) {
+		return
+	}
+	if a.Config.K9s.Session.AutoRestore {
+		a.restoreSession(ss)
+		return
+	}
+
+	msg := fmt.Sprintf("Restore your last session (%d views, %d port-forwards)?", len(ss), forwards)
+	d := a.Styles.Dialog()
+	dialog.ShowConfirm(&d, a.Content.Pages, "Restore Session", msg, func() {
+		a.restoreSession(ss)
+	}, func() {
+		if forwards == 0 {
+			return
+		}
+		a.Config.ClearPortForwards()
+		if err := a.Config.Save(true); err != nil {
+			slog.Error("Unable to save port-forwards", slogs.Error, err)
+		}
+	})
+}
+
+// restoreSession reopens a saved views stack and its port-forwards.
+func (a *App) restoreSession(ss []data.ViewState) {
+	var count int
+	for _, s := range ss {
+		if err := a.command.run(cmd.NewInterpreter(s.Command), "", count == 0, true); err != nil {
+			slog.Warn("Unable to restore session view", slogs.Command, s.Command, slogs.Error, err)
+			continue
+		}
+		count++
+		if top := a.Content.Top(); top != nil && s.Filter != "" {
+			top.SetFilter(s.Filter, true)
+		}
+	}
+	if !a.Config.K9s.PortForward.Persist {
+		restoreForwards(a)
+	}
+	a.Flash().Infof("Restored %d of %d views", count, len(ss))
+}
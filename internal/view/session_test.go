@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionState(t *testing.T) {
+	po := NewBrowser(client.PodGVR)
+	po.SetCommand(cmd.NewInterpreter("pods kube-system @prod"))
+	po.GetTable().CmdBuff().SetText("fred", "", true)
+
+	dp := NewBrowser(client.DpGVR)
+	dp.SetCommand(cmd.NewInterpreter("dp"))
+
+	drill := NewBrowser(client.PodGVR)
+	drill.SetCommand(cmd.NewInterpreter("pods"))
+	drill.GetTable().Path = "default/nginx"
+
+	assert.Equal(t, []data.ViewState{
+		{Command: "pods kube-system", Filter: "fred"},
+		{Command: "dp"},
+	}, sessionState([]model.Component{po, NewBrowser(client.SvcGVR), dp, drill}))
+}
+
+func TestRestorable(t *testing.T) {
+	uu := map[string]struct {
+		ss       []data.ViewState
+		forwards int
+		e        bool
+	}{
+		"empty": {},
+		"default": {
+			ss: []data.ViewState{{Command: "pods"}},
+		},
+		"filter": {
+			ss: []data.ViewState{{Command: "pods", Filter: "fred"}},
+			e:  true,
+		},
+		"stack": {
+			ss: []data.ViewState{{Command: "pods"}, {Command: "dp"}},
+			e:  true,
+		},
+		"forwards": {
+			ss:       []data.ViewState{{Command: "pods"}},
+			forwards: 1,
+			e:        true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, restorable(u.ss, u.forwards))
+		})
+	}
+}
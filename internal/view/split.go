@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const splitTitle = "split"
+
+// SplitTarget represents a split pane context and namespace.
+type SplitTarget struct {
+	Context, Namespace string
+}
+
+// NewSplitTarget returns a target given a [context][/namespace] spec.
+// Blank parts default to the given context and namespace.
+func NewSplitTarget(spec, ctx, ns string) SplitTarget {
+	c, n, _ := strings.Cut(spec, "/")
+	if c == "" {
+		c = ctx
+	}
+	if n == "" {
+		n = ns
+	}
+
+	return SplitTarget{Context: c, Namespace: n}
+}
+
+// String returns the target spec.
+func (t SplitTarget) String() string {
+	return t.Context + "/" + client.PrintNamespace(t.Namespace)
+}
+
+// Split presents a resource side by side across contexts or namespaces.
+type Split struct {
+	*tview.Flex
+
+	app     *App
+	gvr     *client.GVR
+	targets []SplitTarget
+	panes   []*splitPane
+	active  int
+}
+
+// NewSplit returns a new split view.
+func NewSplit(gvr *client.GVR, tt ...SplitTarget) *Split {
+	return &Split{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexColumn),
+		gvr:     gvr,
+		targets: tt,
+	}
+}
+
+// Init initializes the view.
+func (s *Split) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	if s.app.Conn() == nil {
+		return fmt.Errorf("no connection available")
+	}
+	meta, err := dao.MetaAccess.MetaFor(s.gvr)
+	if err != nil {
+		return err
+	}
+	colorerFn := model1.DefaultColorer
+	if r, ok := model.Registry[s.gvr]; ok && r.Renderer != nil {
+		colorerFn = r.Renderer.ColorerFunc()
+	}
+
+	for i, t := range s.targets {
+		f, err := dialContext(s.app.Conn().Config(), t.Context)
+		if err != nil {
+			s.terminate()
+			return err
+		}
+		p := splitPane{
+			Table:   NewTable(s.gvr),
+			target:  t,
+			factory: f,
+		}
+		p.Extras = t.String()
+		if err := p.Table.Init(ctx); err != nil {
+			s.terminate()
+			return err
+		}
+		p.SetColorerFn(colorerFn)
+		p.Actions().Bulk(ui.KeyMap{
+			tcell.KeyTab:    ui.NewKeyAction("Switch Pane", s.switchCmd, true),
+			tcell.KeyEscape: ui.NewKeyAction("Back", s.resetCmd, false),
+		})
+		ns := client.CleanseNamespace(t.Namespace)
+		if !meta.Namespaced {
+			ns = client.ClusterScope
+		}
+		p.GetModel().SetNamespace(ns)
+		s.panes = append(s.panes, &p)
+		s.AddItem(&p, 0, 1, i == s.active)
+	}
+
+	return nil
+}
+
+// dialContext connects to a given context using a dedicated factory.
+func dialContext(c *client.Config, ct string) (*watch.Factory, error) {
+	cfg, err := c.ForContext(ct)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.InitConnection(cfg, slog.Default())
+	if err != nil && !conn.ConnectionOK() {
+		return nil, fmt.Errorf("unable to connect to context %q: %w", ct, err)
+	}
+
+	return watch.NewFactory(conn), nil
+}
+
+// Start initializes the panes watch loops.
+func (s *Split) Start() {
+	s.Stop()
+	for _, p := range s.panes {
+		p.start()
+	}
+}
+
+// Stop terminates the panes watch loops.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.stop()
+	}
+}
+
+func (s *Split) terminate() {
+	for _, p := range s.panes {
+		p.factory.Terminate()
+	}
+}
+
+// Focus delegates focus to the active pane.
+func (s *Split) Focus(delegate func(p tview.Primitive)) {
+	if s.active < len(s.panes) {
+		delegate(s.panes[s.active])
+	}
+}
+
+func (s *Split) switchCmd(*tcell.EventKey) *tcell.EventKey {
+	s.active = (s.active + 1) % len(s.panes)
+	s.app.SetFocus(s.panes[s.active])
+	s.app.Menu().HydrateMenu(s.Hints())
+
+	return nil
+}
+
+func (s *Split) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p := s.activePane()
+	if !p.CmdBuff().InCmdMode() {
+		p.CmdBuff().ClearText(false)
+		return s.app.PrevCmd(evt)
+	}
+	p.CmdBuff().Reset()
+	p.GetModel().SetLabelSelector(labels.Everything())
+	p.Refresh()
+
+	return nil
+}
+
+// Name returns the component name.
+func (*Split) Name() string { return splitTitle }
+
+// InCmdMode checks if prompt is active.
+func (s *Split) InCmdMode() bool {
+	if p := s.activePane(); p != nil {
+		return p.CmdBuff().InCmdMode()
+	}
+
+	return false
+}
+
+// SetCommand sets the current command.
+func (*Split) SetCommand(*cmd.Interpreter) {}
+
+// SetFilter sets the active pane filter.
+func (s *Split) SetFilter(q string, wipe bool) {
+	if p := s.activePane(); p != nil {
+		p.CmdBuff().SetText(q, "", wipe)
+	}
+}
+
+// SetLabelSelector sets the active pane label selector.
+func (s *Split) SetLabelSelector(sel labels.Selector, _ bool) {
+	if p := s.activePane(); p != nil {
+		p.GetModel().SetLabelSelector(sel)
+	}
+}
+
+// Hints returns the active pane menu hints.
+func (s *Split) Hints() model.MenuHints {
+	if p := s.activePane(); p != nil {
+		return p.Hints()
+	}
+
+	return nil
+}
+
+// ExtraHints returns additional hints.
+func (*Split) ExtraHints() map[string]string {
+	return nil
+}
+
+func (s *Split) activePane() *splitPane {
+	if s.active >= len(s.panes) {
+		return nil
+	}
+
+	return s.panes[s.active]
+}
+
+// splitPane tracks a split view pane dedicated connection and watch loop.
+type splitPane struct {
+	*Table
+
+	target   SplitTarget
+	factory  *watch.Factory
+	cancelFn context.CancelFunc
+}
+
+func (p *splitPane) start() {
+	p.Table.Start()
+	p.factory.Start(p.target.Namespace)
+	p.GetModel().AddListener(p)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, p.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, p.GVR())
+	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(p.target.Namespace))
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, p.factory.Client().HasMetrics())
+	ctx = context.WithValue(ctx, internal.KeyPageSize, p.app.Config.K9s.GetListPageSize())
+	ctx, p.cancelFn = context.WithCancel(ctx)
+	if err := p.GetModel().Watch(ctx); err != nil {
+		slog.Error("Split pane watch failed",
+			slogs.GVR, p.GVR(),
+			slogs.Context, p.target.Context,
+			slogs.Error, err,
+		)
+		p.app.Flash().Errf("Watcher failed for %s in %s -- %s", p.GVR(), p.target, err)
+	}
+}
+
+func (p *splitPane) stop() {
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+	p.GetModel().RemoveListener(p)
+	p.Table.Stop()
+	p.factory.Terminate()
+}
+
+// TableDataChanged notifies the pane data changed.
+func (p *splitPane) TableDataChanged(data *model1.TableData) {
+	cdata := p.Update(data, p.factory.Client().HasMetrics())
+	p.app.QueueUpdateDraw(func() {
+		p.UpdateUI(cdata, data)
+	})
+}
+
+// TableNoData notifies the pane has no data.
+func (p *splitPane) TableNoData(data *model1.TableData) {
+	p.TableDataChanged(data)
+}
+
+// TableLoadFailed notifies the pane load failed.
+func (p *splitPane) TableLoadFailed(err error) {
+	p.app.QueueUpdateDraw(func() {
+		p.app.Flash().Errf("%s load failed -- %s", p.target, err)
+	})
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSplitTarget(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    SplitTarget
+		s    string
+	}{
+		"blank": {
+			e: SplitTarget{Context: "fred", Namespace: "default"},
+			s: "fred/default",
+		},
+		"context": {
+			spec: "staging",
+			e:    SplitTarget{Context: "staging", Namespace: "default"},
+			s:    "staging/default",
+		},
+		"namespace": {
+			spec: "/kube-system",
+			e:    SplitTarget{Context: "fred", Namespace: "kube-system"},
+			s:    "fred/kube-system",
+		},
+		"full": {
+			spec: "prod/all",
+			e:    SplitTarget{Context: "prod", Namespace: "all"},
+			s:    "prod/all",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tg := NewSplitTarget(u.spec, "fred", "default")
+			assert.Equal(t, u.e, tg)
+			assert.Equal(t, u.s, tg.String())
+		})
+	}
+}
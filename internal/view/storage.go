@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Storage presents a claims, volumes and usage diagnostics viewer.
+type Storage struct {
+	ResourceViewer
+}
+
+// NewStorage returns a new viewer.
+func NewStorage(gvr *client.GVR) ResourceViewer {
+	s := Storage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetEnterFn(s.describeClaim)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *Storage) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", s.describeCmd, true),
+		ui.KeyShiftU: ui.NewKeyAction("Sort %USED", s.GetTable().SortColCmd("%USED", false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", s.GetTable().SortColCmd("CAPACITY", false), false),
+	})
+}
+
+func (s *Storage) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	s.describeClaim(s.App(), nil, s.GVR(), path)
+
+	return nil
+}
+
+func (*Storage) describeClaim(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	describeResource(app, nil, client.PvcGVR, path)
+}
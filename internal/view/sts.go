@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // StatefulSet represents a statefulset viewer.
@@ -20,13 +21,20 @@ type StatefulSet struct {
 // NewStatefulSet returns a new viewer.
 func NewStatefulSet(gvr *client.GVR) ResourceViewer {
 	var s StatefulSet
-	s.ResourceViewer = NewPortForwardExtender(
-		NewVulnerabilityExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewImageExtender(
-						NewOwnerExtender(
-							NewLogsExtender(NewBrowser(gvr), s.logOptions),
+	s.ResourceViewer = NewRolloutExtender(
+		NewTimelineExtender(
+			NewPortForwardExtender(
+				NewVulnerabilityExtender(
+					NewRestartExtender(
+						NewScaleExtender(
+							NewImageExtender(
+								NewOwnerExtender(
+									NewLogGrepExtender(
+										NewLogsExtender(NewBrowser(gvr), s.logOptions),
+										s.selector,
+									),
+								),
+							),
 						),
 					),
 				),
@@ -61,6 +69,15 @@ func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _ *client.GVR, path strin
 	showPodsFromSelector(app, path, i.Spec.Selector)
 }
 
+func (s *StatefulSet) selector(path string) (*metav1.LabelSelector, error) {
+	sts, err := s.getInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return sts.Spec.Selector, nil
+}
+
 func (s *StatefulSet) getInstance(path string) (*appsv1.StatefulSet, error) {
 	var sts dao.StatefulSet
 
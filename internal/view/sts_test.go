@@ -17,5 +17,5 @@ func TestStatefulSetNew(t *testing.T) {
 
 	require.NoError(t, s.Init(makeCtx(t)))
 	assert.Equal(t, "StatefulSets", s.Name())
-	assert.Len(t, s.Hints(), 14)
+	assert.Len(t, s.Hints(), 19)
 }
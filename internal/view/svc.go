@@ -35,9 +35,11 @@ type Service struct {
 // NewService returns a new viewer.
 func NewService(gvr *client.GVR) ResourceViewer {
 	s := Service{
-		ResourceViewer: NewPortForwardExtender(
-			NewOwnerExtender(
-				NewLogsExtender(NewBrowser(gvr), nil),
+		ResourceViewer: NewMonitorExtender(
+			NewPortForwardExtender(
+				NewOwnerExtender(
+					NewLogsExtender(NewBrowser(gvr), nil),
+				),
 			),
 		),
 	}
@@ -51,10 +53,23 @@ func NewService(gvr *client.GVR) ResourceViewer {
 
 func (s *Service) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		ui.KeyB: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Endpoints", s.endpointsCmd, true),
 	})
 }
 
+func (s *Service) endpointsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := s.App().inject(newServiceEndpointsFor(path), false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (s *Service) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
 	var res dao.Service
 	res.Init(a.factory, s.GVR())
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ServiceEndpoints presents a service endpoint slices addresses viewer.
+type ServiceEndpoints struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewServiceEndpoints returns a new viewer.
+func NewServiceEndpoints(gvr *client.GVR) ResourceViewer {
+	s := ServiceEndpoints{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetEnterFn(s.showPod)
+	s.GetTable().SetSortCol("READY", true)
+	s.AddBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.svcContext)
+
+	return &s
+}
+
+// newServiceEndpointsFor returns a viewer listing a given service endpoints.
+func newServiceEndpointsFor(path string) ResourceViewer {
+	v := NewServiceEndpoints(client.SepGVR)
+	v.(*ServiceEndpoints).path = path
+
+	return v
+}
+
+// Init initializes the view.
+func (s *ServiceEndpoints) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (s *ServiceEndpoints) svcContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, s.path)
+}
+
+func (s *ServiceEndpoints) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyO: ui.NewKeyAction("Show Node", s.showNodeCmd, true),
+	})
+}
+
+func (*ServiceEndpoints) showPod(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	pod, _, err := dao.ParseEndpointPath(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if pod == "" {
+		app.Flash().Warn("Endpoint is not backed by a pod")
+		return
+	}
+	ns, _ := client.Namespaced(pod)
+	app.gotoResource(client.PodGVR.String()+" "+ns, pod, false, true)
+}
+
+func (s *ServiceEndpoints) showNodeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	_, node, err := dao.ParseEndpointPath(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if node == "" {
+		s.App().Flash().Err(errors.New("no node assigned"))
+		return nil
+	}
+	no := NewNode(client.NodeGVR)
+	no.SetInstance(node)
+	if err := s.App().inject(no, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
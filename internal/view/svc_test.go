@@ -174,5 +174,5 @@ func TestServiceNew(t *testing.T) {
 
 	require.NoError(t, s.Init(makeCtx(t)))
 	assert.Equal(t, "Services", s.Name())
-	assert.Len(t, s.Hints(), 13)
+	assert.Len(t, s.Hints(), 17)
 }
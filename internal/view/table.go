@@ -8,6 +8,8 @@ import (
 	"log/slog"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -19,6 +21,9 @@ import (
 	"github.com/derailed/tcell/v2"
 )
 
+// sortSaveDelay coalesces bursts of sort changes into a single config save.
+const sortSaveDelay = 500 * time.Millisecond
+
 // Table represents a table viewer.
 type Table struct {
 	*ui.Table
@@ -28,6 +33,8 @@ type Table struct {
 	envFn      EnvFunc
 	bindKeysFn []BindKeysFunc
 	command    *cmd.Interpreter
+	sortMx     sync.Mutex
+	sortTimer  *time.Timer
 }
 
 // NewTable returns a new viewer.
@@ -61,10 +68,44 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(t.app.Config.K9s.RefreshDuration())
 	t.CmdBuff().AddListener(t)
+	t.restoreSort()
 
 	return nil
 }
 
+// restoreSort applies the view last manual sort and tracks subsequent changes.
+// NOTE! The API server does not support ordering list results so sorting remains client side.
+func (t *Table) restoreSort() {
+	view := t.GVR().String()
+	if spec := t.app.Config.SortSpec(view); spec != "" {
+		if err := t.Table.SetSortSpec(spec); err != nil {
+			slog.Warn("Invalid sort spec", slogs.GVR, view, slogs.Error, err)
+		}
+	}
+	t.Table.SetSortChangedFn(func(spec string) {
+		if spec == t.app.Config.SortSpec(view) {
+			return
+		}
+		t.app.Config.SetSortSpec(view, spec)
+		t.scheduleSortSave()
+	})
+}
+
+func (t *Table) scheduleSortSave() {
+	t.sortMx.Lock()
+	defer t.sortMx.Unlock()
+	if t.sortTimer != nil {
+		t.sortTimer.Stop()
+	}
+	t.sortTimer = time.AfterFunc(sortSaveDelay, func() {
+		t.app.QueueUpdate(func() {
+			if err := t.app.Config.Save(true); err != nil {
+				slog.Error("Unable to save view sort", slogs.Error, err)
+			}
+		})
+	})
+}
+
 // SetCommand sets the current command.
 func (t *Table) SetCommand(i *cmd.Interpreter) {
 	t.command = i
@@ -229,6 +270,7 @@ func (t *Table) bindKeys() {
 		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
 		ui.KeyShiftS:           ui.NewKeyAction("Sort Status", t.SortColCmd(statusCol, true), false),
 		ui.KeyShiftO:           ui.NewKeyAction("Sort Selected Column", t.sortSelectedColumnCmd, false),
+		tcell.KeyCtrlO:         ui.NewKeyAction("Sort Then Selected Column", t.thenSortSelectedColumnCmd, false),
 	})
 }
 
@@ -247,6 +289,11 @@ func (t *Table) sortSelectedColumnCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) thenSortSelectedColumnCmd(*tcell.EventKey) *tcell.EventKey {
+	t.Table.ThenSortSelectedColumn()
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	paths := t.GetSelectedItems()
 	if len(paths) == 0 {
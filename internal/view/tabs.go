@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	maxTabs = 9
+
+	tabNew   = "new"
+	tabClose = "close"
+	tabNext  = "next"
+	tabPrev  = "prev"
+)
+
+// tab represents a workspace with its own views stack and namespace.
+type tab struct {
+	stack *PageStack
+	ns    string
+}
+
+// tabs tracks the app workspaces.
+type tabs struct {
+	tt     []*tab
+	active int
+}
+
+func (t *tabs) current() *tab {
+	return t.tt[t.active]
+}
+
+// cycle returns the tab index at a given offset from the active one.
+func (t *tabs) cycle(offset int) int {
+	n := len(t.tt)
+
+	return ((t.active+offset)%n + n) % n
+}
+
+// remove deletes a given tab and returns the tab to activate next.
+func (t *tabs) remove(i int) int {
+	t.tt = append(t.tt[:i], t.tt[i+1:]...)
+	if i > 0 {
+		i--
+	}
+
+	return i
+}
+
+// initStack initializes a views stack and wires its listeners.
+func (a *App) initStack(ctx context.Context, s *PageStack) error {
+	if err := s.Init(ctx); err != nil {
+		return err
+	}
+	s.AddListener(a.Crumbs())
+	s.AddListener(a.Menu())
+	s.AddListener(newSessionKeeper(a))
+
+	return nil
+}
+
+// newTab opens a new workspace and runs the given command or the active view in it.
+func (a *App) newTab(command string) error {
+	if len(a.tabs.tt) >= maxTabs {
+		return fmt.Errorf("maximum of %d tabs reached", maxTabs)
+	}
+	if command == "" {
+		command = a.Config.ActiveView()
+	}
+	s := NewPageStack()
+	ctx := context.WithValue(context.Background(), internal.KeyApp, a)
+	if err := a.initStack(ctx, s); err != nil {
+		return err
+	}
+	prev := a.tabs.active
+	a.tabs.current().ns = a.Config.ActiveNamespace()
+	a.tabs.tt = append(a.tabs.tt, &tab{stack: s, ns: a.Config.ActiveNamespace()})
+	a.showTab(len(a.tabs.tt) - 1)
+	if err := a.command.run(cmd.NewInterpreter(command), "", true, true); err != nil {
+		a.tabs.remove(a.tabs.active)
+		a.showTab(prev)
+		return err
+	}
+
+	return nil
+}
+
+// closeTab closes the active workspace.
+func (a *App) closeTab() error {
+	if len(a.tabs.tt) == 1 {
+		return errors.New("unable to close the last tab")
+	}
+	if top := a.Content.Top(); top != nil {
+		top.Stop()
+	}
+	a.showTab(a.tabs.remove(a.tabs.active))
+
+	return nil
+}
+
+// closeOtherTabs closes all but the active workspace.
+func (a *App) closeOtherTabs() {
+	if len(a.tabs.tt) <= 1 {
+		return
+	}
+	for i, t := range a.tabs.tt {
+		if i == a.tabs.active {
+			continue
+		}
+		if top := t.stack.Top(); top != nil {
+			top.Stop()
+		}
+	}
+	a.tabs.tt, a.tabs.active = []*tab{a.tabs.current()}, 0
+	a.Crumbs().SetTabs(1, 0)
+}
+
+// switchTab activates a given workspace.
+func (a *App) switchTab(i int) error {
+	if i < 0 || i >= len(a.tabs.tt) {
+		return fmt.Errorf("no tab %d", i+1)
+	}
+	if i == a.tabs.active {
+		return nil
+	}
+	a.tabs.current().ns = a.Config.ActiveNamespace()
+	a.showTab(i)
+
+	return nil
+}
+
+// showTab swaps in a given workspace views stack.
+// Background workspaces views keep running so log tails remain live.
+func (a *App) showTab(i int) {
+	a.tabs.active = i
+	t := a.tabs.current()
+	a.body.RemoveItem(a.Content)
+	a.body.AddItemAtIndex(0, t.stack, 0, contentProportion, true)
+	a.Content = t.stack
+	if err := a.switchNS(t.ns); err != nil {
+		slog.Error("Unable to switch tab namespace", slogs.Namespace, t.ns, slogs.Error, err)
+	}
+	a.Crumbs().Reset(t.stack.Peek())
+	a.Crumbs().SetTabs(len(a.tabs.tt), i)
+	if top := t.stack.Top(); top != nil {
+		a.Menu().HydrateMenu(top.Hints())
+		a.SetFocus(top)
+	}
+}
+
+func (a *App) newTabCmd(*tcell.EventKey) *tcell.EventKey {
+	if err := a.newTab(""); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) closeTabCmd(*tcell.EventKey) *tcell.EventKey {
+	if err := a.closeTab(); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) nextTabCmd(*tcell.EventKey) *tcell.EventKey {
+	if err := a.switchTab(a.tabs.cycle(1)); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTabsCycle(t *testing.T) {
+	uu := map[string]struct {
+		count, active, offset int
+		e                     int
+	}{
+		"single": {
+			count: 1,
+			e:     0,
+		},
+		"next": {
+			count: 3, active: 1, offset: 1,
+			e: 2,
+		},
+		"wrap-next": {
+			count: 3, active: 2, offset: 1,
+			e: 0,
+		},
+		"wrap-prev": {
+			count: 3, offset: -1,
+			e: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt := tabs{tt: make([]*tab, u.count), active: u.active}
+			assert.Equal(t, u.e, tt.cycle(u.offset))
+		})
+	}
+}
+
+func TestTabsRemove(t *testing.T) {
+	uu := map[string]struct {
+		count, remove int
+		e             int
+	}{
+		"first": {
+			count: 3,
+			e:     0,
+		},
+		"middle": {
+			count: 3, remove: 1,
+			e: 0,
+		},
+		"last": {
+			count: 3, remove: 2,
+			e: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt := tabs{tt: make([]*tab, u.count)}
+			assert.Equal(t, u.e, tt.remove(u.remove))
+			assert.Len(t, tt.tt, u.count-1)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Timeline presents a resource events, rollouts and restarts in chronological order.
+type Timeline struct {
+	ResourceViewer
+}
+
+// NewTimeline returns a new viewer.
+func NewTimeline(gvr *client.GVR) ResourceViewer {
+	t := Timeline{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetSortCol("TIME", true)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+// Init initializes the view.
+func (t *Timeline) Init(ctx context.Context) error {
+	if err := t.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	t.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (t *Timeline) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Time", t.GetTable().SortColCmd("TIME", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Source", t.GetTable().SortColCmd("SOURCE", true), false),
+		ui.KeyShiftY: ui.NewKeyAction("Sort Type", t.GetTable().SortColCmd("TYPE", true), false),
+	})
+}
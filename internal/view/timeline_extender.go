@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// TimelineExtender adds resource timeline extensions.
+type TimelineExtender struct {
+	ResourceViewer
+}
+
+// NewTimelineExtender returns a new extender.
+func NewTimelineExtender(r ResourceViewer) ResourceViewer {
+	t := TimelineExtender{ResourceViewer: r}
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *TimelineExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftT, ui.NewKeyAction("Timeline", t.timelineCmd, true))
+}
+
+func (t *TimelineExtender) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	v := NewTimeline(client.TlGVR)
+	v.SetContextFn(timelineContext(t.GVR(), path))
+	if err := t.App().inject(v, false); err != nil {
+		t.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func timelineContext(gvr *client.GVR, path string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	}
+}
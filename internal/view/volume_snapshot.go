@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	snapshotDialogKey = "snapshot"
+	restoreDialogKey  = "snapshotRestore"
+)
+
+// VolumeSnapshot represents a volume snapshot viewer.
+type VolumeSnapshot struct {
+	ResourceViewer
+}
+
+// NewVolumeSnapshot returns a new viewer.
+func NewVolumeSnapshot(gvr *client.GVR) ResourceViewer {
+	v := VolumeSnapshot{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetSortCol("AGE", true)
+
+	return &v
+}
+
+func (v *VolumeSnapshot) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", v.GetTable().SortColCmd("READY", true), false))
+	if v.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Restore", v.restoreCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+func (v *VolumeSnapshot) restoreCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	_, n := client.Namespaced(path)
+	f := newSnapshotForm(v.App(), "Claim:", n+"-restore", "Storage Class:", nil, func(claim, class string) {
+		if err := v.restore(path, claim, class); err != nil {
+			v.App().Flash().Err(err)
+		}
+	})
+	if field, ok := f.GetFormItemByLabel("Storage Class:").(*tview.InputField); ok {
+		field.SetPlaceholder("keep source class")
+	}
+	showSnapshotDialog(v.App(), restoreDialogKey, "<Restore>", fmt.Sprintf("Restore snapshot %s as a new claim?", path), f)
+
+	return nil
+}
+
+func (v *VolumeSnapshot) restore(path, claim, class string) error {
+	var vs dao.VolumeSnapshot
+	vs.Init(v.App().factory, client.VsGVR)
+	ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+	defer cancel()
+	fqn, err := vs.Restore(ctx, path, claim, class)
+	if err != nil {
+		return err
+	}
+	v.App().audit(audit.RestoreAction, client.VsGVR, path)
+	v.App().Flash().Info(dryRunMsg(fmt.Sprintf("Snapshot %s restored as claim %s", path, fqn)))
+
+	return nil
+}
+
+// snapshotClaim prompts for a snapshot name and class and snapshots the claim.
+func snapshotClaim(app *App, path string) {
+	_, n := client.Namespaced(path)
+	cc, err := dao.SnapshotClasses(app.factory)
+	if err != nil {
+		app.Flash().Errf("Volume snapshots are not available: %s", err)
+		return
+	}
+	name := n + "-" + time.Now().Format("20060102150405")
+	f := newSnapshotForm(app, "Name:", name, "Class:", cc, func(name, class string) {
+		var vs dao.VolumeSnapshot
+		vs.Init(app.factory, client.VsGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		fqn, err := vs.Snapshot(ctx, path, name, class)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.audit(audit.SnapshotAction, client.PvcGVR, path)
+		app.Flash().Info(dryRunMsg(fmt.Sprintf("Claim %s snapshotted as %s", path, fqn)))
+	})
+	showSnapshotDialog(app, snapshotDialogKey, "<Snapshot>", fmt.Sprintf("Snapshot claim %s?", path), f)
+}
+
+// newSnapshotForm returns a name and class form. Classes show as a dropdown when known.
+func newSnapshotForm(app *App, nameLabel, name, classLabel string, classes []string, ok func(name, class string)) *tview.Form {
+	styles := app.Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var class string
+	f.AddInputField(nameLabel, name, 0, nil, func(changed string) {
+		name = changed
+	})
+	if len(classes) > 0 {
+		class = classes[0]
+		f.AddDropDown(classLabel, classes, 0, func(option string, _ int) {
+			class = option
+		})
+	} else {
+		f.AddInputField(classLabel, "", 0, nil, func(changed string) {
+			class = changed
+		})
+	}
+
+	dismiss := func() {
+		app.Content.RemovePage(snapshotDialogKey)
+		app.Content.RemovePage(restoreDialogKey)
+	}
+	f.AddButton("OK", func() {
+		defer dismiss()
+		if name == "" {
+			app.Flash().Errf("%s is required", strings.TrimSuffix(nameLabel, ":"))
+			return
+		}
+		ok(name, class)
+	})
+	f.AddButton("Cancel", dismiss)
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	return f
+}
+
+func showSnapshotDialog(app *App, key, title, msg string, f *tview.Form) {
+	confirm := tview.NewModalForm(title, f)
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		app.Content.RemovePage(key)
+	})
+	app.Content.AddPage(key, confirm, false, false)
+	app.Content.ShowPage(key)
+}
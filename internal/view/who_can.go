@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// WhoCan presents a RBAC reverse lookup viewer listing subjects allowed to perform an action.
+type WhoCan struct {
+	ResourceViewer
+
+	query dao.WhoCanQuery
+}
+
+// NewWhoCan returns a new viewer.
+func NewWhoCan(q dao.WhoCanQuery) *WhoCan {
+	w := WhoCan{
+		ResourceViewer: NewBrowser(client.WcGVR),
+		query:          q,
+	}
+	w.AddBindKeysFn(w.bindKeys)
+	w.GetTable().SetSortCol("NAME", true)
+	w.SetContextFn(w.whoCanCtx)
+	w.GetTable().SetEnterFn(w.showBinding)
+
+	return &w
+}
+
+func (w *WhoCan) whoCanCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, w.query.String())
+	return context.WithValue(ctx, internal.KeyWhoCan, w.query)
+}
+
+func (w *WhoCan) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Scope", w.GetTable().SortColCmd("SCOPE", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Binding", w.GetTable().SortColCmd("BINDING", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Role", w.GetTable().SortColCmd("ROLE", true), false),
+	})
+}
+
+func (*WhoCan) showBinding(app *App, t ui.Tabular, _ *client.GVR, path string) {
+	gvr, fqn, ok := dao.ParseWhoCanID(path)
+	if !ok {
+		app.Flash().Err(fmt.Errorf("unable to locate binding for %q", path))
+		return
+	}
+	showRules(app, t, gvr, fqn)
+}
@@ -5,11 +5,15 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
@@ -18,25 +22,153 @@ import (
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // Workload presents a workload viewer.
 type Workload struct {
 	ResourceViewer
+
+	degradedOnly bool
+	namespaces   []string
+	grouped      bool
+	collapsed    sets.Set[string]
+	ownedHidden  bool
 }
 
 // NewWorkload returns a new viewer.
 func NewWorkload(gvr *client.GVR) ResourceViewer {
 	w := Workload{
 		ResourceViewer: NewBrowser(gvr),
+		collapsed:      sets.New[string](),
 	}
 	w.GetTable().SetEnterFn(w.showRes)
 	w.AddBindKeysFn(w.bindKeys)
+	w.SetContextFn(w.workloadContext)
 	w.GetTable().SetSortCol("KIND", true)
 
 	return &w
 }
 
+func (w *Workload) workloadContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyDegradedOnly, w.degradedOnly)
+	ctx = context.WithValue(ctx, internal.KeyNamespaces, w.namespaces)
+	ctx = context.WithValue(ctx, internal.KeyCollapseOwned, w.ownedHidden)
+	if w.grouped {
+		ctx = context.WithValue(ctx, internal.KeyWorkloadGroup, w.App().Config.K9s.Workload.GetGroupLabel())
+		ctx = context.WithValue(ctx, internal.KeyCollapsed, sets.List(w.collapsed))
+	}
+
+	ctx = context.WithValue(ctx, internal.KeyWorkloadCEL, w.App().Config.K9s.Workload.Custom)
+
+	return context.WithValue(ctx, internal.KeyWorkloadGVRs, w.App().Config.K9s.Workload.GVRs)
+}
+
+func (w *Workload) toggleDegradedCmd(*tcell.EventKey) *tcell.EventKey {
+	w.degradedOnly = !w.degradedOnly
+	if w.degradedOnly {
+		w.App().Flash().Info("Showing degraded workloads only")
+	} else {
+		w.App().Flash().Info("Showing all workloads")
+	}
+	w.Start()
+
+	return nil
+}
+
+func (w *Workload) toggleGroupsCmd(*tcell.EventKey) *tcell.EventKey {
+	w.grouped = !w.grouped
+	if w.grouped {
+		w.GetTable().SetSortCol("GROUP", true)
+		w.App().Flash().Infof("Grouping workloads by %s", w.App().Config.K9s.Workload.GetGroupLabel())
+	} else {
+		w.GetTable().SetSortCol("KIND", true)
+		w.App().Flash().Info("Showing flat workloads")
+	}
+	w.Start()
+
+	return nil
+}
+
+func (w *Workload) toggleOwnedCmd(*tcell.EventKey) *tcell.EventKey {
+	w.ownedHidden = !w.ownedHidden
+	if w.ownedHidden {
+		w.App().Flash().Info("Collapsing workloads under their owners")
+	} else {
+		w.App().Flash().Info("Showing owned workloads")
+	}
+	w.Start()
+
+	return nil
+}
+
+func (w *Workload) toggleCollapse(path string) {
+	_, fqn, _ := dao.ParseWorkloadPath(path)
+	if w.collapsed.Has(fqn) {
+		w.collapsed.Delete(fqn)
+	} else {
+		w.collapsed.Insert(fqn)
+	}
+	w.GetTable().SetPendingSelection(path)
+	w.Start()
+}
+
+func (w *Workload) selectNamespacesCmd(*tcell.EventKey) *tcell.EventKey {
+	nn, err := w.App().factory.Client().ValidNamespaceNames()
+	if err != nil {
+		w.App().Flash().Err(err)
+		return nil
+	}
+	d := w.App().Styles.Dialog()
+	dialog.ShowMultiSelection(&d, w.App().Content.Pages, "Namespaces", slices.Sorted(maps.Keys(nn)), w.namespaces, func(nss []string) {
+		w.namespaces = nss
+		if len(nss) == 0 {
+			w.App().Flash().Info("Showing workloads in active namespace")
+		} else {
+			w.App().Flash().Infof("Showing workloads in namespaces %s", strings.Join(nss, ","))
+		}
+		w.Start()
+	})
+
+	return nil
+}
+
+func (w *Workload) exportCmd(*tcell.EventKey) *tcell.EventKey {
+	ff := []dao.ExportFormat{dao.ExportJSON, dao.ExportCSV}
+	opts := make([]string, 0, len(ff))
+	for _, f := range ff {
+		opts = append(opts, strings.ToUpper(string(f)))
+	}
+	d := w.App().Styles.Dialog()
+	dialog.ShowSelection(&d, w.App().Content.Pages, "Export Format", opts, func(i int) {
+		if i < 0 || i >= len(ff) {
+			return
+		}
+		path, err := w.export(ff[i])
+		if err != nil {
+			w.App().Flash().Err(err)
+			return
+		}
+		w.App().Flash().Infof("Workloads snapshot saved to %s", path)
+	})
+
+	return nil
+}
+
+func (w *Workload) export(f dao.ExportFormat) (string, error) {
+	a, err := dao.AccessorFor(w.App().factory, w.GVR())
+	if err != nil {
+		return "", err
+	}
+	ns := w.GetTable().GetModel().GetNamespace()
+	oo, err := a.List(w.GetTable().GetContext(), ns)
+	if err != nil {
+		return "", err
+	}
+
+	return dao.ExportWorkloads(w.App().Config.K9s.ContextScreenDumpDir(), ns, f, oo)
+}
+
 func (w *Workload) bindDangerousKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyE: ui.NewKeyActionWithOpts("Edit", w.editCmd,
@@ -49,9 +181,58 @@ func (w *Workload) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyR: ui.NewKeyActionWithOpts("Restart", w.restartCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyS: ui.NewKeyActionWithOpts("Scale", w.scaleCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftB: ui.NewKeyActionWithOpts("Bulk Action", w.bulkCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftZ: ui.NewKeyActionWithOpts("Pause Rollout", w.pauseCmd(true),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftU: ui.NewKeyActionWithOpts("Resume Rollout", w.pauseCmd(false),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
+func (w *Workload) pauseCmd(paused bool) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		paths := w.GetTable().GetSelectedItems()
+		if len(paths) == 0 || paths[0] == "" {
+			return evt
+		}
+		tt := make([]rolloutTarget, 0, len(paths))
+		for _, path := range paths {
+			gvr, fqn, ok := parsePath(path)
+			if !ok || gvr != client.DpGVR {
+				continue
+			}
+			tt = append(tt, rolloutTarget{gvr: gvr, path: fqn})
+		}
+		if len(tt) == 0 {
+			w.App().Flash().Warn("Only deployments rollouts can be paused")
+			return nil
+		}
+		pauseRollouts(w.App(), tt, paused, w.Refresh)
+
+		return nil
+	}
+}
+
 func (w *Workload) bindKeys(aa *ui.KeyActions) {
 	if !w.App().Config.IsReadOnly() {
 		w.bindDangerousKeys(aa)
@@ -61,28 +242,48 @@ func (w *Workload) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", w.GetTable().SortColCmd("READY", true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", w.GetTable().SortColCmd(ageCol, true), false),
+		ui.KeyShiftD: ui.NewKeyAction("Toggle Degraded", w.toggleDegradedCmd, true),
+		ui.KeyShiftG: ui.NewKeyAction("Toggle Groups", w.toggleGroupsCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Toggle Owned", w.toggleOwnedCmd, true),
+		ui.KeyShiftM: ui.NewKeyAction("Select Namespaces", w.selectNamespacesCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Export", w.exportCmd, true),
 		ui.KeyY:      ui.NewKeyAction(yamlAction, w.yamlCmd, true),
 		ui.KeyD:      ui.NewKeyAction("Describe", w.describeCmd, true),
+		ui.KeyI:      ui.NewKeyAction("Conditions", w.conditionsCmd, true),
+		ui.KeyShiftH: ui.NewKeyAction("Headroom", w.headroomCmd, true),
 	})
 }
 
 func parsePath(path string) (*client.GVR, string, bool) {
-	tt := strings.Split(path, "|")
-	if len(tt) != 3 {
+	gvr, fqn, err := dao.ParseWorkloadPath(path)
+	if err != nil || dao.IsAppGroup(path) {
 		slog.Error("Unable to parse workload path", slogs.Path, path)
 		return client.NoGVR, client.FQN("", ""), false
 	}
 
-	return client.NewGVR(tt[0]), client.FQN(tt[1], tt[2]), true
+	return gvr, fqn, true
 }
 
-func (*Workload) showRes(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+func (w *Workload) showRes(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	if dao.IsAppGroup(path) {
+		w.toggleCollapse(path)
+		return
+	}
+	gotoPath(app, path)
+}
+
+// gotoPath navigates to a resource given its gvr|ns|name path.
+func gotoPath(app *App, path string) {
 	gvr, fqn, ok := parsePath(path)
 	if !ok {
 		app.Flash().Err(fmt.Errorf("unable to parse path: %q", path))
 		return
 	}
-	app.gotoResource(gvr.String(), fqn, false, true)
+	ns, _ := client.Namespaced(fqn)
+	app.gotoResource(strings.TrimSpace(gvr.String()+" "+ns), "", false, true)
+	if v, ok := app.Content.Top().(ResourceViewer); ok && v.GVR() == gvr {
+		v.GetTable().SetPendingSelection(fqn)
+	}
 }
 
 func (w *Workload) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -125,9 +326,9 @@ func (w *Workload) resourceDelete(selections []string, msg string) {
 	okFn := func(propagation *metav1.DeletionPropagation, force bool) {
 		w.GetTable().ShowDeleted()
 		if len(selections) > 1 {
-			w.App().Flash().Infof("Delete %d marked %s", len(selections), w.GVR())
+			w.App().Flash().Info(dryRunMsg(fmt.Sprintf("Delete %d marked %s", len(selections), w.GVR())))
 		} else {
-			w.App().Flash().Infof("Delete resource %s %s", w.GVR(), selections[0])
+			w.App().Flash().Info(dryRunMsg(fmt.Sprintf("Delete resource %s %s", w.GVR(), selections[0])))
 		}
 		for _, sel := range selections {
 			gvr, fqn, ok := parsePath(sel)
@@ -143,14 +344,135 @@ func (w *Workload) resourceDelete(selections []string, msg string) {
 			if err := w.GetTable().GetModel().Delete(w.defaultContext(gvr, fqn), fqn, propagation, grace); err != nil {
 				w.App().Flash().Errf("Delete failed with `%s", err)
 			} else {
+				w.App().audit(audit.DeleteAction, gvr, fqn)
 				w.App().factory.DeleteForwarder(sel)
 			}
 			w.GetTable().DeleteMark(sel)
 		}
 		w.GetTable().Start()
 	}
+	var pods []string
+	for _, sel := range selections {
+		if gvr, fqn, ok := parsePath(sel); ok && gvr == client.PodGVR {
+			pods = append(pods, fqn)
+		}
+	}
+	var guard string
+	if len(pods) > 0 {
+		guard = disruptionsGuard(w.App(), client.PodGVR, pods)
+	}
+	d := w.App().Styles.Dialog()
+	dialog.ShowDeleteGuarded(&d, w.App().Content.Pages, msg, w.deleteImpact(selections), guard, okFn, func() {})
+}
+
+func (w *Workload) deleteImpact(paths []string) dialog.ImpactFunc {
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+	type dependents struct {
+		path string
+		gvr  *client.GVR
+		dd   []dao.WorkloadDependent
+	}
+	deps := make([]dependents, 0, len(paths))
+	for _, path := range paths {
+		gvr, fqn, ok := parsePath(path)
+		if !ok {
+			continue
+		}
+		dd, err := wk.Dependents(gvr, fqn)
+		if err != nil {
+			slog.Warn("Unable to resolve workload dependents",
+				slogs.Path, path,
+				slogs.Error, err,
+			)
+			continue
+		}
+		deps = append(deps, dependents{path: path, gvr: gvr, dd: dd})
+	}
+
+	return func(propagation string) string {
+		ss := make([]string, 0, len(deps))
+		for _, d := range deps {
+			impact := dao.DeleteImpact(d.gvr, d.dd, propagation)
+			if len(deps) > 1 {
+				impact = d.path + "\n" + impact
+			}
+			ss = append(ss, impact)
+		}
+		return strings.Join(ss, "\n\n")
+	}
+}
+
+func (w *Workload) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := w.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+
+	w.Stop()
+	defer w.Start()
+	msg := fmt.Sprintf("Restart %s?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Restart %d marked workloads?", len(paths))
+	}
 	d := w.App().Styles.Dialog()
-	dialog.ShowDelete(&d, w.App().Content.Pages, msg, okFn, func() {})
+	opts := dialog.RestartDialogOpts{
+		Title:        "Confirm Restart",
+		Message:      msg,
+		FieldManager: "kubectl-rollout",
+		Ack: func(opts *metav1.PatchOptions) bool {
+			w.runRestart(paths, opts)
+			return true
+		},
+		Cancel: func() {},
+	}
+	dialog.ShowRestart(&d, w.App().Content.Pages, &opts)
+
+	return nil
+}
+
+// runRestart issues the rollout restarts off the UI thread and reports once they all landed.
+func (w *Workload) runRestart(paths []string, opts *metav1.PatchOptions) {
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+
+	w.App().Flash().Infof("Restarting %d workloads...", len(paths))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var (
+			errs []error
+			tt   = make([]rolloutTarget, 0, len(paths))
+		)
+		for _, path := range paths {
+			gvr, fqn, ok := parsePath(path)
+			if !ok {
+				errs = append(errs, fmt.Errorf("unable to parse path: %q", path))
+				continue
+			}
+			if err := wk.Restart(ctx, gvr, fqn, opts); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			w.App().audit(audit.RestartAction, gvr, fqn)
+			tt = append(tt, rolloutTarget{gvr: gvr, path: fqn})
+		}
+		w.App().QueueUpdateDraw(func() {
+			w.restartDone(tt, errs)
+		})
+	}()
+}
+
+func (w *Workload) restartDone(tt []rolloutTarget, errs []error) {
+	switch {
+	case len(errs) > 0:
+		w.App().Flash().Err(errors.Join(errs...))
+	case len(tt) == 1:
+		w.App().Flash().Info(dryRunMsg(fmt.Sprintf("Restart in progress for `%s...", tt[0].path)))
+	default:
+		w.App().Flash().Info(dryRunMsg(fmt.Sprintf("Restart in progress for %d workloads...", len(tt))))
+	}
+	watchRollouts(w.App(), tt)
 }
 
 func (w *Workload) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -169,6 +491,62 @@ func (w *Workload) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (w *Workload) conditionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	gvr, fqn, ok := parsePath(path)
+	if !ok {
+		w.App().Flash().Err(fmt.Errorf("unable to parse path: %q", path))
+		return evt
+	}
+
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+	defer cancel()
+	cc, err := wk.Conditions(ctx, gvr, fqn)
+	if err != nil {
+		w.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(w.App(), "Conditions", fqn, contentYAML, true).Update(cc)
+	if err := w.App().inject(details, false); err != nil {
+		w.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (w *Workload) headroomCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	gvr, fqn, ok := parsePath(path)
+	if !ok {
+		w.App().Flash().Err(fmt.Errorf("unable to parse path: %q", path))
+		return evt
+	}
+
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+	defer cancel()
+	h, err := wk.Headroom(ctx, gvr, fqn)
+	if err != nil {
+		w.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(w.App(), "Headroom", fqn, contentYAML, true).Update(h)
+	if err := w.App().inject(details, false); err != nil {
+		w.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (w *Workload) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := w.GetTable().GetSelectedItem()
 	if path == "" {
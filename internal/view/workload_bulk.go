@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const replicasInput = "replicas"
+
+var workloadOps = []dao.WorkloadOp{
+	dao.WorkloadDelete,
+	dao.WorkloadRestart,
+	dao.WorkloadScale,
+}
+
+var workloadOpActions = map[dao.WorkloadOp]string{
+	dao.WorkloadDelete:  audit.DeleteAction,
+	dao.WorkloadRestart: audit.RestartAction,
+	dao.WorkloadScale:   audit.ScaleAction,
+}
+
+func (w *Workload) bulkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := w.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+	paths = slices.DeleteFunc(paths, dao.IsAppGroup)
+	if len(paths) == 0 {
+		w.App().Flash().Warn("Bulk actions do not apply to application groups")
+		return nil
+	}
+
+	opts := make([]string, 0, len(workloadOps))
+	for _, op := range workloadOps {
+		opts = append(opts, string(op))
+	}
+	d := w.App().Styles.Dialog()
+	dialog.ShowSelection(&d, w.App().Content.Pages, fmt.Sprintf("Bulk Action [%d]", len(paths)), opts, func(i int) {
+		if i < 0 || i >= len(workloadOps) {
+			return
+		}
+		w.showBulkDialog(workloadOps[i], paths)
+	})
+
+	return nil
+}
+
+func (w *Workload) showBulkDialog(op dao.WorkloadOp, paths []string) {
+	d := w.App().Styles.Dialog()
+	msg := fmt.Sprintf("%s %d marked workloads?", op, len(paths))
+	switch op {
+	case dao.WorkloadDelete:
+		dialog.ShowDeleteImpact(&d, w.App().Content.Pages, msg, w.deleteImpact(paths), func(propagation *metav1.DeletionPropagation, force bool) {
+			grace := dao.DefaultGrace
+			if force {
+				grace = dao.ForceGrace
+			}
+			w.runBulk(paths, &dao.WorkloadBulkOpts{Op: op, Propagation: propagation, Grace: grace})
+		}, func() {})
+	case dao.WorkloadRestart:
+		dialog.ShowRestart(&d, w.App().Content.Pages, &dialog.RestartDialogOpts{
+			Title:        "Confirm Restart",
+			Message:      msg,
+			FieldManager: "kubectl-rollout",
+			Ack: func(opts *metav1.PatchOptions) bool {
+				w.runBulk(paths, &dao.WorkloadBulkOpts{Op: op, FieldManager: opts.FieldManager})
+				return true
+			},
+			Cancel: func() {},
+		})
+	case dao.WorkloadScale:
+		inputs := []config.PluginInput{
+			{Name: replicasInput, Label: "Replicas", Type: config.InputTypeNumber, Required: true},
+		}
+		dialog.ShowPluginInputs(&d, w.App().Content.Pages, msg, inputs,
+			func(msg string) {
+				w.App().Flash().Warn(msg)
+			},
+			func(vv dialog.PluginInputValues) {
+				replicas, err := strconv.Atoi(vv[replicasInput])
+				if err != nil || replicas < 0 {
+					w.App().Flash().Errf("Invalid replicas count %q", vv[replicasInput])
+					return
+				}
+				w.runBulk(paths, &dao.WorkloadBulkOpts{Op: op, Replicas: int32(replicas)})
+			},
+			func() {},
+		)
+	}
+}
+
+func (w *Workload) runBulk(paths []string, opts *dao.WorkloadBulkOpts) {
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+
+	w.App().Flash().Infof("%s %d workloads...", opts.Op, len(paths))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+		defer cancel()
+		rr := wk.Bulk(ctx, paths, opts)
+		for _, r := range rr {
+			gvr, fqn, err := dao.ParseWorkloadPath(r.Path)
+			if err != nil {
+				continue
+			}
+			w.App().auditResult(workloadOpActions[opts.Op], gvr, fqn, r.Err)
+			if r.Err == nil && opts.Op == dao.WorkloadDelete && gvr == client.PodGVR {
+				w.App().factory.DeleteForwarder(fqn)
+			}
+		}
+		w.App().QueueUpdateDraw(func() {
+			w.bulkDone(opts.Op, rr)
+		})
+	}()
+}
+
+func (w *Workload) bulkDone(op dao.WorkloadOp, rr []dao.WorkloadResult) {
+	var errs int
+	for _, r := range rr {
+		if r.Err != nil {
+			errs++
+			continue
+		}
+		w.GetTable().DeleteMark(r.Path)
+	}
+	if errs == 0 {
+		w.App().Flash().Infof("%s succeeded for %d workloads", op, len(rr))
+	} else {
+		w.App().Flash().Warnf("%s failed for %d/%d workloads", op, errs, len(rr))
+	}
+
+	details := NewDetails(w.App(), fmt.Sprintf("Bulk %s", op), w.GVR().R(), contentYAML, true).Update(bulkReport(rr))
+	if err := w.App().inject(details, false); err != nil {
+		w.App().Flash().Err(err)
+	}
+}
+
+func bulkReport(rr []dao.WorkloadResult) string {
+	var b strings.Builder
+	b.WriteString("results:\n")
+	for _, r := range rr {
+		fmt.Fprintf(&b, "- workload: %s\n", r.Path)
+		if r.Err != nil {
+			fmt.Fprintf(&b, "  status: failed\n  error: %q\n", r.Err.Error())
+			continue
+		}
+		b.WriteString("  status: ok\n")
+	}
+
+	return b.String()
+}
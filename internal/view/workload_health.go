@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// WorkloadHealth presents a namespace workloads health viewer.
+type WorkloadHealth struct {
+	ResourceViewer
+}
+
+// NewWorkloadHealth returns a new viewer.
+func NewWorkloadHealth(gvr *client.GVR) ResourceViewer {
+	w := WorkloadHealth{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetEnterFn(w.showWorkloads)
+	w.AddBindKeysFn(w.bindKeys)
+	w.SetContextFn(w.workloadContext)
+	w.GetTable().SetSortCol("DEGRADED", false)
+
+	return &w
+}
+
+func (w *WorkloadHealth) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftS)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Degraded", w.GetTable().SortColCmd("DEGRADED", false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort %Ready", w.GetTable().SortColCmd("%READY", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Namespace", w.GetTable().SortColCmd("NAMESPACE", true), false),
+	})
+}
+
+func (w *WorkloadHealth) workloadContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyWorkloadCEL, w.App().Config.K9s.Workload.Custom)
+
+	return context.WithValue(ctx, internal.KeyWorkloadGVRs, w.App().Config.K9s.Workload.GVRs)
+}
+
+func (*WorkloadHealth) showWorkloads(app *App, _ ui.Tabular, _ *client.GVR, ns string) {
+	app.gotoResource(client.WkGVR.String()+" "+ns, "", false, true)
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+func (w *Workload) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := w.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+
+	w.Stop()
+	defer w.Start()
+	w.showScaleDialog(paths)
+
+	return nil
+}
+
+func (w *Workload) showScaleDialog(paths []string) {
+	msg := fmt.Sprintf("Scale %s?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Scale %d marked workloads?", len(paths))
+	}
+	confirm := tview.NewModalForm("<Scale>", w.makeScaleForm(paths, func(s string) {
+		if m, ok := w.App().Content.GetPrimitive(scaleDialogKey).(*tview.ModalForm); ok {
+			m.SetText(s)
+		}
+	}))
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		w.dismissScaleDialog()
+	})
+	w.App().Content.AddPage(scaleDialogKey, confirm, false, false)
+	w.App().Content.ShowPage(scaleDialogKey)
+}
+
+func (w *Workload) makeScaleForm(paths []string, preview func(string)) *tview.Form {
+	factor := "0"
+	if len(paths) == 1 {
+		factor = w.desiredReplicas()
+	}
+
+	styles := w.App().Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	f.AddInputField("Replicas:", factor, 4, func(textToCheck string, _ rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(changed string) {
+		factor = changed
+	})
+
+	f.AddButton("Preview", func() {
+		count, err := strconv.Atoi(factor)
+		if err != nil {
+			w.App().Flash().Err(err)
+			return
+		}
+		preview(w.previewScale(paths, int32(count)))
+	})
+	f.AddButton("OK", func() {
+		defer w.dismissScaleDialog()
+		count, err := strconv.Atoi(factor)
+		if err != nil {
+			w.App().Flash().Err(err)
+			return
+		}
+		w.scale(paths, int32(count))
+	})
+	f.AddButton("Cancel", func() {
+		w.dismissScaleDialog()
+	})
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	return f
+}
+
+// desiredReplicas returns the selected workload desired replicas from its READY column.
+func (w *Workload) desiredReplicas() string {
+	idx, ok := w.GetTable().HeaderIndex("READY")
+	if !ok {
+		return "0"
+	}
+	tokens := strings.Split(w.GetTable().GetSelectedCell(idx), "/")
+	if len(tokens) < 2 {
+		return "0"
+	}
+
+	return strings.TrimRight(tokens[1], ui.DeltaSign)
+}
+
+func (w *Workload) previewScale(paths []string, replicas int32) string {
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	ll := make([]string, 0, len(paths)+1)
+	ll = append(ll, "Dry run:")
+	for _, path := range paths {
+		gvr, fqn, ok := parsePath(path)
+		if !ok {
+			ll = append(ll, fmt.Sprintf("%s: invalid path", path))
+			continue
+		}
+		p, err := wk.PreviewScale(ctx, gvr, fqn, replicas)
+		if err != nil {
+			ll = append(ll, fmt.Sprintf("%s %s: %s", gvr.R(), fqn, err))
+			continue
+		}
+		ll = append(ll, fmt.Sprintf("%s %s: %d -> %d", gvr.R(), fqn, p.Current, p.Desired))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func (w *Workload) scale(paths []string, replicas int32) {
+	var wk dao.Workload
+	wk.Init(w.App().factory, w.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	for _, path := range paths {
+		gvr, fqn, ok := parsePath(path)
+		if !ok {
+			w.App().Flash().Err(fmt.Errorf("unable to parse path: %q", path))
+			return
+		}
+		if err := wk.Scale(ctx, gvr, fqn, replicas); err != nil {
+			w.App().Flash().Err(err)
+			return
+		}
+	}
+	if len(paths) != 1 {
+		w.App().Flash().Infof("[%d] workloads scaled successfully", len(paths))
+	} else {
+		w.App().Flash().Infof("%s scaled successfully", paths[0])
+	}
+}
+
+func (w *Workload) dismissScaleDialog() {
+	w.App().Content.RemovePage(scaleDialogKey)
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 )
@@ -28,6 +29,7 @@ const (
 // Factory tracks various resource informers.
 type Factory struct {
 	factories  map[string]di.DynamicSharedInformerFactory
+	informed   map[string]sets.Set[*client.GVR]
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
@@ -39,6 +41,7 @@ func NewFactory(clt client.Connection) *Factory {
 	return &Factory{
 		client:     clt,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informed:   make(map[string]sets.Set[*client.GVR]),
 		forwarders: NewForwarders(),
 	}
 }
@@ -68,6 +71,7 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	clear(f.informed)
 	f.forwarders.DeleteAll()
 }
 
@@ -255,13 +259,33 @@ func (f *Factory) ForResource(ns string, gvr *client.GVR) (informers.GenericInfo
 		return inf, nil
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if client.IsClusterWide(ns) {
+		ns = client.BlankNamespace
+	}
+	if _, ok := f.informed[ns]; !ok {
+		f.informed[ns] = sets.New[*client.GVR]()
+	}
+	f.informed[ns].Insert(gvr)
 	fact.Start(f.stopChan)
 
 	return inf, nil
 }
 
+// CachedGVRs returns the resources currently watched per namespace.
+func (f *Factory) CachedGVRs() map[string][]*client.GVR {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	mm := make(map[string][]*client.GVR, len(f.informed))
+	for ns, gg := range f.informed {
+		mm[ns] = gg.UnsortedList()
+	}
+
+	return mm
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.BlankNamespace
@@ -325,6 +349,9 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 // BOZO!! Review!!!
 func (f *Factory) ValidatePortForwards() {
 	for k, fwd := range f.forwarders {
+		if fwd.KeepAlive() {
+			continue
+		}
 		tokens := strings.Split(k, ":")
 		if len(tokens) != 2 {
 			slog.Error("Invalid port-forward key", slogs.Key, k)
@@ -48,6 +48,12 @@ type Forwarder interface {
 
 	// HasPortMapping returns true if port mapping exists.
 	HasPortMapping(string) bool
+
+	// Status returns the forward connection status.
+	Status() string
+
+	// KeepAlive returns true if the forward is re-established when its pod goes away.
+	KeepAlive() bool
 }
 
 // Forwarders tracks active port forwards.
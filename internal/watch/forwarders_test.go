@@ -186,3 +186,5 @@ func (noOpForwarder) SetActive(bool)             {}
 func (noOpForwarder) Age() time.Time             { return time.Now() }
 func (noOpForwarder) HasPortMapping(string) bool { return false }
 func (noOpForwarder) Address() string            { return "" }
+func (noOpForwarder) Status() string             { return "" }
+func (noOpForwarder) KeepAlive() bool            { return false }